@@ -1,7 +1,9 @@
 package event
 
 import (
+	"github.com/pkg/errors"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	ServiceNotification "github.com/sergeygardner/meal-planner-api/infrastructure/service/notification"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -12,11 +14,40 @@ const (
 
 var userConfirmationInActivePanicMessage = "an error occurred while executing UserConfirmationEvent. The UserConfirmation is inactive."
 
-// UserConfirmationEvent sends confirmation to the user. It is Panic while UserConfirmation.Active is false
-func UserConfirmationEvent(userConfirmation *DomainEntity.UserConfirmation) {
+// UserConfirmationMessage is the data of AuthConfirmationTopicName, User is the recipient of UserConfirmation.
+type UserConfirmationMessage struct {
+	User             *DomainEntity.User
+	UserConfirmation *DomainEntity.UserConfirmation
+}
+
+// UserConfirmationEvent sends confirmation to the user by the configured notifier, the code is only logged when there
+// is no notifier. It is Panic while UserConfirmation.Active is false
+func UserConfirmationEvent(message *UserConfirmationMessage) {
+	userConfirmation := message.UserConfirmation
+
 	if !userConfirmation.Active {
 		panic(userConfirmationInActivePanicMessage)
-	} else {
+	}
+
+	notifier := ServiceNotification.GetNotifier()
+
+	if notifier == nil {
 		log.Infof("I have sent the confirmation with code '%s'\n", userConfirmation.Value)
+
+		return
+	}
+
+	errorNotify := notifier.Notify(
+		userConfirmation.Type.String(),
+		message.User.Username,
+		map[string]string{
+			"Name":     message.User.Name,
+			"Username": message.User.Username,
+			"Code":     userConfirmation.Value,
+		},
+	)
+
+	if errorNotify != nil {
+		log.Error(errors.Wrapf(errorNotify, "an error occurred while sending a confirmation by provided data userId=%s", message.User.Id))
 	}
 }
@@ -3,7 +3,12 @@ package event
 import (
 	"github.com/google/uuid"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	ServiceNotification "github.com/sergeygardner/meal-planner-api/infrastructure/service/notification"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -80,8 +85,39 @@ func TestUserConfirmationEvent(t *testing.T) {
 					}
 				}()
 
-				UserConfirmationEvent(testCase.UserConfirmation)
+				UserConfirmationEvent(&UserConfirmationMessage{User: &DomainEntity.User{}, UserConfirmation: testCase.UserConfirmation})
 			},
 		)
 	}
 }
+
+func TestUserConfirmationEventNotify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notification.log")
+
+	ServiceNotification.SetNotifier(&notification.DSN{Type: notification.FileType.String(), Path: path, Locale: "ru-RU", Attempts: 1})
+
+	user := &DomainEntity.User{Id: uuid.New()}
+	user.Username = "username@meal-planner.local"
+	user.Name = "Name"
+
+	UserConfirmationEvent(
+		&UserConfirmationMessage{
+			User: user,
+			UserConfirmation: &DomainEntity.UserConfirmation{
+				Id:     uuid.New(),
+				UserId: user.Id,
+				Value:  "123456",
+				Active: true,
+				Type:   kind.UserConfirmationTypePasswordReset,
+			},
+		},
+	)
+
+	content, errorReadFile := os.ReadFile(path)
+
+	assert.Nil(t, errorReadFile)
+	assert.Contains(t, string(content), "To: username@meal-planner.local")
+	assert.Contains(t, string(content), "Locale: ru")
+	assert.Contains(t, string(content), "Здравствуйте, Name!")
+	assert.Contains(t, string(content), "Ваш код для сброса пароля: 123456.")
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -18,6 +19,8 @@ var (
 )
 
 func AltNameCreate(userId *uuid.UUID, entityId *uuid.UUID, altNameDTO *DomainEntity.AltName) (*DomainEntity.AltName, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	altNameRepository := InfrastructureService.GetFactoryRepository().GetAltNameRepository()
 	criteria := altNameRepository.GetCriteria().GetCriteriaByName(&altNameDTO.Name, nil)
 	criteria = altNameRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
@@ -45,14 +48,20 @@ func AltNameCreate(userId *uuid.UUID, entityId *uuid.UUID, altNameDTO *DomainEnt
 }
 
 func AltNamesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainEntity.AltName, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildAltNameEntities(nil, userId, entityId, criteria)
 }
 
 func AltNameInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainEntity.AltName, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightRead)
+
 	return getAltNameEntity(id, userId, entityId, criteria)
 }
 
 func AltNameUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, altNameDTO *DomainEntity.AltName) (*DomainEntity.AltName, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	altNameRepository := InfrastructureService.GetFactoryRepository().GetAltNameRepository()
 	altName, errorAltName := getAltNameEntity(id, userId, entityId, nil)
 
@@ -91,6 +100,8 @@ func AltNameUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, altNam
 }
 
 func AltNameDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	altNameRepository := InfrastructureService.GetFactoryRepository().GetAltNameRepository()
 
 	criteria := altNameRepository.GetCriteria().GetCriteriaById(id, nil)
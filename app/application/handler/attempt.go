@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"github.com/pkg/errors"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	InfrastructureServiceCache "github.com/sergeygardner/meal-planner-api/infrastructure/service/cache"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+)
+
+const (
+	authAttemptCacheKey = "auth_attempt"
+	authLockoutCacheKey = "auth_lockout"
+	// authAttemptTTL is the window in minutes the failed attempts are counted in, the counter of the user is dropped
+	// by a successful login.
+	authAttemptTTL     int64 = 24 * 60
+	authAttemptUserMax int64 = 5
+	authAttemptIpMax   int64 = 20
+	// authLockoutTTL is the first lockout in minutes, every next failed attempt doubles it up to authLockoutTTLMax.
+	authLockoutTTL    int64 = 1
+	authLockoutTTLMax int64 = 60
+)
+
+var (
+	errorAuthAttemptsExceeded = errors.New("too many failed attempts, try again later")
+)
+
+type authAttemptSubject struct {
+	key     string
+	maximum int64
+}
+
+// authAttemptLocked reports whether the username or the ip address is locked out after the failed attempts. The
+// attempts are not limited when there is no cache or the cache fails, so the login keeps working. The null cache
+// never counts, it is warned about at the start, see cache.Counts.
+func authAttemptLocked(username string, ip string) bool {
+	cacheManager := InfrastructureServiceCache.GetCacheManager()
+
+	if cacheManager == nil {
+		return false
+	}
+
+	attemptTTL := authAttemptTTL
+
+	for _, subject := range authAttemptSubjects(username, ip) {
+		attempts, errorAttempts := cacheManager.Increment(cache.PrepareKey(authAttemptCacheKey, subject.key), 0, &attemptTTL)
+
+		if errorAttempts != nil {
+			log.Error(errors.Wrapf(errorAttempts, "an error occurred while getting failed attempts by provided data key=%s", subject.key))
+
+			continue
+		} else if attempts < subject.maximum {
+			continue
+		}
+
+		lockoutTTL := ApplicationService.AttemptLockout(attempts, subject.maximum, authLockoutTTL, authLockoutTTLMax)
+		locked, errorLocked := cacheManager.Increment(authLockoutKey(subject.key, attempts), 0, &lockoutTTL)
+
+		if errorLocked != nil {
+			log.Error(errors.Wrapf(errorLocked, "an error occurred while getting a lockout by provided data key=%s", subject.key))
+		} else if locked > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authAttemptFail counts the failed attempt of the username and the ip address and locks them out when the maximum of
+// the attempts is reached. The lockout is kept by the amount of the attempts, so every next one gets its own longer
+// lockout.
+func authAttemptFail(username string, ip string) {
+	cacheManager := InfrastructureServiceCache.GetCacheManager()
+
+	if cacheManager == nil {
+		return
+	}
+
+	attemptTTL := authAttemptTTL
+
+	for _, subject := range authAttemptSubjects(username, ip) {
+		attempts, errorAttempts := cacheManager.Increment(cache.PrepareKey(authAttemptCacheKey, subject.key), 1, &attemptTTL)
+
+		if errorAttempts != nil {
+			log.Error(errors.Wrapf(errorAttempts, "an error occurred while counting a failed attempt by provided data key=%s", subject.key))
+
+			continue
+		} else if attempts < subject.maximum {
+			continue
+		}
+
+		lockoutTTL := ApplicationService.AttemptLockout(attempts, subject.maximum, authLockoutTTL, authLockoutTTLMax)
+		_, errorLocked := cacheManager.Increment(authLockoutKey(subject.key, attempts), 1, &lockoutTTL)
+
+		if errorLocked != nil {
+			log.Error(errors.Wrapf(errorLocked, "an error occurred while locking out by provided data key=%s", subject.key))
+		}
+	}
+}
+
+// authAttemptReset drops the failed attempts of the username after a successful login, the attempts of the ip address
+// are kept, so the login to a known account doesn't allow to go on guessing the others.
+func authAttemptReset(username string) {
+	cacheManager := InfrastructureServiceCache.GetCacheManager()
+
+	if cacheManager == nil {
+		return
+	}
+
+	_ = cacheManager.Delete(cache.PrepareKey(authAttemptCacheKey, authAttemptUserKey(username)))
+}
+
+func authAttemptSubjects(username string, ip string) []authAttemptSubject {
+	subjects := []authAttemptSubject{{key: authAttemptUserKey(username), maximum: authAttemptUserMax}}
+
+	if ip != "" {
+		subjects = append(subjects, authAttemptSubject{key: "ip:" + ip, maximum: authAttemptIpMax})
+	}
+
+	return subjects
+}
+
+func authAttemptUserKey(username string) string {
+	return "user:" + username
+}
+
+func authLockoutKey(key string, attempts int64) []byte {
+	return cache.PrepareKey(authLockoutCacheKey, key, ":", strconv.FormatInt(attempts, 10))
+}
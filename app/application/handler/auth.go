@@ -1,14 +1,17 @@
 package handler
 
 import (
+	"crypto/subtle"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sergeygardner/meal-planner-api/application/event"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service"
 	ApplicationServicePassword "github.com/sergeygardner/meal-planner-api/application/service/password"
 	"github.com/sergeygardner/meal-planner-api/application/service/update"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/model"
 	"github.com/sergeygardner/meal-planner-api/domain/response"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
@@ -20,86 +23,183 @@ import (
 )
 
 var (
-	errorUserNotFound            = errors.New("user is not found by credentials")
+	errorUserNotFound            = errors.New("the credentials or the code are wrong")
 	errorUserRegister            = errors.New("user has not registered by credentials")
 	errorAuthConfirmationNotSent = errors.New("the server hasn't been sent the confirmation")
+	errorUserDisabled            = errors.New("user is disabled")
+	errorAuthTokenRevoked        = errors.New("token has been revoked")
+	errorAuthTokenReused         = errors.New("token has been already exchanged, the session is revoked")
+	errorAuthTokenNotRefresh     = errors.New("token is not a refresh token")
+	errorAuthTokenNotAccess      = errors.New("token is not an access token")
+	errorAuthPasswordWrong       = errors.New("the current password is wrong")
+	errorAuthPasswordEmpty       = errors.New("the new password is empty")
 )
 
-func AuthCredentials(authCredentialsDTO dto.UserCredentialsDTO) (*response.AuthConfirmation, *entity.UserConfirmation, error) {
-	userRepository := repository.GetFactoryRepository().GetUserRepository()
-	user, errorUser := userRepository.FindOne(userRepository.GetCriteriaByUsername(authCredentialsDTO.Username))
-	passwordOk := ApplicationServicePassword.CheckPassword(authCredentialsDTO.Password, user.Password)
+// AuthCredentials checks the credentials of the user and sends the confirmation code, a user who enabled the two-factor
+// authentication confirms with the code of the authenticator application instead, so nothing is sent. The failed
+// attempts are counted by the username and the ip address, and the wrong username and the wrong password get the same
+// error, so the response doesn't reveal whether the user exists.
+func AuthCredentials(authCredentialsDTO dto.UserCredentialsDTO, ip string) (*response.AuthConfirmation, *entity.UserConfirmation, error) {
+	if authAttemptLocked(authCredentialsDTO.Username, ip) {
+		return nil, nil, errorAuthAttemptsExceeded
+	}
+
+	user, userOk := authUserCheck(authCredentialsDTO)
+
+	if !userOk {
+		authAttemptFail(authCredentialsDTO.Username, ip)
 
-	if errorUser != nil || user == nil || !passwordOk {
 		return nil, nil, errorUserNotFound
+	} else if user.Status == kind.UserStatusDisabled {
+		return nil, nil, errorUserDisabled
+	} else if _, errorUserTotp := userTotpFind(&user.Id, kind.UserTotpStatusEnabled); errorUserTotp == nil {
+		return &response.AuthConfirmation{Message: "Confirm with the code of the authenticator application", Status: http.StatusOK}, nil, nil
 	} else {
-		userConfirmationRepository := repository.GetFactoryRepository().GetUserConfirmationRepository()
-		userConfirmation, errorConfirmationFindOne := userConfirmationRepository.FindOne(userConfirmationRepository.GetCriteriaByUserIdAndActive(user))
+		userConfirmation, errorUserConfirmation := authConfirmationSend(user, kind.UserConfirmationTypeAuth)
 
-		if errorConfirmationFindOne != nil {
-			userConfirmationInsertOne, errorConfirmationInsertOne := userConfirmationRepository.InsertOne(prepareUserConfirmationRepositoryInsert(*user))
-			userConfirmation = userConfirmationInsertOne
+		if errorUserConfirmation != nil {
+			return nil, nil, errors.Wrapf(errorUserConfirmation, "an error occurred while sending a confirmation by provided data username=%s", authCredentialsDTO.Username)
+		}
 
-			if errorConfirmationInsertOne != nil {
-				return nil, nil, errors.Wrapf(errorAuthConfirmationNotSent, "an error occurred while inserting a confirmation in the database by provided data %s", authCredentialsDTO)
-			}
+		return &response.AuthConfirmation{Message: "The server has been sent the confirmation", Status: http.StatusOK}, userConfirmation, nil
+	}
+}
+
+// AuthConfirmation issues the tokens when the code is right, it is the code of the authenticator application or
+// a recovery code for a user who enabled the two-factor authentication and the sent one otherwise. The wrong
+// credentials and the wrong code get the same error and are counted as the failed attempts, the successful one drops
+// the failed attempts of the user.
+func AuthConfirmation(authConfirmationDTO dto.AuthConfirmationDTO, ip string) (*response.AuthToken, error) {
+	userConfirmationRepository := repository.GetFactoryRepository().GetUserConfirmationRepository()
+
+	if authAttemptLocked(authConfirmationDTO.Username, ip) {
+		return nil, errorAuthAttemptsExceeded
+	}
+
+	user, userOk := authUserCheck(authConfirmationDTO.UserCredentialsDTO)
+
+	if !userOk {
+		authAttemptFail(authConfirmationDTO.Username, ip)
+
+		return nil, errorUserNotFound
+	} else if user.Status == kind.UserStatusDisabled {
+		return nil, errorUserDisabled
+	} else if userTotp, errorUserTotp := userTotpFind(&user.Id, kind.UserTotpStatusEnabled); errorUserTotp == nil {
+		if !userTotpCheck(userTotp, authConfirmationDTO.Code) {
+			authAttemptFail(authConfirmationDTO.Username, ip)
+
+			return nil, errorUserNotFound
 		}
+	} else {
+		userConfirmation, errorUserConfirmationFindOne := userConfirmationRepository.FindOne(userConfirmationRepository.GetCriteriaByUserIdAndActive(user, kind.UserConfirmationTypeAuth))
 
-		messageBusService := InfrastructureService.GetMessageBusService()
-		errorMessageBusServiceAddEventListener := messageBusService.AddAuthConfirmationEvent()
+		if errorUserConfirmationFindOne != nil {
+			authAttemptFail(authConfirmationDTO.Username, ip)
 
-		if errorMessageBusServiceAddEventListener != nil {
-			return nil, nil, errors.Wrap(errorAuthConfirmationNotSent, "an error occurred while adding a confirmation event")
-		} else {
-			defer func(messageBusService InfrastructureService.MessageBusServiceInterface, topic string, data interface{}) {
-				errorMessageBusServicePublish := messageBusService.Publish(topic, data)
-
-				if errorMessageBusServicePublish != nil {
-					log.Error(errors.Wrapf(errorMessageBusServicePublish, "an error occurred while publising a confirmation by provided data topic=%s,data=%s", topic, data))
-					return
-				}
-
-				errorMessageBusServiceRemoveEventListener := messageBusService.RemoveAuthConfirmationEvent()
-				if errorMessageBusServiceRemoveEventListener != nil {
-					log.Error(errors.Wrap(errorMessageBusServiceRemoveEventListener, "an error occurred while removing a confirmation event"))
-					return
-				}
-			}(messageBusService, event.AuthConfirmationTopicName, userConfirmation)
+			return nil, errorUserNotFound
 		}
 
-		return &response.AuthConfirmation{Message: "The server has been sent the confirmation", Status: http.StatusOK}, userConfirmation, nil
+		userConfirmationOk, errorUserConfirmationCheck := authConfirmationCheck(userConfirmation, authConfirmationDTO.Code)
+
+		if errorUserConfirmationCheck != nil {
+			return nil, errorUserConfirmationCheck
+		} else if !userConfirmationOk {
+			authAttemptFail(authConfirmationDTO.Username, ip)
+
+			return nil, errorUserNotFound
+		}
+	}
+
+	authAttemptReset(authConfirmationDTO.Username)
+
+	return AuthToken(user)
+}
+
+// AuthPasswordReset sends the one-time code to the user to set a new password by AuthPasswordResetConfirmation. The
+// unknown and the disabled users get the same response without sending anything, so the response doesn't reveal
+// whether the user exists.
+func AuthPasswordReset(authPasswordResetDTO dto.AuthPasswordResetDTO) (*response.AuthConfirmation, *entity.UserConfirmation, error) {
+	userRepository := repository.GetFactoryRepository().GetUserRepository()
+	user, errorUser := userRepository.FindOne(userRepository.GetCriteriaByUsername(authPasswordResetDTO.Username))
+	authConfirmation := &response.AuthConfirmation{Message: "The server has been sent the confirmation", Status: http.StatusOK}
+
+	if errorUser != nil || user == nil || user.Status == kind.UserStatusDisabled {
+		return authConfirmation, nil, nil
+	}
+
+	userConfirmation, errorUserConfirmation := authConfirmationSend(user, kind.UserConfirmationTypePasswordReset)
+
+	if errorUserConfirmation != nil {
+		return nil, nil, errors.Wrapf(errorUserConfirmation, "an error occurred while sending a confirmation by provided data username=%s", authPasswordResetDTO.Username)
 	}
+
+	return authConfirmation, userConfirmation, nil
 }
 
-func AuthConfirmation(authConfirmationDTO dto.AuthConfirmationDTO) (*response.AuthToken, error) {
+// AuthPasswordResetConfirmation sets the new password of the user by the code sent by AuthPasswordReset and revokes
+// the sessions of the user. The unknown user and the wrong code get the same error and are counted as the failed
+// attempts.
+func AuthPasswordResetConfirmation(authPasswordResetConfirmationDTO dto.AuthPasswordResetConfirmationDTO, ip string) (bool, error) {
 	userRepository := repository.GetFactoryRepository().GetUserRepository()
 	userConfirmationRepository := repository.GetFactoryRepository().GetUserConfirmationRepository()
-	user, errorUserFindOne := userRepository.FindOne(userRepository.GetCriteriaByUsername(authConfirmationDTO.UserCredentialsDTO.Username))
-	passwordOk := ApplicationServicePassword.CheckPassword(authConfirmationDTO.Password, user.Password)
 
-	if errorUserFindOne != nil {
-		return nil, errors.Wrapf(errorUserFindOne, "an error occurred while getting a user by provided data %s", authConfirmationDTO)
-	} else if !passwordOk {
-		return nil, errors.Wrapf(errorUserNotFound, "an error occurred while checking a password for a user by provided data password=%s", authConfirmationDTO.Password)
-	} else {
-		userConfirmation, errorUserConfirmationFindOne := userConfirmationRepository.FindOne(userConfirmationRepository.GetCriteriaByUserIdAndActive(user))
+	if authAttemptLocked(authPasswordResetConfirmationDTO.Username, ip) {
+		return false, errorAuthAttemptsExceeded
+	}
 
-		if errorUserConfirmationFindOne != nil || userConfirmation.Value != authConfirmationDTO.Code {
-			return nil, errorUserNotFound
-		} else {
-			errorSetUserConfirmationInActive := update.SetUserConfirmationInActive(userConfirmation)
+	user, errorUser := userRepository.FindOne(userRepository.GetCriteriaByUsername(authPasswordResetConfirmationDTO.Username))
 
-			if errorSetUserConfirmationInActive != nil {
-				return nil, errors.Wrapf(errorSetUserConfirmationInActive, "an error occurred while setting a confirmation to the inactive status a user by provided data %v", userConfirmation)
-			}
+	if errorUser != nil || user == nil || user.Status == kind.UserStatusDisabled {
+		authAttemptFail(authPasswordResetConfirmationDTO.Username, ip)
 
-			return AuthToken(user)
-		}
+		return false, errorUserNotFound
+	}
+
+	userConfirmation, errorUserConfirmation := userConfirmationRepository.FindOne(userConfirmationRepository.GetCriteriaByUserIdAndActive(user, kind.UserConfirmationTypePasswordReset))
+
+	if errorUserConfirmation != nil {
+		authAttemptFail(authPasswordResetConfirmationDTO.Username, ip)
+
+		return false, errorUserNotFound
+	}
+
+	userConfirmationOk, errorUserConfirmationCheck := authConfirmationCheck(userConfirmation, authPasswordResetConfirmationDTO.Code)
+
+	if errorUserConfirmationCheck != nil {
+		return false, errorUserConfirmationCheck
+	} else if !userConfirmationOk {
+		authAttemptFail(authPasswordResetConfirmationDTO.Username, ip)
+
+		return false, errorUserNotFound
+	}
+
+	authAttemptReset(authPasswordResetConfirmationDTO.Username)
+
+	return authPasswordSet(user, authPasswordResetConfirmationDTO.Password)
+}
+
+// AuthPasswordChange sets the new password of the user when the current one is right and revokes the sessions of
+// the user.
+func AuthPasswordChange(userId *uuid.UUID, authPasswordChangeDTO dto.AuthPasswordChangeDTO) (bool, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return false, errors.Wrapf(errorUser, "an error occurred while getting a user by provided data userId=%s", userId)
+	} else if !ApplicationServicePassword.CheckPassword(user.Password, authPasswordChangeDTO.Password) {
+		return false, errorAuthPasswordWrong
 	}
+
+	return authPasswordSet(user, authPasswordChangeDTO.PasswordNew)
 }
 
 func AuthToken(user *entity.User) (*response.AuthToken, error) {
-	authToken, errorMakeTokens := makeTokens(user)
+	userSession, errorUserSession := userSessionCreate(user)
+
+	if errorUserSession != nil {
+		return nil, errorUserSession
+	}
+
+	authToken, errorMakeTokens := makeTokens(user, userSession)
 
 	if errorMakeTokens != nil {
 		return nil, errors.Wrapf(errorMakeTokens, "an error occurred while making tokens by provided data %v", user)
@@ -119,6 +219,90 @@ func AuthTokenByUserId(userId *uuid.UUID) (*response.AuthToken, error) {
 	}
 }
 
+// AuthTokenVerify checks that the token is of the type, the user of the token still exists, is not disabled and has not
+// been logged out after the token was issued and that the session of the token is not revoked. The time of issuing is
+// kept in seconds, so the logout is compared in seconds as well.
+func AuthTokenVerify(token *model.Token, tokenType kind.TokenType) error {
+	if token.Type != tokenType && tokenType == kind.TokenTypeRefresh {
+		return errorAuthTokenNotRefresh
+	} else if token.Type != tokenType {
+		return errorAuthTokenNotAccess
+	}
+
+	user, errorUser := UserInfo(&token.UserId)
+
+	if errorUser != nil || user.Status == kind.UserStatusDisabled {
+		return errorAuthTokenRevoked
+	}
+
+	issuedAt := time.Time{}
+
+	if token.IssuedAt != nil {
+		issuedAt = token.IssuedAt.Time
+	}
+
+	if issuedAt.Before(user.DateLogout.Truncate(time.Second)) {
+		return errorAuthTokenRevoked
+	}
+
+	if token.SessionId != uuid.Nil && userSessionRevoked(token) {
+		return errorAuthTokenRevoked
+	}
+
+	return nil
+}
+
+// AuthRefresh exchanges the refresh token for the new pair of tokens of the same session. Every refresh token can be
+// exchanged once, an attempt to exchange the previous one means it has leaked, so the whole session is revoked.
+func AuthRefresh(token *model.Token) (*response.AuthToken, error) {
+	if token.Type != kind.TokenTypeRefresh {
+		return nil, errorAuthTokenNotRefresh
+	}
+
+	userSession, errorUserSession := userSessionFind(&token.SessionId, &token.UserId)
+
+	if errorUserSession != nil {
+		return nil, errorAuthTokenRevoked
+	} else if userSession.Status != kind.UserSessionStatusActive || !userSession.DateExpire.After(time.Now().UTC()) {
+		return nil, errorAuthTokenRevoked
+	}
+
+	if userSession.RefreshTokenId.String() != token.ID {
+		errorUserSessionRevoke := userSessionRevoke(userSession)
+
+		if errorUserSessionRevoke != nil {
+			log.Error(errors.Wrapf(errorUserSessionRevoke, "an error occurred while revoking a reused session by provided data %v", userSession))
+		}
+
+		return nil, errorAuthTokenReused
+	}
+
+	user, errorUser := UserInfo(&token.UserId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while getting a user by provided data userId=%s", token.UserId)
+	}
+
+	userSessionRotated, errorUserSessionRotated := userSessionRotate(userSession)
+
+	if errorUserSessionRotated != nil {
+		return nil, errorUserSessionRotated
+	}
+
+	authToken, errorMakeTokens := makeTokens(user, userSessionRotated)
+
+	if errorMakeTokens != nil {
+		return nil, errors.Wrapf(errorMakeTokens, "an error occurred while making tokens by provided data %v", user)
+	}
+
+	return authToken, nil
+}
+
+// AuthLogout revokes the session of the token.
+func AuthLogout(token *model.Token) (bool, error) {
+	return UserSessionRevoke(&token.SessionId, &token.UserId)
+}
+
 func AuthRegister(userRegisterDTO dto.UserRegisterDTO) (*entity.User, error) {
 	userRepository := repository.GetFactoryRepository().GetUserRepository()
 
@@ -143,15 +327,159 @@ func AuthRegister(userRegisterDTO dto.UserRegisterDTO) (*entity.User, error) {
 	}
 }
 
-func getNewTokenSignedString(user *entity.User, expiresAt time.Duration) (string, error) {
+// authConfirmationSend sends the active confirmation of the type to the user, a new one is created when there is no
+// such a confirmation yet or the one has expired or run out of the attempts.
+func authConfirmationSend(user *entity.User, confirmationType kind.UserConfirmationType) (*entity.UserConfirmation, error) {
+	userConfirmationRepository := repository.GetFactoryRepository().GetUserConfirmationRepository()
+	userConfirmation, errorConfirmationFindOne := userConfirmationRepository.FindOne(userConfirmationRepository.GetCriteriaByUserIdAndActive(user, confirmationType))
+
+	if errorConfirmationFindOne == nil && !ApplicationService.UserConfirmationUsable(userConfirmation, time.Now().UTC()) {
+		errorSetUserConfirmationInActive := update.SetUserConfirmationInActive(userConfirmation)
+
+		if errorSetUserConfirmationInActive != nil {
+			return nil, errors.Wrapf(errorSetUserConfirmationInActive, "an error occurred while setting a confirmation to the inactive status a user by provided data %v", userConfirmation)
+		}
+	}
+
+	if errorConfirmationFindOne != nil || !userConfirmation.Active {
+		userConfirmationInsertOne, errorConfirmationInsertOne := userConfirmationRepository.InsertOne(prepareUserConfirmationRepositoryInsert(*user, confirmationType))
+		userConfirmation = userConfirmationInsertOne
+
+		if errorConfirmationInsertOne != nil {
+			return nil, errors.Wrapf(errorAuthConfirmationNotSent, "an error occurred while inserting a confirmation in the database by provided data userId=%s", user.Id)
+		}
+	}
+
+	messageBusService := InfrastructureService.GetMessageBusService()
+	errorMessageBusServiceAddEventListener := messageBusService.AddAuthConfirmationEvent()
+
+	if errorMessageBusServiceAddEventListener != nil {
+		return nil, errors.Wrap(errorAuthConfirmationNotSent, "an error occurred while adding a confirmation event")
+	} else {
+		defer func(messageBusService InfrastructureService.MessageBusServiceInterface, topic string, data interface{}) {
+			errorMessageBusServicePublish := messageBusService.Publish(topic, data)
+
+			if errorMessageBusServicePublish != nil {
+				log.Error(errors.Wrapf(errorMessageBusServicePublish, "an error occurred while publising a confirmation by provided data topic=%s,data=%s", topic, data))
+				return
+			}
+
+			errorMessageBusServiceRemoveEventListener := messageBusService.RemoveAuthConfirmationEvent()
+			if errorMessageBusServiceRemoveEventListener != nil {
+				log.Error(errors.Wrap(errorMessageBusServiceRemoveEventListener, "an error occurred while removing a confirmation event"))
+				return
+			}
+		}(messageBusService, event.AuthConfirmationTopicName, &event.UserConfirmationMessage{User: user, UserConfirmation: userConfirmation})
+	}
+
+	return userConfirmation, nil
+}
+
+// authConfirmationCheck reports whether the code is the value of the confirmation and deactivates the confirmation when
+// it is. Every attempt is counted atomically in the database before the code is compared, so the concurrent attempts
+// can't go beyond the limit, and the confirmation is deactivated when it runs out of the attempts. The code is compared
+// in constant time and the expired confirmation never matches.
+func authConfirmationCheck(userConfirmation *entity.UserConfirmation, code string) (bool, error) {
+	userConfirmationRepository := repository.GetFactoryRepository().GetUserConfirmationRepository()
+	now := time.Now().UTC()
+
+	if !ApplicationService.UserConfirmationUsable(userConfirmation, now) {
+		return false, nil
+	}
+
+	userConfirmationCounted, errorIncrementAttempts := userConfirmationRepository.IncrementAttempts(userConfirmationRepository.GetCriteriaById(&userConfirmation.Id))
+
+	if errorIncrementAttempts != nil {
+		return false, errors.Wrapf(errorIncrementAttempts, "an error occurred while counting an attempt of a confirmation by provided data %v", userConfirmation)
+	}
+
+	userConfirmation.Attempts = userConfirmationCounted.Attempts
+	userConfirmation.Active = userConfirmationCounted.Active
+	userConfirmation.DateUpdate = now
+
+	if !userConfirmation.Active || userConfirmation.Attempts > entity.UserConfirmationAttemptsMax {
+		return false, nil
+	} else if subtle.ConstantTimeCompare([]byte(userConfirmation.Value), []byte(code)) == 1 {
+		errorSetUserConfirmationInActive := update.SetUserConfirmationInActive(userConfirmation)
+
+		if errorSetUserConfirmationInActive != nil {
+			return false, errors.Wrapf(errorSetUserConfirmationInActive, "an error occurred while setting a confirmation to the inactive status a user by provided data %v", userConfirmation)
+		}
+
+		return true, nil
+	} else if userConfirmation.Attempts >= entity.UserConfirmationAttemptsMax {
+		errorSetUserConfirmationInActive := update.SetUserConfirmationInActive(userConfirmation)
+
+		if errorSetUserConfirmationInActive != nil {
+			return false, errors.Wrapf(errorSetUserConfirmationInActive, "an error occurred while deactivating a confirmation run out of the attempts by provided data %v", userConfirmation)
+		}
+	}
+
+	return false, nil
+}
+
+// authUserCheck returns the user of the credentials, the password is checked even when there is no such a user, so
+// the response takes as long as for the one who exists.
+func authUserCheck(userCredentialsDTO dto.UserCredentialsDTO) (*entity.User, bool) {
+	userRepository := repository.GetFactoryRepository().GetUserRepository()
+	user, errorUser := userRepository.FindOne(userRepository.GetCriteriaByUsername(userCredentialsDTO.Username))
+
+	if errorUser != nil || user == nil {
+		return nil, ApplicationServicePassword.CheckPasswordDummy(userCredentialsDTO.Password)
+	}
+
+	return user, ApplicationServicePassword.CheckPassword(user.Password, userCredentialsDTO.Password)
+}
+
+func authPasswordSet(user *entity.User, password string) (bool, error) {
+	if password == "" {
+		return false, errorAuthPasswordEmpty
+	}
+
+	passwordHashed, errorCastPassword := ApplicationServicePassword.CastPassword(password)
+
+	if errorCastPassword != nil {
+		return false, errors.Wrapf(errorCastPassword, "an error occurred while casting a password for a user by provided data userId=%s", user.Id)
+	}
+
+	user.Password = string(passwordHashed)
+
+	_, errorUserSave := userSave(user)
+
+	if errorUserSave != nil {
+		return false, errorUserSave
+	}
+
+	// the sessions are revoked after the password is saved, so a session made by the old password meanwhile is revoked
+	// as well
+	errorUserSessionsRevoke := userSessionsRevoke(&user.Id)
+
+	if errorUserSessionsRevoke != nil {
+		return false, errors.Wrapf(errorUserSessionsRevoke, "an error occurred while revoking sessions of a user by provided data userId=%s", user.Id)
+	}
+
+	return true, nil
+}
+
+func getNewTokenSignedString(user *entity.User, userSession *entity.UserSession, tokenType kind.TokenType, expiresAt time.Duration) (string, error) {
+	tokenId := uuid.NewString()
+
+	if tokenType == kind.TokenTypeRefresh {
+		tokenId = userSession.RefreshTokenId.String()
+	}
+
 	accessToken := jwt.NewWithClaims(
 		jwt.SigningMethodHS256,
 		model.Token{
 			UserId:    user.Id,
 			Username:  user.Username,
 			UserRoles: user.Roles,
+			SessionId: userSession.Id,
+			Type:      tokenType,
 			RegisteredClaims: jwt.RegisteredClaims{
 				ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(expiresAt)),
+				IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+				ID:        tokenId,
 			},
 		},
 	)
@@ -159,9 +487,9 @@ func getNewTokenSignedString(user *entity.User, expiresAt time.Duration) (string
 	return accessToken.SignedString(ApplicationMiddlewareJWT.GetJwtKey())
 }
 
-func makeTokens(user *entity.User) (*response.AuthToken, error) {
-	accessTokenSignedString, errorAccessTokenSignedString := getNewTokenSignedString(user, model.JWTAccessTokenExpire)
-	refreshTokenSignedString, errorRefreshTokenSignedString := getNewTokenSignedString(user, model.JWTRefreshTokenExpire)
+func makeTokens(user *entity.User, userSession *entity.UserSession) (*response.AuthToken, error) {
+	accessTokenSignedString, errorAccessTokenSignedString := getNewTokenSignedString(user, userSession, kind.TokenTypeAccess, model.JWTAccessTokenExpire)
+	refreshTokenSignedString, errorRefreshTokenSignedString := getNewTokenSignedString(user, userSession, kind.TokenTypeRefresh, model.JWTRefreshTokenExpire)
 
 	if errorAccessTokenSignedString != nil {
 		return nil, errors.Wrapf(errorAccessTokenSignedString, "an error occurred while creating an access token for a user by privided data %v", user)
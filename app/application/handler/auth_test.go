@@ -47,7 +47,7 @@ func TestAuthCredentials(t *testing.T) {
 					}
 				}()
 
-				testResponseAuthConfirmation, testUserConfirmation, errorAuthCredentials = AuthCredentials(testCase.UserCredentialsDTO)
+				testResponseAuthConfirmation, testUserConfirmation, errorAuthCredentials = AuthCredentials(testCase.UserCredentialsDTO, "")
 
 				if testCase.MustBeFault {
 					assert.Nil(t, testResponseAuthConfirmation)
@@ -98,7 +98,7 @@ func TestAuthConfirmation(t *testing.T) {
 		t.Run(
 			testCase.Name,
 			func(t *testing.T) {
-				testResponseAuthToken, errorAuthConfirmation = AuthConfirmation(testCase.AuthConfirmationDTO)
+				testResponseAuthToken, errorAuthConfirmation = AuthConfirmation(testCase.AuthConfirmationDTO, "")
 
 				if testCase.MustBeFault {
 					assert.Nil(t, testResponseAuthToken)
@@ -248,6 +248,8 @@ func TestGetNewTokenSignedString(t *testing.T) {
 	tests := []struct {
 		Name        string
 		User        *entity.User
+		UserSession *entity.UserSession
+		TokenType   kind.TokenType
 		ExpiresAt   time.Duration
 		MustBeFault bool
 	}{
@@ -264,6 +266,8 @@ func TestGetNewTokenSignedString(t *testing.T) {
 					Roles: kind.UserRoles{kind.UserRoleCommon},
 				},
 			},
+			UserSession: &entity.UserSession{Id: uuid.New(), RefreshTokenId: uuid.New()},
+			TokenType:   kind.TokenTypeRefresh,
 			ExpiresAt:   time.Duration(1),
 			MustBeFault: false,
 		},
@@ -273,7 +277,7 @@ func TestGetNewTokenSignedString(t *testing.T) {
 		t.Run(
 			testCase.Name,
 			func(t *testing.T) {
-				tokenSignedString, errorTokenSignedString := getNewTokenSignedString(testCase.User, testCase.ExpiresAt)
+				tokenSignedString, errorTokenSignedString := getNewTokenSignedString(testCase.User, testCase.UserSession, testCase.TokenType, testCase.ExpiresAt)
 
 				if testCase.MustBeFault {
 					assert.Nil(t, tokenSignedString)
@@ -291,6 +295,7 @@ func TestMakeTokens(t *testing.T) {
 	tests := []struct {
 		Name        string
 		User        *entity.User
+		UserSession *entity.UserSession
 		MustBeFault bool
 	}{
 		{
@@ -306,6 +311,7 @@ func TestMakeTokens(t *testing.T) {
 					Roles: kind.UserRoles{kind.UserRoleCommon},
 				},
 			},
+			UserSession: &entity.UserSession{Id: uuid.New(), RefreshTokenId: uuid.New()},
 			MustBeFault: false,
 		},
 	}
@@ -314,7 +320,7 @@ func TestMakeTokens(t *testing.T) {
 		t.Run(
 			testCase.Name,
 			func(t *testing.T) {
-				responseAuthToken, errorResponseAuthToken := makeTokens(testCase.User)
+				responseAuthToken, errorResponseAuthToken := makeTokens(testCase.User, testCase.UserSession)
 
 				if testCase.MustBeFault {
 					assert.Nil(t, responseAuthToken)
@@ -329,3 +335,165 @@ func TestMakeTokens(t *testing.T) {
 		)
 	}
 }
+
+func TestAuthPassword(t *testing.T) {
+	tests := []struct {
+		Name    string
+		UserDTO dto.UserRegisterDTO
+	}{
+		{
+			Name: "Test case with resetting and changing a password",
+			UserDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernamePassword" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:     "NameTest",
+				Birthday: time.Now().UTC(),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.UserDTO)
+
+				assert.Nil(t, errorUser)
+
+				_, errorAuthTokenBefore := AuthToken(user)
+
+				assert.Nil(t, errorAuthTokenBefore)
+
+				_, userConfirmation, errorAuthPasswordReset := AuthPasswordReset(dto.AuthPasswordResetDTO{Username: user.Username})
+
+				assert.Nil(t, errorAuthPasswordReset)
+				assert.Equal(t, kind.UserConfirmationTypePasswordReset, userConfirmation.Type)
+
+				_, errorAuthPasswordResetWrong := AuthPasswordResetConfirmation(
+					dto.AuthPasswordResetConfirmationDTO{Username: user.Username, Code: "wrong", Password: "passwordReset"},
+					"",
+				)
+
+				assert.Equal(t, errorUserNotFound, errorAuthPasswordResetWrong)
+
+				authPasswordResetStatus, errorAuthPasswordResetConfirmation := AuthPasswordResetConfirmation(
+					dto.AuthPasswordResetConfirmationDTO{Username: user.Username, Code: userConfirmation.Value, Password: "passwordReset"},
+					"",
+				)
+
+				assert.True(t, authPasswordResetStatus)
+				assert.Nil(t, errorAuthPasswordResetConfirmation)
+
+				userSessions, errorUserSessions := UserSessionsInfo(&user.Id)
+
+				assert.Nil(t, errorUserSessions)
+				assert.Empty(t, userSessions)
+
+				_, _, errorAuthCredentialsOld := AuthCredentials(testCase.UserDTO.UserCredentialsDTO, "")
+
+				assert.Equal(t, errorUserNotFound, errorAuthCredentialsOld)
+
+				_, _, errorAuthCredentials := AuthCredentials(dto.UserCredentialsDTO{Username: user.Username, Password: "passwordReset"}, "")
+
+				assert.Nil(t, errorAuthCredentials)
+
+				_, errorAuthPasswordChangeWrong := AuthPasswordChange(&user.Id, dto.AuthPasswordChangeDTO{Password: "wrong", PasswordNew: "passwordChange"})
+
+				assert.Equal(t, errorAuthPasswordWrong, errorAuthPasswordChangeWrong)
+
+				_, errorAuthPasswordChangeEmpty := AuthPasswordChange(&user.Id, dto.AuthPasswordChangeDTO{Password: "passwordReset"})
+
+				assert.Equal(t, errorAuthPasswordEmpty, errorAuthPasswordChangeEmpty)
+
+				authPasswordChangeStatus, errorAuthPasswordChange := AuthPasswordChange(&user.Id, dto.AuthPasswordChangeDTO{Password: "passwordReset", PasswordNew: "passwordChange"})
+
+				assert.True(t, authPasswordChangeStatus)
+				assert.Nil(t, errorAuthPasswordChange)
+
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
+
+func TestAuthAttempt(t *testing.T) {
+	tests := []struct {
+		Name    string
+		UserDTO dto.UserRegisterDTO
+		Ip      string
+	}{
+		{
+			Name: "Test case with the failed attempts, the confirmation running out of the attempts and the lockout",
+			UserDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameAttempt" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:     "NameTest",
+				Birthday: time.Now().UTC(),
+			},
+			Ip: "192.0.2.1",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.UserDTO)
+
+				assert.Nil(t, errorUser)
+
+				_, _, errorAuthCredentialsUnknown := AuthCredentials(dto.UserCredentialsDTO{Username: "unknown" + uuid.NewString(), Password: "passwordTest"}, testCase.Ip)
+
+				assert.Equal(t, errorUserNotFound, errorAuthCredentialsUnknown)
+
+				authConfirmationUnknown, userConfirmationUnknown, errorAuthPasswordResetUnknown := AuthPasswordReset(dto.AuthPasswordResetDTO{Username: "unknown" + uuid.NewString()})
+
+				assert.Nil(t, errorAuthPasswordResetUnknown)
+				assert.NotNil(t, authConfirmationUnknown)
+				assert.Nil(t, userConfirmationUnknown)
+
+				userConfirmation, errorUserConfirmation := authConfirmationSend(user, kind.UserConfirmationTypeAuth)
+
+				assert.Nil(t, errorUserConfirmation)
+
+				for i := 0; i < entity.UserConfirmationAttemptsMax; i++ {
+					userConfirmationOk, errorUserConfirmationCheck := authConfirmationCheck(userConfirmation, "wrong")
+
+					assert.False(t, userConfirmationOk)
+					assert.Nil(t, errorUserConfirmationCheck)
+				}
+
+				assert.Equal(t, kind.UserConfirmationInActive, userConfirmation.Active)
+
+				userConfirmationOk, _ := authConfirmationCheck(userConfirmation, userConfirmation.Value)
+
+				assert.False(t, userConfirmationOk)
+
+				userConfirmationNew, errorUserConfirmationNew := authConfirmationSend(user, kind.UserConfirmationTypeAuth)
+
+				assert.Nil(t, errorUserConfirmationNew)
+				assert.NotEqual(t, userConfirmation.Id, userConfirmationNew.Id)
+
+				for i := int64(0); i < authAttemptUserMax; i++ {
+					_, _, errorAuthCredentialsWrong := AuthCredentials(dto.UserCredentialsDTO{Username: user.Username, Password: "wrong"}, testCase.Ip)
+
+					assert.Equal(t, errorUserNotFound, errorAuthCredentialsWrong)
+				}
+
+				_, _, errorAuthCredentialsLocked := AuthCredentials(testCase.UserDTO.UserCredentialsDTO, testCase.Ip)
+
+				assert.Equal(t, errorAuthAttemptsExceeded, errorAuthCredentialsLocked)
+
+				_, errorAuthConfirmationLocked := AuthConfirmation(dto.AuthConfirmationDTO{UserCredentialsDTO: testCase.UserDTO.UserCredentialsDTO, Code: userConfirmationNew.Value}, testCase.Ip)
+
+				assert.Equal(t, errorAuthAttemptsExceeded, errorAuthConfirmationLocked)
+
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
@@ -0,0 +1,397 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"io"
+	"sort"
+)
+
+var (
+	errorCatalogueCategory  = errors.New("category cannot be found by provided name")
+	errorCatalogueDuplicate = errors.New("name has been imported by a previous row")
+)
+
+// CatalogueExport writes the ingredients, units, categories or recipes of the user to the catalogue row by row
+// in the order of their names.
+func CatalogueExport(userId *uuid.UUID, catalogueEntity kind.CatalogueEntity, format kind.CatalogueFormat, w io.Writer) error {
+	catalogueWriter, errorCatalogueWriter := ApplicationServiceHelper.NewCatalogueWriter(w, catalogueEntity, format)
+
+	if errorCatalogueWriter != nil {
+		return errorCatalogueWriter
+	}
+
+	var (
+		records     []ApplicationServiceHelper.CatalogueRecord
+		errorRecord error
+	)
+
+	switch catalogueEntity {
+	case kind.CatalogueEntityUnits:
+		records, errorRecord = catalogueUnitRecords()
+	case kind.CatalogueEntityCategories:
+		records, errorRecord = catalogueCategoryRecords(userId)
+	case kind.CatalogueEntityIngredients:
+		records, errorRecord = catalogueIngredientRecords(userId)
+	case kind.CatalogueEntityRecipes:
+		records, errorRecord = catalogueRecipeRecords(userId)
+	}
+
+	if errorRecord != nil {
+		return errors.Wrapf(errorRecord, "an error occurred while exporting a catalogue of %s by privided data userId=%s", catalogueEntity, userId)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool { return records[i]["name"] < records[j]["name"] })
+
+	for _, record := range records {
+		if errorWrite := catalogueWriter.Write(record); errorWrite != nil {
+			return errorWrite
+		}
+	}
+
+	return catalogueWriter.Flush()
+}
+
+// CatalogueImport creates or updates the ingredients, units, categories or recipes of the user by their names from
+// the catalogue row by row. A row which has not changed anything is left as it is, so the import can be run again.
+// Malformed rows are reported and skipped, the dry run reports the rows without changing anything. The created recipes
+// without a status are unpublished, so they are not public until the user publishes them.
+func CatalogueImport(
+	userId *uuid.UUID,
+	catalogueEntity kind.CatalogueEntity,
+	format kind.CatalogueFormat,
+	data io.Reader,
+	dryRun bool,
+) (*DomainAggregate.CatalogueImport, error) {
+	if _, errorColumns := ApplicationServiceHelper.CatalogueColumns(catalogueEntity); errorColumns != nil {
+		return nil, errorColumns
+	}
+
+	catalogueReader, errorCatalogueReader := ApplicationServiceHelper.NewCatalogueReader(data, format)
+
+	if errorCatalogueReader != nil {
+		return nil, errors.Wrapf(errorCatalogueReader, "an error occurred while importing a catalogue of %s by privided data userId=%s", catalogueEntity, userId)
+	}
+
+	catalogueImport := &DomainAggregate.CatalogueImport{Entity: catalogueEntity, Format: format, DryRun: dryRun}
+	names := make(map[string]int64)
+
+	for {
+		row, errorRow := catalogueReader.Read()
+
+		if errorRow == io.EOF {
+			break
+		} else if errorRow != nil {
+			return nil, errors.Wrapf(errorRow, "an error occurred while importing a catalogue of %s by privided data userId=%s", catalogueEntity, userId)
+		}
+
+		catalogueImportRow := &DomainAggregate.CatalogueImportRow{Line: row.Line, Name: row.Record["name"]}
+		errorAction := row.Error
+
+		if errorAction == nil {
+			if line, ok := names[catalogueImportRow.Name]; ok {
+				errorAction = errors.Wrapf(errorCatalogueDuplicate, "line=%d", line)
+			} else {
+				catalogueImportRow.Action, errorAction = catalogueImportRecord(userId, catalogueEntity, row.Record, dryRun)
+				names[catalogueImportRow.Name] = row.Line
+			}
+		}
+
+		if errorAction != nil {
+			catalogueImportRow.Action = kind.CatalogueImportActionError
+			catalogueImportRow.Error = errorAction.Error()
+		}
+
+		switch catalogueImportRow.Action {
+		case kind.CatalogueImportActionCreate:
+			catalogueImport.Created++
+		case kind.CatalogueImportActionUpdate:
+			catalogueImport.Updated++
+		case kind.CatalogueImportActionUnchanged:
+			catalogueImport.Unchanged++
+		default:
+			catalogueImport.Failed++
+		}
+
+		catalogueImport.Rows = append(catalogueImport.Rows, catalogueImportRow)
+	}
+
+	return catalogueImport, nil
+}
+
+func catalogueImportRecord(
+	userId *uuid.UUID,
+	catalogueEntity kind.CatalogueEntity,
+	record ApplicationServiceHelper.CatalogueRecord,
+	dryRun bool,
+) (kind.CatalogueImportAction, error) {
+	switch catalogueEntity {
+	case kind.CatalogueEntityUnits:
+		return catalogueImportUnit(record, dryRun)
+	case kind.CatalogueEntityCategories:
+		return catalogueImportCategory(userId, record, dryRun)
+	case kind.CatalogueEntityIngredients:
+		return catalogueImportIngredient(userId, record, dryRun)
+	default:
+		return catalogueImportRecipe(userId, record, dryRun)
+	}
+}
+
+func catalogueImportUnit(record ApplicationServiceHelper.CatalogueRecord, dryRun bool) (kind.CatalogueImportAction, error) {
+	unitDTO, errorUnitDTO := ApplicationServiceHelper.CatalogueRecordUnit(record)
+
+	if errorUnitDTO != nil {
+		return "", errorUnitDTO
+	}
+
+	unitRepository := InfrastructureService.GetFactoryRepository().GetUnitRepository()
+	unit, errorUnit := unitRepository.FindOne(unitRepository.GetCriteria().GetCriteriaByName(&unitDTO.Name, nil))
+
+	if errorUnit != nil || unit == nil {
+		if unitDTO.Status == "" {
+			unitDTO.Status = kind.UnitStatusPublished
+		}
+
+		if !dryRun {
+			if _, errorUnitCreate := UnitCreate(unitDTO); errorUnitCreate != nil {
+				return "", errorUnitCreate
+			}
+		}
+
+		return kind.CatalogueImportActionCreate, nil
+	} else if unitDTO.Status == "" || unitDTO.Status == unit.Status {
+		return kind.CatalogueImportActionUnchanged, nil
+	}
+
+	if !dryRun {
+		if _, errorUnitUpdate := UnitUpdate(&unit.Id, unitDTO); errorUnitUpdate != nil {
+			return "", errorUnitUpdate
+		}
+	}
+
+	return kind.CatalogueImportActionUpdate, nil
+}
+
+func catalogueImportCategory(userId *uuid.UUID, record ApplicationServiceHelper.CatalogueRecord, dryRun bool) (kind.CatalogueImportAction, error) {
+	categoryDTO, errorCategoryDTO := ApplicationServiceHelper.CatalogueRecordCategory(record)
+
+	if errorCategoryDTO != nil {
+		return "", errorCategoryDTO
+	}
+
+	category, errorCategory := catalogueCategory(userId, categoryDTO.Name)
+
+	if errorCategory != nil || category == nil {
+		if categoryDTO.Status == "" {
+			categoryDTO.Status = kind.CategoryStatusPublished
+		}
+
+		if !dryRun {
+			if _, errorCategoryCreate := CategoryCreate(userId, categoryDTO); errorCategoryCreate != nil {
+				return "", errorCategoryCreate
+			}
+		}
+
+		return kind.CatalogueImportActionCreate, nil
+	} else if categoryDTO.Status == "" || categoryDTO.Status == category.Status {
+		return kind.CatalogueImportActionUnchanged, nil
+	}
+
+	if !dryRun {
+		if _, errorCategoryUpdate := CategoryUpdate(&category.Id, userId, categoryDTO); errorCategoryUpdate != nil {
+			return "", errorCategoryUpdate
+		}
+	}
+
+	return kind.CatalogueImportActionUpdate, nil
+}
+
+func catalogueImportIngredient(userId *uuid.UUID, record ApplicationServiceHelper.CatalogueRecord, dryRun bool) (kind.CatalogueImportAction, error) {
+	ingredientDTO, categoryName, errorIngredientDTO := ApplicationServiceHelper.CatalogueRecordIngredient(record)
+
+	if errorIngredientDTO != nil {
+		return "", errorIngredientDTO
+	}
+
+	if categoryName != "" {
+		category, errorCategory := catalogueCategory(userId, categoryName)
+
+		if errorCategory != nil || category == nil {
+			return "", errors.Wrapf(errorCatalogueCategory, "category=%s", categoryName)
+		}
+
+		ingredientDTO.CategoryId = category.Id
+	}
+
+	ingredientRepository := InfrastructureService.GetFactoryRepository().GetIngredientRepository()
+	criteria := ingredientRepository.GetCriteria().GetCriteriaByName(&ingredientDTO.Name, nil)
+	criteria = ingredientRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	ingredient, errorIngredient := ingredientRepository.FindOne(criteria)
+
+	if errorIngredient != nil || ingredient == nil {
+		if ingredientDTO.Status == "" {
+			ingredientDTO.Status = kind.IngredientStatusPublished
+		}
+
+		if !dryRun {
+			if _, errorIngredientCreate := IngredientCreate(userId, ingredientDTO); errorIngredientCreate != nil {
+				return "", errorIngredientCreate
+			}
+		}
+
+		return kind.CatalogueImportActionCreate, nil
+	} else if (ingredientDTO.Status == "" || ingredientDTO.Status == ingredient.Status) &&
+		(ingredientDTO.CategoryId == uuid.Nil || ingredientDTO.CategoryId == ingredient.CategoryId) {
+		return kind.CatalogueImportActionUnchanged, nil
+	}
+
+	if !dryRun {
+		if _, errorIngredientUpdate := IngredientUpdate(&ingredient.Id, userId, ingredientDTO); errorIngredientUpdate != nil {
+			return "", errorIngredientUpdate
+		}
+	}
+
+	return kind.CatalogueImportActionUpdate, nil
+}
+
+func catalogueImportRecipe(userId *uuid.UUID, record ApplicationServiceHelper.CatalogueRecord, dryRun bool) (kind.CatalogueImportAction, error) {
+	recipeDTO, errorRecipeDTO := ApplicationServiceHelper.CatalogueRecordRecipe(record)
+
+	if errorRecipeDTO != nil {
+		return "", errorRecipeDTO
+	}
+
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	criteria := recipeRepository.GetCriteria().GetCriteriaByName(&recipeDTO.Name, nil)
+	criteria = recipeRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	recipe, errorRecipe := recipeRepository.FindOne(criteria)
+
+	if errorRecipe != nil || recipe == nil {
+		if recipeDTO.Status == "" {
+			recipeDTO.Status = kind.RecipeStatusUnPublished
+		}
+
+		if !dryRun {
+			if _, errorRecipeCreate := RecipeCreate(userId, recipeDTO); errorRecipeCreate != nil {
+				return "", errorRecipeCreate
+			}
+		}
+
+		return kind.CatalogueImportActionCreate, nil
+	} else if !catalogueRecipeChanged(recipe, recipeDTO) {
+		return kind.CatalogueImportActionUnchanged, nil
+	}
+
+	if !dryRun {
+		if _, errorRecipeUpdate := RecipeUpdate(&recipe.Id, userId, recipeDTO); errorRecipeUpdate != nil {
+			return "", errorRecipeUpdate
+		}
+	}
+
+	return kind.CatalogueImportActionUpdate, nil
+}
+
+// catalogueRecipeChanged checks the filled values of the row only, because an update keeps the empty ones.
+func catalogueRecipeChanged(recipe *DomainEntity.Recipe, recipeDTO *DomainEntity.Recipe) bool {
+	return (recipeDTO.Description != "" && recipeDTO.Description != recipe.Description) ||
+		(recipeDTO.Notes != "" && recipeDTO.Notes != recipe.Notes) ||
+		(recipeDTO.Servings != 0 && recipeDTO.Servings != recipe.Servings) ||
+		(recipeDTO.Calories != 0 && recipeDTO.Calories != recipe.Calories) ||
+		(recipeDTO.Status != "" && recipeDTO.Status != recipe.Status)
+}
+
+func catalogueCategory(userId *uuid.UUID, name string) (*DomainEntity.Category, error) {
+	categoryRepository := InfrastructureService.GetFactoryRepository().GetCategoryRepository()
+	criteria := categoryRepository.GetCriteria().GetCriteriaByName(&name, nil)
+	criteria = categoryRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+
+	return categoryRepository.FindOne(criteria)
+}
+
+func catalogueUnitRecords() ([]ApplicationServiceHelper.CatalogueRecord, error) {
+	units, errorUnits := UnitsInfo(nil)
+
+	if errorUnits != nil {
+		return nil, errorUnits
+	}
+
+	records := make([]ApplicationServiceHelper.CatalogueRecord, 0, len(units))
+
+	for _, unit := range units {
+		records = append(records, ApplicationServiceHelper.CatalogueUnitRecord(unit))
+	}
+
+	return records, nil
+}
+
+func catalogueCategoryRecords(userId *uuid.UUID) ([]ApplicationServiceHelper.CatalogueRecord, error) {
+	categories, errorCategories := catalogueCategories(userId)
+
+	if errorCategories != nil {
+		return nil, errorCategories
+	}
+
+	records := make([]ApplicationServiceHelper.CatalogueRecord, 0, len(categories))
+
+	for _, category := range categories {
+		records = append(records, ApplicationServiceHelper.CatalogueCategoryRecord(category))
+	}
+
+	return records, nil
+}
+
+func catalogueIngredientRecords(userId *uuid.UUID) ([]ApplicationServiceHelper.CatalogueRecord, error) {
+	ingredients, errorIngredients := IngredientsInfo(userId, nil)
+
+	if errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	categories, errorCategories := catalogueCategories(userId)
+
+	if errorCategories != nil {
+		return nil, errorCategories
+	}
+
+	categoryNames := make(map[uuid.UUID]string, len(categories))
+
+	for _, category := range categories {
+		categoryNames[category.Id] = category.Name
+	}
+
+	records := make([]ApplicationServiceHelper.CatalogueRecord, 0, len(ingredients))
+
+	for _, ingredient := range ingredients {
+		records = append(records, ApplicationServiceHelper.CatalogueIngredientRecord(ingredient, categoryNames[ingredient.CategoryId]))
+	}
+
+	return records, nil
+}
+
+func catalogueRecipeRecords(userId *uuid.UUID) ([]ApplicationServiceHelper.CatalogueRecord, error) {
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	recipes, errorRecipes := recipeRepository.FindAll(recipeRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorRecipes != nil {
+		return nil, errorRecipes
+	}
+
+	records := make([]ApplicationServiceHelper.CatalogueRecord, 0, len(recipes))
+
+	for _, recipe := range recipes {
+		records = append(records, ApplicationServiceHelper.CatalogueRecipeRecord(recipe))
+	}
+
+	return records, nil
+}
+
+func catalogueCategories(userId *uuid.UUID) ([]*DomainEntity.Category, error) {
+	categoryRepository := InfrastructureService.GetFactoryRepository().GetCategoryRepository()
+
+	return categoryRepository.FindAll(categoryRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"time"
+)
+
+const (
+	cookableLimit    = 20
+	cookableLimitMax = 100
+)
+
+var (
+	errorCookableIngredients = errors.New("neither ingredients nor the pantry have been given to cook from")
+)
+
+// RecipesCookable ranks the recipes of the user by the ingredients given and by the pantry of the user.
+// Only the relation between the recipes and their ingredients is read to rank, the recipes are read for the best
+// ones only.
+func RecipesCookable(userId *uuid.UUID, cookableQuery *DomainAggregate.CookableQuery) ([]*DomainAggregate.Cookable, error) {
+	if len(cookableQuery.Ingredients) == 0 && !cookableQuery.UsePantry {
+		return nil, errors.Wrapf(errorCookableIngredients, "an error occurred while ranking recipes by privided data %v", cookableQuery)
+	}
+
+	limit := cookableQuery.Limit
+
+	if limit <= 0 {
+		limit = cookableLimit
+	} else if limit > cookableLimitMax {
+		limit = cookableLimitMax
+	}
+
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	recipeRepository := factoryRepository.GetRecipeRepository()
+	recipeIngredientRepository := factoryRepository.GetRecipeIngredientRepository()
+
+	var pantryItems []*DomainAggregate.PantryItem
+
+	if cookableQuery.UsePantry {
+		pantryItemRepository := factoryRepository.GetPantryItemRepository()
+		pantryItemEntities, errorPantryItems := pantryItemRepository.FindAll(pantryItemRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+		if errorPantryItems != nil {
+			return nil, errorPantryItems
+		}
+
+		for _, pantryItemEntity := range pantryItemEntities {
+			pantryItems = append(pantryItems, &DomainAggregate.PantryItem{Entity: pantryItemEntity})
+		}
+	}
+
+	recipeIngredients, errorRecipeIngredients := recipeIngredientRepository.FindAll(recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorRecipeIngredients != nil {
+		return nil, errorRecipeIngredients
+	}
+
+	matches := ApplicationServiceHelper.CookableRank(
+		recipeIngredients,
+		ApplicationServiceHelper.CookableAvailable(cookableQuery.Ingredients, pantryItems, time.Now().UTC()),
+		cookableQuery.Substitutes,
+		cookableQuery.MaxMissing,
+	)
+
+	if int64(len(matches)) > limit {
+		matches = matches[:limit]
+	}
+
+	if len(matches) == 0 {
+		return []*DomainAggregate.Cookable{}, nil
+	}
+
+	recipeIds := make([]*uuid.UUID, 0, len(matches))
+
+	for _, match := range matches {
+		recipeId := match.RecipeId
+		recipeIds = append(recipeIds, &recipeId)
+	}
+
+	recipes, errorRecipes := recipeRepository.FindAll(recipeRepository.GetCriteria().GetCriteriaByIds(recipeIds, recipeRepository.GetCriteria().GetCriteriaByUserId(userId, nil)))
+
+	if errorRecipes != nil {
+		return nil, errorRecipes
+	}
+
+	recipesById := make(map[uuid.UUID]*DomainEntity.Recipe, len(recipes))
+
+	for _, recipe := range recipes {
+		recipesById[recipe.Id] = recipe
+	}
+
+	cookables := make([]*DomainAggregate.Cookable, 0, len(matches))
+
+	for _, match := range matches {
+		recipe, ok := recipesById[match.RecipeId]
+
+		if !ok {
+			continue
+		}
+
+		cookables = append(
+			cookables,
+			&DomainAggregate.Cookable{
+				Entity:      recipe,
+				Score:       match.Score,
+				Coverage:    match.Coverage,
+				Available:   match.Available,
+				Substituted: match.Substituted,
+				Missing:     match.Missing,
+			},
+		)
+	}
+
+	return cookables, nil
+}
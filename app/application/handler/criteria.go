@@ -34,7 +34,7 @@ func prepareUserRepositoryInsert(userRegisterDTO dto.UserRegisterDTO, password s
 	}
 }
 
-func prepareUserConfirmationRepositoryInsert(user entity.User) *entity.UserConfirmation {
+func prepareUserConfirmationRepositoryInsert(user entity.User, confirmationType kind.UserConfirmationType) *entity.UserConfirmation {
 	newUUID, _ := uuid.NewUUID()
 
 	return &entity.UserConfirmation{
@@ -44,6 +44,8 @@ func prepareUserConfirmationRepositoryInsert(user entity.User) *entity.UserConfi
 		UserId:     user.Id,
 		Value:      ApplicationService.MathRandomIntAsString(entity.UserConfirmationValueMin, entity.UserConfirmationValueMax),
 		Active:     kind.UserConfirmationActive,
+		Type:       confirmationType,
+		DateExpire: time.Now().UTC().Add(entity.UserConfirmationExpire),
 	}
 }
 
@@ -137,3 +139,59 @@ func preparePlannerRecipeRepositoryInsert(plannerRecipe *entity.PlannerRecipe) *
 
 	return plannerRecipe
 }
+
+func preparePantryItemRepositoryInsert(pantryItem *entity.PantryItem) *entity.PantryItem {
+	newUUID, _ := uuid.NewUUID()
+	pantryItem.Id = newUUID
+
+	return pantryItem
+}
+
+func prepareShoppingListRepositoryInsert(shoppingList *entity.ShoppingList) *entity.ShoppingList {
+	newUUID, _ := uuid.NewUUID()
+	shoppingList.Id = newUUID
+
+	return shoppingList
+}
+
+func prepareShoppingListItemRepositoryInsert(shoppingListItem *entity.ShoppingListItem) *entity.ShoppingListItem {
+	newUUID, _ := uuid.NewUUID()
+	shoppingListItem.Id = newUUID
+
+	return shoppingListItem
+}
+
+func prepareIngredientPriceRepositoryInsert(ingredientPrice *entity.IngredientPrice) *entity.IngredientPrice {
+	newUUID, _ := uuid.NewUUID()
+	ingredientPrice.Id = newUUID
+
+	return ingredientPrice
+}
+
+func preparePlannerTemplateRepositoryInsert(plannerTemplate *entity.PlannerTemplate) *entity.PlannerTemplate {
+	newUUID, _ := uuid.NewUUID()
+	plannerTemplate.Id = newUUID
+
+	return plannerTemplate
+}
+
+func preparePlannerTemplateIntervalRepositoryInsert(plannerTemplateInterval *entity.PlannerTemplateInterval) *entity.PlannerTemplateInterval {
+	newUUID, _ := uuid.NewUUID()
+	plannerTemplateInterval.Id = newUUID
+
+	return plannerTemplateInterval
+}
+
+func prepareIngredientSubstituteRepositoryInsert(ingredientSubstitute *entity.IngredientSubstitute) *entity.IngredientSubstitute {
+	newUUID, _ := uuid.NewUUID()
+	ingredientSubstitute.Id = newUUID
+
+	return ingredientSubstitute
+}
+
+func prepareRecipeVersionRepositoryInsert(recipeVersion *entity.RecipeVersion) *entity.RecipeVersion {
+	newUUID, _ := uuid.NewUUID()
+	recipeVersion.Id = newUUID
+
+	return recipeVersion
+}
@@ -100,7 +100,7 @@ func TestPrepareUserConfirmationRepositoryInsert(t *testing.T) {
 					}
 				}()
 
-				preparedUserConfirmation := prepareUserConfirmationRepositoryInsert(*testCase.User)
+				preparedUserConfirmation := prepareUserConfirmationRepositoryInsert(*testCase.User, kind.UserConfirmationTypePasswordReset)
 
 				if testCase.MustBeFault {
 					assert.Nil(t, preparedUserConfirmation)
@@ -112,6 +112,9 @@ func TestPrepareUserConfirmationRepositoryInsert(t *testing.T) {
 					assert.Equal(t, testCase.User.Id, preparedUserConfirmation.UserId)
 					assert.NotEmpty(t, preparedUserConfirmation.Value)
 					assert.Contains(t, []bool{kind.UserConfirmationInActive, kind.UserConfirmationActive}, preparedUserConfirmation.Active)
+					assert.Equal(t, kind.UserConfirmationTypePasswordReset, preparedUserConfirmation.Type)
+					assert.Zero(t, preparedUserConfirmation.Attempts)
+					assert.True(t, preparedUserConfirmation.DateExpire.After(preparedUserConfirmation.DateInsert))
 				}
 			},
 		)
@@ -822,3 +825,465 @@ func TestPreparePlannerRecipeRepositoryInsert(t *testing.T) {
 		)
 	}
 }
+
+func TestPreparePantryItemRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name        string
+		PantryItem  *entity.PantryItem
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name: "Test case with PreparePantryItemRepositoryInsert and correct data",
+			PantryItem: &entity.PantryItem{
+				UserId:       uuid.New(),
+				IngredientId: uuid.New(),
+				UnitId:       uuid.New(),
+				DateInsert:   time.Now().UTC(),
+				DateUpdate:   time.Now().UTC(),
+				ExpiryTime:   time.Now().UTC(),
+				Quantity:     100,
+				Status:       kind.PantryItemStatusInActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedPantryItem := preparePantryItemRepositoryInsert(testCase.PantryItem)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedPantryItem)
+				} else {
+					assert.NotNil(t, preparedPantryItem)
+					assert.NotEqual(t, uuid.Nil, preparedPantryItem.Id)
+					assert.Equal(t, testCase.PantryItem.UserId, preparedPantryItem.UserId)
+					assert.Equal(t, testCase.PantryItem.IngredientId, preparedPantryItem.IngredientId)
+					assert.Equal(t, testCase.PantryItem.UnitId, preparedPantryItem.UnitId)
+					assert.Equal(t, testCase.PantryItem.DateInsert, preparedPantryItem.DateInsert)
+					assert.Equal(t, testCase.PantryItem.DateUpdate, preparedPantryItem.DateUpdate)
+					assert.Equal(t, testCase.PantryItem.ExpiryTime, preparedPantryItem.ExpiryTime)
+					assert.Equal(t, testCase.PantryItem.Quantity, preparedPantryItem.Quantity)
+					assert.Equal(t, testCase.PantryItem.Status, preparedPantryItem.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPrepareShoppingListRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name         string
+		ShoppingList *entity.ShoppingList
+		MustBePanic  bool
+		MustBeFault  bool
+	}{
+		{
+			Name: "Test case with PrepareShoppingListRepositoryInsert and correct data",
+			ShoppingList: &entity.ShoppingList{
+				UserId:     uuid.New(),
+				PlannerId:  uuid.New(),
+				DateInsert: time.Now().UTC(),
+				DateUpdate: time.Now().UTC(),
+				Name:       "Test Shopping List",
+				Status:     kind.ShoppingListStatusActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedShoppingList := prepareShoppingListRepositoryInsert(testCase.ShoppingList)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedShoppingList)
+				} else {
+					assert.NotNil(t, preparedShoppingList)
+					assert.NotEqual(t, uuid.Nil, preparedShoppingList.Id)
+					assert.Equal(t, testCase.ShoppingList.UserId, preparedShoppingList.UserId)
+					assert.Equal(t, testCase.ShoppingList.PlannerId, preparedShoppingList.PlannerId)
+					assert.Equal(t, testCase.ShoppingList.DateInsert, preparedShoppingList.DateInsert)
+					assert.Equal(t, testCase.ShoppingList.DateUpdate, preparedShoppingList.DateUpdate)
+					assert.Equal(t, testCase.ShoppingList.Name, preparedShoppingList.Name)
+					assert.Equal(t, testCase.ShoppingList.Status, preparedShoppingList.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPrepareShoppingListItemRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name             string
+		ShoppingListItem *entity.ShoppingListItem
+		MustBePanic      bool
+		MustBeFault      bool
+	}{
+		{
+			Name: "Test case with PrepareShoppingListItemRepositoryInsert and correct data",
+			ShoppingListItem: &entity.ShoppingListItem{
+				UserId:       uuid.New(),
+				EntityId:     uuid.New(),
+				IngredientId: uuid.New(),
+				UnitId:       uuid.New(),
+				DateInsert:   time.Now().UTC(),
+				DateUpdate:   time.Now().UTC(),
+				Name:         "Test Shopping List Item",
+				Amount:       100,
+				Status:       kind.ShoppingListItemStatusUnChecked,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedShoppingListItem := prepareShoppingListItemRepositoryInsert(testCase.ShoppingListItem)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedShoppingListItem)
+				} else {
+					assert.NotNil(t, preparedShoppingListItem)
+					assert.NotEqual(t, uuid.Nil, preparedShoppingListItem.Id)
+					assert.Equal(t, testCase.ShoppingListItem.UserId, preparedShoppingListItem.UserId)
+					assert.Equal(t, testCase.ShoppingListItem.EntityId, preparedShoppingListItem.EntityId)
+					assert.Equal(t, testCase.ShoppingListItem.IngredientId, preparedShoppingListItem.IngredientId)
+					assert.Equal(t, testCase.ShoppingListItem.UnitId, preparedShoppingListItem.UnitId)
+					assert.Equal(t, testCase.ShoppingListItem.Name, preparedShoppingListItem.Name)
+					assert.Equal(t, testCase.ShoppingListItem.Amount, preparedShoppingListItem.Amount)
+					assert.Equal(t, testCase.ShoppingListItem.Status, preparedShoppingListItem.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPrepareIngredientPriceRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name            string
+		IngredientPrice *entity.IngredientPrice
+		MustBePanic     bool
+		MustBeFault     bool
+	}{
+		{
+			Name: "Test case with PrepareIngredientPriceRepositoryInsert and correct data",
+			IngredientPrice: &entity.IngredientPrice{
+				UserId:     uuid.New(),
+				EntityId:   uuid.New(),
+				UnitId:     uuid.New(),
+				DateInsert: time.Now().UTC(),
+				DateUpdate: time.Now().UTC(),
+				PriceTime:  time.Now().UTC(),
+				Price:      199,
+				Quantity:   1,
+				Currency:   "EUR",
+				Store:      "Store",
+				Status:     kind.IngredientPriceStatusActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedIngredientPrice := prepareIngredientPriceRepositoryInsert(testCase.IngredientPrice)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedIngredientPrice)
+				} else {
+					assert.NotNil(t, preparedIngredientPrice)
+					assert.NotEqual(t, uuid.Nil, preparedIngredientPrice.Id)
+					assert.Equal(t, testCase.IngredientPrice.UserId, preparedIngredientPrice.UserId)
+					assert.Equal(t, testCase.IngredientPrice.EntityId, preparedIngredientPrice.EntityId)
+					assert.Equal(t, testCase.IngredientPrice.UnitId, preparedIngredientPrice.UnitId)
+					assert.Equal(t, testCase.IngredientPrice.DateInsert, preparedIngredientPrice.DateInsert)
+					assert.Equal(t, testCase.IngredientPrice.DateUpdate, preparedIngredientPrice.DateUpdate)
+					assert.Equal(t, testCase.IngredientPrice.PriceTime, preparedIngredientPrice.PriceTime)
+					assert.Equal(t, testCase.IngredientPrice.Price, preparedIngredientPrice.Price)
+					assert.Equal(t, testCase.IngredientPrice.Quantity, preparedIngredientPrice.Quantity)
+					assert.Equal(t, testCase.IngredientPrice.Currency, preparedIngredientPrice.Currency)
+					assert.Equal(t, testCase.IngredientPrice.Store, preparedIngredientPrice.Store)
+					assert.Equal(t, testCase.IngredientPrice.Status, preparedIngredientPrice.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPreparePlannerTemplateRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name            string
+		PlannerTemplate *entity.PlannerTemplate
+		MustBePanic     bool
+		MustBeFault     bool
+	}{
+		{
+			Name: "Test case with PreparePlannerTemplateRepositoryInsert and correct data",
+			PlannerTemplate: &entity.PlannerTemplate{
+				UserId:     uuid.New(),
+				DateInsert: time.Now().UTC(),
+				DateUpdate: time.Now().UTC(),
+				NextTime:   time.Now().UTC(),
+				Name:       "PlannerTemplate",
+				Days:       7,
+				Recurrence: kind.PlannerTemplateRecurrenceWeekly,
+				Status:     kind.PlannerTemplateStatusActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedPlannerTemplate := preparePlannerTemplateRepositoryInsert(testCase.PlannerTemplate)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedPlannerTemplate)
+				} else {
+					assert.NotNil(t, preparedPlannerTemplate)
+					assert.NotEqual(t, uuid.Nil, preparedPlannerTemplate.Id)
+					assert.Equal(t, testCase.PlannerTemplate.UserId, preparedPlannerTemplate.UserId)
+					assert.Equal(t, testCase.PlannerTemplate.DateInsert, preparedPlannerTemplate.DateInsert)
+					assert.Equal(t, testCase.PlannerTemplate.DateUpdate, preparedPlannerTemplate.DateUpdate)
+					assert.Equal(t, testCase.PlannerTemplate.NextTime, preparedPlannerTemplate.NextTime)
+					assert.Equal(t, testCase.PlannerTemplate.Name, preparedPlannerTemplate.Name)
+					assert.Equal(t, testCase.PlannerTemplate.Days, preparedPlannerTemplate.Days)
+					assert.Equal(t, testCase.PlannerTemplate.Recurrence, preparedPlannerTemplate.Recurrence)
+					assert.Equal(t, testCase.PlannerTemplate.Status, preparedPlannerTemplate.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPreparePlannerTemplateIntervalRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name                    string
+		PlannerTemplateInterval *entity.PlannerTemplateInterval
+		MustBePanic             bool
+		MustBeFault             bool
+	}{
+		{
+			Name: "Test case with PreparePlannerTemplateIntervalRepositoryInsert and correct data",
+			PlannerTemplateInterval: &entity.PlannerTemplateInterval{
+				UserId:      uuid.New(),
+				EntityId:    uuid.New(),
+				DateInsert:  time.Now().UTC(),
+				DateUpdate:  time.Now().UTC(),
+				Name:        "Breakfast",
+				StartOffset: 420,
+				EndOffset:   480,
+				RecipeIds:   []uuid.UUID{uuid.New()},
+				Status:      kind.PlannerTemplateIntervalStatusActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedPlannerTemplateInterval := preparePlannerTemplateIntervalRepositoryInsert(testCase.PlannerTemplateInterval)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedPlannerTemplateInterval)
+				} else {
+					assert.NotNil(t, preparedPlannerTemplateInterval)
+					assert.NotEqual(t, uuid.Nil, preparedPlannerTemplateInterval.Id)
+					assert.Equal(t, testCase.PlannerTemplateInterval.UserId, preparedPlannerTemplateInterval.UserId)
+					assert.Equal(t, testCase.PlannerTemplateInterval.EntityId, preparedPlannerTemplateInterval.EntityId)
+					assert.Equal(t, testCase.PlannerTemplateInterval.DateInsert, preparedPlannerTemplateInterval.DateInsert)
+					assert.Equal(t, testCase.PlannerTemplateInterval.DateUpdate, preparedPlannerTemplateInterval.DateUpdate)
+					assert.Equal(t, testCase.PlannerTemplateInterval.Name, preparedPlannerTemplateInterval.Name)
+					assert.Equal(t, testCase.PlannerTemplateInterval.StartOffset, preparedPlannerTemplateInterval.StartOffset)
+					assert.Equal(t, testCase.PlannerTemplateInterval.EndOffset, preparedPlannerTemplateInterval.EndOffset)
+					assert.Equal(t, testCase.PlannerTemplateInterval.RecipeIds, preparedPlannerTemplateInterval.RecipeIds)
+					assert.Equal(t, testCase.PlannerTemplateInterval.Status, preparedPlannerTemplateInterval.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPrepareIngredientSubstituteRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		IngredientSubstitute *entity.IngredientSubstitute
+		MustBePanic          bool
+		MustBeFault          bool
+	}{
+		{
+			Name: "Test case with PrepareIngredientSubstituteRepositoryInsert and correct data",
+			IngredientSubstitute: &entity.IngredientSubstitute{
+				UserId:       uuid.New(),
+				EntityId:     uuid.New(),
+				SubstituteId: uuid.New(),
+				DateInsert:   time.Now().UTC(),
+				DateUpdate:   time.Now().UTC(),
+				Ratio:        0.75,
+				Contexts:     []kind.SubstituteContext{kind.SubstituteContextVegan},
+				Notes:        "Notes",
+				Status:       kind.IngredientSubstituteStatusActive,
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedIngredientSubstitute := prepareIngredientSubstituteRepositoryInsert(testCase.IngredientSubstitute)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedIngredientSubstitute)
+				} else {
+					assert.NotNil(t, preparedIngredientSubstitute)
+					assert.NotEqual(t, uuid.Nil, preparedIngredientSubstitute.Id)
+					assert.Equal(t, testCase.IngredientSubstitute.UserId, preparedIngredientSubstitute.UserId)
+					assert.Equal(t, testCase.IngredientSubstitute.EntityId, preparedIngredientSubstitute.EntityId)
+					assert.Equal(t, testCase.IngredientSubstitute.SubstituteId, preparedIngredientSubstitute.SubstituteId)
+					assert.Equal(t, testCase.IngredientSubstitute.DateInsert, preparedIngredientSubstitute.DateInsert)
+					assert.Equal(t, testCase.IngredientSubstitute.DateUpdate, preparedIngredientSubstitute.DateUpdate)
+					assert.Equal(t, testCase.IngredientSubstitute.Ratio, preparedIngredientSubstitute.Ratio)
+					assert.Equal(t, testCase.IngredientSubstitute.Contexts, preparedIngredientSubstitute.Contexts)
+					assert.Equal(t, testCase.IngredientSubstitute.Notes, preparedIngredientSubstitute.Notes)
+					assert.Equal(t, testCase.IngredientSubstitute.Status, preparedIngredientSubstitute.Status)
+				}
+			},
+		)
+	}
+}
+
+func TestPrepareRecipeVersionRepositoryInsert(t *testing.T) {
+	tests := []struct {
+		Name          string
+		RecipeVersion *entity.RecipeVersion
+		MustBePanic   bool
+		MustBeFault   bool
+	}{
+		{
+			Name: "Test case with PrepareRecipeVersionRepositoryInsert and correct data",
+			RecipeVersion: &entity.RecipeVersion{
+				UserId:     uuid.New(),
+				EntityId:   uuid.New(),
+				SubjectId:  uuid.New(),
+				DateInsert: time.Now().UTC(),
+				Version:    1,
+				Subject:    kind.RecipeVersionSubjectRecipe,
+				Action:     kind.RecipeVersionActionCreate,
+				Snapshot:   []byte("{}"),
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				preparedRecipeVersion := prepareRecipeVersionRepositoryInsert(testCase.RecipeVersion)
+
+				if testCase.MustBeFault {
+					assert.Nil(t, preparedRecipeVersion)
+				} else {
+					assert.NotNil(t, preparedRecipeVersion)
+					assert.NotEqual(t, uuid.Nil, preparedRecipeVersion.Id)
+					assert.Equal(t, testCase.RecipeVersion.UserId, preparedRecipeVersion.UserId)
+					assert.Equal(t, testCase.RecipeVersion.EntityId, preparedRecipeVersion.EntityId)
+					assert.Equal(t, testCase.RecipeVersion.SubjectId, preparedRecipeVersion.SubjectId)
+					assert.Equal(t, testCase.RecipeVersion.DateInsert, preparedRecipeVersion.DateInsert)
+					assert.Equal(t, testCase.RecipeVersion.Version, preparedRecipeVersion.Version)
+					assert.Equal(t, testCase.RecipeVersion.Subject, preparedRecipeVersion.Subject)
+					assert.Equal(t, testCase.RecipeVersion.Action, preparedRecipeVersion.Action)
+					assert.Equal(t, testCase.RecipeVersion.Snapshot, preparedRecipeVersion.Snapshot)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,684 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"strings"
+	"time"
+)
+
+var (
+	errorHouseholdInfo         = errors.New("household cannot be showed by provided data")
+	errorHouseholdData         = errors.New("household must have a name")
+	errorHouseholdOwner        = errors.New("household can be changed by its owner only")
+	errorHouseholdDelete       = errors.New("household cannot be deleted by provided data")
+	errorHouseholdMember       = errors.New("user cannot be invited to the household by provided data")
+	errorHouseholdMemberExists = errors.New("user is already a member of the household or is invited to it")
+	errorHouseholdInvitation   = errors.New("household invitation cannot be accepted by provided data")
+	errorHouseholdRevoke       = errors.New("household member can be revoked by the owner of the household or by the member only")
+	errorHouseholdGrantData    = errors.New("household grant must have a planner or a recipe of the user as a subject and a known right")
+	errorHouseholdGrantDelete  = errors.New("household grant can be deleted by the user who has shared the subject or by the owner of the household only")
+)
+
+func HouseholdCreate(userId *uuid.UUID, householdDTO *DomainEntity.Household) (*DomainAggregate.Household, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+
+	householdDTO.Name = strings.TrimSpace(householdDTO.Name)
+
+	if householdDTO.Name == "" {
+		return nil, errorHouseholdData
+	}
+
+	if householdDTO.Status == "" {
+		householdDTO.Status = kind.HouseholdStatusActive
+	}
+
+	householdDTO.Id = uuid.New()
+	householdDTO.UserId = *userId
+	householdDTO.DateInsert = time.Now().UTC()
+	householdDTO.DateUpdate = time.Now().UTC()
+
+	household, errorHouseholdInsertOne := householdRepository.InsertOne(householdDTO)
+
+	if errorHouseholdInsertOne != nil {
+		return nil, errors.Wrapf(errorHouseholdInsertOne, "an error occurred while creating a household in the database by privided data %v", householdDTO)
+	}
+
+	return HouseholdInfo(&household.Id, userId)
+}
+
+// HouseholdsInfo returns the households owned by the user and the ones the user is an active member of.
+func HouseholdsInfo(userId *uuid.UUID) ([]*DomainAggregate.Household, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	households := []*DomainAggregate.Household{}
+
+	householdEntities, errorHouseholdEntities := householdRepository.FindAll(householdRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorHouseholdEntities != nil {
+		return nil, errors.Wrapf(errorHouseholdEntities, "an error occurred while getting households by privided data userId=%s", userId)
+	}
+
+	status := kind.HouseholdMemberStatusActive.String()
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	householdMembers, errorHouseholdMembers := householdMemberRepository.FindAll(criteria)
+
+	if errorHouseholdMembers != nil {
+		return nil, errors.Wrapf(errorHouseholdMembers, "an error occurred while getting households by privided data userId=%s", userId)
+	}
+
+	for _, householdMember := range householdMembers {
+		householdEntity, errorHouseholdEntity := householdRepository.FindOne(householdRepository.GetCriteria().GetCriteriaById(&householdMember.EntityId, nil))
+
+		if errorHouseholdEntity == nil && householdEntity != nil {
+			householdEntities = append(householdEntities, householdEntity)
+		}
+	}
+
+	for _, householdEntity := range householdEntities {
+		household, errorHousehold := getHouseholdAggregate(householdEntity)
+
+		if errorHousehold != nil {
+			return nil, errors.Wrapf(errorHousehold, "an error occurred while getting households by privided data userId=%s", userId)
+		}
+
+		households = append(households, household)
+	}
+
+	return households, nil
+}
+
+// HouseholdInfo returns the household with its members and grants to its owner and to its active members.
+func HouseholdInfo(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.Household, error) {
+	householdEntity := householdMembership(userId, id)
+
+	if householdEntity == nil {
+		return nil, errorHouseholdInfo
+	}
+
+	return getHouseholdAggregate(householdEntity)
+}
+
+func HouseholdUpdate(id *uuid.UUID, userId *uuid.UUID, householdDTO *DomainEntity.Household) (*DomainAggregate.Household, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdEntity, errorHouseholdEntity := getHouseholdEntity(id, userId)
+
+	if errorHouseholdEntity != nil {
+		return nil, errors.Wrapf(errorHouseholdEntity, "an error occurred while updating a household by privided data id=%s,userId=%s", id, userId)
+	}
+
+	householdDTO.Id = *id
+	householdDTO.UserId = *userId
+	householdDTO.Name = strings.TrimSpace(householdDTO.Name)
+	householdDTO.DateInsert = householdEntity.DateInsert
+	householdDTO.DateUpdate = time.Now().UTC()
+
+	householdUpdated, errorHouseholdUpdated := service.Update(householdEntity, householdDTO)
+
+	if errorHouseholdUpdated != nil {
+		return nil, errors.Wrapf(errorHouseholdUpdated, "an error occurred while updating a household by privided data %v", householdDTO)
+	}
+
+	restoredHouseholdUpdated, okRestoredHouseholdUpdated := householdUpdated.Interface().(*DomainEntity.Household)
+
+	if !okRestoredHouseholdUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a household by privided data %s", householdUpdated)
+	}
+
+	updateOne, errorUpdateOne := householdRepository.UpdateOne(
+		householdRepository.GetCriteria().GetCriteriaById(&restoredHouseholdUpdated.Id, nil),
+		restoredHouseholdUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a household entity in the database %v", restoredHouseholdUpdated)
+	}
+
+	return getHouseholdAggregate(updateOne)
+}
+
+// HouseholdDelete deletes the household of the user with all of its members and grants.
+func HouseholdDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+	_, errorHouseholdEntity := getHouseholdEntity(id, userId)
+
+	if errorHouseholdEntity != nil {
+		return false, errors.Wrapf(errorHouseholdEntity, "an error occurred while deleting a household by privided data id=%s,userId=%s", id, userId)
+	}
+
+	householdMembers, _ := householdMemberRepository.FindAll(householdMemberRepository.GetCriteria().GetCriteriaByEntityId(id, nil))
+
+	for _, householdMember := range householdMembers {
+		_, _ = householdMemberRepository.DeleteOne(householdMemberRepository.GetCriteria().GetCriteriaById(&householdMember.Id, nil))
+	}
+
+	householdGrants, _ := householdGrantRepository.FindAll(householdGrantRepository.GetCriteria().GetCriteriaByEntityId(id, nil))
+
+	for _, householdGrant := range householdGrants {
+		_, _ = householdGrantRepository.DeleteOne(householdGrantRepository.GetCriteria().GetCriteriaById(&householdGrant.Id, nil))
+	}
+
+	criteria := householdRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	deleteOneStatus, errorDeleteOne := householdRepository.DeleteOne(criteria)
+
+	if errorDeleteOne != nil {
+		return false, errorDeleteOne
+	} else if !deleteOneStatus {
+		return deleteOneStatus, errorHouseholdDelete
+	}
+
+	return true, nil
+}
+
+// HouseholdInvite invites the user with the username to the household of the user, a revoked member can be invited
+// again.
+func HouseholdInvite(id *uuid.UUID, userId *uuid.UUID, username string) (*DomainEntity.HouseholdMember, error) {
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	userRepository := InfrastructureService.GetFactoryRepository().GetUserRepository()
+	householdEntity, errorHouseholdEntity := getHouseholdEntity(id, userId)
+
+	if errorHouseholdEntity != nil {
+		return nil, errors.Wrapf(errorHouseholdEntity, "an error occurred while inviting to a household by privided data id=%s,userId=%s", id, userId)
+	}
+
+	user, errorUser := userRepository.FindOne(userRepository.GetCriteriaByUsername(strings.TrimSpace(username)))
+
+	if errorUser != nil || user == nil || user.Id == householdEntity.UserId {
+		return nil, errorHouseholdMember
+	}
+
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(&user.Id, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByEntityId(id, criteria)
+	householdMember, errorHouseholdMember := householdMemberRepository.FindOne(criteria)
+
+	if errorHouseholdMember == nil && householdMember != nil {
+		if householdMember.Status != kind.HouseholdMemberStatusRevoked {
+			return nil, errorHouseholdMemberExists
+		}
+
+		householdMember.InviterId = *userId
+		householdMember.DateUpdate = time.Now().UTC()
+		householdMember.Status = kind.HouseholdMemberStatusInvited
+
+		return householdMemberRepository.UpdateOne(householdMemberRepository.GetCriteria().GetCriteriaById(&householdMember.Id, nil), householdMember)
+	}
+
+	householdMember = &DomainEntity.HouseholdMember{
+		Id:         uuid.New(),
+		UserId:     user.Id,
+		EntityId:   *id,
+		InviterId:  *userId,
+		DateInsert: time.Now().UTC(),
+		DateUpdate: time.Now().UTC(),
+		Status:     kind.HouseholdMemberStatusInvited,
+	}
+
+	householdMember, errorHouseholdMemberInsertOne := householdMemberRepository.InsertOne(householdMember)
+
+	if errorHouseholdMemberInsertOne != nil {
+		return nil, errors.Wrapf(errorHouseholdMemberInsertOne, "an error occurred while inviting to a household in the database by privided data id=%s,username=%s", id, username)
+	}
+
+	return householdMember, nil
+}
+
+// HouseholdInvitationsInfo returns the pending invitations of the user with their households.
+func HouseholdInvitationsInfo(userId *uuid.UUID) ([]*DomainAggregate.HouseholdInvitation, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	householdInvitations := []*DomainAggregate.HouseholdInvitation{}
+
+	status := kind.HouseholdMemberStatusInvited.String()
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	householdMembers, errorHouseholdMembers := householdMemberRepository.FindAll(criteria)
+
+	if errorHouseholdMembers != nil {
+		return nil, errors.Wrapf(errorHouseholdMembers, "an error occurred while getting household invitations by privided data userId=%s", userId)
+	}
+
+	for _, householdMember := range householdMembers {
+		householdEntity, errorHouseholdEntity := householdRepository.FindOne(householdRepository.GetCriteria().GetCriteriaById(&householdMember.EntityId, nil))
+
+		if errorHouseholdEntity != nil || householdEntity == nil {
+			continue
+		}
+
+		householdInvitations = append(householdInvitations, &DomainAggregate.HouseholdInvitation{Entity: householdMember, Household: householdEntity})
+	}
+
+	return householdInvitations, nil
+}
+
+// HouseholdInvitationAccept makes the user an active member of the household by the invitation.
+func HouseholdInvitationAccept(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.Household, error) {
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+
+	status := kind.HouseholdMemberStatusInvited.String()
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaById(id, criteria)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	householdMember, errorHouseholdMember := householdMemberRepository.FindOne(criteria)
+
+	if errorHouseholdMember != nil || householdMember == nil {
+		return nil, errorHouseholdInvitation
+	}
+
+	householdMember.DateUpdate = time.Now().UTC()
+	householdMember.Status = kind.HouseholdMemberStatusActive
+
+	_, errorUpdateOne := householdMemberRepository.UpdateOne(householdMemberRepository.GetCriteria().GetCriteriaById(&householdMember.Id, nil), householdMember)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while accepting a household invitation in the database %v", householdMember)
+	}
+
+	return HouseholdInfo(&householdMember.EntityId, userId)
+}
+
+// HouseholdInvitationDecline declines the invitation of the user, see HouseholdMemberRevoke.
+func HouseholdInvitationDecline(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+
+	status := kind.HouseholdMemberStatusInvited.String()
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaById(id, criteria)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	householdMember, errorHouseholdMember := householdMemberRepository.FindOne(criteria)
+
+	if errorHouseholdMember != nil || householdMember == nil {
+		return false, errorHouseholdInvitation
+	}
+
+	return HouseholdMemberRevoke(id, userId, &householdMember.EntityId)
+}
+
+// HouseholdMemberRevoke revokes the membership or the invitation, the owner of the household can revoke anyone and a
+// member can revoke itself, i.e. decline an invitation or leave the household. The grants shared by the member in the
+// household are deleted as well.
+func HouseholdMemberRevoke(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+
+	householdEntity, errorHouseholdEntity := householdRepository.FindOne(householdRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorHouseholdEntity != nil || householdEntity == nil {
+		return false, errorHouseholdInfo
+	}
+
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByEntityId(entityId, nil)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaById(id, criteria)
+	householdMember, errorHouseholdMember := householdMemberRepository.FindOne(criteria)
+
+	if errorHouseholdMember != nil || householdMember == nil || householdMember.Status == kind.HouseholdMemberStatusRevoked {
+		return false, errorHouseholdInfo
+	} else if householdEntity.UserId != *userId && householdMember.UserId != *userId {
+		return false, errorHouseholdRevoke
+	}
+
+	householdMember.DateUpdate = time.Now().UTC()
+	householdMember.Status = kind.HouseholdMemberStatusRevoked
+
+	_, errorUpdateOne := householdMemberRepository.UpdateOne(householdMemberRepository.GetCriteria().GetCriteriaById(&householdMember.Id, nil), householdMember)
+
+	if errorUpdateOne != nil {
+		return false, errors.Wrapf(errorUpdateOne, "an error occurred while revoking a household member in the database %v", householdMember)
+	}
+
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaByUserId(&householdMember.UserId, nil)
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	householdGrants, _ := householdGrantRepository.FindAll(criteria)
+
+	for _, householdGrant := range householdGrants {
+		_, _ = householdGrantRepository.DeleteOne(householdGrantRepository.GetCriteria().GetCriteriaById(&householdGrant.Id, nil))
+	}
+
+	return true, nil
+}
+
+// HouseholdGrantCreate shares the planner or the recipe of the user with the household the user belongs to, sharing
+// the same subject again changes the right of the existing grant.
+func HouseholdGrantCreate(userId *uuid.UUID, entityId *uuid.UUID, householdGrantDTO *DomainEntity.HouseholdGrant) (*DomainEntity.HouseholdGrant, error) {
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+
+	if householdMembership(userId, entityId) == nil {
+		return nil, errorHouseholdInfo
+	}
+
+	if householdGrantDTO.Right.String() == "unknown" || householdGrantDTO.Subject.String() == "" {
+		return nil, errorHouseholdGrantData
+	}
+
+	ownerId := householdSubjectOwner(householdGrantDTO.Subject, &householdGrantDTO.SubjectId)
+
+	if ownerId == nil || *ownerId != *userId {
+		return nil, errorHouseholdGrantData
+	}
+
+	criteria := householdGrantRepository.GetCriteria().GetCriteriaByEntityId(entityId, nil)
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaBySubjectId(&householdGrantDTO.SubjectId, criteria)
+	householdGrant, errorHouseholdGrant := householdGrantRepository.FindOne(criteria)
+
+	if errorHouseholdGrant == nil && householdGrant != nil {
+		householdGrant.DateUpdate = time.Now().UTC()
+		householdGrant.Right = householdGrantDTO.Right
+
+		return householdGrantRepository.UpdateOne(householdGrantRepository.GetCriteria().GetCriteriaById(&householdGrant.Id, nil), householdGrant)
+	}
+
+	householdGrantDTO.Id = uuid.New()
+	householdGrantDTO.UserId = *userId
+	householdGrantDTO.EntityId = *entityId
+	householdGrantDTO.DateInsert = time.Now().UTC()
+	householdGrantDTO.DateUpdate = time.Now().UTC()
+
+	householdGrant, errorHouseholdGrantInsertOne := householdGrantRepository.InsertOne(householdGrantDTO)
+
+	if errorHouseholdGrantInsertOne != nil {
+		return nil, errors.Wrapf(errorHouseholdGrantInsertOne, "an error occurred while creating a household grant in the database by privided data %v", householdGrantDTO)
+	}
+
+	return householdGrant, nil
+}
+
+func HouseholdGrantDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+	householdEntity := householdMembership(userId, entityId)
+
+	if householdEntity == nil {
+		return false, errorHouseholdInfo
+	}
+
+	criteria := householdGrantRepository.GetCriteria().GetCriteriaByEntityId(entityId, nil)
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaById(id, criteria)
+	householdGrant, errorHouseholdGrant := householdGrantRepository.FindOne(criteria)
+
+	if errorHouseholdGrant != nil || householdGrant == nil {
+		return false, errorHouseholdInfo
+	} else if householdGrant.UserId != *userId && householdEntity.UserId != *userId {
+		return false, errorHouseholdGrantDelete
+	}
+
+	return householdGrantRepository.DeleteOne(householdGrantRepository.GetCriteria().GetCriteriaById(id, nil))
+}
+
+// householdUserId returns the owner of the subject when the user is granted the right to it by one of the households,
+// otherwise the user is returned as is. The handlers scope the subjects and their children by the returned user, so the
+// owner and the granted members get the same access.
+func householdUserId(userId *uuid.UUID, subject kind.HouseholdSubject, subjectId *uuid.UUID, right kind.UserRight) *uuid.UUID {
+	if userId == nil || subjectId == nil {
+		return userId
+	}
+
+	ownerId := householdSubjectOwner(subject, subjectId)
+
+	if ownerId == nil || *ownerId == *userId {
+		return userId
+	}
+
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+	criteria := householdGrantRepository.GetCriteria().GetCriteriaByUserId(ownerId, &persistence.Criteria{Uncached: true})
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaBySubjectId(subjectId, criteria)
+	householdGrants, errorHouseholdGrants := householdGrantRepository.FindAll(criteria)
+
+	if errorHouseholdGrants != nil {
+		return userId
+	}
+
+	for _, householdGrant := range householdGrants {
+		if householdGrant.Subject != subject || !ApplicationServiceHelper.HouseholdRightAllows(householdGrant.Right, right) {
+			continue
+		}
+
+		if householdMembership(userId, &householdGrant.EntityId) != nil {
+			return ownerId
+		}
+	}
+
+	return userId
+}
+
+// plannerIntervalUserId returns the user to scope the interval and its recipes by, see householdUserId.
+func plannerIntervalUserId(userId *uuid.UUID, plannerIntervalId *uuid.UUID, right kind.UserRight) *uuid.UUID {
+	plannerIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerIntervalRepository()
+	plannerInterval, errorPlannerInterval := plannerIntervalRepository.FindOne(plannerIntervalRepository.GetCriteria().GetCriteriaById(plannerIntervalId, nil))
+
+	if errorPlannerInterval != nil || plannerInterval == nil {
+		return userId
+	}
+
+	return householdUserId(userId, kind.HouseholdSubjectPlanner, &plannerInterval.EntityId, right)
+}
+
+// recipeIngredientUserId returns the user to scope the recipe ingredient and its measures by, see householdUserId.
+func recipeIngredientUserId(userId *uuid.UUID, recipeIngredientId *uuid.UUID, right kind.UserRight) *uuid.UUID {
+	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
+	recipeIngredient, errorRecipeIngredient := recipeIngredientRepository.FindOne(recipeIngredientRepository.GetCriteria().GetCriteriaById(recipeIngredientId, nil))
+
+	if errorRecipeIngredient != nil || recipeIngredient == nil {
+		return userId
+	}
+
+	return householdUserId(userId, kind.HouseholdSubjectRecipe, &recipeIngredient.EntityId, right)
+}
+
+// householdGrantsDelete deletes the grants of the deleted subject of the user in all of the households.
+func householdGrantsDelete(userId *uuid.UUID, subjectId *uuid.UUID) {
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+	criteria := householdGrantRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdGrantRepository.GetCriteria().GetCriteriaBySubjectId(subjectId, criteria)
+	householdGrants, _ := householdGrantRepository.FindAll(criteria)
+
+	for _, householdGrant := range householdGrants {
+		_, _ = householdGrantRepository.DeleteOne(householdGrantRepository.GetCriteria().GetCriteriaById(&householdGrant.Id, nil))
+	}
+}
+
+func householdSubjectOwner(subject kind.HouseholdSubject, subjectId *uuid.UUID) *uuid.UUID {
+	switch subject {
+	case kind.HouseholdSubjectPlanner:
+		plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
+		planner, errorPlanner := plannerRepository.FindOne(plannerRepository.GetCriteria().GetCriteriaById(subjectId, nil))
+
+		if errorPlanner == nil && planner != nil {
+			return &planner.UserId
+		}
+	case kind.HouseholdSubjectRecipe:
+		recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+		recipe, errorRecipe := recipeRepository.FindOne(recipeRepository.GetCriteria().GetCriteriaById(subjectId, nil))
+
+		if errorRecipe == nil && recipe != nil {
+			return &recipe.UserId
+		}
+	case kind.HouseholdSubjectShoppingList:
+		shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+		shoppingList, errorShoppingList := shoppingListRepository.FindOne(shoppingListRepository.GetCriteria().GetCriteriaById(subjectId, nil))
+
+		if errorShoppingList == nil && shoppingList != nil {
+			return &shoppingList.UserId
+		}
+	case kind.HouseholdSubjectPantryItem:
+		pantryItemRepository := InfrastructureService.GetFactoryRepository().GetPantryItemRepository()
+		pantryItem, errorPantryItem := pantryItemRepository.FindOne(pantryItemRepository.GetCriteria().GetCriteriaById(subjectId, nil))
+
+		if errorPantryItem == nil && pantryItem != nil {
+			return &pantryItem.UserId
+		}
+	case kind.HouseholdSubjectPlannerTemplate:
+		plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+		plannerTemplate, errorPlannerTemplate := plannerTemplateRepository.FindOne(plannerTemplateRepository.GetCriteria().GetCriteriaById(subjectId, nil))
+
+		if errorPlannerTemplate == nil && plannerTemplate != nil {
+			return &plannerTemplate.UserId
+		}
+	}
+
+	return nil
+}
+
+// householdEntityUserId returns the user to scope the alt names and the pictures of the entity by. The entity is the
+// recipe, one of its categories, ingredients or processes or a picture of them, the other entities are not shared, so
+// the user is returned as is, see householdUserId.
+func householdEntityUserId(userId *uuid.UUID, entityId *uuid.UUID, right kind.UserRight) *uuid.UUID {
+	if userId == nil || entityId == nil {
+		return userId
+	}
+
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	recipe, errorRecipe := recipeRepository.FindOne(recipeRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorRecipe == nil && recipe != nil {
+		return householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, right)
+	}
+
+	recipeCategoryRepository := InfrastructureService.GetFactoryRepository().GetRecipeCategoryRepository()
+	recipeCategory, errorRecipeCategory := recipeCategoryRepository.FindOne(recipeCategoryRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorRecipeCategory == nil && recipeCategory != nil {
+		return householdUserId(userId, kind.HouseholdSubjectRecipe, &recipeCategory.EntityId, right)
+	}
+
+	recipeProcessRepository := InfrastructureService.GetFactoryRepository().GetRecipeProcessRepository()
+	recipeProcess, errorRecipeProcess := recipeProcessRepository.FindOne(recipeProcessRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorRecipeProcess == nil && recipeProcess != nil {
+		return householdUserId(userId, kind.HouseholdSubjectRecipe, &recipeProcess.EntityId, right)
+	}
+
+	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
+	recipeIngredient, errorRecipeIngredient := recipeIngredientRepository.FindOne(recipeIngredientRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorRecipeIngredient == nil && recipeIngredient != nil {
+		return householdUserId(userId, kind.HouseholdSubjectRecipe, &recipeIngredient.EntityId, right)
+	}
+
+	pictureRepository := InfrastructureService.GetFactoryRepository().GetPictureRepository()
+	picture, errorPicture := pictureRepository.FindOne(pictureRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorPicture == nil && picture != nil && picture.EntityId != *entityId {
+		return householdEntityUserId(userId, &picture.EntityId, right)
+	}
+
+	return userId
+}
+
+// householdGrantsShared returns the grants of the subjects which the other users share with the user by the households
+// the user is the owner or an active member of.
+func householdGrantsShared(userId *uuid.UUID, subject kind.HouseholdSubject) ([]*DomainEntity.HouseholdGrant, error) {
+	var householdGrants []*DomainEntity.HouseholdGrant
+
+	households, errorHouseholds := HouseholdsInfo(userId)
+
+	if errorHouseholds != nil {
+		return nil, errorHouseholds
+	}
+
+	shared := map[uuid.UUID]bool{}
+
+	for _, household := range households {
+		if household.Entity.Status != kind.HouseholdStatusActive {
+			continue
+		}
+
+		for _, householdGrant := range household.Grants {
+			if householdGrant.Subject != subject || householdGrant.UserId == *userId || shared[householdGrant.SubjectId] {
+				continue
+			}
+
+			shared[householdGrant.SubjectId] = true
+			householdGrants = append(householdGrants, householdGrant)
+		}
+	}
+
+	return householdGrants, nil
+}
+
+// householdMembership returns the active household when the user is its owner or its active member. The household and
+// the membership are read uncached, so a removed member or a disabled household loses the access at once.
+func householdMembership(userId *uuid.UUID, householdId *uuid.UUID) *DomainEntity.Household {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+
+	if userId == nil || householdId == nil {
+		return nil
+	}
+
+	householdEntity, errorHouseholdEntity := householdRepository.FindOne(householdRepository.GetCriteria().GetCriteriaById(householdId, &persistence.Criteria{Uncached: true}))
+
+	if errorHouseholdEntity != nil || householdEntity == nil || householdEntity.Status != kind.HouseholdStatusActive {
+		return nil
+	} else if householdEntity.UserId == *userId {
+		return householdEntity
+	}
+
+	status := kind.HouseholdMemberStatusActive.String()
+	criteria := householdMemberRepository.GetCriteria().GetCriteriaByUserId(userId, &persistence.Criteria{Uncached: true})
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByEntityId(householdId, criteria)
+	criteria = householdMemberRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	householdMember, errorHouseholdMember := householdMemberRepository.FindOne(criteria)
+
+	if errorHouseholdMember != nil || householdMember == nil {
+		return nil
+	}
+
+	return householdEntity
+}
+
+func getHouseholdEntity(id *uuid.UUID, userId *uuid.UUID) (*DomainEntity.Household, error) {
+	householdRepository := InfrastructureService.GetFactoryRepository().GetHouseholdRepository()
+	criteria := householdRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = householdRepository.GetCriteria().GetCriteriaById(id, criteria)
+	householdEntity, errorHouseholdEntity := householdRepository.FindOne(criteria)
+
+	if errorHouseholdEntity != nil || householdEntity == nil {
+		return nil, errorHouseholdOwner
+	}
+
+	return householdEntity, nil
+}
+
+func getHouseholdAggregate(householdEntity *DomainEntity.Household) (*DomainAggregate.Household, error) {
+	householdMemberRepository := InfrastructureService.GetFactoryRepository().GetHouseholdMemberRepository()
+	householdGrantRepository := InfrastructureService.GetFactoryRepository().GetHouseholdGrantRepository()
+
+	householdMembers, errorHouseholdMembers := householdMemberRepository.FindAll(householdMemberRepository.GetCriteria().GetCriteriaByEntityId(&householdEntity.Id, nil))
+
+	if errorHouseholdMembers != nil {
+		return nil, errors.Wrapf(errorHouseholdMembers, "an error occurred while getting members of the household by privided data %v", householdEntity)
+	}
+
+	householdGrants, errorHouseholdGrants := householdGrantRepository.FindAll(householdGrantRepository.GetCriteria().GetCriteriaByEntityId(&householdEntity.Id, nil))
+
+	if errorHouseholdGrants != nil {
+		return nil, errors.Wrapf(errorHouseholdGrants, "an error occurred while getting grants of the household by privided data %v", householdEntity)
+	}
+
+	household := &DomainAggregate.Household{
+		Entity:  householdEntity,
+		Members: []*DomainEntity.HouseholdMember{},
+		Grants:  []*DomainEntity.HouseholdGrant{},
+	}
+
+	for _, householdMember := range householdMembers {
+		if householdMember.Status != kind.HouseholdMemberStatusRevoked {
+			household.Members = append(household.Members, householdMember)
+		}
+	}
+
+	household.Grants = append(household.Grants, householdGrants...)
+
+	return household, nil
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testsHouseholdData = testsHousehold{
+		{
+			name:   "Test case with correct data",
+			userId: &testUserId,
+			householdDTO: &DomainEntity.Household{
+				Name: "Test Household " + uuid.NewString(),
+			},
+			memberDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameHousehold" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:       "NameTest",
+				Surname:    "SurnameTest",
+				MiddleName: "MiddleNameTest",
+				Birthday:   time.Now().UTC(),
+			},
+			plannerDTO: &DomainEntity.Planner{
+				Name:   "Household Planner",
+				Status: kind.PlannerStatusActive,
+			},
+			recipeDTO: &DomainEntity.Recipe{
+				Name:   "Household Recipe " + uuid.NewString(),
+				Status: kind.RecipeStatusUnPublished,
+			},
+		},
+	}
+)
+
+type testsHousehold []struct {
+	name         string
+	userId       *uuid.UUID
+	householdDTO *DomainEntity.Household
+	memberDTO    dto.UserRegisterDTO
+	plannerDTO   *DomainEntity.Planner
+	recipeDTO    *DomainEntity.Recipe
+	household    *DomainAggregate.Household
+	member       *DomainEntity.User
+	planner      *DomainAggregate.Planner
+}
+
+func TestHouseholdInvite(t *testing.T) {
+	for index, testCase := range testsHouseholdData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				household, errorHousehold := HouseholdCreate(testCase.userId, testCase.householdDTO)
+
+				assert.Nil(t, errorHousehold)
+				assert.Equal(t, kind.HouseholdStatusActive, household.Entity.Status)
+
+				member, errorMember := AuthRegister(testCase.memberDTO)
+
+				assert.Nil(t, errorMember)
+
+				householdMember, errorHouseholdMember := HouseholdInvite(&household.Entity.Id, testCase.userId, testCase.memberDTO.Username)
+
+				assert.Nil(t, errorHouseholdMember)
+				assert.Equal(t, kind.HouseholdMemberStatusInvited, householdMember.Status)
+
+				_, errorInvitedAgain := HouseholdInvite(&household.Entity.Id, testCase.userId, testCase.memberDTO.Username)
+
+				assert.Equal(t, errorHouseholdMemberExists, errorInvitedAgain)
+
+				_, errorNotAccepted := HouseholdInfo(&household.Entity.Id, &member.Id)
+
+				assert.Equal(t, errorHouseholdInfo, errorNotAccepted)
+
+				invitations, errorInvitations := HouseholdInvitationsInfo(&member.Id)
+
+				assert.Nil(t, errorInvitations)
+				assert.Len(t, invitations, 1)
+
+				accepted, errorAccepted := HouseholdInvitationAccept(&invitations[0].Entity.Id, &member.Id)
+
+				assert.Nil(t, errorAccepted)
+				assert.Equal(t, household.Entity.Id, accepted.Entity.Id)
+				assert.Len(t, accepted.Members, 1)
+				assert.Equal(t, kind.HouseholdMemberStatusActive, accepted.Members[0].Status)
+
+				testsHouseholdData[index].household = household
+				testsHouseholdData[index].member = member
+			},
+		)
+	}
+}
+
+func TestHouseholdGrantCreate(t *testing.T) {
+	for index, testCase := range testsHouseholdData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.household == nil {
+					t.Skip()
+				}
+
+				planner, errorPlanner := PlannerCreate(testCase.userId, testCase.plannerDTO)
+
+				assert.Nil(t, errorPlanner)
+
+				_, errorNotGranted := PlannerInfo(&planner.Entity.Id, &testCase.member.Id, nil)
+
+				assert.NotNil(t, errorNotGranted)
+
+				_, errorNotOwned := HouseholdGrantCreate(
+					&testCase.member.Id,
+					&testCase.household.Entity.Id,
+					&DomainEntity.HouseholdGrant{SubjectId: planner.Entity.Id, Subject: kind.HouseholdSubjectPlanner, Right: kind.UserRightRead},
+				)
+
+				assert.Equal(t, errorHouseholdGrantData, errorNotOwned)
+
+				householdGrant, errorHouseholdGrant := HouseholdGrantCreate(
+					testCase.userId,
+					&testCase.household.Entity.Id,
+					&DomainEntity.HouseholdGrant{SubjectId: planner.Entity.Id, Subject: kind.HouseholdSubjectPlanner, Right: kind.UserRightRead},
+				)
+
+				assert.Nil(t, errorHouseholdGrant)
+				assert.Equal(t, kind.UserRightRead, householdGrant.Right)
+
+				actual, errorActual := PlannerInfo(&planner.Entity.Id, &testCase.member.Id, nil)
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, planner.Entity.Id, actual.Entity.Id)
+
+				_, errorReadOnly := PlannerUpdate(&planner.Entity.Id, &testCase.member.Id, &DomainEntity.Planner{Name: "Household Planner Updated"})
+
+				assert.NotNil(t, errorReadOnly)
+
+				householdGrant, errorHouseholdGrant = HouseholdGrantCreate(
+					testCase.userId,
+					&testCase.household.Entity.Id,
+					&DomainEntity.HouseholdGrant{SubjectId: planner.Entity.Id, Subject: kind.HouseholdSubjectPlanner, Right: kind.UserRightWrite},
+				)
+
+				assert.Nil(t, errorHouseholdGrant)
+				assert.Equal(t, kind.UserRightWrite, householdGrant.Right)
+
+				updated, errorUpdated := PlannerUpdate(&planner.Entity.Id, &testCase.member.Id, &DomainEntity.Planner{Name: "Household Planner Updated"})
+
+				assert.Nil(t, errorUpdated)
+				assert.Equal(t, "Household Planner Updated", updated.Entity.Name)
+				assert.Equal(t, *testCase.userId, updated.Entity.UserId)
+
+				testsHouseholdData[index].planner = planner
+			},
+		)
+	}
+}
+
+func TestHouseholdGrantsShared(t *testing.T) {
+	for _, testCase := range testsHouseholdData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.planner == nil {
+					t.Skip()
+				}
+
+				planners, errorPlanners := PlannersInfo(&testCase.member.Id, nil)
+
+				assert.Nil(t, errorPlanners)
+				assert.True(t, householdTestContainsPlanner(planners, &testCase.planner.Entity.Id))
+
+				recipe, errorRecipe := RecipeCreate(testCase.userId, testCase.recipeDTO)
+
+				assert.Nil(t, errorRecipe)
+
+				recipes, errorRecipes := RecipesInfo(&testCase.member.Id, nil)
+
+				assert.Nil(t, errorRecipes)
+				assert.False(t, householdTestContainsRecipe(recipes, &recipe.Entity.Id))
+
+				_, errorHouseholdGrant := HouseholdGrantCreate(
+					testCase.userId,
+					&testCase.household.Entity.Id,
+					&DomainEntity.HouseholdGrant{SubjectId: recipe.Entity.Id, Subject: kind.HouseholdSubjectRecipe, Right: kind.UserRightRead},
+				)
+
+				assert.Nil(t, errorHouseholdGrant)
+
+				recipes, errorRecipes = RecipesInfo(&testCase.member.Id, nil)
+
+				assert.Nil(t, errorRecipes)
+				assert.True(t, householdTestContainsRecipe(recipes, &recipe.Entity.Id))
+
+				_, _ = RecipeDelete(&recipe.Entity.Id, testCase.userId)
+			},
+		)
+	}
+}
+
+func TestHouseholdMemberRevoke(t *testing.T) {
+	for _, testCase := range testsHouseholdData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.planner == nil {
+					t.Skip()
+				}
+
+				household, errorHousehold := HouseholdInfo(&testCase.household.Entity.Id, testCase.userId)
+
+				assert.Nil(t, errorHousehold)
+				assert.Len(t, household.Members, 1)
+
+				actual, errorActual := HouseholdMemberRevoke(&household.Members[0].Id, testCase.userId, &household.Entity.Id)
+
+				assert.Nil(t, errorActual)
+				assert.True(t, actual)
+
+				_, errorRevoked := PlannerInfo(&testCase.planner.Entity.Id, &testCase.member.Id, nil)
+
+				assert.NotNil(t, errorRevoked)
+
+				planners, errorPlanners := PlannersInfo(&testCase.member.Id, nil)
+
+				assert.Nil(t, errorPlanners)
+				assert.False(t, householdTestContainsPlanner(planners, &testCase.planner.Entity.Id))
+
+				_, _ = PlannerDelete(&testCase.planner.Entity.Id, testCase.userId)
+				_, _ = HouseholdDelete(&household.Entity.Id, testCase.userId)
+				_, _ = UserDelete(&testCase.member.Id)
+			},
+		)
+	}
+}
+
+func householdTestContainsPlanner(planners []*DomainAggregate.Planner, id *uuid.UUID) bool {
+	for _, planner := range planners {
+		if planner.Entity.Id == *id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func householdTestContainsRecipe(recipes []*DomainAggregate.Recipe, id *uuid.UUID) bool {
+	for _, recipe := range recipes {
+		if recipe.Entity.Id == *id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	errorIngredientPriceInfo = errors.New("ingredient price cannot be showed by provided data")
+	errorIngredientPriceData = errors.New("ingredient price must have a unit, a positive quantity, a non-negative price and a currency")
+)
+
+func IngredientPriceCreate(userId *uuid.UUID, entityId *uuid.UUID, ingredientPriceDTO *DomainEntity.IngredientPrice) (*DomainAggregate.IngredientPrice, error) {
+	ingredientPriceRepository := InfrastructureService.GetFactoryRepository().GetIngredientPriceRepository()
+	_, errorIngredient := getIngredientEntity(entityId, userId, nil)
+
+	if errorIngredient != nil {
+		return nil, errors.Wrapf(errorIngredient, "an error occurred while creating an ingredient price by privided data entityId=%s,userId=%s", entityId, userId)
+	}
+
+	_, errorUnit := getUnitEntity(&ingredientPriceDTO.UnitId, nil)
+
+	if errorUnit != nil {
+		return nil, errors.Wrapf(errorUnit, "an error occurred while creating an ingredient price by privided data %v", ingredientPriceDTO)
+	}
+
+	if errorIngredientPriceValidate := validateIngredientPrice(ingredientPriceDTO); errorIngredientPriceValidate != nil {
+		return nil, errorIngredientPriceValidate
+	}
+
+	if ingredientPriceDTO.PriceTime.IsZero() {
+		ingredientPriceDTO.PriceTime = time.Now().UTC()
+	}
+
+	if ingredientPriceDTO.Status == "" {
+		ingredientPriceDTO.Status = kind.IngredientPriceStatusActive
+	}
+
+	ingredientPriceDTO.UserId = *userId
+	ingredientPriceDTO.EntityId = *entityId
+	ingredientPriceDTO.DateInsert = time.Now().UTC()
+	ingredientPriceDTO.DateUpdate = time.Now().UTC()
+
+	ingredientPrice, errorIngredientPriceInsertOne := ingredientPriceRepository.InsertOne(prepareIngredientPriceRepositoryInsert(ingredientPriceDTO))
+
+	if errorIngredientPriceInsertOne != nil {
+		return nil, errors.Wrapf(errorIngredientPriceInsertOne, "an error occurred while creating an ingredient price in the database by privided data %v", ingredientPriceDTO)
+	}
+
+	return getIngredientPriceAggregate(&ingredientPrice.Id, &ingredientPrice.UserId, &ingredientPrice.EntityId, nil)
+}
+
+// IngredientPricesInfo returns the history of prices of the ingredient, the latest price goes first.
+func IngredientPricesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.IngredientPrice, error) {
+	ingredientPrices, errorIngredientPrices := ApplicationService.BuildIngredientPricesAggregate(nil, userId, entityId, criteria)
+
+	if errorIngredientPrices != nil {
+		return nil, errorIngredientPrices
+	}
+
+	sort.SliceStable(
+		ingredientPrices,
+		func(i, j int) bool {
+			return ingredientPrices[i].Entity.PriceTime.After(ingredientPrices[j].Entity.PriceTime)
+		},
+	)
+
+	return ingredientPrices, nil
+}
+
+func IngredientPriceInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.IngredientPrice, error) {
+	return getIngredientPriceAggregate(id, userId, entityId, criteria)
+}
+
+func IngredientPriceUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, ingredientPriceDTO *DomainEntity.IngredientPrice) (*DomainAggregate.IngredientPrice, error) {
+	ingredientPriceRepository := InfrastructureService.GetFactoryRepository().GetIngredientPriceRepository()
+	ingredientPrice, errorIngredientPrice := getIngredientPriceAggregate(id, userId, entityId, nil)
+
+	if errorIngredientPrice != nil {
+		return nil, errors.Wrapf(errorIngredientPrice, "an error occurred while updating an ingredient price by privided data id=%s,userId=%s,entityId=%s,criteria=%v", id, userId, entityId, nil)
+	}
+
+	ingredientPriceDTO.Id = *id
+	ingredientPriceDTO.UserId = *userId
+	ingredientPriceDTO.EntityId = *entityId
+	ingredientPriceDTO.DateInsert = ingredientPrice.Entity.DateInsert
+	ingredientPriceDTO.DateUpdate = time.Now().UTC()
+
+	ingredientPriceUpdated, errorIngredientPriceUpdated := service.Update(ingredientPrice.Entity, ingredientPriceDTO)
+
+	if errorIngredientPriceUpdated != nil {
+		return nil, errors.Wrapf(errorIngredientPriceUpdated, "an error occurred while updating an ingredient price by privided data %v", ingredientPriceDTO)
+	}
+
+	restoredIngredientPriceUpdated, okRestoredIngredientPriceUpdated := ingredientPriceUpdated.Interface().(*DomainEntity.IngredientPrice)
+
+	if !okRestoredIngredientPriceUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated an ingredient price by privided data %s", ingredientPriceUpdated)
+	}
+
+	if errorIngredientPriceValidate := validateIngredientPrice(restoredIngredientPriceUpdated); errorIngredientPriceValidate != nil {
+		return nil, errorIngredientPriceValidate
+	}
+
+	updateOne, errorUpdateOne := ingredientPriceRepository.UpdateOne(
+		ingredientPriceRepository.GetCriteria().GetCriteriaById(&restoredIngredientPriceUpdated.Id, nil),
+		restoredIngredientPriceUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating an ingredient price entity in the database %v", restoredIngredientPriceUpdated)
+	}
+
+	return getIngredientPriceAggregate(&updateOne.Id, &updateOne.UserId, &updateOne.EntityId, nil)
+}
+
+func IngredientPriceDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	ingredientPriceRepository := InfrastructureService.GetFactoryRepository().GetIngredientPriceRepository()
+
+	criteria := ingredientPriceRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = ingredientPriceRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	criteria = ingredientPriceRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	return ingredientPriceRepository.DeleteOne(criteria)
+}
+
+func validateIngredientPrice(ingredientPrice *DomainEntity.IngredientPrice) error {
+	ingredientPrice.Currency = strings.ToUpper(strings.TrimSpace(ingredientPrice.Currency))
+
+	if ingredientPrice.UnitId == uuid.Nil || ingredientPrice.Quantity <= 0 || ingredientPrice.Price < 0 || ingredientPrice.Currency == "" {
+		return errorIngredientPriceData
+	}
+
+	return nil
+}
+
+func getIngredientPriceAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.IngredientPrice, error) {
+	ingredientPricesAggregate, errorIngredientPricesAggregate := ApplicationService.BuildIngredientPricesAggregate(id, userId, entityId, criteria)
+	if errorIngredientPricesAggregate != nil {
+		return nil, errors.Wrapf(errorIngredientPricesAggregate, "an error occurred while getting an ingredient price by privided data id=%s,userId=%s,entityId=%s,criteria=%v", id, userId, entityId, criteria)
+	} else if len(ingredientPricesAggregate) == 0 {
+		return nil, errorIngredientPriceInfo
+	}
+	return ingredientPricesAggregate[0], nil
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testsIngredientPriceData = testsIngredientPrice{
+		{
+			name:   "Test case with correct data",
+			id:     nil,
+			userId: &testUserId,
+			ingredientDTO: &DomainEntity.Ingredient{
+				Name:   "Test Ingredient " + uuid.NewString(),
+				Status: kind.IngredientStatusPublished,
+			},
+			unitDTO: &DomainEntity.Unit{
+				Name:   "Test Unit " + uuid.NewString(),
+				Status: kind.UnitStatusPublished,
+			},
+			ingredientPriceDTO: &DomainEntity.IngredientPrice{
+				PriceTime: time.Now().UTC().Add(-time.Hour),
+				Price:     199,
+				Quantity:  1,
+				Currency:  "eur",
+				Store:     "Store",
+			},
+			toUpdatingIngredientPriceDTO: &DomainEntity.IngredientPrice{
+				Price:  249,
+				Status: kind.IngredientPriceStatusInActive,
+			},
+		},
+	}
+)
+
+type testsIngredientPrice []struct {
+	name                         string
+	id                           *uuid.UUID
+	userId                       *uuid.UUID
+	ingredientDTO                *DomainEntity.Ingredient
+	unitDTO                      *DomainEntity.Unit
+	ingredientPriceDTO           *DomainEntity.IngredientPrice
+	toUpdatingIngredientPriceDTO *DomainEntity.IngredientPrice
+	ingredientPrice              *DomainAggregate.IngredientPrice
+}
+
+func init() {
+	InfrastructureService.PrepareCache()
+	InfrastructureService.PreparePersistence()
+}
+
+func TestIngredientPriceCreate(t *testing.T) {
+	for index, testCase := range testsIngredientPriceData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				ingredient, errorIngredient := IngredientCreate(testCase.userId, testCase.ingredientDTO)
+
+				assert.Nil(t, errorIngredient)
+
+				unit, errorUnit := UnitCreate(testCase.unitDTO)
+
+				assert.Nil(t, errorUnit)
+
+				testCase.ingredientPriceDTO.UnitId = unit.Id
+				actual, errorActual := IngredientPriceCreate(testCase.userId, &ingredient.Id, testCase.ingredientPriceDTO)
+
+				assert.Nil(t, errorActual)
+
+				testsIngredientPriceData[index].ingredientPrice = actual
+				testsIngredientPriceData[index].id = &actual.Entity.Id
+
+				assert.NotNil(t, actual.Entity.Id)
+				assert.Equal(t, *testCase.userId, actual.Entity.UserId)
+				assert.Equal(t, ingredient.Id, actual.Entity.EntityId)
+				assert.Equal(t, unit.Id, actual.Unit.Id)
+				assert.Equal(t, testCase.ingredientPriceDTO.Price, actual.Entity.Price)
+				assert.Equal(t, "EUR", actual.Entity.Currency)
+				assert.Equal(t, kind.IngredientPriceStatusActive, actual.Entity.Status)
+			},
+		)
+	}
+}
+
+func TestIngredientPriceCreateWithoutQuantity(t *testing.T) {
+	for _, testCase := range testsIngredientPriceData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientPrice == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientPriceCreate(
+					testCase.userId,
+					&testCase.ingredientPrice.Entity.EntityId,
+					&DomainEntity.IngredientPrice{UnitId: testCase.ingredientPrice.Entity.UnitId, Price: 100, Currency: "EUR"},
+				)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, errorIngredientPriceData, errorActual)
+			},
+		)
+	}
+}
+
+func TestIngredientPricesInfo(t *testing.T) {
+	for _, testCase := range testsIngredientPriceData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientPrice == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientPricesInfo(testCase.userId, &testCase.ingredientPrice.Entity.EntityId, nil)
+
+				assert.Nil(t, errorActual)
+				assert.Len(t, actual, 1)
+				assert.Equal(t, testCase.ingredientPrice.Entity.Id, actual[0].Entity.Id)
+			},
+		)
+	}
+}
+
+func TestIngredientPriceUpdate(t *testing.T) {
+	for _, testCase := range testsIngredientPriceData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientPrice == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientPriceUpdate(testCase.id, testCase.userId, &testCase.ingredientPrice.Entity.EntityId, testCase.toUpdatingIngredientPriceDTO)
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, testCase.toUpdatingIngredientPriceDTO.Price, actual.Entity.Price)
+				assert.Equal(t, testCase.toUpdatingIngredientPriceDTO.Status, actual.Entity.Status)
+				assert.Equal(t, testCase.ingredientPrice.Entity.Quantity, actual.Entity.Quantity)
+			},
+		)
+	}
+}
+
+func TestIngredientPriceDelete(t *testing.T) {
+	for _, testCase := range testsIngredientPriceData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientPrice == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientPriceDelete(testCase.id, testCase.userId, &testCase.ingredientPrice.Entity.EntityId)
+
+				assert.Nil(t, errorActual)
+				assert.True(t, actual)
+
+				_, _ = IngredientDelete(&testCase.ingredientPrice.Entity.EntityId, testCase.userId)
+				_, _ = UnitDelete(&testCase.ingredientPrice.Entity.UnitId)
+			},
+		)
+	}
+}
@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"strings"
+	"time"
+)
+
+var (
+	errorIngredientSubstituteInfo = errors.New("ingredient substitute cannot be showed by provided data")
+	errorIngredientSubstituteData = errors.New("ingredient substitute must have another ingredient as a substitute, a positive ratio and known contexts")
+	errorRecipeSubstituteNone     = errors.New("there is nothing to substitute in the recipe")
+)
+
+func IngredientSubstituteCreate(userId *uuid.UUID, entityId *uuid.UUID, ingredientSubstituteDTO *DomainEntity.IngredientSubstitute) (*DomainAggregate.IngredientSubstitute, error) {
+	ingredientSubstituteRepository := InfrastructureService.GetFactoryRepository().GetIngredientSubstituteRepository()
+	_, errorIngredient := getIngredientEntity(entityId, userId, nil)
+
+	if errorIngredient != nil {
+		return nil, errors.Wrapf(errorIngredient, "an error occurred while creating an ingredient substitute by privided data entityId=%s,userId=%s", entityId, userId)
+	}
+
+	_, errorSubstitute := getIngredientEntity(&ingredientSubstituteDTO.SubstituteId, userId, nil)
+
+	if errorSubstitute != nil {
+		return nil, errors.Wrapf(errorSubstitute, "an error occurred while creating an ingredient substitute by privided data %v", ingredientSubstituteDTO)
+	}
+
+	if ingredientSubstituteDTO.Ratio == 0 {
+		ingredientSubstituteDTO.Ratio = 1
+	}
+
+	if ingredientSubstituteDTO.Status == "" {
+		ingredientSubstituteDTO.Status = kind.IngredientSubstituteStatusActive
+	}
+
+	ingredientSubstituteDTO.UserId = *userId
+	ingredientSubstituteDTO.EntityId = *entityId
+	ingredientSubstituteDTO.DateInsert = time.Now().UTC()
+	ingredientSubstituteDTO.DateUpdate = time.Now().UTC()
+
+	if errorIngredientSubstituteValidate := validateIngredientSubstitute(ingredientSubstituteDTO); errorIngredientSubstituteValidate != nil {
+		return nil, errorIngredientSubstituteValidate
+	}
+
+	ingredientSubstitute, errorIngredientSubstituteInsertOne := ingredientSubstituteRepository.InsertOne(prepareIngredientSubstituteRepositoryInsert(ingredientSubstituteDTO))
+
+	if errorIngredientSubstituteInsertOne != nil {
+		return nil, errors.Wrapf(errorIngredientSubstituteInsertOne, "an error occurred while creating an ingredient substitute in the database by privided data %v", ingredientSubstituteDTO)
+	}
+
+	return getIngredientSubstituteAggregate(&ingredientSubstitute.Id, &ingredientSubstitute.UserId, &ingredientSubstitute.EntityId, nil)
+}
+
+func IngredientSubstitutesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.IngredientSubstitute, error) {
+	return ApplicationService.BuildIngredientSubstitutesAggregate(nil, userId, entityId, criteria)
+}
+
+func IngredientSubstituteInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.IngredientSubstitute, error) {
+	return getIngredientSubstituteAggregate(id, userId, entityId, criteria)
+}
+
+func IngredientSubstituteUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, ingredientSubstituteDTO *DomainEntity.IngredientSubstitute) (*DomainAggregate.IngredientSubstitute, error) {
+	ingredientSubstituteRepository := InfrastructureService.GetFactoryRepository().GetIngredientSubstituteRepository()
+	ingredientSubstitute, errorIngredientSubstitute := getIngredientSubstituteAggregate(id, userId, entityId, nil)
+
+	if errorIngredientSubstitute != nil {
+		return nil, errors.Wrapf(errorIngredientSubstitute, "an error occurred while updating an ingredient substitute by privided data id=%s,userId=%s,entityId=%s,criteria=%v", id, userId, entityId, nil)
+	}
+
+	if ingredientSubstituteDTO.SubstituteId != uuid.Nil {
+		_, errorSubstitute := getIngredientEntity(&ingredientSubstituteDTO.SubstituteId, userId, nil)
+
+		if errorSubstitute != nil {
+			return nil, errors.Wrapf(errorSubstitute, "an error occurred while updating an ingredient substitute by privided data %v", ingredientSubstituteDTO)
+		}
+	}
+
+	ingredientSubstituteDTO.Id = *id
+	ingredientSubstituteDTO.UserId = *userId
+	ingredientSubstituteDTO.EntityId = *entityId
+	ingredientSubstituteDTO.DateInsert = ingredientSubstitute.Entity.DateInsert
+	ingredientSubstituteDTO.DateUpdate = time.Now().UTC()
+
+	ingredientSubstituteUpdated, errorIngredientSubstituteUpdated := service.Update(ingredientSubstitute.Entity, ingredientSubstituteDTO)
+
+	if errorIngredientSubstituteUpdated != nil {
+		return nil, errors.Wrapf(errorIngredientSubstituteUpdated, "an error occurred while updating an ingredient substitute by privided data %v", ingredientSubstituteDTO)
+	}
+
+	restoredIngredientSubstituteUpdated, okRestoredIngredientSubstituteUpdated := ingredientSubstituteUpdated.Interface().(*DomainEntity.IngredientSubstitute)
+
+	if !okRestoredIngredientSubstituteUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated an ingredient substitute by privided data %s", ingredientSubstituteUpdated)
+	}
+
+	if errorIngredientSubstituteValidate := validateIngredientSubstitute(restoredIngredientSubstituteUpdated); errorIngredientSubstituteValidate != nil {
+		return nil, errorIngredientSubstituteValidate
+	}
+
+	updateOne, errorUpdateOne := ingredientSubstituteRepository.UpdateOne(
+		ingredientSubstituteRepository.GetCriteria().GetCriteriaById(&restoredIngredientSubstituteUpdated.Id, nil),
+		restoredIngredientSubstituteUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating an ingredient substitute entity in the database %v", restoredIngredientSubstituteUpdated)
+	}
+
+	return getIngredientSubstituteAggregate(&updateOne.Id, &updateOne.UserId, &updateOne.EntityId, nil)
+}
+
+func IngredientSubstituteDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	ingredientSubstituteRepository := InfrastructureService.GetFactoryRepository().GetIngredientSubstituteRepository()
+
+	criteria := ingredientSubstituteRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = ingredientSubstituteRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	criteria = ingredientSubstituteRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	return ingredientSubstituteRepository.DeleteOne(criteria)
+}
+
+// RecipeSubstitutesInfo returns the active substitutes of every ingredient of the recipe, only the ones suitable
+// for all the contexts when the contexts are given.
+func RecipeSubstitutesInfo(id *uuid.UUID, userId *uuid.UUID, contexts []kind.SubstituteContext) ([]*DomainAggregate.RecipeIngredientSubstitutes, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightRead)
+
+	recipe, errorRecipe := RecipeInfo(id, userId, nil)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while getting substitutes of the recipe with id=%s", id)
+	}
+
+	substitutes, errorSubstitutes := IngredientSubstitutesInfo(userId, nil, nil)
+
+	if errorSubstitutes != nil {
+		return nil, errors.Wrapf(errorSubstitutes, "an error occurred while getting substitutes of the recipe with id=%s", id)
+	}
+
+	recipeIngredientSubstitutes := make([]*DomainAggregate.RecipeIngredientSubstitutes, 0, len(recipe.Ingredients))
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if recipeIngredient.Entity == nil {
+			continue
+		}
+
+		ingredientSubstitutes := &DomainAggregate.RecipeIngredientSubstitutes{
+			RecipeIngredient: recipeIngredient.Entity,
+			Substitutes:      []*DomainAggregate.IngredientSubstitute{},
+		}
+
+		for _, substitute := range substitutes {
+			if substitute.Entity.EntityId != recipeIngredient.Entity.DeriveId || substitute.Substitute == nil {
+				continue
+			}
+
+			if len(contexts) == 0 && substitute.Entity.Status == kind.IngredientSubstituteStatusActive || ApplicationServiceHelper.SubstituteMatch(substitute.Entity, contexts) {
+				ingredientSubstitutes.Substitutes = append(ingredientSubstitutes.Substitutes, substitute)
+			}
+		}
+
+		recipeIngredientSubstitutes = append(recipeIngredientSubstitutes, ingredientSubstitutes)
+	}
+
+	return recipeIngredientSubstitutes, nil
+}
+
+// RecipeSubstitute creates a variant of the recipe with the ingredients replaced by their substitutes, the amounts
+// of the substitutes are scaled by the ratios. The categories and the processes are copied to the variant.
+func RecipeSubstitute(id *uuid.UUID, userId *uuid.UUID, substituteQuery *DomainAggregate.SubstituteQuery) (*DomainAggregate.RecipeSubstitute, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightWrite)
+
+	var pantryItems []*DomainAggregate.PantryItem
+
+	recipe, errorRecipe := RecipeInfo(id, userId, nil)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while substituting ingredients of the recipe with id=%s", id)
+	}
+
+	substitutes, errorSubstitutes := IngredientSubstitutesInfo(userId, nil, nil)
+
+	if errorSubstitutes != nil {
+		return nil, errors.Wrapf(errorSubstitutes, "an error occurred while substituting ingredients of the recipe with id=%s", id)
+	}
+
+	if substituteQuery.UsePantry {
+		var errorPantryItems error
+
+		pantryItems, errorPantryItems = PantryItemsInfo(userId, nil)
+
+		if errorPantryItems != nil {
+			return nil, errors.Wrapf(errorPantryItems, "an error occurred while substituting ingredients of the recipe with id=%s", id)
+		}
+	}
+
+	substitutions := ApplicationServiceHelper.SubstituteRecipe(recipe, substitutes, substituteQuery, pantryItems, time.Now().UTC())
+
+	if len(substitutions) == 0 {
+		return nil, errors.Wrapf(errorRecipeSubstituteNone, "an error occurred while substituting ingredients of the recipe with id=%s by privided data %v", id, substituteQuery)
+	}
+
+	variant, errorVariant := recipeSubstituteVariant(userId, recipe, substitutions)
+
+	if errorVariant != nil {
+		return nil, errorVariant
+	}
+
+	return &DomainAggregate.RecipeSubstitute{Recipe: variant, Substitutions: substitutions}, nil
+}
+
+// PlannerSubstitute calculates the planner with the ingredients replaced by their substitutes.
+func PlannerSubstitute(id *uuid.UUID, userId *uuid.UUID, substituteQuery *DomainAggregate.SubstituteQuery) (*DomainAggregate.PlannerSubstitute, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
+
+	var pantryItems []*DomainAggregate.PantryItem
+
+	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while substituting ingredients of the planner with id=%s", id)
+	}
+
+	substitutes, errorSubstitutes := IngredientSubstitutesInfo(userId, nil, nil)
+
+	if errorSubstitutes != nil {
+		return nil, errors.Wrapf(errorSubstitutes, "an error occurred while substituting ingredients of the planner with id=%s", id)
+	}
+
+	if substituteQuery.UsePantry {
+		var errorPantryItems error
+
+		pantryItems, errorPantryItems = PantryItemsInfo(userId, nil)
+
+		if errorPantryItems != nil {
+			return nil, errors.Wrapf(errorPantryItems, "an error occurred while substituting ingredients of the planner with id=%s", id)
+		}
+	}
+
+	now := time.Now().UTC()
+	plannerSubstitute := ApplicationServiceHelper.SubstituteCalculations(plannerCalculations(planner), substitutes, substituteQuery, pantryItems, now)
+	ingredientPrices, _ := ApplicationService.BuildIngredientPricesAggregate(nil, userId, nil, nil)
+
+	ApplicationServiceHelper.PlannerCalculationCost(plannerSubstitute.Calculations, ingredientPrices, planner.Entity.Currency, now)
+
+	return plannerSubstitute, nil
+}
+
+func recipeSubstituteVariant(userId *uuid.UUID, recipe *DomainAggregate.Recipe, substitutions []*DomainAggregate.Substitution) (*DomainAggregate.Recipe, error) {
+	substitutesByRecipeIngredient := map[uuid.UUID]*DomainEntity.Ingredient{}
+	amounts := map[string]int64{}
+	var substituteNames []string
+
+	for _, substitution := range substitutions {
+		if _, ok := substitutesByRecipeIngredient[substitution.RecipeIngredient.Id]; !ok {
+			substituteNames = append(substituteNames, substitution.Substitute.Name)
+		}
+
+		substitutesByRecipeIngredient[substitution.RecipeIngredient.Id] = substitution.Substitute
+
+		if substitution.Unit != nil {
+			amounts[substitution.RecipeIngredient.Id.String()+substitution.Unit.Id.String()] = substitution.SubstituteAmount
+		}
+	}
+
+	variant, errorVariant := RecipeCreate(
+		userId,
+		&DomainEntity.Recipe{
+			Name:        recipe.Entity.Name + " (" + strings.Join(substituteNames, ", ") + ")",
+			Description: recipe.Entity.Description,
+			Notes:       recipe.Entity.Notes,
+			Servings:    recipe.Entity.Servings,
+			Calories:    recipe.Entity.Calories,
+			Status:      recipe.Entity.Status,
+		},
+	)
+
+	if errorVariant != nil {
+		return nil, errors.Wrapf(errorVariant, "an error occurred while creating a variant of the recipe with id=%s", recipe.Entity.Id)
+	}
+
+	for _, recipeCategory := range recipe.Categories {
+		_, errorRecipeCategory := RecipeCategoryCreate(
+			userId,
+			&variant.Entity.Id,
+			&DomainEntity.RecipeCategory{DeriveId: recipeCategory.Entity.DeriveId, Status: recipeCategory.Entity.Status},
+		)
+
+		if errorRecipeCategory != nil {
+			return nil, errors.Wrapf(errorRecipeCategory, "an error occurred while copying a category to the variant of the recipe with id=%s", recipe.Entity.Id)
+		}
+	}
+
+	for _, recipeProcess := range recipe.Processes {
+		_, errorRecipeProcess := RecipeProcessCreate(
+			userId,
+			&variant.Entity.Id,
+			&DomainEntity.RecipeProcess{
+				Name:        recipeProcess.Entity.Name,
+				Description: recipeProcess.Entity.Description,
+				Notes:       recipeProcess.Entity.Notes,
+				Status:      recipeProcess.Entity.Status,
+			},
+		)
+
+		if errorRecipeProcess != nil {
+			return nil, errors.Wrapf(errorRecipeProcess, "an error occurred while copying a process to the variant of the recipe with id=%s", recipe.Entity.Id)
+		}
+	}
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		recipeIngredientDTO := &DomainEntity.RecipeIngredient{
+			DeriveId: recipeIngredient.Entity.DeriveId,
+			Name:     recipeIngredient.Entity.Name,
+			Status:   recipeIngredient.Entity.Status,
+		}
+		substitute, substituted := substitutesByRecipeIngredient[recipeIngredient.Entity.Id]
+
+		if substituted {
+			recipeIngredientDTO.DeriveId = substitute.Id
+			recipeIngredientDTO.Name = substitute.Name
+		}
+
+		variantIngredient, errorVariantIngredient := RecipeIngredientCreate(userId, &variant.Entity.Id, recipeIngredientDTO)
+
+		if errorVariantIngredient != nil {
+			return nil, errors.Wrapf(errorVariantIngredient, "an error occurred while copying an ingredient to the variant of the recipe with id=%s", recipe.Entity.Id)
+		}
+
+		for _, measure := range recipeIngredient.Measures {
+			value := measure.Entity.Value
+
+			if amount, ok := amounts[recipeIngredient.Entity.Id.String()+measure.Entity.UnitId.String()]; substituted && ok {
+				value = amount
+			}
+
+			_, errorVariantMeasure := RecipeMeasureCreate(
+				userId,
+				&variantIngredient.Entity.Id,
+				&DomainEntity.RecipeMeasure{UnitId: measure.Entity.UnitId, Value: value, Status: measure.Entity.Status},
+			)
+
+			if errorVariantMeasure != nil {
+				return nil, errors.Wrapf(errorVariantMeasure, "an error occurred while copying a measure to the variant of the recipe with id=%s", recipe.Entity.Id)
+			}
+		}
+	}
+
+	return RecipeInfo(&variant.Entity.Id, userId, nil)
+}
+
+func validateIngredientSubstitute(ingredientSubstitute *DomainEntity.IngredientSubstitute) error {
+	if ingredientSubstitute.SubstituteId == uuid.Nil || ingredientSubstitute.SubstituteId == ingredientSubstitute.EntityId || ingredientSubstitute.Ratio <= 0 {
+		return errorIngredientSubstituteData
+	}
+
+	for _, context := range ingredientSubstitute.Contexts {
+		if context.String() == "" {
+			return errorIngredientSubstituteData
+		}
+	}
+
+	return nil
+}
+
+func getIngredientSubstituteAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.IngredientSubstitute, error) {
+	ingredientSubstitutesAggregate, errorIngredientSubstitutesAggregate := ApplicationService.BuildIngredientSubstitutesAggregate(id, userId, entityId, criteria)
+	if errorIngredientSubstitutesAggregate != nil {
+		return nil, errors.Wrapf(errorIngredientSubstitutesAggregate, "an error occurred while getting an ingredient substitute by privided data id=%s,userId=%s,entityId=%s,criteria=%v", id, userId, entityId, criteria)
+	} else if len(ingredientSubstitutesAggregate) == 0 {
+		return nil, errorIngredientSubstituteInfo
+	}
+	return ingredientSubstitutesAggregate[0], nil
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	testsIngredientSubstituteData = testsIngredientSubstitute{
+		{
+			name:   "Test case with correct data",
+			id:     nil,
+			userId: &testUserId,
+			ingredientDTO: &DomainEntity.Ingredient{
+				Name:   "Test Ingredient " + uuid.NewString(),
+				Status: kind.IngredientStatusPublished,
+			},
+			substituteDTO: &DomainEntity.Ingredient{
+				Name:   "Test Substitute " + uuid.NewString(),
+				Status: kind.IngredientStatusPublished,
+			},
+			ingredientSubstituteDTO: &DomainEntity.IngredientSubstitute{
+				Ratio:    0.75,
+				Contexts: []kind.SubstituteContext{kind.SubstituteContextVegan},
+				Notes:    "Notes",
+			},
+			toUpdatingIngredientSubstituteDTO: &DomainEntity.IngredientSubstitute{
+				Ratio:  1.5,
+				Status: kind.IngredientSubstituteStatusInActive,
+			},
+		},
+	}
+)
+
+type testsIngredientSubstitute []struct {
+	name                              string
+	id                                *uuid.UUID
+	userId                            *uuid.UUID
+	ingredientDTO                     *DomainEntity.Ingredient
+	substituteDTO                     *DomainEntity.Ingredient
+	ingredientSubstituteDTO           *DomainEntity.IngredientSubstitute
+	toUpdatingIngredientSubstituteDTO *DomainEntity.IngredientSubstitute
+	ingredientSubstitute              *DomainAggregate.IngredientSubstitute
+}
+
+func TestIngredientSubstituteCreate(t *testing.T) {
+	for index, testCase := range testsIngredientSubstituteData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				ingredient, errorIngredient := IngredientCreate(testCase.userId, testCase.ingredientDTO)
+
+				assert.Nil(t, errorIngredient)
+
+				substitute, errorSubstitute := IngredientCreate(testCase.userId, testCase.substituteDTO)
+
+				assert.Nil(t, errorSubstitute)
+
+				testCase.ingredientSubstituteDTO.SubstituteId = substitute.Id
+				actual, errorActual := IngredientSubstituteCreate(testCase.userId, &ingredient.Id, testCase.ingredientSubstituteDTO)
+
+				assert.Nil(t, errorActual)
+
+				testsIngredientSubstituteData[index].ingredientSubstitute = actual
+				testsIngredientSubstituteData[index].id = &actual.Entity.Id
+
+				assert.NotNil(t, actual.Entity.Id)
+				assert.Equal(t, *testCase.userId, actual.Entity.UserId)
+				assert.Equal(t, ingredient.Id, actual.Entity.EntityId)
+				assert.Equal(t, substitute.Id, actual.Substitute.Id)
+				assert.Equal(t, testCase.ingredientSubstituteDTO.Ratio, actual.Entity.Ratio)
+				assert.Equal(t, kind.IngredientSubstituteStatusActive, actual.Entity.Status)
+			},
+		)
+	}
+}
+
+func TestIngredientSubstituteCreateWithItself(t *testing.T) {
+	for _, testCase := range testsIngredientSubstituteData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientSubstitute == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientSubstituteCreate(
+					testCase.userId,
+					&testCase.ingredientSubstitute.Entity.EntityId,
+					&DomainEntity.IngredientSubstitute{SubstituteId: testCase.ingredientSubstitute.Entity.EntityId},
+				)
+
+				assert.Nil(t, actual)
+				assert.Equal(t, errorIngredientSubstituteData, errorActual)
+			},
+		)
+	}
+}
+
+func TestIngredientSubstitutesInfo(t *testing.T) {
+	for _, testCase := range testsIngredientSubstituteData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientSubstitute == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientSubstitutesInfo(testCase.userId, &testCase.ingredientSubstitute.Entity.EntityId, nil)
+
+				assert.Nil(t, errorActual)
+				assert.Len(t, actual, 1)
+				assert.Equal(t, testCase.ingredientSubstitute.Entity.Id, actual[0].Entity.Id)
+			},
+		)
+	}
+}
+
+func TestIngredientSubstituteUpdate(t *testing.T) {
+	for _, testCase := range testsIngredientSubstituteData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientSubstitute == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientSubstituteUpdate(testCase.id, testCase.userId, &testCase.ingredientSubstitute.Entity.EntityId, testCase.toUpdatingIngredientSubstituteDTO)
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, testCase.toUpdatingIngredientSubstituteDTO.Ratio, actual.Entity.Ratio)
+				assert.Equal(t, testCase.toUpdatingIngredientSubstituteDTO.Status, actual.Entity.Status)
+				assert.Equal(t, testCase.ingredientSubstitute.Entity.SubstituteId, actual.Entity.SubstituteId)
+			},
+		)
+	}
+}
+
+func TestIngredientSubstituteDelete(t *testing.T) {
+	for _, testCase := range testsIngredientSubstituteData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.ingredientSubstitute == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := IngredientSubstituteDelete(testCase.id, testCase.userId, &testCase.ingredientSubstitute.Entity.EntityId)
+
+				assert.Nil(t, errorActual)
+				assert.True(t, actual)
+
+				_, _ = IngredientDelete(&testCase.ingredientSubstitute.Entity.EntityId, testCase.userId)
+				_, _ = IngredientDelete(&testCase.ingredientSubstitute.Entity.SubstituteId, testCase.userId)
+			},
+		)
+	}
+}
@@ -0,0 +1,401 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"time"
+)
+
+var (
+	errorMergeSame = errors.New("survivor and duplicate are the same")
+	errorMergeUser = errors.New("survivor and duplicate belong to different users")
+)
+
+// IngredientSimilar returns the ingredients of the user which are similar to the name by their names or by their
+// alternative names, the ingredient which has the id is not taken into account.
+func IngredientSimilar(userId *uuid.UUID, name string, excludeId *uuid.UUID) ([]*DomainAggregate.FuzzyMatch, error) {
+	candidates, errorCandidates := ingredientFuzzyCandidates(userId, excludeId)
+
+	if errorCandidates != nil {
+		return nil, errorCandidates
+	}
+
+	return ApplicationServiceHelper.FuzzyMatch(name, candidates), nil
+}
+
+// IngredientDuplicates returns the pairs of the ingredients of the user which look like duplicates to be merged.
+func IngredientDuplicates(userId *uuid.UUID) ([]*DomainAggregate.MergeSuggestion, error) {
+	candidates, errorCandidates := ingredientFuzzyCandidates(userId, nil)
+
+	if errorCandidates != nil {
+		return nil, errorCandidates
+	}
+
+	return ApplicationServiceHelper.FuzzyDuplicates(candidates), nil
+}
+
+// CategorySimilar returns the categories of the user which are similar to the name by their names or by their
+// alternative names, the category which has the id is not taken into account.
+func CategorySimilar(userId *uuid.UUID, name string, excludeId *uuid.UUID) ([]*DomainAggregate.FuzzyMatch, error) {
+	candidates, errorCandidates := categoryFuzzyCandidates(userId, excludeId)
+
+	if errorCandidates != nil {
+		return nil, errorCandidates
+	}
+
+	return ApplicationServiceHelper.FuzzyMatch(name, candidates), nil
+}
+
+// CategoryDuplicates returns the pairs of the categories of the user which look like duplicates to be merged.
+func CategoryDuplicates(userId *uuid.UUID) ([]*DomainAggregate.MergeSuggestion, error) {
+	candidates, errorCandidates := categoryFuzzyCandidates(userId, nil)
+
+	if errorCandidates != nil {
+		return nil, errorCandidates
+	}
+
+	return ApplicationServiceHelper.FuzzyDuplicates(candidates), nil
+}
+
+// IngredientMerge re-points the recipe ingredients, the alternative names, the pictures, the pantry items,
+// the prices and the substitutes from the duplicate to the survivor, then deletes the duplicate.
+func IngredientMerge(mergeDTO *dto.MergeDTO) (*DomainAggregate.Merge, error) {
+	if mergeDTO.SurvivorId == mergeDTO.DuplicateId {
+		return nil, errors.Wrapf(errorMergeSame, "an error occurred while merging ingredients by privided data %v", mergeDTO)
+	}
+
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	ingredientRepository := factoryRepository.GetIngredientRepository()
+	survivor, errorSurvivor := ingredientRepository.FindOne(ingredientRepository.GetCriteria().GetCriteriaById(&mergeDTO.SurvivorId, nil))
+
+	if errorSurvivor != nil {
+		return nil, errors.Wrapf(errorSurvivor, "an error occurred while getting a survivor ingredient by privided data %v", mergeDTO)
+	}
+
+	duplicate, errorDuplicate := ingredientRepository.FindOne(ingredientRepository.GetCriteria().GetCriteriaById(&mergeDTO.DuplicateId, nil))
+
+	if errorDuplicate != nil {
+		return nil, errors.Wrapf(errorDuplicate, "an error occurred while getting a duplicate ingredient by privided data %v", mergeDTO)
+	}
+
+	if survivor.UserId != duplicate.UserId {
+		return nil, errors.Wrapf(errorMergeUser, "an error occurred while merging ingredients by privided data %v", mergeDTO)
+	}
+
+	merge := &DomainAggregate.Merge{SurvivorId: survivor.Id, DuplicateId: duplicate.Id}
+	now := time.Now().UTC()
+
+	recipeIngredientRepository := factoryRepository.GetRecipeIngredientRepository()
+	recipeIngredients, errorRecipeIngredients := recipeIngredientRepository.FindAll(recipeIngredientRepository.GetCriteria().GetCriteriaByDeriveId(&duplicate.Id, nil))
+
+	if errorRecipeIngredients != nil {
+		return nil, errorRecipeIngredients
+	}
+
+	for _, recipeIngredient := range recipeIngredients {
+		recipeIngredient.DeriveId = survivor.Id
+		recipeIngredient.DateUpdate = now
+
+		if _, errorUpdateOne := recipeIngredientRepository.UpdateOne(recipeIngredientRepository.GetCriteria().GetCriteriaById(&recipeIngredient.Id, nil), recipeIngredient); errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing a recipe ingredient by privided data %v", recipeIngredient)
+		}
+
+		merge.RecipeIngredients++
+	}
+
+	pantryItemRepository := factoryRepository.GetPantryItemRepository()
+	pantryItems, errorPantryItems := pantryItemRepository.FindAll(pantryItemRepository.GetCriteria().GetCriteriaByIngredientId(&duplicate.Id, nil))
+
+	if errorPantryItems != nil {
+		return nil, errorPantryItems
+	}
+
+	for _, pantryItem := range pantryItems {
+		pantryItem.IngredientId = survivor.Id
+		pantryItem.DateUpdate = now
+
+		if _, errorUpdateOne := pantryItemRepository.UpdateOne(pantryItemRepository.GetCriteria().GetCriteriaById(&pantryItem.Id, nil), pantryItem); errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing a pantry item by privided data %v", pantryItem)
+		}
+
+		merge.PantryItems++
+	}
+
+	ingredientPriceRepository := factoryRepository.GetIngredientPriceRepository()
+	ingredientPrices, errorIngredientPrices := ingredientPriceRepository.FindAll(ingredientPriceRepository.GetCriteria().GetCriteriaByEntityId(&duplicate.Id, nil))
+
+	if errorIngredientPrices != nil {
+		return nil, errorIngredientPrices
+	}
+
+	for _, ingredientPrice := range ingredientPrices {
+		ingredientPrice.EntityId = survivor.Id
+		ingredientPrice.DateUpdate = now
+
+		if _, errorUpdateOne := ingredientPriceRepository.UpdateOne(ingredientPriceRepository.GetCriteria().GetCriteriaById(&ingredientPrice.Id, nil), ingredientPrice); errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing an ingredient price by privided data %v", ingredientPrice)
+		}
+
+		merge.IngredientPrices++
+	}
+
+	errorMergeSubstitutes := mergeIngredientSubstitutes(merge, now)
+
+	if errorMergeSubstitutes != nil {
+		return nil, errorMergeSubstitutes
+	}
+
+	errorMergeEntities := mergeEntities(merge, now)
+
+	if errorMergeEntities != nil {
+		return nil, errorMergeEntities
+	}
+
+	_, errorDeleteOne := ingredientRepository.DeleteOne(ingredientRepository.GetCriteria().GetCriteriaById(&duplicate.Id, nil))
+
+	if errorDeleteOne != nil {
+		return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a duplicate ingredient by privided data %v", mergeDTO)
+	}
+
+	return merge, nil
+}
+
+// CategoryMerge re-points the recipe categories, the ingredients, the alternative names and the pictures from
+// the duplicate to the survivor, then deletes the duplicate.
+func CategoryMerge(mergeDTO *dto.MergeDTO) (*DomainAggregate.Merge, error) {
+	if mergeDTO.SurvivorId == mergeDTO.DuplicateId {
+		return nil, errors.Wrapf(errorMergeSame, "an error occurred while merging categories by privided data %v", mergeDTO)
+	}
+
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	categoryRepository := factoryRepository.GetCategoryRepository()
+	survivor, errorSurvivor := categoryRepository.FindOne(categoryRepository.GetCriteria().GetCriteriaById(&mergeDTO.SurvivorId, nil))
+
+	if errorSurvivor != nil {
+		return nil, errors.Wrapf(errorSurvivor, "an error occurred while getting a survivor category by privided data %v", mergeDTO)
+	}
+
+	duplicate, errorDuplicate := categoryRepository.FindOne(categoryRepository.GetCriteria().GetCriteriaById(&mergeDTO.DuplicateId, nil))
+
+	if errorDuplicate != nil {
+		return nil, errors.Wrapf(errorDuplicate, "an error occurred while getting a duplicate category by privided data %v", mergeDTO)
+	}
+
+	if survivor.UserId != duplicate.UserId {
+		return nil, errors.Wrapf(errorMergeUser, "an error occurred while merging categories by privided data %v", mergeDTO)
+	}
+
+	merge := &DomainAggregate.Merge{SurvivorId: survivor.Id, DuplicateId: duplicate.Id}
+	now := time.Now().UTC()
+
+	recipeCategoryRepository := factoryRepository.GetRecipeCategoryRepository()
+	recipeCategories, errorRecipeCategories := recipeCategoryRepository.FindAll(recipeCategoryRepository.GetCriteria().GetCriteriaByDeriveId(&duplicate.Id, nil))
+
+	if errorRecipeCategories != nil {
+		return nil, errorRecipeCategories
+	}
+
+	for _, recipeCategory := range recipeCategories {
+		recipeCategory.DeriveId = survivor.Id
+		recipeCategory.DateUpdate = now
+
+		if _, errorUpdateOne := recipeCategoryRepository.UpdateOne(recipeCategoryRepository.GetCriteria().GetCriteriaById(&recipeCategory.Id, nil), recipeCategory); errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing a recipe category by privided data %v", recipeCategory)
+		}
+
+		merge.RecipeCategories++
+	}
+
+	ingredientRepository := factoryRepository.GetIngredientRepository()
+	ingredients, errorIngredients := ingredientRepository.FindAll(ingredientRepository.GetCriteria().GetCriteriaByCategoryId(&duplicate.Id, nil))
+
+	if errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	for _, ingredient := range ingredients {
+		ingredient.CategoryId = survivor.Id
+		ingredient.DateUpdate = now
+
+		if _, errorUpdateOne := ingredientRepository.UpdateOne(ingredientRepository.GetCriteria().GetCriteriaById(&ingredient.Id, nil), ingredient); errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing an ingredient by privided data %v", ingredient)
+		}
+
+		merge.Ingredients++
+	}
+
+	errorMergeEntities := mergeEntities(merge, now)
+
+	if errorMergeEntities != nil {
+		return nil, errorMergeEntities
+	}
+
+	_, errorDeleteOne := categoryRepository.DeleteOne(categoryRepository.GetCriteria().GetCriteriaById(&duplicate.Id, nil))
+
+	if errorDeleteOne != nil {
+		return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a duplicate category by privided data %v", mergeDTO)
+	}
+
+	return merge, nil
+}
+
+// mergeEntities re-points the alternative names and the pictures which belong to any kind of entity.
+func mergeEntities(merge *DomainAggregate.Merge, now time.Time) error {
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+
+	altNameRepository := factoryRepository.GetAltNameRepository()
+	altNames, errorAltNames := altNameRepository.FindAll(altNameRepository.GetCriteria().GetCriteriaByEntityId(&merge.DuplicateId, nil))
+
+	if errorAltNames != nil {
+		return errorAltNames
+	}
+
+	for _, altName := range altNames {
+		altName.EntityId = merge.SurvivorId
+		altName.DateUpdate = now
+
+		if _, errorUpdateOne := altNameRepository.UpdateOne(altNameRepository.GetCriteria().GetCriteriaById(&altName.Id, nil), altName); errorUpdateOne != nil {
+			return errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing an alt name by privided data %v", altName)
+		}
+
+		merge.AltNames++
+	}
+
+	pictureRepository := factoryRepository.GetPictureRepository()
+	pictures, errorPictures := pictureRepository.FindAll(pictureRepository.GetCriteria().GetCriteriaByEntityId(&merge.DuplicateId, nil))
+
+	if errorPictures != nil {
+		return errorPictures
+	}
+
+	for _, picture := range pictures {
+		picture.EntityId = merge.SurvivorId
+		picture.DateUpdate = now
+
+		if _, errorUpdateOne := pictureRepository.UpdateOne(pictureRepository.GetCriteria().GetCriteriaById(&picture.Id, nil), picture); errorUpdateOne != nil {
+			return errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing a picture by privided data %v", picture)
+		}
+
+		merge.Pictures++
+	}
+
+	return nil
+}
+
+// mergeIngredientSubstitutes re-points the substitutes of the duplicate and the substitutes by the duplicate,
+// a substitute of the survivor by itself is deleted.
+func mergeIngredientSubstitutes(merge *DomainAggregate.Merge, now time.Time) error {
+	ingredientSubstituteRepository := InfrastructureService.GetFactoryRepository().GetIngredientSubstituteRepository()
+	criteria := ingredientSubstituteRepository.GetCriteria()
+	ingredientSubstitutes, errorIngredientSubstitutes := ingredientSubstituteRepository.FindAll(criteria.GetCriteriaByEntityId(&merge.DuplicateId, nil))
+
+	if errorIngredientSubstitutes != nil {
+		return errorIngredientSubstitutes
+	}
+
+	substitutesBy, errorSubstitutesBy := ingredientSubstituteRepository.FindAll(criteria.GetCriteriaBySubstituteId(&merge.DuplicateId, nil))
+
+	if errorSubstitutesBy != nil {
+		return errorSubstitutesBy
+	}
+
+	for _, ingredientSubstitute := range append(ingredientSubstitutes, substitutesBy...) {
+		if ingredientSubstitute.EntityId == merge.DuplicateId {
+			ingredientSubstitute.EntityId = merge.SurvivorId
+		}
+
+		if ingredientSubstitute.SubstituteId == merge.DuplicateId {
+			ingredientSubstitute.SubstituteId = merge.SurvivorId
+		}
+
+		ingredientSubstitute.DateUpdate = now
+
+		if ingredientSubstitute.EntityId == ingredientSubstitute.SubstituteId {
+			if _, errorDeleteOne := ingredientSubstituteRepository.DeleteOne(criteria.GetCriteriaById(&ingredientSubstitute.Id, nil)); errorDeleteOne != nil {
+				return errors.Wrapf(errorDeleteOne, "an error occurred while deleting an ingredient substitute by privided data %v", ingredientSubstitute)
+			}
+		} else if _, errorUpdateOne := ingredientSubstituteRepository.UpdateOne(criteria.GetCriteriaById(&ingredientSubstitute.Id, nil), ingredientSubstitute); errorUpdateOne != nil {
+			return errors.Wrapf(errorUpdateOne, "an error occurred while re-pointing an ingredient substitute by privided data %v", ingredientSubstitute)
+		}
+
+		merge.IngredientSubstitutes++
+	}
+
+	return nil
+}
+
+func ingredientFuzzyCandidates(userId *uuid.UUID, excludeId *uuid.UUID) ([]*ApplicationServiceHelper.FuzzyCandidate, error) {
+	ingredientRepository := InfrastructureService.GetFactoryRepository().GetIngredientRepository()
+	ingredients, errorIngredients := ingredientRepository.FindAll(ingredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	altNames, errorAltNames := fuzzyAltNames(userId)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	candidates := make([]*ApplicationServiceHelper.FuzzyCandidate, 0, len(ingredients))
+
+	for _, ingredient := range ingredients {
+		if excludeId == nil || ingredient.Id != *excludeId {
+			candidates = append(
+				candidates,
+				&ApplicationServiceHelper.FuzzyCandidate{Id: ingredient.Id, Name: ingredient.Name, AltNames: altNames[ingredient.Id], DateInsert: ingredient.DateInsert},
+			)
+		}
+	}
+
+	return candidates, nil
+}
+
+func categoryFuzzyCandidates(userId *uuid.UUID, excludeId *uuid.UUID) ([]*ApplicationServiceHelper.FuzzyCandidate, error) {
+	categoryRepository := InfrastructureService.GetFactoryRepository().GetCategoryRepository()
+	categories, errorCategories := categoryRepository.FindAll(categoryRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorCategories != nil {
+		return nil, errorCategories
+	}
+
+	altNames, errorAltNames := fuzzyAltNames(userId)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	candidates := make([]*ApplicationServiceHelper.FuzzyCandidate, 0, len(categories))
+
+	for _, category := range categories {
+		if excludeId == nil || category.Id != *excludeId {
+			candidates = append(
+				candidates,
+				&ApplicationServiceHelper.FuzzyCandidate{Id: category.Id, Name: category.Name, AltNames: altNames[category.Id], DateInsert: category.DateInsert},
+			)
+		}
+	}
+
+	return candidates, nil
+}
+
+func fuzzyAltNames(userId *uuid.UUID) (map[uuid.UUID][]string, error) {
+	altNames, errorAltNames := AltNamesInfo(userId, nil, nil)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	altNamesByEntity := map[uuid.UUID][]string{}
+
+	for _, altName := range altNames {
+		altNamesByEntity[altName.EntityId] = append(altNamesByEntity[altName.EntityId], altName.Name)
+	}
+
+	return altNamesByEntity, nil
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"time"
+)
+
+var (
+	errorPantryItemCreate = errors.New("pantry item has not created by provided data")
+	errorPantryItemExists = errors.New("pantry item has not created by provided data")
+	errorPantryItemInfo   = errors.New("pantry item cannot be showed by provided data")
+)
+
+func PantryItemCreate(userId *uuid.UUID, pantryItemDTO *DomainEntity.PantryItem) (*DomainAggregate.PantryItem, error) {
+	pantryItemRepository := InfrastructureService.GetFactoryRepository().GetPantryItemRepository()
+	criteria := pantryItemRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = pantryItemRepository.GetCriteria().GetCriteriaByIngredientId(&pantryItemDTO.IngredientId, criteria)
+	criteria = pantryItemRepository.GetCriteria().GetCriteriaByUnitId(&pantryItemDTO.UnitId, criteria)
+	pantryItemFindOne, errorPantryItemFindOne := pantryItemRepository.FindOne(criteria)
+
+	if errorPantryItemFindOne == nil {
+		return nil, errorPantryItemCreate
+	} else if pantryItemFindOne != nil {
+		return nil, errorPantryItemExists
+	} else {
+		pantryItemDTO.UserId = *userId
+		pantryItemDTO.DateInsert = time.Now().UTC()
+		pantryItemDTO.DateUpdate = time.Now().UTC()
+
+		pantryItem, errorPantryItemInsertOne := pantryItemRepository.InsertOne(preparePantryItemRepositoryInsert(pantryItemDTO))
+
+		if errorPantryItemInsertOne != nil {
+			return nil, errors.Wrapf(errorPantryItemInsertOne, "an error occurred while creating a pantry item in the database by privided data %v", pantryItemDTO)
+		} else {
+			return getPantryItemAggregate(&pantryItem.Id, &pantryItem.UserId, nil)
+		}
+	}
+}
+
+func PantryItemsInfo(userId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.PantryItem, error) {
+	return ApplicationService.BuildPantryItemsAggregate(nil, userId, criteria)
+}
+
+func PantryItemInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PantryItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPantryItem, id, kind.UserRightRead)
+
+	return getPantryItemAggregate(id, userId, criteria)
+}
+
+func PantryItemUpdate(id *uuid.UUID, userId *uuid.UUID, pantryItemDTO *DomainEntity.PantryItem) (*DomainAggregate.PantryItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPantryItem, id, kind.UserRightWrite)
+
+	pantryItemRepository := InfrastructureService.GetFactoryRepository().GetPantryItemRepository()
+	pantryItem, errorPantryItem := getPantryItemAggregate(id, userId, nil)
+
+	if errorPantryItem != nil {
+		return nil, errors.Wrapf(errorPantryItem, "an error occurred while updating a pantry item by privided data id=%s,userId=%s,criteria=%v", id, userId, nil)
+	}
+
+	pantryItemDTO.Id = *id
+	pantryItemDTO.UserId = *userId
+	pantryItemDTO.DateInsert = pantryItem.Entity.DateInsert
+	pantryItemDTO.DateUpdate = time.Now().UTC()
+
+	pantryItemUpdated, errorPantryItemUpdated := service.Update(pantryItem.Entity, pantryItemDTO)
+
+	if errorPantryItemUpdated != nil {
+		return nil, errors.Wrapf(errorPantryItemUpdated, "an error occurred while updating a pantry item by privided data %v", pantryItemDTO)
+	}
+
+	restoredPantryItemUpdated, okRestoredPantryItemUpdated := pantryItemUpdated.Interface().(*DomainEntity.PantryItem)
+
+	if !okRestoredPantryItemUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a pantry item by privided data %s", pantryItemUpdated)
+	}
+
+	updateOne, errorUpdateOne := pantryItemRepository.UpdateOne(
+		pantryItemRepository.GetCriteria().GetCriteriaById(&restoredPantryItemUpdated.Id, nil),
+		restoredPantryItemUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a pantry item entity in the database %v", restoredPantryItemUpdated)
+	}
+
+	return getPantryItemAggregate(&updateOne.Id, &updateOne.UserId, nil)
+}
+
+func PantryItemDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPantryItem, id, kind.UserRightWrite)
+
+	pantryItemRepository := InfrastructureService.GetFactoryRepository().GetPantryItemRepository()
+
+	criteria := pantryItemRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = pantryItemRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	deleteOneStatus, errorDeleteOne := pantryItemRepository.DeleteOne(criteria)
+
+	if deleteOneStatus {
+		householdGrantsDelete(userId, id)
+	}
+
+	return deleteOneStatus, errorDeleteOne
+}
+
+func getPantryItemAggregate(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PantryItem, error) {
+	pantryItemsAggregate, errorPantryItemsAggregate := ApplicationService.BuildPantryItemsAggregate(id, userId, criteria)
+	if errorPantryItemsAggregate != nil {
+		return nil, errors.Wrapf(errorPantryItemsAggregate, "an error occurred while getting a pantry item by privided data id=%s,userId=%s,criteria=%v", id, userId, criteria)
+	} else if len(pantryItemsAggregate) == 0 {
+		return nil, errorPantryItemInfo
+	}
+	return pantryItemsAggregate[0], nil
+}
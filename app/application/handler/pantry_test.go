@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testsPantryItemData = testsPantryItem{
+		{
+			name:   "Test case with correct data",
+			id:     nil,
+			userId: &testUserId,
+			pantryItemDTO: &DomainEntity.PantryItem{
+				IngredientId: uuid.New(),
+				UnitId:       uuid.New(),
+				ExpiryTime:   time.Now().UTC().Add(time.Hour * 24),
+				Quantity:     500,
+				Status:       kind.PantryItemStatusInActive,
+			},
+			toUpdatingPantryItemDTO: &DomainEntity.PantryItem{
+				Quantity: 250,
+				Status:   kind.PantryItemStatusActive,
+			},
+		},
+	}
+)
+
+type testsPantryItem []struct {
+	name                    string
+	id                      *uuid.UUID
+	userId                  *uuid.UUID
+	pantryItemDTO           *DomainEntity.PantryItem
+	toUpdatingPantryItemDTO *DomainEntity.PantryItem
+	pantryItem              *DomainAggregate.PantryItem
+}
+
+func init() {
+	InfrastructureService.PrepareCache()
+	InfrastructureService.PreparePersistence()
+}
+
+func TestPantryItemCreate(t *testing.T) {
+	for index, testCase := range testsPantryItemData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := PantryItemCreate(testCase.userId, testCase.pantryItemDTO)
+
+				assert.Nil(t, errorActual)
+
+				testsPantryItemData[index].pantryItem = actual
+				testsPantryItemData[index].id = &actual.Entity.Id
+
+				assert.NotNil(t, actual.Entity.Id)
+				assert.Equal(t, testCase.pantryItemDTO.UserId, actual.Entity.UserId)
+				assert.NotNil(t, actual.Entity.DateInsert)
+				assert.NotNil(t, actual.Entity.DateUpdate)
+				assert.Equal(t, testCase.pantryItemDTO.IngredientId, actual.Entity.IngredientId)
+				assert.Equal(t, testCase.pantryItemDTO.UnitId, actual.Entity.UnitId)
+				assert.Equal(t, testCase.pantryItemDTO.Quantity, actual.Entity.Quantity)
+				assert.Equal(t, testCase.pantryItemDTO.Status, actual.Entity.Status)
+			},
+		)
+	}
+}
+
+func TestPantryItemsInfo(t *testing.T) {
+	for _, testCase := range testsPantryItemData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := PantryItemsInfo(testCase.userId, nil)
+
+				if testCase.pantryItem != nil {
+					assert.Nil(t, errorActual)
+
+					for _, actualEntity := range actual {
+						assert.Equal(t, testCase.pantryItem.Entity.Id, actualEntity.Entity.Id)
+						assert.Equal(t, testCase.pantryItem.Entity.UserId, actualEntity.Entity.UserId)
+						assert.Equal(t, testCase.pantryItem.Entity.DateInsert.Format(time.UnixDate), actualEntity.Entity.DateInsert.Format(time.UnixDate))
+						assert.Equal(t, testCase.pantryItem.Entity.DateUpdate.Format(time.UnixDate), actualEntity.Entity.DateUpdate.Format(time.UnixDate))
+						assert.Equal(t, testCase.pantryItem.Entity.Quantity, actualEntity.Entity.Quantity)
+						assert.Equal(t, testCase.pantryItem.Entity.Status, actualEntity.Entity.Status)
+					}
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestPantryItemInfo(t *testing.T) {
+	for _, testCase := range testsPantryItemData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := PantryItemInfo(testCase.id, testCase.userId, nil)
+
+				if testCase.pantryItem != nil {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.pantryItem.Entity.Id, actual.Entity.Id)
+					assert.Equal(t, testCase.pantryItem.Entity.UserId, actual.Entity.UserId)
+					assert.Equal(t, testCase.pantryItem.Entity.DateInsert.Format(time.UnixDate), actual.Entity.DateInsert.Format(time.UnixDate))
+					assert.Equal(t, testCase.pantryItem.Entity.DateUpdate.Format(time.UnixDate), actual.Entity.DateUpdate.Format(time.UnixDate))
+					assert.Equal(t, testCase.pantryItem.Entity.Quantity, actual.Entity.Quantity)
+					assert.Equal(t, testCase.pantryItem.Entity.Status, actual.Entity.Status)
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestPantryItemUpdate(t *testing.T) {
+	for index, testCase := range testsPantryItemData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := PantryItemUpdate(testCase.id, testCase.userId, testCase.toUpdatingPantryItemDTO)
+
+				if testCase.pantryItem != nil {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.toUpdatingPantryItemDTO.Quantity, actual.Entity.Quantity)
+					assert.Equal(t, testCase.toUpdatingPantryItemDTO.Status, actual.Entity.Status)
+					testsPantryItemData[index].pantryItem.Entity.Quantity = actual.Entity.Quantity
+					testsPantryItemData[index].pantryItem.Entity.Status = actual.Entity.Status
+					testsPantryItemData[index].pantryItem.Entity.DateUpdate = actual.Entity.DateUpdate
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestPantryItemDelete(t *testing.T) {
+	for _, testCase := range testsPantryItemData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := PantryItemDelete(testCase.id, testCase.userId)
+
+				if testCase.pantryItem != nil {
+					assert.Nil(t, errorActual)
+					assert.True(t, actual)
+				} else {
+					assert.NotNil(t, errorActual)
+					assert.False(t, actual)
+				}
+			},
+		)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -19,6 +20,8 @@ var (
 )
 
 func PictureCreate(userId *uuid.UUID, entityId *uuid.UUID, pictureDTO *DomainEntity.Picture) (*DomainAggregate.Picture, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	pictureRepository := InfrastructureService.GetFactoryRepository().GetPictureRepository()
 	criteria := pictureRepository.GetCriteria().GetCriteriaByName(&pictureDTO.Name, nil)
 	criteria = pictureRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
@@ -46,14 +49,20 @@ func PictureCreate(userId *uuid.UUID, entityId *uuid.UUID, pictureDTO *DomainEnt
 }
 
 func PicturesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.Picture, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildPicturesAggregate(nil, userId, entityId, criteria)
 }
 
 func PictureInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.Picture, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightRead)
+
 	return getPictureAggregate(id, userId, entityId, criteria)
 }
 
 func PictureUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, pictureDTO *DomainEntity.Picture) (*DomainAggregate.Picture, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	pictureRepository := InfrastructureService.GetFactoryRepository().GetPictureRepository()
 	pictureAggregate, errorPictureAggregate := getPictureAggregate(id, userId, entityId, nil)
 
@@ -94,6 +103,8 @@ func PictureUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, pictur
 }
 
 func PictureDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdEntityUserId(userId, entityId, kind.UserRightWrite)
+
 	pictureRepository := InfrastructureService.GetFactoryRepository().GetPictureRepository()
 
 	criteria := pictureRepository.GetCriteria().GetCriteriaById(id, nil)
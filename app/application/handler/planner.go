@@ -3,12 +3,15 @@ package handler
 import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"strings"
 	"time"
 )
 
@@ -32,6 +35,7 @@ func PlannerCreate(userId *uuid.UUID, plannerDTO *DomainEntity.Planner) (*Domain
 		plannerDTO.UserId = *userId
 		plannerDTO.DateInsert = time.Now().UTC()
 		plannerDTO.DateUpdate = time.Now().UTC()
+		plannerDTO.CalendarToken = ""
 
 		planner, errorPlannerInsertOne := plannerRepository.InsertOne(preparePlannerRepositoryInsert(plannerDTO))
 
@@ -44,14 +48,40 @@ func PlannerCreate(userId *uuid.UUID, plannerDTO *DomainEntity.Planner) (*Domain
 }
 
 func PlannersInfo(userId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.Planner, error) {
-	return ApplicationService.BuildPlannersAggregate(nil, userId, criteria)
+	plannersAggregate, errorPlannersAggregate := ApplicationService.BuildPlannersAggregate(nil, userId, criteria)
+
+	if errorPlannersAggregate != nil {
+		return nil, errorPlannersAggregate
+	}
+
+	householdGrants, errorHouseholdGrants := householdGrantsShared(userId, kind.HouseholdSubjectPlanner)
+
+	if errorHouseholdGrants != nil {
+		return nil, errors.Wrapf(errorHouseholdGrants, "an error occurred while getting shared planners by privided data userId=%s", userId)
+	}
+
+	for _, householdGrant := range householdGrants {
+		sharedPlannersAggregate, errorSharedPlannersAggregate := ApplicationService.BuildPlannersAggregate(&householdGrant.SubjectId, &householdGrant.UserId, nil)
+
+		if errorSharedPlannersAggregate != nil {
+			return nil, errors.Wrapf(errorSharedPlannersAggregate, "an error occurred while getting a shared planner by privided data %v", householdGrant)
+		}
+
+		plannersAggregate = append(plannersAggregate, sharedPlannersAggregate...)
+	}
+
+	return plannersAggregate, nil
 }
 
 func PlannerInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.Planner, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
+
 	return getPlannerAggregate(id, userId, criteria)
 }
 
 func PlannerUpdate(id *uuid.UUID, userId *uuid.UUID, plannerDTO *DomainEntity.Planner) (*DomainAggregate.Planner, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightWrite)
+
 	plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
 	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
 
@@ -63,6 +93,7 @@ func PlannerUpdate(id *uuid.UUID, userId *uuid.UUID, plannerDTO *DomainEntity.Pl
 	plannerDTO.UserId = *userId
 	plannerDTO.DateInsert = planner.Entity.DateInsert
 	plannerDTO.DateUpdate = time.Now().UTC()
+	plannerDTO.CalendarToken = ""
 
 	plannerUpdated, errorPlannerUpdated := service.Update(planner.Entity, plannerDTO)
 
@@ -94,42 +125,224 @@ func PlannerDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
 	criteria := plannerRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
 	criteria = plannerRepository.GetCriteria().GetCriteriaById(id, criteria)
 
-	return plannerRepository.DeleteOne(criteria)
+	deleteOneStatus, errorDeleteOne := plannerRepository.DeleteOne(criteria)
+
+	if deleteOneStatus {
+		householdGrantsDelete(userId, id)
+	}
+
+	return deleteOneStatus, errorDeleteOne
 }
 
 func PlannerCalculate(id *uuid.UUID, userId *uuid.UUID) ([]*DomainAggregate.PlannerCalculation, error) {
-	var plannerCalculations []*DomainAggregate.PlannerCalculation
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
 
-	mapPlannerCalculations := map[string]*DomainAggregate.PlannerCalculation{}
 	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
 
 	if errorPlanner != nil {
 		return nil, errors.Wrapf(errorPlanner, "an error occurred while calculating the planner with id=%s", id)
 	}
 
+	calculations := plannerCalculations(planner)
+	ingredientPrices, errorIngredientPrices := ApplicationService.BuildIngredientPricesAggregate(nil, userId, nil, nil)
+
+	if errorIngredientPrices != nil {
+		return nil, errors.Wrapf(errorIngredientPrices, "an error occurred while calculating the planner with id=%s", id)
+	}
+
+	ApplicationServiceHelper.PlannerCalculationCost(calculations, ingredientPrices, planner.Entity.Currency, time.Now().UTC())
+
+	return calculations, nil
+}
+
+// PlannerCost estimates the cost of the planner by the latest prices of ingredients. Every recipe of the planner
+// is estimated separately and the warnings are made when the estimated cost exceeds the budget of the planner.
+func PlannerCost(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.PlannerCost, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
+
+	var recipeCosts []*DomainAggregate.RecipeCost
+
+	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while estimating the cost of the planner with id=%s", id)
+	}
+
+	plannerCalculations, errorPlannerCalculations := PlannerCalculate(id, userId)
+
+	if errorPlannerCalculations != nil {
+		return nil, errors.Wrapf(errorPlannerCalculations, "an error occurred while estimating the cost of the planner with id=%s", id)
+	}
+
+	now := time.Now().UTC()
+	ingredientPrices, errorIngredientPrices := ApplicationService.BuildIngredientPricesAggregate(nil, userId, nil, nil)
+
+	if errorIngredientPrices != nil {
+		return nil, errors.Wrapf(errorIngredientPrices, "an error occurred while estimating the cost of the planner with id=%s", id)
+	}
+
+	total, currency, unpriced := ApplicationServiceHelper.PlannerCalculationCost(plannerCalculations, ingredientPrices, planner.Entity.Currency, now)
+
 	for _, interval := range planner.Intervals {
 		for _, recipe := range interval.Recipes {
-			for _, ingredient := range recipe.Recipe.Ingredients {
-				for _, measure := range ingredient.Measures {
-					mapKey := ingredient.Derive.Id.String() + measure.Unit.Id.String()
-					_, ok := mapPlannerCalculations[mapKey]
-
-					if !ok {
-						mapPlannerCalculations[mapKey] = &DomainAggregate.PlannerCalculation{
-							Ingredient: ingredient.Derive,
-							Unit:       measure.Unit,
-							Amount:     0,
-						}
-						plannerCalculations = append(plannerCalculations, mapPlannerCalculations[mapKey])
-					}
+			if recipe.Recipe == nil {
+				continue
+			}
+
+			recipeCosts = append(recipeCosts, recipeCost(recipe.Recipe, ingredientPrices, currency, now))
+		}
+	}
+
+	return &DomainAggregate.PlannerCost{
+		Planner:      planner.Entity,
+		Recipes:      recipeCosts,
+		Calculations: plannerCalculations,
+		Total:        total,
+		Budget:       planner.Entity.Budget,
+		Currency:     currency,
+		Unpriced:     unpriced,
+		Warnings:     ApplicationServiceHelper.PlannerBudgetWarnings(planner.Entity.Budget, total, currency, unpriced),
+	}, nil
+}
+
+// PlannerGenerate fills the empty intervals of the planner by the recipes of the user satisfying the constraints.
+// The budget and the currency of the planner are used when the constraints have not got them. The seed is made
+// from the current time when it has not been provided and it is returned to repeat the same plan later.
+func PlannerGenerate(id *uuid.UUID, userId *uuid.UUID, constraints *DomainAggregate.PlannerGenerateConstraints) (*DomainAggregate.PlannerGenerate, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightWrite)
+
+	plannerRecipeRepository := InfrastructureService.GetFactoryRepository().GetPlannerRecipeRepository()
+	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while generating the planner with id=%s", id)
+	}
+
+	recipes, errorRecipes := RecipesInfo(userId, nil)
+
+	if errorRecipes != nil {
+		return nil, errors.Wrapf(errorRecipes, "an error occurred while generating the planner with id=%s", id)
+	}
+
+	now := time.Now().UTC()
 
-					mapPlannerCalculations[mapKey].Amount += measure.Entity.Value
+	if constraints.Seed == 0 {
+		constraints.Seed = now.UnixNano()
+	}
+
+	if constraints.Budget <= 0 {
+		constraints.Budget = planner.Entity.Budget
+	}
+
+	if constraints.Currency == "" {
+		constraints.Currency = planner.Entity.Currency
+	}
+
+	currency := strings.ToUpper(constraints.Currency)
+	recipeCosts := map[uuid.UUID]int64{}
+	ingredientPrices, errorIngredientPrices := ApplicationService.BuildIngredientPricesAggregate(nil, userId, nil, nil)
+
+	if errorIngredientPrices != nil {
+		return nil, errors.Wrapf(errorIngredientPrices, "an error occurred while generating the planner with id=%s", id)
+	}
+
+	for _, recipe := range recipes {
+		if recipe.Entity == nil {
+			continue
+		}
+
+		recipeCostValue := recipeCost(recipe, ingredientPrices, currency, now)
+		recipeCosts[recipe.Entity.Id] = recipeCostValue.Total
+
+		if currency == "" {
+			currency = recipeCostValue.Currency
+		}
+	}
+
+	pantryIngredients := map[uuid.UUID]bool{}
+
+	if constraints.PreferPantry {
+		pantryItems, errorPantryItems := PantryItemsInfo(userId, nil)
+
+		if errorPantryItems != nil {
+			return nil, errors.Wrapf(errorPantryItems, "an error occurred while generating the planner with id=%s", id)
+		}
+
+		pantryIngredients = ApplicationServiceHelper.PantryIngredients(pantryItems, now)
+	}
+
+	assignments, total, warnings := ApplicationServiceHelper.PlannerGenerate(
+		planner.Intervals,
+		recipes,
+		userId,
+		constraints,
+		recipeCosts,
+		pantryIngredients,
+	)
+
+	for _, assignment := range assignments {
+		if constraints.Replace {
+			for _, interval := range planner.Intervals {
+				if interval.Entity.Id != assignment.Interval.Id {
+					continue
+				}
+
+				for _, plannerRecipe := range interval.Recipes {
+					criteria := plannerRecipeRepository.GetCriteria().GetCriteriaById(&plannerRecipe.Entity.Id, nil)
+					criteria = plannerRecipeRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+
+					_, errorDeleteOne := plannerRecipeRepository.DeleteOne(criteria)
+
+					if errorDeleteOne != nil {
+						return nil, errors.Wrapf(errorDeleteOne, "an error occurred while replacing a planner recipe %v", plannerRecipe.Entity)
+					}
 				}
 			}
 		}
+
+		_, errorPlannerRecipe := PlannerRecipeCreate(
+			userId,
+			&assignment.Interval.Id,
+			&DomainEntity.PlannerRecipe{RecipeId: assignment.Recipe.Id, Status: kind.PlannerRecipeStatusActive},
+		)
+
+		if errorPlannerRecipe != nil {
+			return nil, errors.Wrapf(errorPlannerRecipe, "an error occurred while generating the planner with id=%s", id)
+		}
+	}
+
+	planner, errorPlanner = getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while generating the planner with id=%s", id)
+	}
+
+	return &DomainAggregate.PlannerGenerate{
+		Planner:     planner,
+		Assignments: assignments,
+		Seed:        constraints.Seed,
+		Total:       total,
+		Currency:    currency,
+		Warnings:    warnings,
+	}, nil
+}
+
+func PlannerShoppingList(id *uuid.UUID, userId *uuid.UUID) ([]*DomainAggregate.PlannerCalculation, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
+
+	plannerCalculations, errorPlannerCalculations := PlannerCalculate(id, userId)
+
+	if errorPlannerCalculations != nil {
+		return nil, errors.Wrapf(errorPlannerCalculations, "an error occurred while making a shopping list of the planner with id=%s", id)
 	}
 
-	return plannerCalculations, nil
+	pantryItems, errorPantryItems := PantryItemsInfo(userId, nil)
+
+	if errorPantryItems != nil {
+		return nil, errors.Wrapf(errorPantryItems, "an error occurred while making a shopping list of the planner with id=%s", id)
+	}
+
+	return ApplicationServiceHelper.PantrySubtract(plannerCalculations, pantryItems, time.Now().UTC()), nil
 }
 
 func getPlannerAggregate(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.Planner, error) {
@@ -141,3 +354,15 @@ func getPlannerAggregate(id *uuid.UUID, userId *uuid.UUID, criteria *persistence
 	}
 	return plannerEntities[0], nil
 }
+
+func plannerCalculations(planner *DomainAggregate.Planner) []*DomainAggregate.PlannerCalculation {
+	var recipeCalculations [][]*DomainAggregate.PlannerCalculation
+
+	for _, interval := range planner.Intervals {
+		for _, recipe := range interval.Recipes {
+			recipeCalculations = append(recipeCalculations, ApplicationServiceHelper.RecipeCalculate(recipe.Recipe))
+		}
+	}
+
+	return ApplicationServiceHelper.PlannerCalculationMerge(recipeCalculations...)
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"io"
+	"time"
+)
+
+var (
+	errorPlannerCalendarToken = errors.New("planner calendar cannot be showed by provided token")
+)
+
+// PlannerCalendar returns the calendar of the planner, the planner with its intervals and recipes is read uncached, so
+// the subscribed calendars get the changes at once.
+func PlannerCalendar(id *uuid.UUID, userId *uuid.UUID, link string) (string, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightRead)
+
+	planner, errorPlanner := getPlannerAggregate(id, userId, &persistence.Criteria{Uncached: true})
+
+	if errorPlanner != nil {
+		return "", errors.Wrapf(errorPlanner, "an error occurred while exporting a calendar of the planner by privided data id=%s,userId=%s", id, userId)
+	}
+
+	return ApplicationServiceHelper.PlannerCalendar(planner, link, time.Now().UTC()), nil
+}
+
+// PlannerCalendarFeed returns the calendar of the planner which the subscription token belongs to.
+func PlannerCalendarFeed(token string, link string) (string, error) {
+	if token == "" {
+		return "", errorPlannerCalendarToken
+	}
+
+	plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
+	criteria := plannerRepository.GetCriteria().GetCriteriaByCalendarToken(&token, nil)
+	criteria.Uncached = true
+
+	plannerFindOne, errorPlannerFindOne := plannerRepository.FindOne(criteria)
+
+	if errorPlannerFindOne != nil || plannerFindOne == nil {
+		return "", errorPlannerCalendarToken
+	}
+
+	return PlannerCalendar(&plannerFindOne.Id, &plannerFindOne.UserId, link)
+}
+
+// PlannerCalendarTokenCreate sets a new subscription token of the planner, a previous one stops working.
+func PlannerCalendarTokenCreate(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.Planner, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightWrite)
+
+	token, errorToken := ApplicationServiceHelper.CalendarToken()
+
+	if errorToken != nil {
+		return nil, errors.Wrapf(errorToken, "an error occurred while making a calendar token of the planner with id=%s", id)
+	}
+
+	return plannerCalendarTokenUpdate(id, userId, token)
+}
+
+// PlannerCalendarTokenDelete removes the subscription token of the planner.
+func PlannerCalendarTokenDelete(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.Planner, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightWrite)
+
+	return plannerCalendarTokenUpdate(id, userId, "")
+}
+
+func plannerCalendarTokenUpdate(id *uuid.UUID, userId *uuid.UUID, token string) (*DomainAggregate.Planner, error) {
+	plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
+	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while updating a calendar token of the planner by privided data id=%s,userId=%s", id, userId)
+	}
+
+	planner.Entity.CalendarToken = token
+	planner.Entity.DateUpdate = time.Now().UTC()
+
+	updateOne, errorUpdateOne := plannerRepository.UpdateOne(
+		plannerRepository.GetCriteria().GetCriteriaById(&planner.Entity.Id, nil),
+		planner.Entity,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a planner entity in the database %v", planner.Entity)
+	}
+
+	return getPlannerAggregate(&updateOne.Id, &updateOne.UserId, nil)
+}
+
+// PlannerCalendarImport makes the intervals of the planner from the events of an RFC 5545 calendar which start
+// within the planner, the recipes are matched with the summaries of the events. The events which have been
+// imported before are skipped.
+func PlannerCalendarImport(id *uuid.UUID, userId *uuid.UUID, data io.Reader) (*DomainAggregate.PlannerCalendarImport, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, id, kind.UserRightWrite)
+
+	planner, errorPlanner := getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while importing a calendar into the planner by privided data id=%s,userId=%s", id, userId)
+	}
+
+	events, errorEvents := ApplicationServiceHelper.PlannerCalendarEvents(data, planner.Entity.StartTime, planner.Entity.EndTime)
+
+	if errorEvents != nil {
+		return nil, errors.Wrapf(errorEvents, "an error occurred while importing a calendar into the planner with id=%s", id)
+	}
+
+	recipes, errorRecipes := RecipesInfo(userId, nil)
+
+	if errorRecipes != nil {
+		return nil, errors.Wrapf(errorRecipes, "an error occurred while importing a calendar into the planner with id=%s", id)
+	}
+
+	plannerCalendarImport := &DomainAggregate.PlannerCalendarImport{}
+	intervalNames := make(map[string]*DomainEntity.PlannerInterval)
+
+	for _, plannerInterval := range planner.Intervals {
+		intervalNames[plannerInterval.Entity.Name] = plannerInterval.Entity
+	}
+
+	for _, event := range events {
+		name, matched, unmatched := ApplicationServiceHelper.PlannerCalendarEventRecipes(event.Summary, recipes)
+		uniqueName := ApplicationServiceHelper.PlannerCalendarIntervalName(name, event.StartTime)
+
+		if plannerCalendarIntervalImported(intervalNames, name, uniqueName, event) {
+			plannerCalendarImport.Warnings = append(plannerCalendarImport.Warnings, fmt.Sprintf("the event %q at %s has been imported before", event.Summary, event.StartTime.Format(time.RFC3339)))
+
+			continue
+		}
+
+		if _, ok := intervalNames[name]; ok {
+			name = uniqueName
+		}
+
+		plannerInterval, errorPlannerInterval := PlannerIntervalCreate(
+			userId,
+			id,
+			&DomainEntity.PlannerInterval{
+				StartTime: event.StartTime,
+				EndTime:   event.EndTime,
+				Name:      name,
+				Status:    kind.PlannerIntervalStatusActive,
+			},
+		)
+
+		if errorPlannerInterval != nil {
+			return nil, errors.Wrapf(errorPlannerInterval, "an error occurred while importing the event %q into the planner with id=%s", event.Summary, id)
+		}
+
+		intervalNames[plannerInterval.Entity.Name] = plannerInterval.Entity
+		plannerCalendarImport.Intervals++
+
+		for _, recipe := range matched {
+			_, errorPlannerRecipe := PlannerRecipeCreate(
+				userId,
+				&plannerInterval.Entity.Id,
+				&DomainEntity.PlannerRecipe{RecipeId: recipe.Entity.Id, Status: kind.PlannerRecipeStatusActive},
+			)
+
+			if errorPlannerRecipe != nil {
+				return nil, errors.Wrapf(errorPlannerRecipe, "an error occurred while importing the event %q into the planner with id=%s", event.Summary, id)
+			}
+
+			plannerCalendarImport.Recipes++
+		}
+
+		for _, recipeName := range unmatched {
+			plannerCalendarImport.Warnings = append(plannerCalendarImport.Warnings, fmt.Sprintf("the recipe %q of the event %q is not found", recipeName, event.Summary))
+		}
+	}
+
+	plannerCalendarImport.Planner, errorPlanner = getPlannerAggregate(id, userId, nil)
+
+	if errorPlanner != nil {
+		return nil, errorPlanner
+	}
+
+	return plannerCalendarImport, nil
+}
+
+func plannerCalendarIntervalImported(intervalNames map[string]*DomainEntity.PlannerInterval, name string, uniqueName string, event *DomainAggregate.PlannerCalendarEvent) bool {
+	for _, intervalName := range []string{name, uniqueName} {
+		plannerInterval, ok := intervalNames[intervalName]
+
+		if ok && plannerInterval.StartTime.Equal(event.StartTime) && plannerInterval.EndTime.Equal(event.EndTime) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -19,6 +20,8 @@ var (
 )
 
 func PlannerIntervalCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerIntervalDTO *DomainEntity.PlannerInterval) (*DomainAggregate.PlannerInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, entityId, kind.UserRightWrite)
+
 	plannerIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerIntervalRepository()
 	criteria := plannerIntervalRepository.GetCriteria().GetCriteriaByName(&plannerIntervalDTO.Name, nil)
 	criteria = plannerIntervalRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
@@ -46,14 +49,20 @@ func PlannerIntervalCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerInterv
 }
 
 func PlannerIntervalsInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.PlannerInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildPlannerIntervalAggregates(nil, userId, entityId, criteria)
 }
 
 func PlannerIntervalInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PlannerInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, entityId, kind.UserRightRead)
+
 	return getPlannerIntervalAggregate(id, userId, entityId, criteria)
 }
 
 func PlannerIntervalUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, plannerIntervalDTO *DomainEntity.PlannerInterval) (*DomainAggregate.PlannerInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, entityId, kind.UserRightWrite)
+
 	plannerIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerIntervalRepository()
 	plannerInterval, errorPlannerInterval := getPlannerIntervalAggregate(id, userId, entityId, nil)
 
@@ -92,6 +101,8 @@ func PlannerIntervalUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID
 }
 
 func PlannerIntervalDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlanner, entityId, kind.UserRightWrite)
+
 	plannerIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerIntervalRepository()
 
 	criteria := plannerIntervalRepository.GetCriteria().GetCriteriaById(id, nil)
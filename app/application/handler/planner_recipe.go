@@ -3,9 +3,11 @@ package handler
 import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -13,12 +15,16 @@ import (
 )
 
 var (
-	errorPlannerRecipeCreate = errors.New("planner recipe has not created by provided data")
-	errorPlannerRecipeExists = errors.New("planner recipe has not created by provided data")
-	errorPlannerRecipeInfo   = errors.New("planner recipe cannot be showed by provided data")
+	errorPlannerRecipeCreate  = errors.New("planner recipe has not created by provided data")
+	errorPlannerRecipeExists  = errors.New("planner recipe has not created by provided data")
+	errorPlannerRecipeInfo    = errors.New("planner recipe cannot be showed by provided data")
+	errorPlannerRecipeCooked  = errors.New("planner recipe has already been cooked")
+	errorPlannerRecipeVersion = errors.New("planner recipe version does not belong to the recipe")
 )
 
 func PlannerRecipeCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerRecipeDTO *DomainEntity.PlannerRecipe) (*DomainAggregate.PlannerRecipe, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightWrite)
+
 	plannerRecipeRepository := InfrastructureService.GetFactoryRepository().GetPlannerRecipeRepository()
 	criteria := plannerRecipeRepository.GetCriteria().GetCriteriaByRecipeId(&plannerRecipeDTO.RecipeId, nil)
 	criteria = plannerRecipeRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
@@ -29,6 +35,8 @@ func PlannerRecipeCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerRecipeDT
 		return nil, errorPlannerRecipeCreate
 	} else if plannerRecipeFindOne != nil {
 		return nil, errorPlannerRecipeExists
+	} else if !plannerRecipeVersionValid(userId, &plannerRecipeDTO.RecipeId, &plannerRecipeDTO.RecipeVersionId) {
+		return nil, errorPlannerRecipeVersion
 	} else {
 		plannerRecipeDTO.UserId = *userId
 		plannerRecipeDTO.EntityId = *entityId
@@ -46,14 +54,20 @@ func PlannerRecipeCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerRecipeDT
 }
 
 func PlannerRecipesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.PlannerRecipe, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildPlannerRecipeAggregates(nil, userId, entityId, criteria)
 }
 
 func PlannerRecipeInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PlannerRecipe, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightRead)
+
 	return getPlannerRecipeAggregate(id, userId, entityId, criteria)
 }
 
 func PlannerRecipeUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, plannerRecipeDTO *DomainEntity.PlannerRecipe) (*DomainAggregate.PlannerRecipe, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightWrite)
+
 	plannerRecipeRepository := InfrastructureService.GetFactoryRepository().GetPlannerRecipeRepository()
 	plannerRecipe, errorPlannerRecipe := getPlannerRecipeAggregate(id, userId, entityId, nil)
 
@@ -61,6 +75,16 @@ func PlannerRecipeUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID,
 		return nil, errors.Wrapf(errorPlannerRecipe, "an error occurred while updating a planner recipe by privided data id=%s,userId=%s,entityId=%v,criteria=%v", id, userId, entityId, nil)
 	}
 
+	recipeId := plannerRecipeDTO.RecipeId
+
+	if recipeId == uuid.Nil {
+		recipeId = plannerRecipe.Entity.RecipeId
+	}
+
+	if !plannerRecipeVersionValid(userId, &recipeId, &plannerRecipeDTO.RecipeVersionId) {
+		return nil, errorPlannerRecipeVersion
+	}
+
 	plannerRecipeDTO.Id = *id
 	plannerRecipeDTO.UserId = *userId
 	plannerRecipeDTO.EntityId = *entityId
@@ -92,6 +116,8 @@ func PlannerRecipeUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID,
 }
 
 func PlannerRecipeDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightWrite)
+
 	plannerRecipeRepository := InfrastructureService.GetFactoryRepository().GetPlannerRecipeRepository()
 
 	criteria := plannerRecipeRepository.GetCriteria().GetCriteriaById(id, nil)
@@ -101,6 +127,65 @@ func PlannerRecipeDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID)
 	return plannerRecipeRepository.DeleteOne(criteria)
 }
 
+func PlannerRecipeCook(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (*DomainAggregate.PlannerRecipe, error) {
+	userId = plannerIntervalUserId(userId, entityId, kind.UserRightWrite)
+
+	plannerRecipeRepository := InfrastructureService.GetFactoryRepository().GetPlannerRecipeRepository()
+	pantryItemRepository := InfrastructureService.GetFactoryRepository().GetPantryItemRepository()
+	plannerRecipe, errorPlannerRecipe := getPlannerRecipeAggregate(id, userId, entityId, nil)
+
+	if errorPlannerRecipe != nil {
+		return nil, errors.Wrapf(errorPlannerRecipe, "an error occurred while cooking a planner recipe by privided data id=%s,userId=%s,entityId=%v", id, userId, entityId)
+	} else if plannerRecipe.Entity.Status == kind.PlannerRecipeStatusCooked {
+		return nil, errorPlannerRecipeCooked
+	}
+
+	pantryItems, errorPantryItems := PantryItemsInfo(userId, nil)
+
+	if errorPantryItems != nil {
+		return nil, errors.Wrapf(errorPantryItems, "an error occurred while cooking a planner recipe by privided data id=%s,userId=%s,entityId=%v", id, userId, entityId)
+	}
+
+	consumedPantryItems := map[uuid.UUID]*DomainEntity.PantryItem{}
+
+	for _, ingredient := range plannerRecipe.Recipe.Ingredients {
+		if ingredient.Derive == nil {
+			continue
+		}
+
+		for _, measure := range ingredient.Measures {
+			for _, pantryItem := range ApplicationServiceHelper.PantryConsume(pantryItems, ingredient.Derive.Id, measure.Unit, measure.Entity.Value, time.Now().UTC()) {
+				consumedPantryItems[pantryItem.Entity.Id] = pantryItem.Entity
+			}
+		}
+	}
+
+	for _, pantryItem := range consumedPantryItems {
+		_, errorUpdateOne := pantryItemRepository.UpdateOne(
+			pantryItemRepository.GetCriteria().GetCriteriaById(&pantryItem.Id, nil),
+			pantryItem,
+		)
+
+		if errorUpdateOne != nil {
+			return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a pantry item entity in the database %v", pantryItem)
+		}
+	}
+
+	plannerRecipe.Entity.Status = kind.PlannerRecipeStatusCooked
+	plannerRecipe.Entity.DateUpdate = time.Now().UTC()
+
+	updateOne, errorUpdateOne := plannerRecipeRepository.UpdateOne(
+		plannerRecipeRepository.GetCriteria().GetCriteriaById(&plannerRecipe.Entity.Id, nil),
+		plannerRecipe.Entity,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a planner recipe entity in the database %v", plannerRecipe.Entity)
+	}
+
+	return getPlannerRecipeAggregate(&updateOne.Id, &updateOne.UserId, &updateOne.EntityId, nil)
+}
+
 func getPlannerRecipeAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PlannerRecipe, error) {
 	plannerRecipeAggregates, errorPlannerRecipeAggregates := ApplicationService.BuildPlannerRecipeAggregates(id, userId, entityId, criteria)
 	if errorPlannerRecipeAggregates != nil {
@@ -110,3 +195,18 @@ func getPlannerRecipeAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.
 	}
 	return plannerRecipeAggregates[0], nil
 }
+
+// plannerRecipeVersionValid checks that the pinned version belongs to the recipe, uuid.Nil follows the latest version.
+func plannerRecipeVersionValid(userId *uuid.UUID, recipeId *uuid.UUID, recipeVersionId *uuid.UUID) bool {
+	if *recipeVersionId == uuid.Nil {
+		return true
+	}
+
+	recipeVersionRepository := InfrastructureService.GetFactoryRepository().GetRecipeVersionRepository()
+	criteria := recipeVersionRepository.GetCriteria().GetCriteriaById(recipeVersionId, nil)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByEntityId(recipeId, criteria)
+	recipeVersion, errorRecipeVersion := recipeVersionRepository.FindOne(criteria)
+
+	return errorRecipeVersion == nil && recipeVersion != nil
+}
@@ -0,0 +1,483 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+var (
+	errorPlannerTemplateCreate         = errors.New("planner template has not created by provided data")
+	errorPlannerTemplateExists         = errors.New("planner template has not created by provided data")
+	errorPlannerTemplateInfo           = errors.New("planner template cannot be showed by provided data")
+	errorPlannerTemplateStartTime      = errors.New("planner template cannot be instantiated without a start time")
+	errorPlannerTemplateRecurrence     = errors.New("planner template recurrence has been made concurrently")
+	errorPlannerTemplateIntervalCreate = errors.New("planner template interval has not created by provided data")
+	errorPlannerTemplateIntervalExists = errors.New("planner template interval has not created by provided data")
+	errorPlannerTemplateIntervalInfo   = errors.New("planner template interval cannot be showed by provided data")
+)
+
+func PlannerTemplateCreate(userId *uuid.UUID, plannerTemplateDTO *DomainEntity.PlannerTemplate) (*DomainAggregate.PlannerTemplate, error) {
+	plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+	criteria := plannerTemplateRepository.GetCriteria().GetCriteriaByName(&plannerTemplateDTO.Name, nil)
+	criteria = plannerTemplateRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	plannerTemplateFindOne, errorPlannerTemplateFindOne := plannerTemplateRepository.FindOne(criteria)
+
+	if errorPlannerTemplateFindOne == nil {
+		return nil, errorPlannerTemplateCreate
+	} else if plannerTemplateFindOne != nil {
+		return nil, errorPlannerTemplateExists
+	} else {
+		plannerTemplateDTO.UserId = *userId
+		plannerTemplateDTO.DateInsert = time.Now().UTC()
+		plannerTemplateDTO.DateUpdate = time.Now().UTC()
+
+		if plannerTemplateDTO.Recurrence == "" {
+			plannerTemplateDTO.Recurrence = kind.PlannerTemplateRecurrenceNone
+		}
+
+		if plannerTemplateDTO.Status == "" {
+			plannerTemplateDTO.Status = kind.PlannerTemplateStatusActive
+		}
+
+		plannerTemplate, errorPlannerTemplateInsertOne := plannerTemplateRepository.InsertOne(preparePlannerTemplateRepositoryInsert(plannerTemplateDTO))
+
+		if errorPlannerTemplateInsertOne != nil {
+			return nil, errors.Wrapf(errorPlannerTemplateInsertOne, "an error occurred while creating a planner template in the database by privided data %v", plannerTemplateDTO)
+		} else {
+			return getPlannerTemplateAggregate(&plannerTemplate.Id, &plannerTemplate.UserId, nil)
+		}
+	}
+}
+
+func PlannerTemplatesInfo(userId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.PlannerTemplate, error) {
+	return ApplicationService.BuildPlannerTemplatesAggregate(nil, userId, criteria)
+}
+
+func PlannerTemplateInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PlannerTemplate, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, id, kind.UserRightRead)
+
+	return getPlannerTemplateAggregate(id, userId, criteria)
+}
+
+func PlannerTemplateUpdate(id *uuid.UUID, userId *uuid.UUID, plannerTemplateDTO *DomainEntity.PlannerTemplate) (*DomainAggregate.PlannerTemplate, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, id, kind.UserRightWrite)
+
+	plannerTemplate, errorPlannerTemplate := getPlannerTemplateAggregate(id, userId, nil)
+
+	if errorPlannerTemplate != nil {
+		return nil, errors.Wrapf(errorPlannerTemplate, "an error occurred while updating a planner template by privided data id=%s,userId=%s,criteria=%v", id, userId, nil)
+	}
+
+	plannerTemplateDTO.Id = *id
+	plannerTemplateDTO.UserId = *userId
+	plannerTemplateDTO.DateInsert = plannerTemplate.Entity.DateInsert
+	plannerTemplateDTO.DateUpdate = time.Now().UTC()
+
+	plannerTemplateUpdated, errorPlannerTemplateUpdated := service.Update(plannerTemplate.Entity, plannerTemplateDTO)
+
+	if errorPlannerTemplateUpdated != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateUpdated, "an error occurred while updating a planner template by privided data %v", plannerTemplateDTO)
+	}
+
+	restoredPlannerTemplateUpdated, okRestoredPlannerTemplateUpdated := plannerTemplateUpdated.Interface().(*DomainEntity.PlannerTemplate)
+
+	if !okRestoredPlannerTemplateUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a planner template by privided data %s", plannerTemplateUpdated)
+	}
+
+	updateOne, errorUpdateOne := plannerTemplateUpdateOne(restoredPlannerTemplateUpdated)
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	return getPlannerTemplateAggregate(&updateOne.Id, &updateOne.UserId, nil)
+}
+
+func PlannerTemplateDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, id, kind.UserRightWrite)
+
+	plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+	plannerTemplateIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateIntervalRepository()
+	plannerTemplate, errorPlannerTemplate := getPlannerTemplateAggregate(id, userId, nil)
+
+	if errorPlannerTemplate != nil {
+		return false, errorPlannerTemplate
+	}
+
+	for _, plannerTemplateInterval := range plannerTemplate.Intervals {
+		criteria := plannerTemplateIntervalRepository.GetCriteria().GetCriteriaById(&plannerTemplateInterval.Id, nil)
+		criteria = plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+
+		_, errorDeleteOne := plannerTemplateIntervalRepository.DeleteOne(criteria)
+
+		if errorDeleteOne != nil {
+			return false, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a planner template interval %v", plannerTemplateInterval)
+		}
+	}
+
+	criteria := plannerTemplateRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = plannerTemplateRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	deleteOneStatus, errorDeleteOne := plannerTemplateRepository.DeleteOne(criteria)
+
+	if deleteOneStatus {
+		householdGrantsDelete(userId, id)
+	}
+
+	return deleteOneStatus, errorDeleteOne
+}
+
+// PlannerTemplateInstantiate makes a planner with intervals and default recipes from the template for the start
+// time. When the template recurs the next planner is scheduled after the made one.
+func PlannerTemplateInstantiate(id *uuid.UUID, userId *uuid.UUID, startTime time.Time) (*DomainAggregate.Planner, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, id, kind.UserRightRead)
+
+	if startTime.IsZero() {
+		return nil, errorPlannerTemplateStartTime
+	}
+
+	plannerTemplate, errorPlannerTemplate := getPlannerTemplateAggregate(id, userId, nil)
+
+	if errorPlannerTemplate != nil {
+		return nil, errors.Wrapf(errorPlannerTemplate, "an error occurred while instantiating the planner template with id=%s", id)
+	}
+
+	planner, errorPlanner := plannerTemplateInstantiate(plannerTemplate, startTime.UTC())
+
+	if errorPlanner != nil {
+		return nil, errorPlanner
+	}
+
+	if days := plannerTemplate.Entity.Recurrence.Days(); days > 0 {
+		nextTime := startTime.UTC().AddDate(0, 0, days)
+
+		if plannerTemplate.Entity.NextTime.Before(nextTime) {
+			plannerTemplateEntity, errorPlannerTemplateEntity := plannerTemplateClaim(plannerTemplate.Entity)
+
+			if errorPlannerTemplateEntity != nil {
+				return nil, errorPlannerTemplateEntity
+			}
+
+			plannerTemplateEntity.NextTime = nextTime
+			plannerTemplateEntity.DateUpdate = time.Now().UTC()
+
+			_, errorUpdateOne := plannerTemplateUpdateOne(plannerTemplateEntity)
+
+			if errorUpdateOne != nil {
+				return nil, errorUpdateOne
+			}
+		}
+	}
+
+	return planner, nil
+}
+
+// PlannerTemplatesRecur makes the planners which the recurrences of the templates of all of the users are due by the
+// moment. The template is claimed and its next time is moved before the planners are made, so the planners of a period
+// are made once even when the recurrences run concurrently. All of the templates are recurred, the first error is
+// returned.
+func PlannerTemplatesRecur(now time.Time) ([]*DomainAggregate.Planner, error) {
+	var (
+		planners            []*DomainAggregate.Planner
+		errorPlannersRecurs error
+	)
+
+	plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+
+	status := kind.PlannerTemplateStatusActive.String()
+	criteria := plannerTemplateRepository.GetCriteria().GetCriteriaByStatus(&status, nil)
+	criteria.Uncached = true
+	plannerTemplateEntities, errorPlannerTemplateEntities := plannerTemplateRepository.FindAll(criteria)
+
+	if errorPlannerTemplateEntities != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateEntities, "an error occurred while getting the planner templates to recur by privided data status=%s", status)
+	}
+
+	for _, plannerTemplateEntity := range plannerTemplateEntities {
+		plannerTemplatePlanners, errorPlannerTemplateRecur := plannerTemplateRecur(plannerTemplateEntity, now)
+
+		if errorPlannerTemplateRecur != nil && errorPlannersRecurs == nil {
+			errorPlannersRecurs = errorPlannerTemplateRecur
+		}
+
+		planners = append(planners, plannerTemplatePlanners...)
+	}
+
+	return planners, errorPlannersRecurs
+}
+
+// PlannerTemplatesRecurEvery runs PlannerTemplatesRecur every interval until the process ends, the errors are logged.
+func PlannerTemplatesRecurEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+
+	for {
+		_, errorPlannerTemplatesRecur := PlannerTemplatesRecur(time.Now().UTC())
+
+		if errorPlannerTemplatesRecur != nil {
+			log.Error(errorPlannerTemplatesRecur)
+		}
+
+		<-ticker.C
+	}
+}
+
+func PlannerTemplateIntervalCreate(userId *uuid.UUID, entityId *uuid.UUID, plannerTemplateIntervalDTO *DomainEntity.PlannerTemplateInterval) (*DomainEntity.PlannerTemplateInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, entityId, kind.UserRightWrite)
+
+	plannerTemplateIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateIntervalRepository()
+	criteria := plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByName(&plannerTemplateIntervalDTO.Name, nil)
+	criteria = plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	criteria = plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	plannerTemplateIntervalFindOne, errorPlannerTemplateIntervalFindOne := plannerTemplateIntervalRepository.FindOne(criteria)
+
+	if errorPlannerTemplateIntervalFindOne == nil {
+		return nil, errorPlannerTemplateIntervalCreate
+	} else if plannerTemplateIntervalFindOne != nil {
+		return nil, errorPlannerTemplateIntervalExists
+	}
+
+	_, errorPlannerTemplate := getPlannerTemplateAggregate(entityId, userId, nil)
+
+	if errorPlannerTemplate != nil {
+		return nil, errors.Wrapf(errorPlannerTemplate, "an error occurred while creating a planner template interval by privided data %v", plannerTemplateIntervalDTO)
+	}
+
+	plannerTemplateIntervalDTO.UserId = *userId
+	plannerTemplateIntervalDTO.EntityId = *entityId
+	plannerTemplateIntervalDTO.DateInsert = time.Now().UTC()
+	plannerTemplateIntervalDTO.DateUpdate = time.Now().UTC()
+
+	if plannerTemplateIntervalDTO.Status == "" {
+		plannerTemplateIntervalDTO.Status = kind.PlannerTemplateIntervalStatusActive
+	}
+
+	plannerTemplateInterval, errorPlannerTemplateIntervalInsertOne := plannerTemplateIntervalRepository.InsertOne(preparePlannerTemplateIntervalRepositoryInsert(plannerTemplateIntervalDTO))
+
+	if errorPlannerTemplateIntervalInsertOne != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateIntervalInsertOne, "an error occurred while creating a planner template interval in the database by privided data %v", plannerTemplateIntervalDTO)
+	}
+
+	return getPlannerTemplateIntervalEntity(&plannerTemplateInterval.Id, &plannerTemplateInterval.UserId, &plannerTemplateInterval.EntityId, nil)
+}
+
+func PlannerTemplateIntervalsInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainEntity.PlannerTemplateInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, entityId, kind.UserRightRead)
+
+	return ApplicationService.BuildPlannerTemplateIntervalEntities(nil, userId, entityId, criteria)
+}
+
+func PlannerTemplateIntervalInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainEntity.PlannerTemplateInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, entityId, kind.UserRightRead)
+
+	return getPlannerTemplateIntervalEntity(id, userId, entityId, criteria)
+}
+
+func PlannerTemplateIntervalUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, plannerTemplateIntervalDTO *DomainEntity.PlannerTemplateInterval) (*DomainEntity.PlannerTemplateInterval, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, entityId, kind.UserRightWrite)
+
+	plannerTemplateIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateIntervalRepository()
+	plannerTemplateInterval, errorPlannerTemplateInterval := getPlannerTemplateIntervalEntity(id, userId, entityId, nil)
+
+	if errorPlannerTemplateInterval != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateInterval, "an error occurred while updating a planner template interval by privided data id=%s,userId=%s,entityId=%v,criteria=%v", id, userId, entityId, nil)
+	}
+
+	plannerTemplateIntervalDTO.Id = *id
+	plannerTemplateIntervalDTO.UserId = *userId
+	plannerTemplateIntervalDTO.EntityId = *entityId
+	plannerTemplateIntervalDTO.DateInsert = plannerTemplateInterval.DateInsert
+	plannerTemplateIntervalDTO.DateUpdate = time.Now().UTC()
+
+	plannerTemplateIntervalUpdated, errorPlannerTemplateIntervalUpdated := service.Update(plannerTemplateInterval, plannerTemplateIntervalDTO)
+
+	if errorPlannerTemplateIntervalUpdated != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateIntervalUpdated, "an error occurred while updating a planner template interval by privided data %v", plannerTemplateIntervalDTO)
+	}
+
+	restoredPlannerTemplateIntervalUpdated, okRestoredPlannerTemplateIntervalUpdated := plannerTemplateIntervalUpdated.Interface().(*DomainEntity.PlannerTemplateInterval)
+
+	if !okRestoredPlannerTemplateIntervalUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a planner template interval by privided data %s", plannerTemplateIntervalUpdated)
+	}
+
+	updateOne, errorUpdateOne := plannerTemplateIntervalRepository.UpdateOne(
+		plannerTemplateIntervalRepository.GetCriteria().GetCriteriaById(&restoredPlannerTemplateIntervalUpdated.Id, nil),
+		restoredPlannerTemplateIntervalUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a planner template interval entity in the database %v", restoredPlannerTemplateIntervalUpdated)
+	}
+
+	return getPlannerTemplateIntervalEntity(&updateOne.Id, &updateOne.UserId, &updateOne.EntityId, nil)
+}
+
+func PlannerTemplateIntervalDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectPlannerTemplate, entityId, kind.UserRightWrite)
+
+	plannerTemplateIntervalRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateIntervalRepository()
+
+	criteria := plannerTemplateIntervalRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	criteria = plannerTemplateIntervalRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+
+	return plannerTemplateIntervalRepository.DeleteOne(criteria)
+}
+
+func plannerTemplateInstantiate(plannerTemplate *DomainAggregate.PlannerTemplate, startTime time.Time) (*DomainAggregate.Planner, error) {
+	userId := plannerTemplate.Entity.UserId
+	plannerInstance := ApplicationServiceHelper.PlannerTemplateInstantiate(plannerTemplate, startTime, time.Now().UTC())
+	planner, errorPlanner := PlannerCreate(&userId, plannerInstance.Entity)
+
+	if errorPlanner != nil {
+		return nil, errors.Wrapf(errorPlanner, "an error occurred while instantiating the planner template with id=%s", plannerTemplate.Entity.Id)
+	}
+
+	for _, plannerIntervalInstance := range plannerInstance.Intervals {
+		plannerInterval, errorPlannerInterval := PlannerIntervalCreate(&userId, &planner.Entity.Id, plannerIntervalInstance.Entity)
+
+		if errorPlannerInterval != nil {
+			_, _ = PlannerDelete(&planner.Entity.Id, &userId)
+
+			return nil, errors.Wrapf(errorPlannerInterval, "an error occurred while instantiating the planner template with id=%s", plannerTemplate.Entity.Id)
+		}
+
+		for _, plannerRecipeInstance := range plannerIntervalInstance.Recipes {
+			_, errorPlannerRecipe := PlannerRecipeCreate(&userId, &plannerInterval.Entity.Id, plannerRecipeInstance.Entity)
+
+			if errorPlannerRecipe != nil {
+				_, _ = PlannerDelete(&planner.Entity.Id, &userId)
+
+				return nil, errors.Wrapf(errorPlannerRecipe, "an error occurred while instantiating the planner template with id=%s", plannerTemplate.Entity.Id)
+			}
+		}
+	}
+
+	return getPlannerAggregate(&planner.Entity.Id, &userId, nil)
+}
+
+// plannerTemplateRecur makes the due planners of the template, nothing is made when the template is not due or has been
+// claimed by another recurrence. The next time is moved on with the claim, so the concurrent recurrences skip the
+// template, and it is moved back to the first planner which has not been made, so the next recurrence makes it again.
+func plannerTemplateRecur(plannerTemplateEntity *DomainEntity.PlannerTemplate, now time.Time) ([]*DomainAggregate.Planner, error) {
+	var planners []*DomainAggregate.Planner
+
+	startTimes, nextTime := ApplicationServiceHelper.PlannerTemplateSchedule(plannerTemplateEntity, now)
+
+	if len(startTimes) == 0 && nextTime.Equal(plannerTemplateEntity.NextTime) {
+		return nil, nil
+	}
+
+	plannerTemplateClaimed, errorPlannerTemplateClaimed := plannerTemplateClaim(plannerTemplateEntity)
+
+	if errors.Is(errorPlannerTemplateClaimed, errorPlannerTemplateRecurrence) {
+		return nil, nil
+	} else if errorPlannerTemplateClaimed != nil {
+		return nil, errorPlannerTemplateClaimed
+	}
+
+	plannerTemplateClaimed.NextTime = nextTime
+	plannerTemplateClaimed.DateUpdate = now
+
+	_, errorUpdateOne := plannerTemplateUpdateOne(plannerTemplateClaimed)
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	plannerTemplate, errorPlannerTemplate := getPlannerTemplateAggregate(&plannerTemplateClaimed.Id, &plannerTemplateClaimed.UserId, &persistence.Criteria{Uncached: true})
+
+	if errorPlannerTemplate != nil {
+		plannerTemplateUnclaim(plannerTemplateClaimed, plannerTemplateEntity.NextTime)
+
+		return nil, errors.Wrapf(errorPlannerTemplate, "an error occurred while making a recurring planner of the template with id=%s", plannerTemplateClaimed.Id)
+	}
+
+	for _, startTime := range startTimes {
+		planner, errorPlanner := plannerTemplateInstantiate(plannerTemplate, startTime)
+
+		if errorPlanner != nil {
+			plannerTemplateUnclaim(plannerTemplateClaimed, startTime)
+
+			return planners, errors.Wrapf(errorPlanner, "an error occurred while making a recurring planner of the template with id=%s", plannerTemplateClaimed.Id)
+		}
+
+		planners = append(planners, planner)
+	}
+
+	return planners, nil
+}
+
+// plannerTemplateClaim claims the recurrence of the template, so the next time of the template is not moved by
+// concurrent recurrences.
+func plannerTemplateClaim(plannerTemplateEntity *DomainEntity.PlannerTemplate) (*DomainEntity.PlannerTemplate, error) {
+	plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+	plannerTemplateClaimed, errorPlannerTemplateClaimed := plannerTemplateRepository.ClaimRecurrence(&plannerTemplateEntity.Id, plannerTemplateEntity.Recurrences)
+
+	if errorPlannerTemplateClaimed != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateClaimed, "an error occurred while claiming a recurrence of the template with id=%s", plannerTemplateEntity.Id)
+	} else if plannerTemplateClaimed == nil {
+		return nil, errorPlannerTemplateRecurrence
+	}
+
+	return plannerTemplateClaimed, nil
+}
+
+// plannerTemplateUnclaim moves the next time of the claimed template back to the planner which has not been made.
+func plannerTemplateUnclaim(plannerTemplateClaimed *DomainEntity.PlannerTemplate, nextTime time.Time) {
+	plannerTemplateClaimed.NextTime = nextTime
+	plannerTemplateClaimed.DateUpdate = time.Now().UTC()
+
+	_, errorUpdateOne := plannerTemplateUpdateOne(plannerTemplateClaimed)
+
+	if errorUpdateOne != nil {
+		log.Error(errors.Wrapf(errorUpdateOne, "an error occurred while moving back the next time of the template with id=%s", plannerTemplateClaimed.Id))
+	}
+}
+
+func plannerTemplateUpdateOne(plannerTemplate *DomainEntity.PlannerTemplate) (*DomainEntity.PlannerTemplate, error) {
+	plannerTemplateRepository := InfrastructureService.GetFactoryRepository().GetPlannerTemplateRepository()
+	updateOne, errorUpdateOne := plannerTemplateRepository.UpdateOne(
+		plannerTemplateRepository.GetCriteria().GetCriteriaById(&plannerTemplate.Id, nil),
+		plannerTemplate,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a planner template entity in the database %v", plannerTemplate)
+	}
+
+	return updateOne, nil
+}
+
+func getPlannerTemplateAggregate(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.PlannerTemplate, error) {
+	plannerTemplateEntities, errorPlannerTemplateEntities := ApplicationService.BuildPlannerTemplatesAggregate(id, userId, criteria)
+	if errorPlannerTemplateEntities != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateEntities, "an error occurred while getting a planner template by privided data id=%s,userId=%s,criteria=%v", id, userId, criteria)
+	} else if len(plannerTemplateEntities) == 0 {
+		return nil, errorPlannerTemplateInfo
+	}
+	return plannerTemplateEntities[0], nil
+}
+
+func getPlannerTemplateIntervalEntity(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainEntity.PlannerTemplateInterval, error) {
+	plannerTemplateIntervalEntities, errorPlannerTemplateIntervalEntities := ApplicationService.BuildPlannerTemplateIntervalEntities(id, userId, entityId, criteria)
+	if errorPlannerTemplateIntervalEntities != nil {
+		return nil, errors.Wrapf(errorPlannerTemplateIntervalEntities, "an error occurred while getting a planner template interval by privided data id=%s,userId=%s,entityId=%s,criteria=%v", id, userId, entityId, criteria)
+	} else if len(plannerTemplateIntervalEntities) == 0 {
+		return nil, errorPlannerTemplateIntervalInfo
+	}
+	return plannerTemplateIntervalEntities[0], nil
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlannerTemplatesRecur(t *testing.T) {
+	tests := []struct {
+		name               string
+		userId             *uuid.UUID
+		plannerTemplateDTO *DomainEntity.PlannerTemplate
+	}{
+		{
+			name:   "Test case with a due weekly template recurred twice",
+			userId: &testUserId,
+			plannerTemplateDTO: &DomainEntity.PlannerTemplate{
+				Name:       "Planner Template " + uuid.NewString(),
+				Days:       7,
+				Recurrence: kind.PlannerTemplateRecurrenceWeekly,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				now := time.Now().UTC()
+				plannerTemplate, errorPlannerTemplate := PlannerTemplateCreate(testCase.userId, testCase.plannerTemplateDTO)
+
+				assert.Nil(t, errorPlannerTemplate)
+
+				planner, errorPlanner := PlannerTemplateInstantiate(&plannerTemplate.Entity.Id, testCase.userId, now.AddDate(0, 0, -8))
+
+				assert.Nil(t, errorPlanner)
+
+				planners, errorPlanners := PlannerTemplatesRecur(now)
+
+				assert.Nil(t, errorPlanners)
+
+				recurred := plannerTemplateTestRecurred(planners, plannerTemplate.Entity)
+
+				assert.NotEmpty(t, recurred)
+
+				planners, errorPlanners = PlannerTemplatesRecur(now)
+
+				assert.Nil(t, errorPlanners)
+				assert.Empty(t, plannerTemplateTestRecurred(planners, plannerTemplate.Entity))
+
+				for _, recurredPlanner := range append(recurred, planner) {
+					_, _ = PlannerDelete(&recurredPlanner.Entity.Id, testCase.userId)
+				}
+
+				_, _ = PlannerTemplateDelete(&plannerTemplate.Entity.Id, testCase.userId)
+			},
+		)
+	}
+}
+
+func plannerTemplateTestRecurred(planners []*DomainAggregate.Planner, plannerTemplate *DomainEntity.PlannerTemplate) []*DomainAggregate.Planner {
+	var recurred []*DomainAggregate.Planner
+
+	for _, planner := range planners {
+		if planner.Entity.UserId == plannerTemplate.UserId && strings.HasPrefix(planner.Entity.Name, plannerTemplate.Name+" ") {
+			recurred = append(recurred, planner)
+		}
+	}
+
+	return recurred
+}
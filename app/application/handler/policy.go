@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+)
+
+var (
+	errorPolicyDenied = errors.New("the action is not allowed for the user")
+)
+
+// PolicyEnforce checks whether the user is allowed to perform the action with the right on the resource. The roles of
+// the user and the active roles bound to the user through UserToRoleRepository are read from the database bypassing the
+// cache, so a changed role is applied at once, a role which is not stored yet falls back to the built-in permissions of
+// its code.
+func PolicyEnforce(userId *uuid.UUID, resource kind.UserResource, right kind.UserRight) error {
+	userRepository := InfrastructureService.GetFactoryRepository().GetUserRepository()
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+
+	userCriteria := userRepository.GetCriteriaByUserId(userId)
+	userCriteria.Uncached = true
+	user, errorUser := userRepository.FindOne(userCriteria)
+
+	if errorUser != nil {
+		return errors.Wrapf(errorUser, "an error occurred while enforcing a policy by privided data userId=%s", userId)
+	}
+
+	for _, role := range user.Roles {
+		permissions, errorPermissions := policyRolePermissions(role)
+
+		if errorPermissions != nil {
+			return errors.Wrapf(errorPermissions, "an error occurred while enforcing a policy by privided data userId=%s,role=%s", userId, role)
+		}
+
+		if ApplicationServiceHelper.PolicyAllows(permissions, nil, resource, right) {
+			return nil
+		}
+	}
+
+	status := kind.UserToRoleStatusActive.String()
+	criteria := userToRoleRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = userToRoleRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	criteria.Uncached = true
+	userToRoles, errorUserToRoles := userToRoleRepository.FindAll(criteria)
+
+	if errorUserToRoles != nil {
+		return errors.Wrapf(errorUserToRoles, "an error occurred while enforcing a policy by privided data userId=%s", userId)
+	}
+
+	for _, userToRole := range userToRoles {
+		userRoleCriteria := userRoleRepository.GetCriteria().GetCriteriaById(&userToRole.RoleId, nil)
+		userRoleCriteria.Uncached = true
+		userRole, errorUserRole := userRoleRepository.FindOne(userRoleCriteria)
+
+		if errorUserRole != nil || userRole.Status != kind.UserRoleStatusActive {
+			continue
+		}
+
+		if ApplicationServiceHelper.PolicyAllows(userRole.Permissions, userToRole.Rights, resource, right) {
+			return nil
+		}
+	}
+
+	return errorPolicyDenied
+}
+
+func policyRolePermissions(role kind.UserRole) ([]DomainEntity.UserPermission, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+	code := string(role)
+
+	criteria := userRoleRepository.GetCriteria().GetCriteriaByCode(&code, nil)
+	criteria.Uncached = true
+	userRoles, errorUserRoles := userRoleRepository.FindAll(criteria)
+
+	if errorUserRoles != nil {
+		return nil, errorUserRoles
+	}
+
+	if len(userRoles) == 0 {
+		return ApplicationServiceHelper.PolicyDefaultPermissions(role), nil
+	}
+
+	if userRoles[0].Status != kind.UserRoleStatusActive {
+		return nil, nil
+	}
+
+	return userRoles[0].Permissions, nil
+}
@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"strings"
+)
+
+const (
+	publicLimit    = 20
+	publicLimitMax = 100
+)
+
+// PublicRecipesInfo returns a page of the published recipes of all users with their published children only, the
+// recipes are filtered by the query and are ordered by relevance when the query has words and by names otherwise.
+// The filters, the order and the page are applied by the database, so only the recipes of the page are built.
+func PublicRecipesInfo(query *DomainAggregate.PublicRecipeQuery) (*DomainAggregate.PublicRecipes, error) {
+	if strings.TrimSpace(query.Query) != "" && len(ApplicationServiceHelper.SearchTerms(query.Query)) == 0 {
+		return nil, errors.Wrapf(errorSearchQuery, "an error occurred while getting public recipes by privided data query=%s", query.Query)
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = publicLimit
+	} else if query.Limit > publicLimitMax {
+		query.Limit = publicLimitMax
+	}
+
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	publicRecipes := &DomainAggregate.PublicRecipes{
+		Recipes: []*DomainAggregate.Recipe{},
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	}
+
+	recipeEntities, total, errorRecipeEntities := publicRecipeEntities(query)
+
+	if errorRecipeEntities != nil {
+		return nil, errors.Wrapf(errorRecipeEntities, "an error occurred while getting public recipes by privided data %v", query)
+	}
+
+	publicRecipes.Total = total
+
+	for _, recipeEntity := range recipeEntities {
+		recipe, errorRecipe := RecipeInfo(&recipeEntity.Id, &recipeEntity.UserId, &persistence.Criteria{Uncached: true})
+
+		if errorRecipe != nil {
+			continue
+		}
+
+		publicRecipe := ApplicationServiceHelper.PublicRecipe(recipe)
+
+		if publicRecipe != nil {
+			publicRecipes.Recipes = append(publicRecipes.Recipes, publicRecipe)
+		}
+	}
+
+	return publicRecipes, nil
+}
+
+// PublicRecipeInfo returns the published recipe of any user with its published children only.
+func PublicRecipeInfo(id *uuid.UUID) (*DomainAggregate.Recipe, error) {
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	status := kind.RecipeStatusPublished.String()
+	criteria := recipeRepository.GetCriteria().GetCriteriaById(id, &persistence.Criteria{Uncached: true})
+	criteria = recipeRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	recipeEntity, errorRecipeEntity := recipeRepository.FindOne(criteria)
+
+	if errorRecipeEntity != nil || recipeEntity == nil {
+		return nil, errorRecipeInfo
+	}
+
+	recipe, errorRecipe := RecipeInfo(id, &recipeEntity.UserId, &persistence.Criteria{Uncached: true})
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while getting a public recipe by privided data id=%s", id)
+	}
+
+	publicRecipe := ApplicationServiceHelper.PublicRecipe(recipe)
+
+	if publicRecipe == nil {
+		return nil, errorRecipeInfo
+	}
+
+	return publicRecipe, nil
+}
+
+// publicRecipeEntities returns the page of the published recipes by the query and the total of the recipes matched.
+func publicRecipeEntities(query *DomainAggregate.PublicRecipeQuery) ([]*DomainEntity.Recipe, int64, error) {
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	status := kind.RecipeStatusPublished.String()
+	criteria := recipeRepository.GetCriteria().GetCriteriaByStatus(&status, &persistence.Criteria{Uncached: true})
+
+	if strings.TrimSpace(query.Category) != "" || strings.TrimSpace(query.Ingredient) != "" {
+		ids, errorIds := publicRecipeIds(query)
+
+		if errorIds != nil {
+			return nil, 0, errorIds
+		} else if len(ids) == 0 {
+			return nil, 0, nil
+		}
+
+		criteria = recipeRepository.GetCriteria().GetCriteriaByIds(ids, criteria)
+	}
+
+	if strings.TrimSpace(query.Query) != "" {
+		criteria = recipeRepository.GetCriteria().GetCriteriaByText(&query.Query, criteria)
+	} else {
+		criteria.Order = map[string]interface{}{"name": 1}
+	}
+
+	criteria.Limit = int(query.Limit)
+	criteria.Offset = int(query.Offset)
+
+	var (
+		recipeEntities      []*DomainEntity.Recipe
+		errorRecipeEntities error
+	)
+
+	if strings.TrimSpace(query.Query) != "" {
+		recipeEntities, _, errorRecipeEntities = recipeRepository.FindText(criteria, query.Query)
+	} else {
+		recipeEntities, errorRecipeEntities = recipeRepository.FindAll(criteria)
+	}
+
+	if errorRecipeEntities != nil {
+		return nil, 0, errorRecipeEntities
+	}
+
+	criteria.Limit = 0
+	criteria.Offset = 0
+	total, errorTotal := recipeRepository.Count(criteria)
+
+	if errorTotal != nil {
+		return nil, 0, errorTotal
+	}
+
+	return recipeEntities, total, nil
+}
+
+// publicRecipeIds returns the ids of the recipes which have a published category and a published ingredient with the
+// names of the query, the published alternative names are matched as well.
+func publicRecipeIds(query *DomainAggregate.PublicRecipeQuery) ([]*uuid.UUID, error) {
+	var ids map[uuid.UUID]bool
+
+	if category := strings.TrimSpace(query.Category); category != "" {
+		categoryIds, errorCategoryIds := publicRecipeIdsByCategory(category)
+
+		if errorCategoryIds != nil {
+			return nil, errorCategoryIds
+		}
+
+		ids = categoryIds
+	}
+
+	if ingredient := strings.TrimSpace(query.Ingredient); ingredient != "" {
+		ingredientIds, errorIngredientIds := publicRecipeIdsByIngredient(ingredient)
+
+		if errorIngredientIds != nil {
+			return nil, errorIngredientIds
+		}
+
+		if ids == nil {
+			ids = ingredientIds
+		} else {
+			for id := range ids {
+				if !ingredientIds[id] {
+					delete(ids, id)
+				}
+			}
+		}
+	}
+
+	return publicIds(ids), nil
+}
+
+func publicRecipeIdsByCategory(name string) (map[uuid.UUID]bool, error) {
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	categoryRepository := factoryRepository.GetCategoryRepository()
+	recipeCategoryRepository := factoryRepository.GetRecipeCategoryRepository()
+	status := kind.CategoryStatusPublished.String()
+	recipeCategoryStatus := kind.RecipeCategoryStatusPublished.String()
+
+	altNameIds, errorAltNameIds := publicAltNameEntityIds(name)
+
+	if errorAltNameIds != nil {
+		return nil, errorAltNameIds
+	}
+
+	criteria := categoryRepository.GetCriteria().GetCriteriaByNameFold(&name, &persistence.Criteria{Uncached: true})
+	criteria = categoryRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	categories, errorCategories := categoryRepository.FindAll(criteria)
+
+	if errorCategories != nil {
+		return nil, errorCategories
+	}
+
+	if len(altNameIds) > 0 {
+		criteria = categoryRepository.GetCriteria().GetCriteriaByIds(altNameIds, &persistence.Criteria{Uncached: true})
+		criteria = categoryRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+		altNameCategories, errorAltNameCategories := categoryRepository.FindAll(criteria)
+
+		if errorAltNameCategories != nil {
+			return nil, errorAltNameCategories
+		}
+
+		categories = append(categories, altNameCategories...)
+	}
+
+	categoryIds := map[uuid.UUID]bool{}
+
+	for _, category := range categories {
+		categoryIds[category.Id] = true
+	}
+
+	ids := map[uuid.UUID]bool{}
+
+	if len(categoryIds) == 0 {
+		return ids, nil
+	}
+
+	criteria = recipeCategoryRepository.GetCriteria().GetCriteriaByDeriveIds(publicIds(categoryIds), &persistence.Criteria{Uncached: true})
+	criteria = recipeCategoryRepository.GetCriteria().GetCriteriaByStatus(&recipeCategoryStatus, criteria)
+	recipeCategories, errorRecipeCategories := recipeCategoryRepository.FindAll(criteria)
+
+	if errorRecipeCategories != nil {
+		return nil, errorRecipeCategories
+	}
+
+	for _, recipeCategory := range recipeCategories {
+		ids[recipeCategory.EntityId] = true
+	}
+
+	return ids, nil
+}
+
+func publicRecipeIdsByIngredient(name string) (map[uuid.UUID]bool, error) {
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	ingredientRepository := factoryRepository.GetIngredientRepository()
+	recipeIngredientRepository := factoryRepository.GetRecipeIngredientRepository()
+	status := kind.IngredientStatusPublished.String()
+	recipeIngredientStatus := kind.RecipeIngredientStatusPublished.String()
+
+	altNameIds, errorAltNameIds := publicAltNameEntityIds(name)
+
+	if errorAltNameIds != nil {
+		return nil, errorAltNameIds
+	}
+
+	criteria := ingredientRepository.GetCriteria().GetCriteriaByNameFold(&name, &persistence.Criteria{Uncached: true})
+	criteria = ingredientRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	ingredients, errorIngredients := ingredientRepository.FindAll(criteria)
+
+	if errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByNameFold(&name, &persistence.Criteria{Uncached: true})
+	criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByStatus(&recipeIngredientStatus, criteria)
+	recipeIngredients, errorRecipeIngredients := recipeIngredientRepository.FindAll(criteria)
+
+	if errorRecipeIngredients != nil {
+		return nil, errorRecipeIngredients
+	}
+
+	if len(altNameIds) > 0 {
+		criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByIds(altNameIds, &persistence.Criteria{Uncached: true})
+		criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByStatus(&recipeIngredientStatus, criteria)
+		altNameRecipeIngredients, errorAltNameRecipeIngredients := recipeIngredientRepository.FindAll(criteria)
+
+		if errorAltNameRecipeIngredients != nil {
+			return nil, errorAltNameRecipeIngredients
+		}
+
+		recipeIngredients = append(recipeIngredients, altNameRecipeIngredients...)
+	}
+
+	if len(ingredients) > 0 {
+		ingredientIds := map[uuid.UUID]bool{}
+
+		for _, ingredient := range ingredients {
+			ingredientIds[ingredient.Id] = true
+		}
+
+		criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByDeriveIds(publicIds(ingredientIds), &persistence.Criteria{Uncached: true})
+		criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByStatus(&recipeIngredientStatus, criteria)
+		derivedRecipeIngredients, errorDerivedRecipeIngredients := recipeIngredientRepository.FindAll(criteria)
+
+		if errorDerivedRecipeIngredients != nil {
+			return nil, errorDerivedRecipeIngredients
+		}
+
+		recipeIngredients = append(recipeIngredients, derivedRecipeIngredients...)
+	}
+
+	ids := map[uuid.UUID]bool{}
+
+	for _, recipeIngredient := range recipeIngredients {
+		ids[recipeIngredient.EntityId] = true
+	}
+
+	return ids, nil
+}
+
+// publicAltNameEntityIds returns the ids of the entities which have a published alternative name with the name.
+func publicAltNameEntityIds(name string) ([]*uuid.UUID, error) {
+	altNameRepository := InfrastructureService.GetFactoryRepository().GetAltNameRepository()
+	status := kind.AltNameStatusPublished.String()
+	criteria := altNameRepository.GetCriteria().GetCriteriaByNameFold(&name, &persistence.Criteria{Uncached: true})
+	criteria = altNameRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	altNames, errorAltNames := altNameRepository.FindAll(criteria)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	ids := map[uuid.UUID]bool{}
+
+	for _, altName := range altNames {
+		ids[altName.EntityId] = true
+	}
+
+	return publicIds(ids), nil
+}
+
+func publicIds(ids map[uuid.UUID]bool) []*uuid.UUID {
+	var result []*uuid.UUID
+
+	for id := range ids {
+		id := id
+		result = append(result, &id)
+	}
+
+	return result
+}
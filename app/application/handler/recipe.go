@@ -3,9 +3,11 @@ package handler
 import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
 	ApplicationServiceBuilder "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -37,18 +39,44 @@ func RecipeCreate(userId *uuid.UUID, recipeDTO *DomainEntity.Recipe) (*DomainAgg
 		recipe, errorRecipesInsertOne := recipesRepository.InsertOne(prepareRecipeRepositoryInsert(recipeDTO))
 
 		if errorRecipesInsertOne != nil {
-			return nil, errors.Wrapf(errorRecipesInsertOne, "an error occurred while creating a recipe in the database by privided data %s", recipeDTO)
+			return nil, errors.Wrapf(errorRecipesInsertOne, "an error occurred while creating a recipe in the database by privided data %v", recipeDTO)
 		} else {
+			recipeVersionWrite(&recipe.Id, &recipe.UserId, &recipe.Id, kind.RecipeVersionSubjectRecipe, kind.RecipeVersionActionCreate)
+
 			return RecipeInfo(&recipe.Id, &recipe.UserId, nil)
 		}
 	}
 }
 
 func RecipesInfo(userId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.Recipe, error) {
-	return ApplicationServiceBuilder.BuildRecipesAggregate(nil, userId, criteria)
+	recipesAggregate, errorRecipesAggregate := ApplicationServiceBuilder.BuildRecipesAggregate(nil, userId, criteria)
+
+	if errorRecipesAggregate != nil {
+		return nil, errorRecipesAggregate
+	}
+
+	householdGrants, errorHouseholdGrants := householdGrantsShared(userId, kind.HouseholdSubjectRecipe)
+
+	if errorHouseholdGrants != nil {
+		return nil, errors.Wrapf(errorHouseholdGrants, "an error occurred while getting shared recipes by privided data userId=%s", userId)
+	}
+
+	for _, householdGrant := range householdGrants {
+		sharedRecipesAggregate, errorSharedRecipesAggregate := ApplicationServiceBuilder.BuildRecipesAggregate(&householdGrant.SubjectId, &householdGrant.UserId, nil)
+
+		if errorSharedRecipesAggregate != nil {
+			return nil, errors.Wrapf(errorSharedRecipesAggregate, "an error occurred while getting a shared recipe by privided data %v", householdGrant)
+		}
+
+		recipesAggregate = append(recipesAggregate, sharedRecipesAggregate...)
+	}
+
+	return recipesAggregate, nil
 }
 
 func RecipeInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.Recipe, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightRead)
+
 	recipesAggregate, errorRecipesAggregate := ApplicationServiceBuilder.BuildRecipesAggregate(id, userId, criteria)
 
 	if errorRecipesAggregate != nil {
@@ -60,7 +88,29 @@ func RecipeInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria
 	return recipesAggregate[0], nil
 }
 
+// RecipeCost estimates the cost of the recipe and of one serving by the latest prices of ingredients.
+// When the currency is empty the currency of the first found price is used.
+func RecipeCost(id *uuid.UUID, userId *uuid.UUID, currency string) (*DomainAggregate.RecipeCost, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightRead)
+
+	recipe, errorRecipe := RecipeInfo(id, userId, nil)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while estimating the cost of the recipe with id=%s", id)
+	}
+
+	ingredientPrices, errorIngredientPrices := ApplicationServiceBuilder.BuildIngredientPricesAggregate(nil, userId, nil, nil)
+
+	if errorIngredientPrices != nil {
+		return nil, errors.Wrapf(errorIngredientPrices, "an error occurred while estimating the cost of the recipe with id=%s", id)
+	}
+
+	return recipeCost(recipe, ingredientPrices, currency, time.Now().UTC()), nil
+}
+
 func RecipeUpdate(id *uuid.UUID, userId *uuid.UUID, recipeDTO *DomainEntity.Recipe) (*DomainAggregate.Recipe, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightWrite)
+
 	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
 	recipeAggregate, errorRecipeAggregate := RecipeInfo(id, userId, nil)
 
@@ -72,11 +122,13 @@ func RecipeUpdate(id *uuid.UUID, userId *uuid.UUID, recipeDTO *DomainEntity.Reci
 	recipeDTO.UserId = *userId
 	recipeDTO.DateInsert = recipeAggregate.Entity.DateInsert
 	recipeDTO.DateUpdate = time.Now().UTC()
+	recipeDTO.ForkedFromId = recipeAggregate.Entity.ForkedFromId
+	recipeDTO.ForkedFromVersionId = recipeAggregate.Entity.ForkedFromVersionId
 
 	recipeEntityUpdated, errorRecipeEntityUpdate := service.Update(recipeAggregate.Entity, recipeDTO)
 
 	if errorRecipeEntityUpdate != nil {
-		return nil, errors.Wrapf(errorRecipeEntityUpdate, "an error occurred while updating a recipe by privided data %s", recipeDTO)
+		return nil, errors.Wrapf(errorRecipeEntityUpdate, "an error occurred while updating a recipe by privided data %v", recipeDTO)
 	}
 
 	restoredRecipeEntityUpdated, okRestoredRecipeEntityUpdated := recipeEntityUpdated.Interface().(*DomainEntity.Recipe)
@@ -91,11 +143,13 @@ func RecipeUpdate(id *uuid.UUID, userId *uuid.UUID, recipeDTO *DomainEntity.Reci
 	)
 
 	if errorUpdateOne != nil {
-		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a recipe entity in the database %s", restoredRecipeEntityUpdated)
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a recipe entity in the database %v", restoredRecipeEntityUpdated)
 	}
 
 	recipeAggregate.Entity = updateOne
 
+	recipeVersionWrite(id, userId, id, kind.RecipeVersionSubjectRecipe, kind.RecipeVersionActionUpdate)
+
 	return recipeAggregate, nil
 }
 
@@ -112,6 +166,31 @@ func RecipeDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
 	} else if !deleteOneStatus {
 		return deleteOneStatus, errorRecipeDelete
 	} else {
+		householdGrantsDelete(userId, id)
+
 		return true, nil
 	}
 }
+
+func recipeCost(
+	recipe *DomainAggregate.Recipe,
+	ingredientPrices []*DomainAggregate.IngredientPrice,
+	currency string,
+	now time.Time,
+) *DomainAggregate.RecipeCost {
+	total, currency, unpriced := ApplicationServiceHelper.PlannerCalculationCost(
+		ApplicationServiceHelper.RecipeCalculate(recipe),
+		ingredientPrices,
+		currency,
+		now,
+	)
+
+	return &DomainAggregate.RecipeCost{
+		Recipe:     recipe.Entity,
+		Servings:   recipe.Entity.Servings,
+		Total:      total,
+		PerServing: ApplicationServiceHelper.CostPerServing(total, recipe.Entity.Servings),
+		Currency:   currency,
+		Unpriced:   unpriced,
+	}
+}
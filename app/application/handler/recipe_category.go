@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -20,6 +21,8 @@ var (
 )
 
 func RecipeCategoryCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeCategoryDTO *DomainEntity.RecipeCategory) (*DomainAggregate.RecipeCategory, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeCategoryRepository := InfrastructureService.GetFactoryRepository().GetRecipeCategoryRepository()
 	categoryRepository := InfrastructureService.GetFactoryRepository().GetCategoryRepository()
 	criteria := recipeCategoryRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
@@ -50,20 +53,28 @@ func RecipeCategoryCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeCategory
 		if errorRecipeCategoriesInsertOne != nil {
 			return nil, errors.Wrapf(errorRecipeCategoriesInsertOne, "an error occurred while creating a recipe category in the database by privided data %s", recipeCategoryDTO)
 		} else {
+			recipeVersionWrite(entityId, userId, &recipeCategory.Id, kind.RecipeVersionSubjectCategory, kind.RecipeVersionActionCreate)
+
 			return getCategoryAggregate(&recipeCategory.Id, &recipeCategory.UserId, &recipeCategory.EntityId, nil)
 		}
 	}
 }
 
 func RecipeCategoriesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.RecipeCategory, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildRecipeCategoriesAggregate(nil, userId, entityId, criteria)
 }
 
 func RecipeCategoryInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeCategory, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return getCategoryAggregate(id, userId, entityId, criteria)
 }
 
 func RecipeCategoryUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, recipeCategoryDTO *DomainEntity.RecipeCategory) (*DomainAggregate.RecipeCategory, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	var criteria *persistence.Criteria
 
 	recipeCategoryRepository := InfrastructureService.GetFactoryRepository().GetRecipeCategoryRepository()
@@ -116,17 +127,27 @@ func RecipeCategoryUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID,
 
 	recipeCategoryAggregate.Entity = updateOne
 
+	recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectCategory, kind.RecipeVersionActionUpdate)
+
 	return recipeCategoryAggregate, nil
 }
 
 func RecipeCategoryDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeCategoryRepository := InfrastructureService.GetFactoryRepository().GetRecipeCategoryRepository()
 
 	criteria := recipeCategoryRepository.GetCriteria().GetCriteriaById(id, nil)
 	criteria = recipeCategoryRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
 	criteria = recipeCategoryRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
 
-	return recipeCategoryRepository.DeleteOne(criteria)
+	deleteOneStatus, errorDeleteOne := recipeCategoryRepository.DeleteOne(criteria)
+
+	if errorDeleteOne == nil && deleteOneStatus {
+		recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectCategory, kind.RecipeVersionActionDelete)
+	}
+
+	return deleteOneStatus, errorDeleteOne
 }
 
 func getCategoryAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeCategory, error) {
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+var (
+	errorRecipeExportFormat = errors.New("recipe cannot be exported in provided format")
+)
+
+// RecipeExport returns the recipe as a schema.org Recipe JSON-LD document or as a Markdown document, the link of
+// the API is used to make the URL of the recipe.
+func RecipeExport(id *uuid.UUID, userId *uuid.UUID, format kind.RecipeExportFormat, link string) (string, error) {
+	recipe, errorRecipe := RecipeInfo(id, userId, nil)
+
+	if errorRecipe != nil {
+		return "", errors.Wrapf(errorRecipe, "an error occurred while exporting a recipe by privided data id=%s,userId=%s", id, userId)
+	}
+
+	switch format {
+	case kind.RecipeExportFormatJSONLD:
+		document, errorDocument := ApplicationServiceHelper.RecipeJSONLD(recipe, link)
+
+		if errorDocument != nil {
+			return "", errors.Wrapf(errorDocument, "an error occurred while exporting a recipe with id=%s", id)
+		}
+
+		return string(document), nil
+	case kind.RecipeExportFormatMarkdown:
+		return ApplicationServiceHelper.RecipeMarkdown(recipe), nil
+	default:
+		return "", errors.Wrapf(errorRecipeExportFormat, "format=%s", format)
+	}
+}
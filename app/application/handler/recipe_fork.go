@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+)
+
+var (
+	errorRecipeFork         = errors.New("recipe cannot be forked by provided data")
+	errorRecipeForkUpstream = errors.New("recipe is not a fork or its upstream is not available")
+)
+
+// RecipeFork deep-copies the recipe with its categories, ingredients, measures, processes, pictures and alt names
+// under the user. A recipe of another user can be forked only when it is published, its categories and ingredients
+// are matched to the ones of the user by their names or are created. The fork of the own recipe is a clone which
+// keeps the categories and the ingredients. The fork is unpublished until the user publishes it. When the name is
+// empty the name of the recipe is used, the clone gets the "(copy)" suffix.
+func RecipeFork(id *uuid.UUID, userId *uuid.UUID, name string) (*DomainAggregate.Recipe, error) {
+	upstream, errorUpstream := recipeForkUpstream(id, userId)
+
+	if errorUpstream != nil {
+		return nil, errorUpstream
+	}
+
+	upstreamVersionId, errorUpstreamVersionId := recipeForkVersionId(&upstream.Entity.Id, &upstream.Entity.UserId)
+
+	if errorUpstreamVersionId != nil {
+		return nil, errors.Wrapf(errorUpstreamVersionId, "an error occurred while forking the recipe with id=%s", id)
+	}
+
+	clone := upstream.Entity.UserId == *userId
+
+	if name == "" {
+		name = upstream.Entity.Name
+
+		if clone {
+			name += " (copy)"
+		}
+	}
+
+	fork, errorFork := RecipeCreate(
+		userId,
+		&DomainEntity.Recipe{
+			Name:                name,
+			Description:         upstream.Entity.Description,
+			Notes:               upstream.Entity.Notes,
+			Servings:            upstream.Entity.Servings,
+			Calories:            upstream.Entity.Calories,
+			Status:              kind.RecipeStatusUnPublished,
+			ForkedFromId:        upstream.Entity.Id,
+			ForkedFromVersionId: *upstreamVersionId,
+		},
+	)
+
+	if errorFork != nil {
+		return nil, errors.Wrapf(errorFork, "an error occurred while forking the recipe with id=%s", id)
+	}
+
+	if errorAltNames := recipeForkAltNames(userId, &fork.Entity.Id, upstream.AltNames); errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	if errorPictures := recipeForkPictures(userId, &fork.Entity.Id, upstream.Pictures); errorPictures != nil {
+		return nil, errorPictures
+	}
+
+	if errorCategories := recipeForkCategories(userId, &fork.Entity.Id, upstream.Categories, clone); errorCategories != nil {
+		return nil, errorCategories
+	}
+
+	if errorIngredients := recipeForkIngredients(userId, &fork.Entity.Id, upstream.Ingredients, clone); errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	for _, recipeProcess := range upstream.Processes {
+		forkProcess, errorForkProcess := RecipeProcessCreate(
+			userId,
+			&fork.Entity.Id,
+			&DomainEntity.RecipeProcess{
+				Name:        recipeProcess.Entity.Name,
+				Description: recipeProcess.Entity.Description,
+				Notes:       recipeProcess.Entity.Notes,
+				Status:      recipeProcess.Entity.Status,
+			},
+		)
+
+		if errorForkProcess != nil {
+			return nil, errors.Wrapf(errorForkProcess, "an error occurred while copying a process to the fork of the recipe with id=%s", id)
+		}
+
+		if errorAltNames := recipeForkAltNames(userId, &forkProcess.Entity.Id, recipeProcess.AltNames); errorAltNames != nil {
+			return nil, errorAltNames
+		}
+
+		if errorPictures := recipeForkPictures(userId, &forkProcess.Entity.Id, recipeProcess.Pictures); errorPictures != nil {
+			return nil, errorPictures
+		}
+	}
+
+	return RecipeInfo(&fork.Entity.Id, userId, nil)
+}
+
+// RecipeForkUpstreamDiff compares the upstream recipe as it was at the moment of the fork with its current version.
+func RecipeForkUpstreamDiff(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.RecipeDiff, error) {
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	criteria := recipeRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = recipeRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	fork, errorFork := recipeRepository.FindOne(criteria)
+
+	if errorFork != nil || fork == nil {
+		return nil, errorRecipeInfo
+	} else if fork.ForkedFromId == uuid.Nil {
+		return nil, errorRecipeForkUpstream
+	}
+
+	upstream, errorUpstream := recipeForkUpstream(&fork.ForkedFromId, userId)
+
+	if errorUpstream != nil {
+		return nil, errors.Wrapf(errorRecipeForkUpstream, "an error occurred while comparing the fork with id=%s", id)
+	}
+
+	upstreamVersionId, errorUpstreamVersionId := recipeForkVersionId(&upstream.Entity.Id, &upstream.Entity.UserId)
+
+	if errorUpstreamVersionId != nil {
+		return nil, errors.Wrapf(errorUpstreamVersionId, "an error occurred while comparing the fork with id=%s", id)
+	}
+
+	return RecipeVersionDiff(&fork.ForkedFromVersionId, upstreamVersionId, &upstream.Entity.UserId, &upstream.Entity.Id)
+}
+
+// recipeForkUpstream returns the recipe which the user is allowed to fork, that is the own or a published one.
+func recipeForkUpstream(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.Recipe, error) {
+	recipeRepository := InfrastructureService.GetFactoryRepository().GetRecipeRepository()
+	upstream, errorUpstream := recipeRepository.FindOne(recipeRepository.GetCriteria().GetCriteriaById(id, nil))
+
+	if errorUpstream != nil || upstream == nil {
+		return nil, errorRecipeInfo
+	} else if upstream.UserId != *householdUserId(userId, kind.HouseholdSubjectRecipe, id, kind.UserRightRead) && upstream.Status != kind.RecipeStatusPublished {
+		return nil, errorRecipeFork
+	}
+
+	return RecipeInfo(id, &upstream.UserId, nil)
+}
+
+// recipeForkVersionId returns the id of the latest version of the recipe. A recipe which has been created before
+// the versioning gets its first version here.
+func recipeForkVersionId(id *uuid.UUID, userId *uuid.UUID) (*uuid.UUID, error) {
+	recipeVersions, errorRecipeVersions := RecipeVersionsInfo(userId, id)
+
+	if errorRecipeVersions != nil {
+		return nil, errorRecipeVersions
+	}
+
+	if len(recipeVersions) == 0 {
+		if errorRecipeVersion := recipeVersionInsert(id, userId, id, kind.RecipeVersionSubjectRecipe, kind.RecipeVersionActionCreate); errorRecipeVersion != nil {
+			return nil, errorRecipeVersion
+		}
+
+		return recipeForkVersionId(id, userId)
+	}
+
+	return &recipeVersions[0].Id, nil
+}
+
+func recipeForkCategories(userId *uuid.UUID, forkId *uuid.UUID, recipeCategories []*DomainAggregate.RecipeCategory, clone bool) error {
+	var categories []*DomainAggregate.Category
+
+	if !clone {
+		categories, _ = CategoriesInfo(userId, nil)
+	}
+
+	for _, recipeCategory := range recipeCategories {
+		deriveId := recipeCategory.Entity.DeriveId
+
+		if !clone {
+			if recipeCategory.Derive == nil {
+				continue
+			}
+
+			category := recipeImportCategory(recipeCategory.Derive.Entity.Name, categories)
+
+			if category == nil {
+				var errorCategory error
+
+				category, errorCategory = CategoryCreate(userId, &DomainEntity.Category{Name: recipeCategory.Derive.Entity.Name, Status: kind.CategoryStatusPublished})
+
+				if errorCategory != nil {
+					return errors.Wrapf(errorCategory, "an error occurred while copying a category %q to the fork of the recipe with id=%s", recipeCategory.Derive.Entity.Name, forkId)
+				}
+
+				categories = append(categories, category)
+			}
+
+			deriveId = category.Entity.Id
+		}
+
+		_, errorRecipeCategory := RecipeCategoryCreate(userId, forkId, &DomainEntity.RecipeCategory{DeriveId: deriveId, Status: recipeCategory.Entity.Status})
+
+		if errorRecipeCategory != nil {
+			return errors.Wrapf(errorRecipeCategory, "an error occurred while copying a category to the fork of the recipe with id=%s", forkId)
+		}
+	}
+
+	return nil
+}
+
+func recipeForkIngredients(userId *uuid.UUID, forkId *uuid.UUID, recipeIngredients []*DomainAggregate.RecipeIngredient, clone bool) error {
+	var (
+		ingredients []*DomainEntity.Ingredient
+		altNames    []*DomainEntity.AltName
+	)
+
+	if !clone {
+		ingredients, _ = IngredientsInfo(userId, nil)
+		altNames, _ = AltNamesInfo(userId, nil, nil)
+	}
+
+	for _, recipeIngredient := range recipeIngredients {
+		deriveId := recipeIngredient.Entity.DeriveId
+
+		if !clone {
+			name := recipeIngredient.Entity.Name
+
+			if recipeIngredient.Derive != nil {
+				name = recipeIngredient.Derive.Name
+			}
+
+			ingredient := ApplicationServiceHelper.RecipeImportIngredient(name, ingredients, altNames)
+
+			if ingredient == nil {
+				var errorIngredient error
+
+				ingredient, errorIngredient = IngredientCreate(userId, &DomainEntity.Ingredient{Name: name, Status: kind.IngredientStatusPublished})
+
+				if errorIngredient != nil {
+					return errors.Wrapf(errorIngredient, "an error occurred while copying an ingredient %q to the fork of the recipe with id=%s", name, forkId)
+				}
+
+				ingredients = append(ingredients, ingredient)
+			}
+
+			deriveId = ingredient.Id
+		}
+
+		forkIngredient, errorForkIngredient := RecipeIngredientCreate(
+			userId,
+			forkId,
+			&DomainEntity.RecipeIngredient{DeriveId: deriveId, Name: recipeIngredient.Entity.Name, Status: recipeIngredient.Entity.Status},
+		)
+
+		if errorForkIngredient != nil {
+			return errors.Wrapf(errorForkIngredient, "an error occurred while copying an ingredient to the fork of the recipe with id=%s", forkId)
+		}
+
+		if errorAltNames := recipeForkAltNames(userId, &forkIngredient.Entity.Id, recipeIngredient.AltNames); errorAltNames != nil {
+			return errorAltNames
+		}
+
+		if errorPictures := recipeForkPictures(userId, &forkIngredient.Entity.Id, recipeIngredient.Pictures); errorPictures != nil {
+			return errorPictures
+		}
+
+		for _, recipeMeasure := range recipeIngredient.Measures {
+			forkMeasure, errorForkMeasure := RecipeMeasureCreate(
+				userId,
+				&forkIngredient.Entity.Id,
+				&DomainEntity.RecipeMeasure{UnitId: recipeMeasure.Entity.UnitId, Value: recipeMeasure.Entity.Value, Status: recipeMeasure.Entity.Status},
+			)
+
+			if errorForkMeasure != nil {
+				return errors.Wrapf(errorForkMeasure, "an error occurred while copying a measure to the fork of the recipe with id=%s", forkId)
+			}
+
+			if errorAltNames := recipeForkAltNames(userId, &forkMeasure.Entity.Id, recipeMeasure.AltNames); errorAltNames != nil {
+				return errorAltNames
+			}
+		}
+	}
+
+	return nil
+}
+
+func recipeForkPictures(userId *uuid.UUID, entityId *uuid.UUID, pictures []*DomainAggregate.Picture) error {
+	for _, picture := range pictures {
+		forkPicture, errorForkPicture := PictureCreate(
+			userId,
+			entityId,
+			&DomainEntity.Picture{
+				Name:   picture.Entity.Name,
+				URL:    picture.Entity.URL,
+				Width:  picture.Entity.Width,
+				Height: picture.Entity.Height,
+				Size:   picture.Entity.Size,
+				Type:   picture.Entity.Type,
+				Status: picture.Entity.Status,
+			},
+		)
+
+		if errorForkPicture != nil {
+			return errors.Wrapf(errorForkPicture, "an error occurred while copying a picture to the fork by privided data entityId=%s", entityId)
+		}
+
+		if errorAltNames := recipeForkAltNames(userId, &forkPicture.Entity.Id, picture.AltNames); errorAltNames != nil {
+			return errorAltNames
+		}
+	}
+
+	return nil
+}
+
+func recipeForkAltNames(userId *uuid.UUID, entityId *uuid.UUID, altNames []*DomainEntity.AltName) error {
+	for _, altName := range altNames {
+		_, errorAltName := AltNameCreate(userId, entityId, &DomainEntity.AltName{Name: altName.Name, Status: altName.Status})
+
+		if errorAltName != nil {
+			return errors.Wrapf(errorAltName, "an error occurred while copying an alt name to the fork by privided data entityId=%s", entityId)
+		}
+	}
+
+	return nil
+}
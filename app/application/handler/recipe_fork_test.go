@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	testsRecipeForkData = testsRecipeFork{
+		{
+			name:   "Test case with correct data",
+			userId: &testUserId,
+			recipeDTO: &DomainEntity.Recipe{
+				Name:   "Test Recipe " + uuid.NewString(),
+				Status: kind.RecipeStatusPublished,
+			},
+			recipeProcessDTO: &DomainEntity.RecipeProcess{
+				Name:   "Test Process",
+				Status: kind.RecipeProcessStatusPublished,
+			},
+			toUpdatingRecipeDTO: &DomainEntity.Recipe{
+				Name: "Test Recipe Updated " + uuid.NewString(),
+			},
+		},
+	}
+)
+
+type testsRecipeFork []struct {
+	name                string
+	userId              *uuid.UUID
+	recipeDTO           *DomainEntity.Recipe
+	recipeProcessDTO    *DomainEntity.RecipeProcess
+	toUpdatingRecipeDTO *DomainEntity.Recipe
+	recipe              *DomainAggregate.Recipe
+	fork                *DomainAggregate.Recipe
+}
+
+func TestRecipeFork(t *testing.T) {
+	for index, testCase := range testsRecipeForkData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				recipe, errorRecipe := RecipeCreate(testCase.userId, testCase.recipeDTO)
+
+				assert.Nil(t, errorRecipe)
+
+				_, errorRecipeProcess := RecipeProcessCreate(testCase.userId, &recipe.Entity.Id, testCase.recipeProcessDTO)
+
+				assert.Nil(t, errorRecipeProcess)
+
+				actual, errorActual := RecipeFork(&recipe.Entity.Id, testCase.userId, "")
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, testCase.recipeDTO.Name+" (copy)", actual.Entity.Name)
+				assert.Equal(t, recipe.Entity.Id, actual.Entity.ForkedFromId)
+				assert.NotEqual(t, uuid.Nil, actual.Entity.ForkedFromVersionId)
+				assert.Equal(t, kind.RecipeStatusUnPublished, actual.Entity.Status)
+				assert.Len(t, actual.Processes, 1)
+				assert.Equal(t, testCase.recipeProcessDTO.Name, actual.Processes[0].Entity.Name)
+
+				testsRecipeForkData[index].recipe = recipe
+				testsRecipeForkData[index].fork = actual
+			},
+		)
+	}
+}
+
+func TestRecipeForkUpstreamDiff(t *testing.T) {
+	for _, testCase := range testsRecipeForkData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.fork == nil {
+					t.Skip()
+				}
+
+				_, errorRecipeUpdate := RecipeUpdate(&testCase.recipe.Entity.Id, testCase.userId, testCase.toUpdatingRecipeDTO)
+
+				assert.Nil(t, errorRecipeUpdate)
+
+				actual, errorActual := RecipeForkUpstreamDiff(&testCase.fork.Entity.Id, testCase.userId)
+
+				assert.Nil(t, errorActual)
+				assert.Contains(t, actual.Fields, &DomainAggregate.RecipeFieldChange{Field: "name", From: testCase.recipeDTO.Name, To: testCase.toUpdatingRecipeDTO.Name})
+
+				_, errorNotFork := RecipeForkUpstreamDiff(&testCase.recipe.Entity.Id, testCase.userId)
+
+				assert.Equal(t, errorRecipeForkUpstream, errorNotFork)
+
+				_, _ = RecipeDelete(&testCase.fork.Entity.Id, testCase.userId)
+				_, _ = RecipeDelete(&testCase.recipe.Entity.Id, testCase.userId)
+			},
+		)
+	}
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"io"
+	"strings"
+)
+
+type recipeImportMeasure struct {
+	EntityId uuid.UUID
+	UnitId   uuid.UUID
+	Value    int64
+}
+
+// RecipeImport creates a recipe with its categories, ingredients, measures, processes and pictures from a schema.org
+// Recipe JSON-LD document or from an HTML page with the document embedded. Categories and ingredients are matched by
+// their names and alternative names, the missing ones are created. Units are shared by all users, so they are matched
+// only and a measure with an unknown unit is left out. The recipe is deleted when one of its children is not created,
+// so the import can be repeated.
+func RecipeImport(userId *uuid.UUID, data io.Reader) (*DomainAggregate.Recipe, error) {
+	units, errorUnits := UnitsInfo(nil)
+
+	if errorUnits != nil {
+		return nil, errors.Wrapf(errorUnits, "an error occurred while importing a recipe by privided data userId=%s", userId)
+	}
+
+	altNames, errorAltNames := AltNamesInfo(userId, nil, nil)
+
+	if errorAltNames != nil {
+		return nil, errors.Wrapf(errorAltNames, "an error occurred while importing a recipe by privided data userId=%s", userId)
+	}
+
+	unitNames := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		unitNames = append(unitNames, unit.Name)
+
+		for _, altName := range altNames {
+			if altName.EntityId == unit.Id {
+				unitNames = append(unitNames, altName.Name)
+			}
+		}
+	}
+
+	recipeImport, errorRecipeImport := ApplicationServiceHelper.RecipeImportParse(data, unitNames)
+
+	if errorRecipeImport != nil {
+		return nil, errors.Wrapf(errorRecipeImport, "an error occurred while importing a recipe by privided data userId=%s", userId)
+	}
+
+	recipe, errorRecipe := RecipeCreate(userId, recipeImport.Entity)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while importing a recipe by privided data name=%s", recipeImport.Entity.Name)
+	}
+
+	errorRecipeChildren := recipeImportChildren(userId, &recipe.Entity.Id, recipeImport, units, altNames)
+
+	if errorRecipeChildren != nil {
+		recipeImportRollback(userId, &recipe.Entity.Id)
+
+		return nil, errorRecipeChildren
+	}
+
+	return RecipeInfo(&recipe.Entity.Id, userId, nil)
+}
+
+func recipeImportChildren(
+	userId *uuid.UUID,
+	recipeId *uuid.UUID,
+	recipeImport *DomainAggregate.RecipeImport,
+	units []*DomainEntity.Unit,
+	altNames []*DomainEntity.AltName,
+) error {
+	errorRecipeCategories := recipeImportCategories(userId, recipeId, recipeImport.Categories)
+
+	if errorRecipeCategories != nil {
+		return errorRecipeCategories
+	}
+
+	errorRecipeIngredients := recipeImportIngredients(userId, recipeId, recipeImport.Ingredients, units, altNames)
+
+	if errorRecipeIngredients != nil {
+		return errorRecipeIngredients
+	}
+
+	for _, recipeProcess := range recipeImport.Processes {
+		_, errorRecipeProcess := RecipeProcessCreate(userId, recipeId, recipeProcess)
+
+		if errorRecipeProcess != nil {
+			return errors.Wrapf(errorRecipeProcess, "an error occurred while importing a process of the recipe with id=%s", recipeId)
+		}
+	}
+
+	for _, picture := range recipeImport.Pictures {
+		_, errorPicture := PictureCreate(userId, recipeId, picture)
+
+		if errorPicture != nil {
+			return errors.Wrapf(errorPicture, "an error occurred while importing a picture of the recipe with id=%s", recipeId)
+		}
+	}
+
+	return nil
+}
+
+// recipeImportRollback deletes the recipe which has not been imported completely with the children created so far.
+func recipeImportRollback(userId *uuid.UUID, recipeId *uuid.UUID) {
+	recipe, errorRecipe := RecipeInfo(recipeId, userId, &persistence.Criteria{Uncached: true})
+
+	if errorRecipe == nil {
+		for _, recipeIngredient := range recipe.Ingredients {
+			for _, recipeMeasure := range recipeIngredient.Measures {
+				_, _ = RecipeMeasureDelete(&recipeMeasure.Entity.Id, userId, &recipeIngredient.Entity.Id)
+			}
+
+			_, _ = RecipeIngredientDelete(&recipeIngredient.Entity.Id, userId, recipeId)
+		}
+
+		for _, recipeCategory := range recipe.Categories {
+			_, _ = RecipeCategoryDelete(&recipeCategory.Entity.Id, userId, recipeId)
+		}
+
+		for _, recipeProcess := range recipe.Processes {
+			_, _ = RecipeProcessDelete(&recipeProcess.Entity.Id, userId, recipeId)
+		}
+
+		for _, picture := range recipe.Pictures {
+			_, _ = PictureDelete(&picture.Entity.Id, userId, recipeId)
+		}
+	}
+
+	_, _ = RecipeDelete(recipeId, userId)
+}
+
+func recipeImportCategories(userId *uuid.UUID, recipeId *uuid.UUID, names []string) error {
+	categories, errorCategories := CategoriesInfo(userId, nil)
+
+	if errorCategories != nil {
+		return errors.Wrapf(errorCategories, "an error occurred while importing categories of the recipe with id=%s", recipeId)
+	}
+
+	for _, name := range names {
+		category := recipeImportCategory(name, categories)
+
+		if category == nil {
+			var errorCategory error
+
+			category, errorCategory = CategoryCreate(userId, &DomainEntity.Category{Name: name, Status: kind.CategoryStatusPublished})
+
+			if errorCategory != nil {
+				return errors.Wrapf(errorCategory, "an error occurred while importing a category %q of the recipe with id=%s", name, recipeId)
+			}
+
+			categories = append(categories, category)
+		}
+
+		_, errorRecipeCategory := RecipeCategoryCreate(
+			userId,
+			recipeId,
+			&DomainEntity.RecipeCategory{DeriveId: category.Entity.Id, Status: kind.RecipeCategoryStatusPublished},
+		)
+
+		if errorRecipeCategory != nil {
+			return errors.Wrapf(errorRecipeCategory, "an error occurred while importing a category %q of the recipe with id=%s", name, recipeId)
+		}
+	}
+
+	return nil
+}
+
+func recipeImportCategory(name string, categories []*DomainAggregate.Category) *DomainAggregate.Category {
+	for _, category := range categories {
+		if strings.EqualFold(category.Entity.Name, name) {
+			return category
+		}
+
+		for _, altName := range category.AltNames {
+			if strings.EqualFold(altName.Name, name) {
+				return category
+			}
+		}
+	}
+
+	return nil
+}
+
+func recipeImportIngredients(
+	userId *uuid.UUID,
+	recipeId *uuid.UUID,
+	recipeImportIngredients []*DomainAggregate.RecipeImportIngredient,
+	units []*DomainEntity.Unit,
+	altNames []*DomainEntity.AltName,
+) error {
+	ingredients, errorIngredients := IngredientsInfo(userId, nil)
+
+	if errorIngredients != nil {
+		return errors.Wrapf(errorIngredients, "an error occurred while importing ingredients of the recipe with id=%s", recipeId)
+	}
+
+	recipeIngredients := make(map[uuid.UUID]*DomainAggregate.RecipeIngredient)
+	var measures []*recipeImportMeasure
+
+	for _, recipeImportIngredient := range recipeImportIngredients {
+		ingredient := ApplicationServiceHelper.RecipeImportIngredient(recipeImportIngredient.Name, ingredients, altNames)
+
+		if ingredient == nil {
+			var errorIngredient error
+
+			ingredient, errorIngredient = IngredientCreate(
+				userId,
+				&DomainEntity.Ingredient{Name: recipeImportIngredient.Name, Status: kind.IngredientStatusPublished},
+			)
+
+			if errorIngredient != nil {
+				return errors.Wrapf(errorIngredient, "an error occurred while importing an ingredient %q of the recipe with id=%s", recipeImportIngredient.Line, recipeId)
+			}
+
+			ingredients = append(ingredients, ingredient)
+		}
+
+		recipeIngredient, ok := recipeIngredients[ingredient.Id]
+
+		if !ok {
+			var errorRecipeIngredient error
+
+			recipeIngredient, errorRecipeIngredient = RecipeIngredientCreate(
+				userId,
+				recipeId,
+				&DomainEntity.RecipeIngredient{DeriveId: ingredient.Id, Name: ingredient.Name, Status: kind.RecipeIngredientStatusPublished},
+			)
+
+			if errorRecipeIngredient != nil {
+				return errors.Wrapf(errorRecipeIngredient, "an error occurred while importing an ingredient %q of the recipe with id=%s", recipeImportIngredient.Line, recipeId)
+			}
+
+			recipeIngredients[ingredient.Id] = recipeIngredient
+		}
+
+		if recipeImportIngredient.Unit == "" {
+			continue
+		}
+
+		unit := ApplicationServiceHelper.RecipeImportUnit(recipeImportIngredient.Unit, units, altNames)
+
+		if unit == nil {
+			continue
+		}
+
+		measures = recipeImportMeasureAdd(measures, recipeIngredient.Entity.Id, unit.Id, recipeImportIngredient.Value)
+	}
+
+	for _, measure := range measures {
+		_, errorRecipeMeasure := RecipeMeasureCreate(
+			userId,
+			&measure.EntityId,
+			&DomainEntity.RecipeMeasure{UnitId: measure.UnitId, Value: measure.Value, Status: kind.RecipeMeasureStatusPublished},
+		)
+
+		if errorRecipeMeasure != nil {
+			return errors.Wrapf(errorRecipeMeasure, "an error occurred while importing a measure of the recipe with id=%s", recipeId)
+		}
+	}
+
+	return nil
+}
+
+// recipeImportMeasureAdd sums the values of an ingredient which is listed several times with the same unit,
+// because a recipe ingredient keeps a single measure per unit.
+func recipeImportMeasureAdd(measures []*recipeImportMeasure, entityId uuid.UUID, unitId uuid.UUID, value int64) []*recipeImportMeasure {
+	for _, measure := range measures {
+		if measure.EntityId == entityId && measure.UnitId == unitId {
+			measure.Value += value
+
+			return measures
+		}
+	}
+
+	return append(measures, &recipeImportMeasure{EntityId: entityId, UnitId: unitId, Value: value})
+}
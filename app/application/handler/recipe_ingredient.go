@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -19,6 +20,8 @@ var (
 )
 
 func RecipeIngredientCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeIngredientDTO *DomainEntity.RecipeIngredient) (*DomainAggregate.RecipeIngredient, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
 	criteria := recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
 	criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
@@ -40,20 +43,28 @@ func RecipeIngredientCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeIngred
 		if errorRecipeIngredientsInsertOne != nil {
 			return nil, errors.Wrapf(errorRecipeIngredientsInsertOne, "an error occurred while creating a recipe ingredient in the database by privided data %s", recipeIngredientDTO)
 		} else {
+			recipeVersionWrite(entityId, userId, &recipeIngredient.Id, kind.RecipeVersionSubjectIngredient, kind.RecipeVersionActionCreate)
+
 			return getIngredientAggregate(&recipeIngredient.Id, &recipeIngredient.UserId, &recipeIngredient.EntityId, nil)
 		}
 	}
 }
 
 func RecipeIngredientsInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.RecipeIngredient, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildRecipeIngredientsAggregate(nil, userId, entityId, criteria)
 }
 
 func RecipeIngredientInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeIngredient, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return getIngredientAggregate(id, userId, entityId, criteria)
 }
 
 func RecipeIngredientUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, recipeIngredientDTO *DomainEntity.RecipeIngredient) (*DomainAggregate.RecipeIngredient, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeIngredientAggregate, errorRecipeIngredientAggregate := getIngredientAggregate(id, userId, entityId, nil)
 	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
 
@@ -90,17 +101,27 @@ func RecipeIngredientUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUI
 
 	recipeIngredientAggregate.Entity = updateOne
 
+	recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectIngredient, kind.RecipeVersionActionUpdate)
+
 	return recipeIngredientAggregate, nil
 }
 
 func RecipeIngredientDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
 
 	criteria := recipeIngredientRepository.GetCriteria().GetCriteriaById(id, nil)
 	criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
 	criteria = recipeIngredientRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
 
-	return recipeIngredientRepository.DeleteOne(criteria)
+	deleteOneStatus, errorDeleteOne := recipeIngredientRepository.DeleteOne(criteria)
+
+	if errorDeleteOne == nil && deleteOneStatus {
+		recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectIngredient, kind.RecipeVersionActionDelete)
+	}
+
+	return deleteOneStatus, errorDeleteOne
 }
 
 func getIngredientAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeIngredient, error) {
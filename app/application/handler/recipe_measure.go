@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -19,6 +20,8 @@ var (
 )
 
 func RecipeMeasureCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeMeasureDTO *DomainEntity.RecipeMeasure) (*DomainAggregate.RecipeMeasure, error) {
+	userId = recipeIngredientUserId(userId, entityId, kind.UserRightWrite)
+
 	recipeMeasureRepository := InfrastructureService.GetFactoryRepository().GetRecipeMeasureRepository()
 	criteria := recipeMeasureRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
 	criteria = recipeMeasureRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
@@ -40,20 +43,28 @@ func RecipeMeasureCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeMeasureDT
 		if errorRecipeMeasuresInsertOne != nil {
 			return nil, errors.Wrapf(errorRecipeMeasuresInsertOne, "an error occurred while creating a recipe measure in the database by privided data %v", recipeMeasureDTO)
 		} else {
+			recipeMeasureVersionWrite(entityId, userId, &recipeMeasure.Id, kind.RecipeVersionActionCreate)
+
 			return getMeasureAggregate(&recipeMeasure.Id, &recipeMeasure.UserId, &recipeMeasure.EntityId, nil)
 		}
 	}
 }
 
 func RecipeMeasuresInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.RecipeMeasure, error) {
+	userId = recipeIngredientUserId(userId, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildRecipeMeasuresAggregate(nil, userId, entityId, criteria)
 }
 
 func RecipeMeasureInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeMeasure, error) {
+	userId = recipeIngredientUserId(userId, entityId, kind.UserRightRead)
+
 	return getMeasureAggregate(id, userId, entityId, criteria)
 }
 
 func RecipeMeasureUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, recipeMeasureDTO *DomainEntity.RecipeMeasure) (*DomainAggregate.RecipeMeasure, error) {
+	userId = recipeIngredientUserId(userId, entityId, kind.UserRightWrite)
+
 	recipeMeasureAggregate, errorRecipeMeasureAggregate := getMeasureAggregate(id, userId, entityId, nil)
 	recipeMeasureRepository := InfrastructureService.GetFactoryRepository().GetRecipeMeasureRepository()
 
@@ -90,17 +101,27 @@ func RecipeMeasureUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID,
 
 	recipeMeasureAggregate.Entity = updateOne
 
+	recipeMeasureVersionWrite(entityId, userId, id, kind.RecipeVersionActionUpdate)
+
 	return recipeMeasureAggregate, nil
 }
 
 func RecipeMeasureDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = recipeIngredientUserId(userId, entityId, kind.UserRightWrite)
+
 	recipeMeasureRepository := InfrastructureService.GetFactoryRepository().GetRecipeMeasureRepository()
 
 	criteria := recipeMeasureRepository.GetCriteria().GetCriteriaById(id, nil)
 	criteria = recipeMeasureRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
 	criteria = recipeMeasureRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
 
-	return recipeMeasureRepository.DeleteOne(criteria)
+	deleteOneStatus, errorDeleteOne := recipeMeasureRepository.DeleteOne(criteria)
+
+	if errorDeleteOne == nil && deleteOneStatus {
+		recipeMeasureVersionWrite(entityId, userId, id, kind.RecipeVersionActionDelete)
+	}
+
+	return deleteOneStatus, errorDeleteOne
 }
 
 func getMeasureAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeMeasure, error) {
@@ -6,6 +6,7 @@ import (
 	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
@@ -19,6 +20,8 @@ var (
 )
 
 func RecipeProcessCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeProcessDTO *DomainEntity.RecipeProcess) (*DomainAggregate.RecipeProcess, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeProcessRepository := InfrastructureService.GetFactoryRepository().GetRecipeProcessRepository()
 	criteria := recipeProcessRepository.GetCriteria().GetCriteriaByName(&recipeProcessDTO.Name, nil)
 	criteria = recipeProcessRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
@@ -40,20 +43,28 @@ func RecipeProcessCreate(userId *uuid.UUID, entityId *uuid.UUID, recipeProcessDT
 		if errorRecipeProcessesInsertOne != nil {
 			return nil, errors.Wrapf(errorRecipeProcessesInsertOne, "an error occurred while creating a recipe process in the database by privided data %s", recipeProcessDTO)
 		} else {
+			recipeVersionWrite(entityId, userId, &recipeProcess.Id, kind.RecipeVersionSubjectProcess, kind.RecipeVersionActionCreate)
+
 			return getProcessAggregate(&recipeProcess.Id, &recipeProcess.UserId, &recipeProcess.EntityId, nil)
 		}
 	}
 }
 
 func RecipeProcessesInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.RecipeProcess, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return ApplicationService.BuildRecipeProcessesAggregate(nil, userId, entityId, criteria)
 }
 
 func RecipeProcessInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeProcess, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
 	return getProcessAggregate(id, userId, entityId, criteria)
 }
 
 func RecipeProcessUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, recipeProcessDTO *DomainEntity.RecipeProcess) (*DomainAggregate.RecipeProcess, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeProcessRepository := InfrastructureService.GetFactoryRepository().GetRecipeProcessRepository()
 	recipeProcessAggregate, errorRecipeProcessAggregate := getProcessAggregate(id, userId, entityId, nil)
 
@@ -90,17 +101,27 @@ func RecipeProcessUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID,
 
 	recipeProcessAggregate.Entity = updateOne
 
+	recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectProcess, kind.RecipeVersionActionUpdate)
+
 	return recipeProcessAggregate, nil
 }
 
 func RecipeProcessDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
 	recipeProcessRepository := InfrastructureService.GetFactoryRepository().GetRecipeProcessRepository()
 
 	criteria := recipeProcessRepository.GetCriteria().GetCriteriaById(id, nil)
 	criteria = recipeProcessRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
 	criteria = recipeProcessRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
 
-	return recipeProcessRepository.DeleteOne(criteria)
+	deleteOneStatus, errorDeleteOne := recipeProcessRepository.DeleteOne(criteria)
+
+	if errorDeleteOne == nil && deleteOneStatus {
+		recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectProcess, kind.RecipeVersionActionDelete)
+	}
+
+	return deleteOneStatus, errorDeleteOne
 }
 
 func getProcessAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.RecipeProcess, error) {
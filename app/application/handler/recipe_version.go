@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"time"
+)
+
+// recipeVersionInsertAttempts is the amount of the attempts to take the next number of the version, the number which
+// has been taken by a concurrent change is taken again.
+const recipeVersionInsertAttempts = 5
+
+var (
+	errorRecipeVersionInfo = errors.New("recipe version cannot be showed by provided data")
+)
+
+// RecipeVersionsInfo returns the versions of the recipe without their snapshots, the latest version goes first.
+func RecipeVersionsInfo(userId *uuid.UUID, entityId *uuid.UUID) ([]*DomainEntity.RecipeVersion, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
+	recipeVersionRepository := InfrastructureService.GetFactoryRepository().GetRecipeVersionRepository()
+	criteria := recipeVersionRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	criteria.Uncached = true
+	recipeVersions, errorRecipeVersions := recipeVersionRepository.FindAll(criteria)
+
+	if errorRecipeVersions != nil {
+		return nil, errors.Wrapf(errorRecipeVersions, "an error occurred while getting versions of the recipe by privided data userId=%s,entityId=%s", userId, entityId)
+	}
+
+	for _, recipeVersion := range recipeVersions {
+		recipeVersion.Snapshot = nil
+	}
+
+	sort.SliceStable(recipeVersions, func(i, j int) bool {
+		return recipeVersions[i].Version > recipeVersions[j].Version
+	})
+
+	return recipeVersions, nil
+}
+
+// RecipeVersionInfo returns the version of the recipe with the recipe restored from its snapshot.
+func RecipeVersionInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (*DomainAggregate.RecipeVersion, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
+	recipeVersionRepository := InfrastructureService.GetFactoryRepository().GetRecipeVersionRepository()
+	criteria := recipeVersionRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	recipeVersion, errorRecipeVersion := recipeVersionRepository.FindOne(criteria)
+
+	if errorRecipeVersion != nil || recipeVersion == nil {
+		return nil, errorRecipeVersionInfo
+	}
+
+	recipe, errorRecipe := ApplicationServiceHelper.RecipeFromSnapshot(recipeVersion.Snapshot)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while restoring a recipe version by privided data id=%s,userId=%s,entityId=%s", id, userId, entityId)
+	}
+
+	return &DomainAggregate.RecipeVersion{Entity: recipeVersion, Recipe: recipe}, nil
+}
+
+// RecipeVersionDiff compares two versions of the recipe.
+func RecipeVersionDiff(fromId *uuid.UUID, toId *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (*DomainAggregate.RecipeDiff, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightRead)
+
+	from, errorFrom := RecipeVersionInfo(fromId, userId, entityId)
+
+	if errorFrom != nil {
+		return nil, errors.Wrapf(errorFrom, "an error occurred while comparing versions of the recipe with id=%s", entityId)
+	}
+
+	to, errorTo := RecipeVersionInfo(toId, userId, entityId)
+
+	if errorTo != nil {
+		return nil, errors.Wrapf(errorTo, "an error occurred while comparing versions of the recipe with id=%s", entityId)
+	}
+
+	recipeDiff := ApplicationServiceHelper.RecipeDiff(from, to)
+	recipeDiff.From.Snapshot = nil
+	recipeDiff.To.Snapshot = nil
+
+	return recipeDiff, nil
+}
+
+// RecipeVersionRevert restores the recipe, its categories, ingredients with measures and processes from the version.
+// The restored children keep their ids, so the pictures and the alternative names are attached again. The revert
+// writes a new version itself, the history is never rewritten.
+func RecipeVersionRevert(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (*DomainAggregate.Recipe, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectRecipe, entityId, kind.UserRightWrite)
+
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	recipeRepository := factoryRepository.GetRecipeRepository()
+	recipeCategoryRepository := factoryRepository.GetRecipeCategoryRepository()
+	recipeIngredientRepository := factoryRepository.GetRecipeIngredientRepository()
+	recipeMeasureRepository := factoryRepository.GetRecipeMeasureRepository()
+	recipeProcessRepository := factoryRepository.GetRecipeProcessRepository()
+
+	recipeVersion, errorRecipeVersion := RecipeVersionInfo(id, userId, entityId)
+
+	if errorRecipeVersion != nil {
+		return nil, errors.Wrapf(errorRecipeVersion, "an error occurred while reverting the recipe with id=%s", entityId)
+	} else if recipeVersion.Recipe.Entity == nil {
+		return nil, errorRecipeVersionInfo
+	}
+
+	recipe, errorRecipe := RecipeInfo(entityId, userId, nil)
+
+	if errorRecipe != nil {
+		return nil, errors.Wrapf(errorRecipe, "an error occurred while reverting the recipe with id=%s", entityId)
+	}
+
+	now := time.Now().UTC()
+	recipe.Entity.Name = recipeVersion.Recipe.Entity.Name
+	recipe.Entity.Description = recipeVersion.Recipe.Entity.Description
+	recipe.Entity.Notes = recipeVersion.Recipe.Entity.Notes
+	recipe.Entity.Servings = recipeVersion.Recipe.Entity.Servings
+	recipe.Entity.Calories = recipeVersion.Recipe.Entity.Calories
+	recipe.Entity.Status = recipeVersion.Recipe.Entity.Status
+	recipe.Entity.DateUpdate = now
+
+	if _, errorUpdateOne := recipeRepository.UpdateOne(recipeRepository.GetCriteria().GetCriteriaById(entityId, nil), recipe.Entity); errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a recipe entity in the database %v", recipe.Entity)
+	}
+
+	for _, recipeCategory := range recipe.Categories {
+		if _, errorDeleteOne := recipeCategoryRepository.DeleteOne(recipeCategoryRepository.GetCriteria().GetCriteriaById(&recipeCategory.Entity.Id, nil)); errorDeleteOne != nil {
+			return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a recipe category by privided data %v", recipeCategory.Entity)
+		}
+	}
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		for _, recipeMeasure := range recipeIngredient.Measures {
+			if _, errorDeleteOne := recipeMeasureRepository.DeleteOne(recipeMeasureRepository.GetCriteria().GetCriteriaById(&recipeMeasure.Entity.Id, nil)); errorDeleteOne != nil {
+				return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a recipe measure by privided data %v", recipeMeasure.Entity)
+			}
+		}
+
+		if _, errorDeleteOne := recipeIngredientRepository.DeleteOne(recipeIngredientRepository.GetCriteria().GetCriteriaById(&recipeIngredient.Entity.Id, nil)); errorDeleteOne != nil {
+			return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a recipe ingredient by privided data %v", recipeIngredient.Entity)
+		}
+	}
+
+	for _, recipeProcess := range recipe.Processes {
+		if _, errorDeleteOne := recipeProcessRepository.DeleteOne(recipeProcessRepository.GetCriteria().GetCriteriaById(&recipeProcess.Entity.Id, nil)); errorDeleteOne != nil {
+			return nil, errors.Wrapf(errorDeleteOne, "an error occurred while deleting a recipe process by privided data %v", recipeProcess.Entity)
+		}
+	}
+
+	for _, recipeCategory := range recipeVersion.Recipe.Categories {
+		recipeCategory.Entity.UserId = *userId
+		recipeCategory.Entity.EntityId = *entityId
+		recipeCategory.Entity.DateUpdate = now
+
+		if _, errorInsertOne := recipeCategoryRepository.InsertOne(recipeCategory.Entity); errorInsertOne != nil {
+			return nil, errors.Wrapf(errorInsertOne, "an error occurred while restoring a recipe category by privided data %v", recipeCategory.Entity)
+		}
+	}
+
+	for _, recipeIngredient := range recipeVersion.Recipe.Ingredients {
+		recipeIngredient.Entity.UserId = *userId
+		recipeIngredient.Entity.EntityId = *entityId
+		recipeIngredient.Entity.DateUpdate = now
+
+		if _, errorInsertOne := recipeIngredientRepository.InsertOne(recipeIngredient.Entity); errorInsertOne != nil {
+			return nil, errors.Wrapf(errorInsertOne, "an error occurred while restoring a recipe ingredient by privided data %v", recipeIngredient.Entity)
+		}
+
+		for _, recipeMeasure := range recipeIngredient.Measures {
+			recipeMeasure.Entity.UserId = *userId
+			recipeMeasure.Entity.EntityId = recipeIngredient.Entity.Id
+			recipeMeasure.Entity.DateUpdate = now
+
+			if _, errorInsertOne := recipeMeasureRepository.InsertOne(recipeMeasure.Entity); errorInsertOne != nil {
+				return nil, errors.Wrapf(errorInsertOne, "an error occurred while restoring a recipe measure by privided data %v", recipeMeasure.Entity)
+			}
+		}
+	}
+
+	for _, recipeProcess := range recipeVersion.Recipe.Processes {
+		recipeProcess.Entity.UserId = *userId
+		recipeProcess.Entity.EntityId = *entityId
+		recipeProcess.Entity.DateUpdate = now
+
+		if _, errorInsertOne := recipeProcessRepository.InsertOne(recipeProcess.Entity); errorInsertOne != nil {
+			return nil, errors.Wrapf(errorInsertOne, "an error occurred while restoring a recipe process by privided data %v", recipeProcess.Entity)
+		}
+	}
+
+	recipeVersionWrite(entityId, userId, id, kind.RecipeVersionSubjectRecipe, kind.RecipeVersionActionRevert)
+
+	return RecipeInfo(entityId, userId, nil)
+}
+
+// recipeVersionWrite writes a snapshot of the recipe after a change of the subject. The change has already been
+// made, so an error is logged and is not returned.
+func recipeVersionWrite(
+	entityId *uuid.UUID,
+	userId *uuid.UUID,
+	subjectId *uuid.UUID,
+	subject kind.RecipeVersionSubject,
+	action kind.RecipeVersionAction,
+) {
+	if errorRecipeVersion := recipeVersionInsert(entityId, userId, subjectId, subject, action); errorRecipeVersion != nil {
+		log.Error(errors.Wrapf(errorRecipeVersion, "an error occurred while writing a version of the recipe by provided data entityId=%s,subject=%s,action=%s", entityId, subject, action))
+	}
+}
+
+// recipeMeasureVersionWrite writes a snapshot of the recipe of the recipe ingredient after a change of its measure.
+func recipeMeasureVersionWrite(entityId *uuid.UUID, userId *uuid.UUID, subjectId *uuid.UUID, action kind.RecipeVersionAction) {
+	recipeIngredientRepository := InfrastructureService.GetFactoryRepository().GetRecipeIngredientRepository()
+	recipeIngredient, errorRecipeIngredient := recipeIngredientRepository.FindOne(recipeIngredientRepository.GetCriteria().GetCriteriaById(entityId, nil))
+
+	if errorRecipeIngredient != nil || recipeIngredient == nil {
+		log.Error(errors.Wrapf(errorRecipeInfo, "an error occurred while writing a version of the recipe by provided data recipeIngredientId=%s", entityId))
+
+		return
+	}
+
+	recipeVersionWrite(&recipeIngredient.EntityId, userId, subjectId, kind.RecipeVersionSubjectMeasure, action)
+}
+
+func recipeVersionInsert(
+	entityId *uuid.UUID,
+	userId *uuid.UUID,
+	subjectId *uuid.UUID,
+	subject kind.RecipeVersionSubject,
+	action kind.RecipeVersionAction,
+) error {
+	recipeVersionRepository := InfrastructureService.GetFactoryRepository().GetRecipeVersionRepository()
+	recipe, errorRecipe := RecipeInfo(entityId, userId, &persistence.Criteria{Uncached: true})
+
+	if errorRecipe != nil {
+		return errorRecipe
+	}
+
+	snapshot, errorSnapshot := ApplicationServiceHelper.RecipeSnapshot(recipe)
+
+	if errorSnapshot != nil {
+		return errorSnapshot
+	}
+
+	for attempt := 1; ; attempt++ {
+		version, errorVersion := recipeVersionLatest(entityId)
+
+		if errorVersion != nil {
+			return errorVersion
+		}
+
+		_, errorInsertOne := recipeVersionRepository.InsertOne(
+			prepareRecipeVersionRepositoryInsert(
+				&DomainEntity.RecipeVersion{
+					UserId:     *userId,
+					EntityId:   *entityId,
+					SubjectId:  *subjectId,
+					DateInsert: time.Now().UTC(),
+					Version:    version + 1,
+					Subject:    subject,
+					Action:     action,
+					Snapshot:   snapshot,
+				},
+			),
+		)
+
+		if !errors.Is(errorInsertOne, persistence.ErrorDuplicate) || attempt == recipeVersionInsertAttempts {
+			return errorInsertOne
+		}
+	}
+}
+
+// recipeVersionLatest returns the number of the latest version of the recipe, it is zero when there is no version.
+func recipeVersionLatest(entityId *uuid.UUID) (int64, error) {
+	recipeVersionRepository := InfrastructureService.GetFactoryRepository().GetRecipeVersionRepository()
+	criteria := recipeVersionRepository.GetCriteria().GetCriteriaByEntityId(entityId, nil)
+	criteria.Order = map[string]interface{}{"version": -1}
+	criteria.Limit = 1
+	criteria.Uncached = true
+	recipeVersions, errorRecipeVersions := recipeVersionRepository.FindAll(criteria)
+
+	if errorRecipeVersions != nil {
+		return 0, errorRecipeVersions
+	} else if len(recipeVersions) == 0 {
+		return 0, nil
+	}
+
+	return recipeVersions[0].Version, nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	testsRecipeVersionData = testsRecipeVersion{
+		{
+			name:   "Test case with correct data",
+			userId: &testUserId,
+			recipeDTO: &DomainEntity.Recipe{
+				Name:   "Test Recipe " + uuid.NewString(),
+				Status: kind.RecipeStatusUnPublished,
+			},
+			toUpdatingRecipeDTO: &DomainEntity.Recipe{
+				Name:   "Test Recipe Updated " + uuid.NewString(),
+				Status: kind.RecipeStatusPublished,
+			},
+		},
+	}
+)
+
+type testsRecipeVersion []struct {
+	name                string
+	userId              *uuid.UUID
+	recipeDTO           *DomainEntity.Recipe
+	toUpdatingRecipeDTO *DomainEntity.Recipe
+	recipe              *DomainAggregate.Recipe
+	recipeVersions      []*DomainEntity.RecipeVersion
+}
+
+func TestRecipeVersionsInfo(t *testing.T) {
+	for index, testCase := range testsRecipeVersionData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				recipe, errorRecipe := RecipeCreate(testCase.userId, testCase.recipeDTO)
+
+				assert.Nil(t, errorRecipe)
+
+				_, errorRecipeUpdate := RecipeUpdate(&recipe.Entity.Id, testCase.userId, testCase.toUpdatingRecipeDTO)
+
+				assert.Nil(t, errorRecipeUpdate)
+
+				actual, errorActual := RecipeVersionsInfo(testCase.userId, &recipe.Entity.Id)
+
+				assert.Nil(t, errorActual)
+				assert.Len(t, actual, 2)
+				assert.Equal(t, int64(2), actual[0].Version)
+				assert.Equal(t, kind.RecipeVersionActionUpdate, actual[0].Action)
+				assert.Equal(t, kind.RecipeVersionActionCreate, actual[1].Action)
+
+				testsRecipeVersionData[index].recipe = recipe
+				testsRecipeVersionData[index].recipeVersions = actual
+			},
+		)
+	}
+}
+
+func TestRecipeVersionInfo(t *testing.T) {
+	for _, testCase := range testsRecipeVersionData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.recipe == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := RecipeVersionInfo(&testCase.recipeVersions[1].Id, testCase.userId, &testCase.recipe.Entity.Id)
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, testCase.recipeDTO.Name, actual.Recipe.Entity.Name)
+			},
+		)
+	}
+}
+
+func TestRecipeVersionDiff(t *testing.T) {
+	for _, testCase := range testsRecipeVersionData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.recipe == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := RecipeVersionDiff(&testCase.recipeVersions[1].Id, &testCase.recipeVersions[0].Id, testCase.userId, &testCase.recipe.Entity.Id)
+
+				assert.Nil(t, errorActual)
+				assert.Contains(t, actual.Fields, &DomainAggregate.RecipeFieldChange{Field: "name", From: testCase.recipeDTO.Name, To: testCase.toUpdatingRecipeDTO.Name})
+			},
+		)
+	}
+}
+
+func TestRecipeVersionRevert(t *testing.T) {
+	for _, testCase := range testsRecipeVersionData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				if testCase.recipe == nil {
+					t.Skip()
+				}
+
+				actual, errorActual := RecipeVersionRevert(&testCase.recipeVersions[1].Id, testCase.userId, &testCase.recipe.Entity.Id)
+
+				assert.Nil(t, errorActual)
+				assert.Equal(t, testCase.recipeDTO.Name, actual.Entity.Name)
+				assert.Equal(t, testCase.recipeDTO.Status, actual.Entity.Status)
+
+				recipeVersions, errorRecipeVersions := RecipeVersionsInfo(testCase.userId, &testCase.recipe.Entity.Id)
+
+				assert.Nil(t, errorRecipeVersions)
+				assert.Len(t, recipeVersions, 3)
+				assert.Equal(t, int64(3), recipeVersions[0].Version)
+				assert.Equal(t, kind.RecipeVersionActionRevert, recipeVersions[0].Action)
+
+				recipeVersion, errorRecipeVersion := RecipeVersionInfo(&recipeVersions[0].Id, testCase.userId, &testCase.recipe.Entity.Id)
+
+				assert.Nil(t, errorRecipeVersion)
+				assert.Equal(t, testCase.recipeDTO.Name, recipeVersion.Recipe.Entity.Name)
+
+				_, _ = RecipeDelete(&testCase.recipe.Entity.Id, testCase.userId)
+			},
+		)
+	}
+}
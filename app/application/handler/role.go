@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"strings"
+	"time"
+)
+
+var (
+	errorRoleData           = errors.New("role must have a name, a code and permissions with known resources and rights")
+	errorRoleExists         = errors.New("role with the same code already exists")
+	errorRoleUserData       = errors.New("role can be bound to an existing user with known rights only")
+	errorRoleUserDelete     = errors.New("role binding cannot be deleted by provided data")
+	errorRoleUserExists     = errors.New("role is already bound to the user")
+	errorRoleUserRoleDelete = errors.New("role bindings cannot be deleted by provided data")
+)
+
+func RolesInfo() ([]*DomainEntity.UserRole, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+
+	return userRoleRepository.FindAll(&persistence.Criteria{})
+}
+
+func RoleCreate(roleDTO *DomainEntity.UserRole) (*DomainEntity.UserRole, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+
+	roleDTO.Name = strings.TrimSpace(roleDTO.Name)
+	roleDTO.Code = kind.UserRole(strings.TrimSpace(string(roleDTO.Code)))
+
+	if roleDTO.Name == "" || roleDTO.Code == "" || !roleValidPermissions(roleDTO.Permissions) {
+		return nil, errorRoleData
+	}
+
+	if roleCodeExists(roleDTO.Code, nil) {
+		return nil, errorRoleExists
+	}
+
+	if roleDTO.Status == "" {
+		roleDTO.Status = kind.UserRoleStatusActive
+	}
+
+	roleDTO.Id = uuid.New()
+	roleDTO.DateInsert = time.Now().UTC()
+	roleDTO.DateUpdate = time.Now().UTC()
+
+	role, errorRoleInsertOne := userRoleRepository.InsertOne(roleDTO)
+
+	if errorRoleInsertOne != nil {
+		return nil, errors.Wrapf(errorRoleInsertOne, "an error occurred while creating a role in the database by privided data %v", roleDTO)
+	}
+
+	return role, nil
+}
+
+func RoleInfo(id *uuid.UUID) (*DomainEntity.UserRole, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+
+	return userRoleRepository.FindOne(userRoleRepository.GetCriteria().GetCriteriaById(id, nil))
+}
+
+func RoleUpdate(id *uuid.UUID, roleDTO *DomainEntity.UserRole) (*DomainEntity.UserRole, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+	role, errorRole := RoleInfo(id)
+
+	if errorRole != nil {
+		return nil, errors.Wrapf(errorRole, "an error occurred while updating a role by privided data id=%s", id)
+	}
+
+	roleDTO.Id = *id
+	roleDTO.Name = strings.TrimSpace(roleDTO.Name)
+	roleDTO.Code = kind.UserRole(strings.TrimSpace(string(roleDTO.Code)))
+	roleDTO.DateInsert = role.DateInsert
+	roleDTO.DateUpdate = time.Now().UTC()
+
+	if !roleValidPermissions(roleDTO.Permissions) {
+		return nil, errorRoleData
+	}
+
+	if roleDTO.Code != "" && roleCodeExists(roleDTO.Code, id) {
+		return nil, errorRoleExists
+	}
+
+	roleUpdated, errorRoleUpdated := service.Update(role, roleDTO)
+
+	if errorRoleUpdated != nil {
+		return nil, errors.Wrapf(errorRoleUpdated, "an error occurred while updating a role by privided data %v", roleDTO)
+	}
+
+	restoredRoleUpdated, okRestoredRoleUpdated := roleUpdated.Interface().(*DomainEntity.UserRole)
+
+	if !okRestoredRoleUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a role by privided data %s", roleUpdated)
+	}
+
+	updateOne, errorUpdateOne := userRoleRepository.UpdateOne(
+		userRoleRepository.GetCriteria().GetCriteriaById(&restoredRoleUpdated.Id, nil),
+		restoredRoleUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a role entity in the database %v", restoredRoleUpdated)
+	}
+
+	return updateOne, nil
+}
+
+// RoleDelete deletes the role with all of its bindings to users.
+func RoleDelete(id *uuid.UUID) (bool, error) {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+	_, errorRole := RoleInfo(id)
+
+	if errorRole != nil {
+		return false, errors.Wrapf(errorRole, "an error occurred while deleting a role by privided data id=%s", id)
+	}
+
+	userToRoles, _ := userToRoleRepository.FindAll(userToRoleRepository.GetCriteria().GetCriteriaByRoleId(id, nil))
+
+	for _, userToRole := range userToRoles {
+		deleteStatus, errorDeleteStatus := userToRoleRepository.DeleteOne(userToRoleRepository.GetCriteria().GetCriteriaById(&userToRole.Id, nil))
+
+		if errorDeleteStatus != nil {
+			return false, errors.Wrapf(errorDeleteStatus, "an error occurred while deleting a role binding by privided data id=%s", userToRole.Id)
+		} else if !deleteStatus {
+			return false, errorRoleUserRoleDelete
+		}
+	}
+
+	return userRoleRepository.DeleteOne(userRoleRepository.GetCriteria().GetCriteriaById(id, nil))
+}
+
+func RoleUsersInfo(id *uuid.UUID) ([]*DomainEntity.UserToRole, error) {
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+	_, errorRole := RoleInfo(id)
+
+	if errorRole != nil {
+		return nil, errors.Wrapf(errorRole, "an error occurred while getting role bindings by privided data id=%s", id)
+	}
+
+	return userToRoleRepository.FindAll(userToRoleRepository.GetCriteria().GetCriteriaByRoleId(id, nil))
+}
+
+// RoleUserCreate binds the role to the user, the rights of the binding narrow the permissions of the role down when
+// they are given.
+func RoleUserCreate(id *uuid.UUID, userToRoleDTO *DomainEntity.UserToRole) (*DomainEntity.UserToRole, error) {
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+	_, errorRole := RoleInfo(id)
+
+	if errorRole != nil {
+		return nil, errors.Wrapf(errorRole, "an error occurred while binding a role by privided data id=%s", id)
+	}
+
+	_, errorUser := UserInfo(&userToRoleDTO.UserId)
+
+	if errorUser != nil || !roleValidRights(userToRoleDTO.Rights) {
+		return nil, errorRoleUserData
+	}
+
+	criteria := userToRoleRepository.GetCriteria().GetCriteriaByRoleId(id, nil)
+	criteria = userToRoleRepository.GetCriteria().GetCriteriaByUserId(&userToRoleDTO.UserId, criteria)
+	userToRoles, _ := userToRoleRepository.FindAll(criteria)
+
+	if len(userToRoles) > 0 {
+		return nil, errorRoleUserExists
+	}
+
+	if userToRoleDTO.Status == "" {
+		userToRoleDTO.Status = kind.UserToRoleStatusActive
+	}
+
+	userToRoleDTO.Id = uuid.New()
+	userToRoleDTO.RoleId = *id
+	userToRoleDTO.DateInsert = time.Now().UTC()
+	userToRoleDTO.DateUpdate = time.Now().UTC()
+
+	userToRole, errorUserToRoleInsertOne := userToRoleRepository.InsertOne(userToRoleDTO)
+
+	if errorUserToRoleInsertOne != nil {
+		return nil, errors.Wrapf(errorUserToRoleInsertOne, "an error occurred while binding a role in the database by privided data %v", userToRoleDTO)
+	}
+
+	return userToRole, nil
+}
+
+func RoleUserDelete(id *uuid.UUID, roleId *uuid.UUID) (bool, error) {
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+
+	criteria := userToRoleRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = userToRoleRepository.GetCriteria().GetCriteriaByRoleId(roleId, criteria)
+	_, errorUserToRole := userToRoleRepository.FindOne(criteria)
+
+	if errorUserToRole != nil {
+		return false, errorRoleUserDelete
+	}
+
+	return userToRoleRepository.DeleteOne(criteria)
+}
+
+func roleCodeExists(code kind.UserRole, exceptId *uuid.UUID) bool {
+	userRoleRepository := InfrastructureService.GetFactoryRepository().GetUserRoleRepository()
+	codeValue := string(code)
+
+	userRoles, _ := userRoleRepository.FindAll(userRoleRepository.GetCriteria().GetCriteriaByCode(&codeValue, nil))
+
+	for _, userRole := range userRoles {
+		if exceptId == nil || userRole.Id != *exceptId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roleValidPermissions(permissions []DomainEntity.UserPermission) bool {
+	for _, permission := range permissions {
+		if permission.Resource.String() == "" || len(permission.Rights) == 0 || !roleValidRights(permission.Rights) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func roleValidRights(rights []kind.UserRight) bool {
+	for _, right := range rights {
+		if right.String() == "unknown" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPolicyEnforce(t *testing.T) {
+	tests := []struct {
+		name    string
+		userDTO dto.UserRegisterDTO
+		roleDTO *DomainEntity.UserRole
+	}{
+		{
+			name: "Test case with a stored role bound to the user",
+			userDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameRole" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:       "NameTest",
+				Surname:    "SurnameTest",
+				MiddleName: "MiddleNameTest",
+				Birthday:   time.Now().UTC(),
+			},
+			roleDTO: &DomainEntity.UserRole{
+				Name: "Auditor",
+				Code: kind.UserRole("auditor" + uuid.NewString()),
+				Permissions: []DomainEntity.UserPermission{
+					{Resource: kind.UserResourceUser, Rights: []kind.UserRight{kind.UserRightWrite}},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.userDTO)
+
+				assert.Nil(t, errorUser)
+				assert.Nil(t, PolicyEnforce(&user.Id, kind.UserResourcePlanner, kind.UserRightWrite))
+				assert.Equal(t, errorPolicyDenied, PolicyEnforce(&user.Id, kind.UserResourceUser, kind.UserRightRead))
+
+				role, errorRole := RoleCreate(testCase.roleDTO)
+
+				assert.Nil(t, errorRole)
+				assert.Equal(t, kind.UserRoleStatusActive, role.Status)
+
+				_, errorRoleDuplicate := RoleCreate(&DomainEntity.UserRole{Name: "Auditor", Code: role.Code})
+
+				assert.Equal(t, errorRoleExists, errorRoleDuplicate)
+
+				userToRole, errorUserToRole := RoleUserCreate(&role.Id, &DomainEntity.UserToRole{UserId: user.Id, Rights: []kind.UserRight{kind.UserRightRead}})
+
+				assert.Nil(t, errorUserToRole)
+				assert.Nil(t, PolicyEnforce(&user.Id, kind.UserResourceUser, kind.UserRightRead))
+				assert.Equal(t, errorPolicyDenied, PolicyEnforce(&user.Id, kind.UserResourceUser, kind.UserRightWrite))
+
+				deleted, errorDeleted := RoleUserDelete(&userToRole.Id, &role.Id)
+
+				assert.Nil(t, errorDeleted)
+				assert.True(t, deleted)
+				assert.Equal(t, errorPolicyDenied, PolicyEnforce(&user.Id, kind.UserResourceUser, kind.UserRightRead))
+
+				_, _ = RoleDelete(&role.Id)
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
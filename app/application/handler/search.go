@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+)
+
+const (
+	searchLimit    = 20
+	searchLimitMax = 100
+)
+
+var (
+	errorSearchQuery = errors.New("query doesn't have any words to search by")
+)
+
+// Search finds the recipes of the user by the words of the query in their names, descriptions and notes, in their
+// processes, in their ingredients and in all of the alternative names. The recipes are ordered by relevance and have
+// the fragments of the texts with the words found.
+func Search(userId *uuid.UUID, query string, limit int64) ([]*DomainAggregate.SearchResult, error) {
+	terms := ApplicationServiceHelper.SearchTerms(query)
+
+	if len(terms) == 0 {
+		return nil, errors.Wrapf(errorSearchQuery, "an error occurred while searching recipes by privided data query=%s", query)
+	}
+
+	if limit <= 0 {
+		limit = searchLimit
+	} else if limit > searchLimitMax {
+		limit = searchLimitMax
+	}
+
+	altNames, errorAltNames := AltNamesInfo(userId, nil, nil)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	scores, errorSearchText := searchText(userId, query)
+
+	if errors.Is(errorSearchText, persistence.ErrorTextSearchUnsupported) {
+		return searchIndex(userId, terms, altNames, int(limit))
+	} else if errorSearchText != nil {
+		return nil, errorSearchText
+	}
+
+	var results []*DomainAggregate.SearchResult
+
+	for _, hit := range ApplicationServiceHelper.SearchRank(scores, int(limit)) {
+		recipe, errorRecipe := RecipeInfo(&hit.Id, userId, nil)
+
+		if errorRecipe != nil {
+			continue
+		}
+
+		results = append(
+			results,
+			&DomainAggregate.SearchResult{
+				Entity:     recipe.Entity,
+				Score:      hit.Score,
+				Highlights: ApplicationServiceHelper.SearchHighlights(ApplicationServiceHelper.SearchRecipeDocument(recipe, altNames), terms),
+			},
+		)
+	}
+
+	return results, nil
+}
+
+// searchText sums the scores of the text indexes of the database by the recipes, the texts of the processes, the
+// ingredients and the alternative names are scored for the recipes they belong to.
+func searchText(userId *uuid.UUID, query string) (map[uuid.UUID]float64, error) {
+	factoryRepository := InfrastructureService.GetFactoryRepository()
+	recipeRepository := factoryRepository.GetRecipeRepository()
+	recipeProcessRepository := factoryRepository.GetRecipeProcessRepository()
+	recipeIngredientRepository := factoryRepository.GetRecipeIngredientRepository()
+	ingredientRepository := factoryRepository.GetIngredientRepository()
+	altNameRepository := factoryRepository.GetAltNameRepository()
+
+	scores := map[uuid.UUID]float64{}
+	ingredientScores := map[uuid.UUID]float64{}
+	altNameScores := map[uuid.UUID]float64{}
+
+	recipes, recipeScores, errorRecipes := recipeRepository.FindText(recipeRepository.GetCriteria().GetCriteriaByUserId(userId, nil), query)
+
+	if errorRecipes != nil {
+		return nil, errorRecipes
+	}
+
+	for index, recipe := range recipes {
+		scores[recipe.Id] += recipeScores[index]
+	}
+
+	recipeProcesses, recipeProcessScores, errorRecipeProcesses := recipeProcessRepository.FindText(recipeProcessRepository.GetCriteria().GetCriteriaByUserId(userId, nil), query)
+
+	if errorRecipeProcesses != nil {
+		return nil, errorRecipeProcesses
+	}
+
+	for index, recipeProcess := range recipeProcesses {
+		scores[recipeProcess.EntityId] += recipeProcessScores[index]
+	}
+
+	recipeIngredients, recipeIngredientScores, errorRecipeIngredients := recipeIngredientRepository.FindText(recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil), query)
+
+	if errorRecipeIngredients != nil {
+		return nil, errorRecipeIngredients
+	}
+
+	for index, recipeIngredient := range recipeIngredients {
+		scores[recipeIngredient.EntityId] += recipeIngredientScores[index]
+	}
+
+	ingredients, ingredientTextScores, errorIngredients := ingredientRepository.FindText(ingredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil), query)
+
+	if errorIngredients != nil {
+		return nil, errorIngredients
+	}
+
+	for index, ingredient := range ingredients {
+		ingredientScores[ingredient.Id] += ingredientTextScores[index]
+	}
+
+	altNames, altNameTextScores, errorAltNames := altNameRepository.FindText(altNameRepository.GetCriteria().GetCriteriaByUserId(userId, nil), query)
+
+	if errorAltNames != nil {
+		return nil, errorAltNames
+	}
+
+	for index, altName := range altNames {
+		altNameScores[altName.EntityId] += altNameTextScores[index]
+	}
+
+	if len(altNameScores) > 0 {
+		altNameEntityIds := searchIds(altNameScores)
+
+		recipesByAltName, errorRecipesByAltName := recipeRepository.FindAll(
+			recipeRepository.GetCriteria().GetCriteriaByIds(altNameEntityIds, recipeRepository.GetCriteria().GetCriteriaByUserId(userId, nil)),
+		)
+
+		if errorRecipesByAltName == nil {
+			for _, recipe := range recipesByAltName {
+				scores[recipe.Id] += altNameScores[recipe.Id]
+			}
+		}
+
+		recipeProcessesByAltName, errorRecipeProcessesByAltName := recipeProcessRepository.FindAll(
+			recipeProcessRepository.GetCriteria().GetCriteriaByIds(altNameEntityIds, recipeProcessRepository.GetCriteria().GetCriteriaByUserId(userId, nil)),
+		)
+
+		if errorRecipeProcessesByAltName == nil {
+			for _, recipeProcess := range recipeProcessesByAltName {
+				scores[recipeProcess.EntityId] += altNameScores[recipeProcess.Id]
+			}
+		}
+
+		recipeIngredientsByAltName, errorRecipeIngredientsByAltName := recipeIngredientRepository.FindAll(
+			recipeIngredientRepository.GetCriteria().GetCriteriaByIds(altNameEntityIds, recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil)),
+		)
+
+		if errorRecipeIngredientsByAltName == nil {
+			for _, recipeIngredient := range recipeIngredientsByAltName {
+				scores[recipeIngredient.EntityId] += altNameScores[recipeIngredient.Id]
+			}
+		}
+
+		for entityId, score := range altNameScores {
+			ingredientScores[entityId] += score
+		}
+	}
+
+	if len(ingredientScores) > 0 {
+		recipeIngredientsByDerive, errorRecipeIngredientsByDerive := recipeIngredientRepository.FindAll(
+			recipeIngredientRepository.GetCriteria().GetCriteriaByDeriveIds(searchIds(ingredientScores), recipeIngredientRepository.GetCriteria().GetCriteriaByUserId(userId, nil)),
+		)
+
+		if errorRecipeIngredientsByDerive == nil {
+			for _, recipeIngredient := range recipeIngredientsByDerive {
+				scores[recipeIngredient.EntityId] += ingredientScores[recipeIngredient.DeriveId]
+			}
+		}
+	}
+
+	return scores, nil
+}
+
+// searchIndex searches the recipes of the user by the index of the application when the database doesn't have text
+// indexes.
+func searchIndex(userId *uuid.UUID, terms []string, altNames []*DomainEntity.AltName, limit int) ([]*DomainAggregate.SearchResult, error) {
+	recipes, errorRecipes := RecipesInfo(userId, nil)
+
+	if errorRecipes != nil {
+		return nil, errorRecipes
+	}
+
+	index := ApplicationServiceHelper.NewSearchIndex()
+	documents := map[uuid.UUID]*ApplicationServiceHelper.SearchDocument{}
+	recipesById := map[uuid.UUID]*DomainAggregate.Recipe{}
+
+	for _, recipe := range recipes {
+		document := ApplicationServiceHelper.SearchRecipeDocument(recipe, altNames)
+
+		index.Add(document)
+		documents[recipe.Entity.Id] = document
+		recipesById[recipe.Entity.Id] = recipe
+	}
+
+	var results []*DomainAggregate.SearchResult
+
+	for _, hit := range index.Search(terms, limit) {
+		results = append(
+			results,
+			&DomainAggregate.SearchResult{
+				Entity:     recipesById[hit.Id].Entity,
+				Score:      hit.Score,
+				Highlights: ApplicationServiceHelper.SearchHighlights(documents[hit.Id], terms),
+			},
+		)
+	}
+
+	return results, nil
+}
+
+func searchIds(scores map[uuid.UUID]float64) []*uuid.UUID {
+	ids := make([]*uuid.UUID, 0, len(scores))
+
+	for id := range scores {
+		id := id
+		ids = append(ids, &id)
+	}
+
+	return ids
+}
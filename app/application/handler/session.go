@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/model"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	InfrastructureServiceCache "github.com/sergeygardner/meal-planner-api/infrastructure/service/cache"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"time"
+)
+
+const (
+	userSessionRevokedCacheKey = "user_session_revoked"
+	userSessionActiveCacheKey  = "user_session_active"
+	// userSessionCacheTTL keeps the active status of a session for a minute only, so a revocation made by another
+	// instance of the application is noticed soon, the revoked status is kept until the session expires.
+	userSessionCacheTTL int64 = 1
+)
+
+var (
+	errorUserSessionNotFound = errors.New("session is not found by provided data")
+)
+
+// UserSessionsInfo returns the active sessions of the user, the latest first.
+func UserSessionsInfo(userId *uuid.UUID) ([]*entity.UserSession, error) {
+	userSessionRepository := InfrastructureService.GetFactoryRepository().GetUserSessionRepository()
+	status := kind.UserSessionStatusActive.String()
+
+	criteria := userSessionRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = userSessionRepository.GetCriteria().GetCriteriaByStatus(&status, criteria)
+	criteria.Uncached = true
+	userSessions, errorUserSessions := userSessionRepository.FindAll(criteria)
+
+	if errorUserSessions != nil {
+		return nil, errors.Wrapf(errorUserSessions, "an error occurred while getting sessions by privided data userId=%s", userId)
+	}
+
+	now := time.Now().UTC()
+	userSessionsActive := make([]*entity.UserSession, 0, len(userSessions))
+
+	for _, userSession := range userSessions {
+		if userSession.DateExpire.After(now) {
+			userSessionsActive = append(userSessionsActive, userSession)
+		}
+	}
+
+	sort.SliceStable(userSessionsActive, func(i, j int) bool {
+		return userSessionsActive[i].DateInsert.After(userSessionsActive[j].DateInsert)
+	})
+
+	return userSessionsActive, nil
+}
+
+// UserSessionRevoke revokes the session of the user, the tokens of the session are rejected since then.
+func UserSessionRevoke(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	userSession, errorUserSession := userSessionFind(id, userId)
+
+	if errorUserSession != nil {
+		return false, errorUserSession
+	}
+
+	errorUserSessionRevoke := userSessionRevoke(userSession)
+
+	if errorUserSessionRevoke != nil {
+		return false, errorUserSessionRevoke
+	}
+
+	return true, nil
+}
+
+func userSessionCreate(user *entity.User) (*entity.UserSession, error) {
+	userSessionRepository := InfrastructureService.GetFactoryRepository().GetUserSessionRepository()
+	now := time.Now().UTC()
+
+	userSession, errorUserSession := userSessionRepository.InsertOne(
+		&entity.UserSession{
+			Id:             uuid.New(),
+			UserId:         user.Id,
+			RefreshTokenId: uuid.New(),
+			Status:         kind.UserSessionStatusActive,
+			DateInsert:     now,
+			DateUpdate:     now,
+			DateExpire:     now.Add(model.JWTRefreshTokenExpire),
+		},
+	)
+
+	if errorUserSession != nil {
+		return nil, errors.Wrapf(errorUserSession, "an error occurred while creating a session in the database by privided data %v", user)
+	}
+
+	return userSession, nil
+}
+
+func userSessionFind(id *uuid.UUID, userId *uuid.UUID) (*entity.UserSession, error) {
+	userSessionRepository := InfrastructureService.GetFactoryRepository().GetUserSessionRepository()
+
+	criteria := userSessionRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = userSessionRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	criteria.Uncached = true
+	userSession, errorUserSession := userSessionRepository.FindOne(criteria)
+
+	if errorUserSession != nil {
+		return nil, errors.Wrapf(errorUserSessionNotFound, "an error occurred while getting a session by privided data id=%s,userId=%s", id, userId)
+	}
+
+	return userSession, nil
+}
+
+// userSessionRotate replaces the refresh token of the session, the previous one can't be exchanged anymore.
+func userSessionRotate(userSession *entity.UserSession) (*entity.UserSession, error) {
+	now := time.Now().UTC()
+
+	userSession.RefreshTokenId = uuid.New()
+	userSession.DateUpdate = now
+	userSession.DateExpire = now.Add(model.JWTRefreshTokenExpire)
+
+	return userSessionSave(userSession)
+}
+
+func userSessionRevoke(userSession *entity.UserSession) error {
+	userSession.Status = kind.UserSessionStatusRevoked
+	userSession.DateUpdate = time.Now().UTC()
+
+	_, errorUserSession := userSessionSave(userSession)
+
+	if errorUserSession != nil {
+		return errorUserSession
+	}
+
+	userSessionCacheRevoked(&userSession.Id, userSession.DateExpire)
+
+	return nil
+}
+
+func userSessionsRevoke(userId *uuid.UUID) error {
+	userSessions, errorUserSessions := UserSessionsInfo(userId)
+
+	if errorUserSessions != nil {
+		return errorUserSessions
+	}
+
+	for _, userSession := range userSessions {
+		errorUserSessionRevoke := userSessionRevoke(userSession)
+
+		if errorUserSessionRevoke != nil {
+			return errorUserSessionRevoke
+		}
+	}
+
+	return nil
+}
+
+func userSessionSave(userSession *entity.UserSession) (*entity.UserSession, error) {
+	userSessionRepository := InfrastructureService.GetFactoryRepository().GetUserSessionRepository()
+
+	updateOne, errorUpdateOne := userSessionRepository.UpdateOne(
+		userSessionRepository.GetCriteria().GetCriteriaById(&userSession.Id, nil),
+		userSession,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a session in the database by privided data %v", userSession)
+	}
+
+	return updateOne, nil
+}
+
+// userSessionRevoked reports whether the session of the token is revoked or expired. The revoked sessions and the
+// sessions checked within the last minute are answered by the counters of the cache, the others are read from the
+// database.
+func userSessionRevoked(token *model.Token) bool {
+	cacheManager := InfrastructureServiceCache.GetCacheManager()
+
+	if cacheManager != nil {
+		ttl := userSessionCacheTTL
+		revoked, errorRevoked := cacheManager.Increment(userSessionCacheKey(userSessionRevokedCacheKey, &token.SessionId), 0, &ttl)
+
+		if errorRevoked == nil && revoked > 0 {
+			return true
+		}
+
+		checked, errorChecked := cacheManager.Increment(userSessionCacheKey(userSessionActiveCacheKey, &token.SessionId), 1, &ttl)
+
+		if errorRevoked == nil && errorChecked == nil && checked > 1 {
+			return false
+		}
+	}
+
+	userSession, errorUserSession := userSessionFind(&token.SessionId, &token.UserId)
+
+	if errorUserSession != nil {
+		return true
+	}
+
+	revoked := userSession.Status != kind.UserSessionStatusActive || !userSession.DateExpire.After(time.Now().UTC())
+
+	if revoked {
+		userSessionCacheRevoked(&userSession.Id, userSession.DateExpire)
+	}
+
+	return revoked
+}
+
+// userSessionCacheRevoked marks the session as revoked in the cache until the session expires.
+func userSessionCacheRevoked(id *uuid.UUID, dateExpire time.Time) {
+	cacheManager := InfrastructureServiceCache.GetCacheManager()
+
+	if cacheManager == nil {
+		return
+	}
+
+	ttl := int64(time.Until(dateExpire).Minutes()) + 1
+	// The counter left by a check expires in a minute, so it is recreated to be kept until the session expires.
+	_ = cacheManager.Delete(userSessionCacheKey(userSessionRevokedCacheKey, id))
+	_, errorRevoked := cacheManager.Increment(userSessionCacheKey(userSessionRevokedCacheKey, id), 1, &ttl)
+
+	if errorRevoked != nil {
+		log.Error(errors.Wrapf(errorRevoked, "an error occurred while caching a revoked session by provided data id=%s", id))
+	}
+
+	_ = cacheManager.Delete(userSessionCacheKey(userSessionActiveCacheKey, id))
+}
+
+func userSessionCacheKey(key string, id *uuid.UUID) []byte {
+	return cache.PrepareKey(key, id.String())
+}
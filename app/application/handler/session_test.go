@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/model"
+	ApplicationMiddlewareJWT "github.com/sergeygardner/meal-planner-api/infrastructure/service/jwt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestUserSession(t *testing.T) {
+	tests := []struct {
+		name    string
+		userDTO dto.UserRegisterDTO
+	}{
+		{
+			name: "Test case with refreshing, reusing and revoking a session",
+			userDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameSession" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:       "NameTest",
+				Surname:    "SurnameTest",
+				MiddleName: "MiddleNameTest",
+				Birthday:   time.Now().UTC(),
+			},
+		},
+	}
+
+	parseToken := func(signedString string) *model.Token {
+		token := &model.Token{}
+		_, errorParse := jwt.ParseWithClaims(
+			signedString,
+			token,
+			func(_ *jwt.Token) (interface{}, error) {
+				return ApplicationMiddlewareJWT.GetJwtKey(), nil
+			},
+		)
+
+		assert.Nil(t, errorParse)
+
+		return token
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.userDTO)
+
+				assert.Nil(t, errorUser)
+
+				authToken, errorAuthToken := AuthToken(user)
+
+				assert.Nil(t, errorAuthToken)
+
+				accessToken := parseToken(authToken.AccessToken)
+				refreshToken := parseToken(authToken.RefreshToken)
+
+				assert.Equal(t, kind.TokenTypeAccess, accessToken.Type)
+				assert.Equal(t, kind.TokenTypeRefresh, refreshToken.Type)
+				assert.Equal(t, accessToken.SessionId, refreshToken.SessionId)
+				assert.Nil(t, AuthTokenVerify(accessToken, kind.TokenTypeAccess))
+				assert.Equal(t, errorAuthTokenNotAccess, AuthTokenVerify(refreshToken, kind.TokenTypeAccess))
+				assert.Equal(t, errorAuthTokenNotRefresh, AuthTokenVerify(accessToken, kind.TokenTypeRefresh))
+
+				_, errorAuthRefreshAccess := AuthRefresh(accessToken)
+
+				assert.Equal(t, errorAuthTokenNotRefresh, errorAuthRefreshAccess)
+
+				authTokenRefreshed, errorAuthRefresh := AuthRefresh(refreshToken)
+
+				assert.Nil(t, errorAuthRefresh)
+
+				refreshTokenRefreshed := parseToken(authTokenRefreshed.RefreshToken)
+
+				assert.Equal(t, refreshToken.SessionId, refreshTokenRefreshed.SessionId)
+				assert.NotEqual(t, refreshToken.ID, refreshTokenRefreshed.ID)
+
+				userSessions, errorUserSessions := UserSessionsInfo(&user.Id)
+
+				assert.Nil(t, errorUserSessions)
+				assert.Len(t, userSessions, 1)
+
+				_, errorAuthRefreshReused := AuthRefresh(refreshToken)
+
+				assert.Equal(t, errorAuthTokenReused, errorAuthRefreshReused)
+				assert.Equal(t, errorAuthTokenRevoked, AuthTokenVerify(refreshTokenRefreshed, kind.TokenTypeRefresh))
+
+				_, errorAuthRefreshRevoked := AuthRefresh(refreshTokenRefreshed)
+
+				assert.Equal(t, errorAuthTokenRevoked, errorAuthRefreshRevoked)
+
+				authTokenOther, errorAuthTokenOther := AuthToken(user)
+
+				assert.Nil(t, errorAuthTokenOther)
+
+				accessTokenOther := parseToken(authTokenOther.AccessToken)
+				authLogoutStatus, errorAuthLogout := AuthLogout(accessTokenOther)
+
+				assert.True(t, authLogoutStatus)
+				assert.Nil(t, errorAuthLogout)
+				assert.Equal(t, errorAuthTokenRevoked, AuthTokenVerify(accessTokenOther, kind.TokenTypeAccess))
+
+				userSessions, errorUserSessions = UserSessionsInfo(&user.Id)
+
+				assert.Nil(t, errorUserSessions)
+				assert.Empty(t, userSessions)
+
+				sessionId := uuid.New()
+				userSessionRevokeStatus, errorUserSessionRevoke := UserSessionRevoke(&sessionId, &user.Id)
+
+				assert.False(t, userSessionRevokeStatus)
+				assert.NotNil(t, errorUserSessionRevoke)
+
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
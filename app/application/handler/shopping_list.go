@@ -0,0 +1,457 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
+	ApplicationService "github.com/sergeygardner/meal-planner-api/application/service/builder"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"time"
+)
+
+var (
+	errorShoppingListCreate     = errors.New("shopping list has not created by provided data [1]")
+	errorShoppingListExists     = errors.New("shopping list has not created by provided data [2]")
+	errorShoppingListInfo       = errors.New("shopping list cannot be showed by provided data")
+	errorShoppingListSource     = errors.New("shopping list must be generated from a planner or a date range")
+	errorShoppingListItemCreate = errors.New("shopping list item has not created by provided data")
+	errorShoppingListItemInfo   = errors.New("shopping list item cannot be showed by provided data")
+)
+
+// ShoppingListGenerate creates the shopping list of the planner or of the date range. The list without a name is named
+// by the planner or by the dates, so when the list of the same planner or the same dates exists it is regenerated and
+// the items are merged into it, and when a list of another source has the name a number is added to the name.
+func ShoppingListGenerate(userId *uuid.UUID, shoppingListDTO *DomainEntity.ShoppingList) (*DomainAggregate.ShoppingList, error) {
+	shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+
+	shoppingListDTO.UserId = *userId
+
+	if shoppingListDTO.PlannerId == uuid.Nil && (shoppingListDTO.StartTime.IsZero() || shoppingListDTO.EndTime.IsZero()) {
+		return nil, errorShoppingListSource
+	}
+
+	nameDefault := shoppingListDTO.Name == ""
+
+	if shoppingListDTO.Name == "" && shoppingListDTO.PlannerId != uuid.Nil {
+		plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
+		planner, errorPlanner := plannerRepository.FindOne(plannerRepository.GetCriteria().GetCriteriaById(&shoppingListDTO.PlannerId, nil))
+
+		if errorPlanner != nil {
+			return nil, errors.Wrapf(errorPlanner, "an error occurred while generating a shopping list by privided data %v", shoppingListDTO)
+		}
+
+		shoppingListDTO.Name = planner.Name
+	} else if shoppingListDTO.Name == "" {
+		shoppingListDTO.Name = fmt.Sprintf("%s - %s", shoppingListDTO.StartTime.Format(time.DateOnly), shoppingListDTO.EndTime.Format(time.DateOnly))
+	}
+
+	name := shoppingListDTO.Name
+	shoppingListFindOne, errorShoppingListFindOne := shoppingListFindByName(userId, &shoppingListDTO.Name)
+
+	for attempt := 2; nameDefault && errorShoppingListFindOne == nil && shoppingListFindOne != nil; attempt++ {
+		if shoppingListFindOne.PlannerId == shoppingListDTO.PlannerId &&
+			(shoppingListDTO.PlannerId != uuid.Nil || shoppingListFindOne.StartTime.Equal(shoppingListDTO.StartTime) && shoppingListFindOne.EndTime.Equal(shoppingListDTO.EndTime)) {
+			return ShoppingListRegenerate(&shoppingListFindOne.Id, userId)
+		}
+
+		shoppingListDTO.Name = fmt.Sprintf("%s (%d)", name, attempt)
+		shoppingListFindOne, errorShoppingListFindOne = shoppingListFindByName(userId, &shoppingListDTO.Name)
+	}
+
+	if errorShoppingListFindOne == nil {
+		return nil, errorShoppingListCreate
+	} else if shoppingListFindOne != nil {
+		return nil, errorShoppingListExists
+	}
+
+	plannerCalculations, errorPlannerCalculations := shoppingListCalculate(shoppingListDTO)
+
+	if errorPlannerCalculations != nil {
+		return nil, errors.Wrapf(errorPlannerCalculations, "an error occurred while generating a shopping list by privided data %v", shoppingListDTO)
+	}
+
+	if shoppingListDTO.Status == "" {
+		shoppingListDTO.Status = kind.ShoppingListStatusActive
+	}
+
+	shoppingListDTO.DateInsert = time.Now().UTC()
+	shoppingListDTO.DateUpdate = time.Now().UTC()
+
+	shoppingList, errorShoppingListInsertOne := shoppingListRepository.InsertOne(prepareShoppingListRepositoryInsert(shoppingListDTO))
+
+	if errorShoppingListInsertOne != nil {
+		return nil, errors.Wrapf(errorShoppingListInsertOne, "an error occurred while creating a shopping list in the database by privided data %v", shoppingListDTO)
+	}
+
+	errorShoppingListItems := shoppingListItemsMerge(shoppingList, nil, plannerCalculations)
+
+	if errorShoppingListItems != nil {
+		return nil, errors.Wrapf(errorShoppingListItems, "an error occurred while creating shopping list items in the database by privided data %v", shoppingList)
+	}
+
+	return getShoppingListAggregate(&shoppingList.Id, &shoppingList.UserId, nil)
+}
+
+func ShoppingListRegenerate(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.ShoppingList, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, id, kind.UserRightWrite)
+
+	shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+	shoppingList, errorShoppingList := getShoppingListAggregate(id, userId, nil)
+
+	if errorShoppingList != nil {
+		return nil, errors.Wrapf(errorShoppingList, "an error occurred while regenerating a shopping list by privided data id=%s,userId=%s", id, userId)
+	}
+
+	plannerCalculations, errorPlannerCalculations := shoppingListCalculate(shoppingList.Entity)
+
+	if errorPlannerCalculations != nil {
+		return nil, errors.Wrapf(errorPlannerCalculations, "an error occurred while regenerating a shopping list by privided data id=%s,userId=%s", id, userId)
+	}
+
+	shoppingListItems, errorShoppingListItemsAggregate := ApplicationService.BuildShoppingListItemsAggregate(nil, userId, id, &persistence.Criteria{Uncached: true})
+
+	if errorShoppingListItemsAggregate != nil {
+		return nil, errors.Wrapf(errorShoppingListItemsAggregate, "an error occurred while getting shopping list items by privided data id=%s,userId=%s", id, userId)
+	}
+
+	errorShoppingListItems := shoppingListItemsMerge(shoppingList.Entity, shoppingListItems, plannerCalculations)
+
+	if errorShoppingListItems != nil {
+		return nil, errors.Wrapf(errorShoppingListItems, "an error occurred while regenerating shopping list items by privided data id=%s,userId=%s", id, userId)
+	}
+
+	shoppingList.Entity.DateUpdate = time.Now().UTC()
+
+	updateOne, errorUpdateOne := shoppingListRepository.UpdateOne(
+		shoppingListRepository.GetCriteria().GetCriteriaById(&shoppingList.Entity.Id, nil),
+		shoppingList.Entity,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a shopping list entity in the database %v", shoppingList.Entity)
+	}
+
+	return getShoppingListAggregate(&updateOne.Id, &updateOne.UserId, nil)
+}
+
+func ShoppingListsInfo(userId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.ShoppingList, error) {
+	return ApplicationService.BuildShoppingListsAggregate(nil, userId, criteria)
+}
+
+func ShoppingListInfo(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.ShoppingList, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, id, kind.UserRightRead)
+
+	return getShoppingListAggregate(id, userId, criteria)
+}
+
+func ShoppingListUpdate(id *uuid.UUID, userId *uuid.UUID, shoppingListDTO *DomainEntity.ShoppingList) (*DomainAggregate.ShoppingList, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, id, kind.UserRightWrite)
+
+	shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+	shoppingList, errorShoppingList := getShoppingListAggregate(id, userId, nil)
+
+	if errorShoppingList != nil {
+		return nil, errors.Wrapf(errorShoppingList, "an error occurred while updating a shopping list by privided data id=%s,userId=%s,criteria=%v", id, userId, nil)
+	}
+
+	shoppingListDTO.Id = *id
+	shoppingListDTO.UserId = *userId
+	shoppingListDTO.DateInsert = shoppingList.Entity.DateInsert
+	shoppingListDTO.DateUpdate = time.Now().UTC()
+
+	shoppingListUpdated, errorShoppingListUpdated := service.Update(shoppingList.Entity, shoppingListDTO)
+
+	if errorShoppingListUpdated != nil {
+		return nil, errors.Wrapf(errorShoppingListUpdated, "an error occurred while updating a shopping list by privided data %v", shoppingListDTO)
+	}
+
+	restoredShoppingListUpdated, okRestoredShoppingListUpdated := shoppingListUpdated.Interface().(*DomainEntity.ShoppingList)
+
+	if !okRestoredShoppingListUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a shopping list by privided data %s", shoppingListUpdated)
+	}
+
+	updateOne, errorUpdateOne := shoppingListRepository.UpdateOne(
+		shoppingListRepository.GetCriteria().GetCriteriaById(&restoredShoppingListUpdated.Id, nil),
+		restoredShoppingListUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a shopping list entity in the database %v", restoredShoppingListUpdated)
+	}
+
+	return getShoppingListAggregate(&updateOne.Id, &updateOne.UserId, nil)
+}
+
+func ShoppingListDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, id, kind.UserRightWrite)
+
+	shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+	shoppingListItemRepository := InfrastructureService.GetFactoryRepository().GetShoppingListItemRepository()
+
+	criteria := shoppingListRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = shoppingListRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	shoppingListDeleteStatus, errorShoppingListDeleteStatus := shoppingListRepository.DeleteOne(criteria)
+
+	if errorShoppingListDeleteStatus != nil || !shoppingListDeleteStatus {
+		return shoppingListDeleteStatus, errorShoppingListDeleteStatus
+	}
+
+	householdGrantsDelete(userId, id)
+
+	shoppingListItems, _ := shoppingListItemRepository.FindAll(
+		shoppingListItemRepository.GetCriteria().GetCriteriaByEntityId(
+			id,
+			shoppingListItemRepository.GetCriteria().GetCriteriaByUserId(userId, nil),
+		),
+	)
+
+	for _, shoppingListItem := range shoppingListItems {
+		_, errorShoppingListItemDelete := shoppingListItemRepository.DeleteOne(
+			shoppingListItemRepository.GetCriteria().GetCriteriaById(&shoppingListItem.Id, nil),
+		)
+
+		if errorShoppingListItemDelete != nil {
+			return false, errors.Wrapf(errorShoppingListItemDelete, "an error occurred while deleting a shopping list item by privided data %v", shoppingListItem)
+		}
+	}
+
+	return true, nil
+}
+
+func ShoppingListItemCreate(userId *uuid.UUID, entityId *uuid.UUID, shoppingListItemDTO *DomainEntity.ShoppingListItem) (*DomainAggregate.ShoppingListItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, entityId, kind.UserRightWrite)
+
+	shoppingListItemRepository := InfrastructureService.GetFactoryRepository().GetShoppingListItemRepository()
+	_, errorShoppingList := getShoppingListAggregate(entityId, userId, nil)
+
+	if errorShoppingList != nil {
+		return nil, errors.Wrapf(errorShoppingList, "an error occurred while creating a shopping list item by privided data entityId=%s,userId=%s", entityId, userId)
+	}
+
+	if shoppingListItemDTO.IngredientId != uuid.Nil {
+		ingredient, errorIngredient := getIngredientEntity(&shoppingListItemDTO.IngredientId, userId, nil)
+
+		if errorIngredient != nil {
+			return nil, errors.Wrapf(errorIngredient, "an error occurred while creating a shopping list item by privided data %v", shoppingListItemDTO)
+		}
+
+		if shoppingListItemDTO.CategoryId == uuid.Nil {
+			shoppingListItemDTO.CategoryId = ingredient.CategoryId
+		}
+
+		if shoppingListItemDTO.Name == "" {
+			shoppingListItemDTO.Name = ingredient.Name
+		}
+	}
+
+	if shoppingListItemDTO.Name == "" {
+		return nil, errorShoppingListItemCreate
+	}
+
+	if shoppingListItemDTO.Status == "" {
+		shoppingListItemDTO.Status = kind.ShoppingListItemStatusUnChecked
+	}
+
+	shoppingListItemDTO.UserId = *userId
+	shoppingListItemDTO.EntityId = *entityId
+	shoppingListItemDTO.Manual = true
+	shoppingListItemDTO.DateInsert = time.Now().UTC()
+	shoppingListItemDTO.DateUpdate = time.Now().UTC()
+
+	shoppingListItem, errorShoppingListItemInsertOne := shoppingListItemRepository.InsertOne(prepareShoppingListItemRepositoryInsert(shoppingListItemDTO))
+
+	if errorShoppingListItemInsertOne != nil {
+		return nil, errors.Wrapf(errorShoppingListItemInsertOne, "an error occurred while creating a shopping list item in the database by privided data %v", shoppingListItemDTO)
+	}
+
+	return getShoppingListItemAggregate(&shoppingListItem.Id, &shoppingListItem.UserId, &shoppingListItem.EntityId, nil)
+}
+
+func ShoppingListItemsInfo(userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) ([]*DomainAggregate.ShoppingListItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, entityId, kind.UserRightRead)
+
+	return ApplicationService.BuildShoppingListItemsAggregate(nil, userId, entityId, criteria)
+}
+
+func ShoppingListItemInfo(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.ShoppingListItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, entityId, kind.UserRightRead)
+
+	return getShoppingListItemAggregate(id, userId, entityId, criteria)
+}
+
+func ShoppingListItemUpdate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, shoppingListItemDTO *DomainEntity.ShoppingListItem) (*DomainAggregate.ShoppingListItem, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, entityId, kind.UserRightWrite)
+
+	shoppingListItemRepository := InfrastructureService.GetFactoryRepository().GetShoppingListItemRepository()
+	shoppingListItem, errorShoppingListItem := getShoppingListItemAggregate(id, userId, entityId, nil)
+
+	if errorShoppingListItem != nil {
+		return nil, errors.Wrapf(errorShoppingListItem, "an error occurred while updating a shopping list item by privided data id=%s,userId=%s,criteria=%v", id, userId, nil)
+	}
+
+	shoppingListItemDTO.Id = *id
+	shoppingListItemDTO.UserId = *userId
+	shoppingListItemDTO.EntityId = *entityId
+	shoppingListItemDTO.Manual = shoppingListItem.Entity.Manual
+	shoppingListItemDTO.DateInsert = shoppingListItem.Entity.DateInsert
+	shoppingListItemDTO.DateUpdate = time.Now().UTC()
+
+	shoppingListItemUpdated, errorShoppingListItemUpdated := service.Update(shoppingListItem.Entity, shoppingListItemDTO)
+
+	if errorShoppingListItemUpdated != nil {
+		return nil, errors.Wrapf(errorShoppingListItemUpdated, "an error occurred while updating a shopping list item by privided data %v", shoppingListItemDTO)
+	}
+
+	restoredShoppingListItemUpdated, okRestoredShoppingListItemUpdated := shoppingListItemUpdated.Interface().(*DomainEntity.ShoppingListItem)
+
+	if !okRestoredShoppingListItemUpdated {
+		return nil, errors.Wrapf(errorUpdateRestored, "an error occurred while restoring updated a shopping list item by privided data %s", shoppingListItemUpdated)
+	}
+
+	updateOne, errorUpdateOne := shoppingListItemRepository.UpdateOne(
+		shoppingListItemRepository.GetCriteria().GetCriteriaById(&restoredShoppingListItemUpdated.Id, nil),
+		restoredShoppingListItemUpdated,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a shopping list item entity in the database %v", restoredShoppingListItemUpdated)
+	}
+
+	return getShoppingListItemAggregate(&updateOne.Id, &updateOne.UserId, &updateOne.EntityId, nil)
+}
+
+func ShoppingListItemDelete(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID) (bool, error) {
+	userId = householdUserId(userId, kind.HouseholdSubjectShoppingList, entityId, kind.UserRightWrite)
+
+	shoppingListItemRepository := InfrastructureService.GetFactoryRepository().GetShoppingListItemRepository()
+
+	criteria := shoppingListItemRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = shoppingListItemRepository.GetCriteria().GetCriteriaByEntityId(entityId, criteria)
+	criteria = shoppingListItemRepository.GetCriteria().GetCriteriaById(id, criteria)
+
+	return shoppingListItemRepository.DeleteOne(criteria)
+}
+
+func shoppingListCalculate(shoppingList *DomainEntity.ShoppingList) ([]*DomainAggregate.PlannerCalculation, error) {
+	if shoppingList.PlannerId != uuid.Nil {
+		return PlannerShoppingList(&shoppingList.PlannerId, &shoppingList.UserId)
+	}
+
+	var plannersCalculations [][]*DomainAggregate.PlannerCalculation
+
+	plannerRepository := InfrastructureService.GetFactoryRepository().GetPlannerRepository()
+	plannerEntities, errorPlannerEntities := plannerRepository.FindAll(plannerRepository.GetCriteria().GetCriteriaByUserId(&shoppingList.UserId, nil))
+
+	if errorPlannerEntities != nil {
+		return nil, errors.Wrapf(errorPlannerEntities, "an error occurred while getting planners by privided data userId=%s", shoppingList.UserId)
+	}
+
+	for _, plannerEntity := range plannerEntities {
+		if plannerEntity.StartTime.After(shoppingList.EndTime) || plannerEntity.EndTime.Before(shoppingList.StartTime) {
+			continue
+		}
+
+		plannerCalculations, errorPlannerCalculations := PlannerCalculate(&plannerEntity.Id, &plannerEntity.UserId)
+
+		if errorPlannerCalculations != nil {
+			return nil, errorPlannerCalculations
+		}
+
+		plannersCalculations = append(plannersCalculations, plannerCalculations)
+	}
+
+	pantryItems, errorPantryItems := PantryItemsInfo(&shoppingList.UserId, nil)
+
+	if errorPantryItems != nil {
+		return nil, errors.Wrapf(errorPantryItems, "an error occurred while getting pantry items by privided data userId=%s", shoppingList.UserId)
+	}
+
+	return ApplicationServiceHelper.PantrySubtract(
+		ApplicationServiceHelper.PlannerCalculationMerge(plannersCalculations...),
+		pantryItems,
+		time.Now().UTC(),
+	), nil
+}
+
+func shoppingListItemsMerge(
+	shoppingList *DomainEntity.ShoppingList,
+	shoppingListItems []*DomainAggregate.ShoppingListItem,
+	plannerCalculations []*DomainAggregate.PlannerCalculation,
+) error {
+	shoppingListItemRepository := InfrastructureService.GetFactoryRepository().GetShoppingListItemRepository()
+	shoppingListItemEntities := make([]*DomainEntity.ShoppingListItem, 0, len(shoppingListItems))
+
+	for _, shoppingListItem := range shoppingListItems {
+		shoppingListItemEntities = append(shoppingListItemEntities, shoppingListItem.Entity)
+	}
+
+	upserted, deleted := ApplicationServiceHelper.ShoppingListItemsMerge(shoppingListItemEntities, plannerCalculations, time.Now().UTC())
+
+	for _, shoppingListItem := range upserted {
+		var errorShoppingListItem error
+
+		if shoppingListItem.Id == uuid.Nil {
+			shoppingListItem.UserId = shoppingList.UserId
+			shoppingListItem.EntityId = shoppingList.Id
+
+			_, errorShoppingListItem = shoppingListItemRepository.InsertOne(prepareShoppingListItemRepositoryInsert(shoppingListItem))
+		} else {
+			_, errorShoppingListItem = shoppingListItemRepository.UpdateOne(
+				shoppingListItemRepository.GetCriteria().GetCriteriaById(&shoppingListItem.Id, nil),
+				shoppingListItem,
+			)
+		}
+
+		if errorShoppingListItem != nil {
+			return errorShoppingListItem
+		}
+	}
+
+	for _, shoppingListItem := range deleted {
+		_, errorShoppingListItem := shoppingListItemRepository.DeleteOne(
+			shoppingListItemRepository.GetCriteria().GetCriteriaById(&shoppingListItem.Id, nil),
+		)
+
+		if errorShoppingListItem != nil {
+			return errorShoppingListItem
+		}
+	}
+
+	return nil
+}
+
+func shoppingListFindByName(userId *uuid.UUID, name *string) (*DomainEntity.ShoppingList, error) {
+	shoppingListRepository := InfrastructureService.GetFactoryRepository().GetShoppingListRepository()
+	criteria := shoppingListRepository.GetCriteria().GetCriteriaByName(name, &persistence.Criteria{Uncached: true})
+	criteria = shoppingListRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+
+	return shoppingListRepository.FindOne(criteria)
+}
+
+func getShoppingListAggregate(id *uuid.UUID, userId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.ShoppingList, error) {
+	shoppingListsAggregate, errorShoppingListsAggregate := ApplicationService.BuildShoppingListsAggregate(id, userId, criteria)
+	if errorShoppingListsAggregate != nil {
+		return nil, errors.Wrapf(errorShoppingListsAggregate, "an error occurred while getting a shopping list by privided data id=%s,userId=%s,criteria=%v", id, userId, criteria)
+	} else if len(shoppingListsAggregate) == 0 {
+		return nil, errorShoppingListInfo
+	}
+	return shoppingListsAggregate[0], nil
+}
+
+func getShoppingListItemAggregate(id *uuid.UUID, userId *uuid.UUID, entityId *uuid.UUID, criteria *persistence.Criteria) (*DomainAggregate.ShoppingListItem, error) {
+	shoppingListItemsAggregate, errorShoppingListItemsAggregate := ApplicationService.BuildShoppingListItemsAggregate(id, userId, entityId, criteria)
+	if errorShoppingListItemsAggregate != nil {
+		return nil, errors.Wrapf(errorShoppingListItemsAggregate, "an error occurred while getting a shopping list item by privided data id=%s,userId=%s,criteria=%v", id, userId, criteria)
+	} else if len(shoppingListItemsAggregate) == 0 {
+		return nil, errorShoppingListItemInfo
+	}
+	return shoppingListItemsAggregate[0], nil
+}
@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testsShoppingListData = testsShoppingList{
+		{
+			name:   "Test case with correct data",
+			id:     nil,
+			userId: &testUserId,
+			shoppingListDTO: &DomainEntity.ShoppingList{
+				StartTime: time.Now().UTC().Add(time.Hour * 24 * 365),
+				EndTime:   time.Now().UTC().Add(time.Hour * 24 * 372),
+				Name:      "Test Shopping List " + uuid.NewString(),
+			},
+			toUpdatingShoppingListDTO: &DomainEntity.ShoppingList{
+				Status: kind.ShoppingListStatusCompleted,
+			},
+			shoppingListItemDTO: &DomainEntity.ShoppingListItem{
+				Name:   "Napkins",
+				Amount: 1,
+			},
+			toUpdatingShoppingListItemDTO: &DomainEntity.ShoppingListItem{
+				Amount: 2,
+				Status: kind.ShoppingListItemStatusChecked,
+			},
+		},
+	}
+)
+
+type testsShoppingList []struct {
+	name                          string
+	id                            *uuid.UUID
+	userId                        *uuid.UUID
+	shoppingListDTO               *DomainEntity.ShoppingList
+	toUpdatingShoppingListDTO     *DomainEntity.ShoppingList
+	shoppingList                  *DomainAggregate.ShoppingList
+	shoppingListItemId            *uuid.UUID
+	shoppingListItemDTO           *DomainEntity.ShoppingListItem
+	toUpdatingShoppingListItemDTO *DomainEntity.ShoppingListItem
+	shoppingListItem              *DomainAggregate.ShoppingListItem
+}
+
+func init() {
+	InfrastructureService.PrepareCache()
+	InfrastructureService.PreparePersistence()
+}
+
+func TestShoppingListGenerate(t *testing.T) {
+	for index, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListGenerate(testCase.userId, testCase.shoppingListDTO)
+
+				assert.Nil(t, errorActual)
+
+				testsShoppingListData[index].shoppingList = actual
+				testsShoppingListData[index].id = &actual.Entity.Id
+
+				assert.NotNil(t, actual.Entity.Id)
+				assert.Equal(t, *testCase.userId, actual.Entity.UserId)
+				assert.Equal(t, testCase.shoppingListDTO.Name, actual.Entity.Name)
+				assert.Equal(t, kind.ShoppingListStatusActive, actual.Entity.Status)
+				assert.Empty(t, actual.Groups)
+			},
+		)
+	}
+}
+
+func TestShoppingListGenerateWithoutSource(t *testing.T) {
+	actual, errorActual := ShoppingListGenerate(&testUserId, &DomainEntity.ShoppingList{Name: "Test Shopping List"})
+
+	assert.Nil(t, actual)
+	assert.Equal(t, errorShoppingListSource, errorActual)
+}
+
+func TestShoppingListInfo(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListInfo(testCase.id, testCase.userId, nil)
+
+				if testCase.shoppingList != nil {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.shoppingList.Entity.Id, actual.Entity.Id)
+					assert.Equal(t, testCase.shoppingList.Entity.Name, actual.Entity.Name)
+					assert.Equal(t, testCase.shoppingList.Entity.StartTime.Format(time.UnixDate), actual.Entity.StartTime.Format(time.UnixDate))
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestShoppingListUpdate(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListUpdate(testCase.id, testCase.userId, testCase.toUpdatingShoppingListDTO)
+
+				if testCase.shoppingList != nil {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.toUpdatingShoppingListDTO.Status, actual.Entity.Status)
+					assert.Equal(t, testCase.shoppingList.Entity.Name, actual.Entity.Name)
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestShoppingListItemCreate(t *testing.T) {
+	for index, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListItemCreate(testCase.userId, testCase.id, testCase.shoppingListItemDTO)
+
+				assert.Nil(t, errorActual)
+
+				testsShoppingListData[index].shoppingListItem = actual
+				testsShoppingListData[index].shoppingListItemId = &actual.Entity.Id
+
+				assert.Equal(t, *testCase.id, actual.Entity.EntityId)
+				assert.Equal(t, testCase.shoppingListItemDTO.Name, actual.Entity.Name)
+				assert.Equal(t, testCase.shoppingListItemDTO.Amount, actual.Entity.Amount)
+				assert.True(t, actual.Entity.Manual)
+				assert.Equal(t, kind.ShoppingListItemStatusUnChecked, actual.Entity.Status)
+			},
+		)
+	}
+}
+
+func TestShoppingListItemUpdate(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListItemUpdate(testCase.shoppingListItemId, testCase.userId, testCase.id, testCase.toUpdatingShoppingListItemDTO)
+
+				if testCase.shoppingListItem != nil {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.toUpdatingShoppingListItemDTO.Amount, actual.Entity.Amount)
+					assert.Equal(t, testCase.toUpdatingShoppingListItemDTO.Status, actual.Entity.Status)
+					assert.True(t, actual.Entity.Manual)
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestShoppingListRegenerate(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListRegenerate(testCase.id, testCase.userId)
+
+				if testCase.shoppingList != nil {
+					assert.Nil(t, errorActual)
+					assert.Len(t, actual.Groups, 1)
+					assert.Len(t, actual.Groups[0].Items, 1)
+					assert.Equal(t, *testCase.shoppingListItemId, actual.Groups[0].Items[0].Entity.Id)
+					assert.Equal(t, kind.ShoppingListItemStatusChecked, actual.Groups[0].Items[0].Entity.Status)
+				} else {
+					assert.NotNil(t, errorActual)
+				}
+			},
+		)
+	}
+}
+
+func TestShoppingListItemDelete(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListItemDelete(testCase.shoppingListItemId, testCase.userId, testCase.id)
+
+				if testCase.shoppingListItem != nil {
+					assert.Nil(t, errorActual)
+					assert.True(t, actual)
+				} else {
+					assert.NotNil(t, errorActual)
+					assert.False(t, actual)
+				}
+			},
+		)
+	}
+}
+
+func TestShoppingListDelete(t *testing.T) {
+	for _, testCase := range testsShoppingListData {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorActual := ShoppingListDelete(testCase.id, testCase.userId)
+
+				if testCase.shoppingList != nil {
+					assert.Nil(t, errorActual)
+					assert.True(t, actual)
+				} else {
+					assert.NotNil(t, errorActual)
+					assert.False(t, actual)
+				}
+			},
+		)
+	}
+}
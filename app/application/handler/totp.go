@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	ApplicationServicePassword "github.com/sergeygardner/meal-planner-api/application/service/password"
+	ApplicationServiceTotp "github.com/sergeygardner/meal-planner-api/application/service/totp"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/response"
+	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"time"
+)
+
+var (
+	errorAuthTotpEnabled    = errors.New("the two-factor authentication is already enabled")
+	errorAuthTotpNotEnabled = errors.New("the two-factor authentication is not enabled")
+	errorAuthTotpNotPending = errors.New("the two-factor authentication is not enrolled")
+	errorAuthTotpWrong      = errors.New("the two-factor authentication code is wrong")
+)
+
+// AuthTotpEnrol generates the new secret of the user, the factor is pending until the code of the secret is verified
+// by AuthTotpVerify.
+func AuthTotpEnrol(userId *uuid.UUID) (*response.AuthTotpEnrol, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while getting a user by provided data userId=%s", userId)
+	}
+
+	if _, errorUserTotpEnabled := userTotpFind(userId, kind.UserTotpStatusEnabled); errorUserTotpEnabled == nil {
+		return nil, errorAuthTotpEnabled
+	}
+
+	secret, errorSecret := ApplicationServiceTotp.GenerateSecret()
+
+	if errorSecret != nil {
+		return nil, errorSecret
+	}
+
+	userTotpRepository := InfrastructureService.GetFactoryRepository().GetUserTotpRepository()
+	now := time.Now().UTC()
+	userTotp, errorUserTotpPending := userTotpFind(userId, kind.UserTotpStatusPending)
+
+	if errorUserTotpPending == nil {
+		userTotp.Secret = secret
+		userTotp.DateUpdate = now
+
+		_, errorUserTotpPending = userTotpSave(userTotp)
+	} else {
+		_, errorUserTotpPending = userTotpRepository.InsertOne(
+			&entity.UserTotp{
+				Id:         uuid.New(),
+				UserId:     user.Id,
+				Secret:     secret,
+				Status:     kind.UserTotpStatusPending,
+				DateInsert: now,
+				DateUpdate: now,
+			},
+		)
+	}
+
+	if errorUserTotpPending != nil {
+		return nil, errors.Wrapf(errorUserTotpPending, "an error occurred while enrolling the two-factor authentication by privided data userId=%s", userId)
+	}
+
+	return &response.AuthTotpEnrol{Secret: secret, Uri: ApplicationServiceTotp.Uri(user.Username, secret)}, nil
+}
+
+// AuthTotpVerify enables the pending factor of the user by the code of the authenticator application and returns the
+// recovery codes, they are shown once.
+func AuthTotpVerify(userId *uuid.UUID, authTotpDTO dto.AuthTotpDTO) (*response.AuthTotpRecoveryCodes, error) {
+	userTotp, errorUserTotp := userTotpFind(userId, kind.UserTotpStatusPending)
+
+	if errorUserTotp != nil {
+		return nil, errorAuthTotpNotPending
+	}
+
+	counter, valid := ApplicationServiceTotp.Validate(userTotp.Secret, authTotpDTO.Code, time.Now().UTC(), userTotp.Counter)
+
+	if !valid {
+		return nil, errorAuthTotpWrong
+	}
+
+	recoveryCodes, errorRecoveryCodes := ApplicationServiceTotp.GenerateRecoveryCodes()
+
+	if errorRecoveryCodes != nil {
+		return nil, errorRecoveryCodes
+	}
+
+	userTotp.RecoveryCodes = make([]string, len(recoveryCodes))
+
+	for i, recoveryCode := range recoveryCodes {
+		userTotp.RecoveryCodes[i] = ApplicationServiceTotp.HashRecoveryCode(recoveryCode)
+	}
+
+	userTotp.Counter = counter
+	userTotp.Status = kind.UserTotpStatusEnabled
+	userTotp.DateUpdate = time.Now().UTC()
+
+	if _, errorUserTotpSave := userTotpSave(userTotp); errorUserTotpSave != nil {
+		return nil, errorUserTotpSave
+	}
+
+	return &response.AuthTotpRecoveryCodes{RecoveryCodes: recoveryCodes}, nil
+}
+
+// AuthTotpDisable removes the factor of the user when the password and the code of the authenticator application or
+// a recovery code are right.
+func AuthTotpDisable(userId *uuid.UUID, authTotpDisableDTO dto.AuthTotpDisableDTO) (bool, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return false, errors.Wrapf(errorUser, "an error occurred while getting a user by provided data userId=%s", userId)
+	} else if !ApplicationServicePassword.CheckPassword(user.Password, authTotpDisableDTO.Password) {
+		return false, errorAuthPasswordWrong
+	}
+
+	userTotp, errorUserTotp := userTotpFind(userId, kind.UserTotpStatusEnabled)
+
+	if errorUserTotp != nil {
+		return false, errorAuthTotpNotEnabled
+	} else if !userTotpCheck(userTotp, authTotpDisableDTO.Code) {
+		return false, errorAuthTotpWrong
+	}
+
+	userTotpRepository := InfrastructureService.GetFactoryRepository().GetUserTotpRepository()
+	deleted, errorDeleteOne := userTotpRepository.DeleteOne(userTotpRepository.GetCriteria().GetCriteriaById(&userTotp.Id, nil))
+
+	if errorDeleteOne != nil {
+		return false, errors.Wrapf(errorDeleteOne, "an error occurred while deleting the two-factor authentication by privided data %v", userTotp)
+	}
+
+	return deleted, nil
+}
+
+func userTotpFind(userId *uuid.UUID, status kind.UserTotpStatus) (*entity.UserTotp, error) {
+	userTotpRepository := InfrastructureService.GetFactoryRepository().GetUserTotpRepository()
+	statusString := status.String()
+
+	criteria := userTotpRepository.GetCriteria().GetCriteriaByUserId(userId, nil)
+	criteria = userTotpRepository.GetCriteria().GetCriteriaByStatus(&statusString, criteria)
+	criteria.Uncached = true
+
+	return userTotpRepository.FindOne(criteria)
+}
+
+// userTotpCheck accepts the code of the authenticator application or one of the recovery codes, the accepted recovery
+// code is used up.
+func userTotpCheck(userTotp *entity.UserTotp, code string) bool {
+	if counter, valid := ApplicationServiceTotp.Validate(userTotp.Secret, code, time.Now().UTC(), userTotp.Counter); valid {
+		userTotp.Counter = counter
+	} else {
+		recoveryCodeHash := ApplicationServiceTotp.HashRecoveryCode(code)
+		recoveryCodes := make([]string, 0, len(userTotp.RecoveryCodes))
+
+		for _, recoveryCode := range userTotp.RecoveryCodes {
+			if recoveryCode != recoveryCodeHash {
+				recoveryCodes = append(recoveryCodes, recoveryCode)
+			}
+		}
+
+		if len(recoveryCodes) == len(userTotp.RecoveryCodes) {
+			return false
+		}
+
+		userTotp.RecoveryCodes = recoveryCodes
+	}
+
+	userTotp.DateUpdate = time.Now().UTC()
+
+	_, errorUserTotpSave := userTotpSave(userTotp)
+
+	return errorUserTotpSave == nil
+}
+
+func userTotpSave(userTotp *entity.UserTotp) (*entity.UserTotp, error) {
+	userTotpRepository := InfrastructureService.GetFactoryRepository().GetUserTotpRepository()
+
+	updateOne, errorUpdateOne := userTotpRepository.UpdateOne(
+		userTotpRepository.GetCriteria().GetCriteriaById(&userTotp.Id, nil),
+		userTotp,
+	)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating the two-factor authentication in the database by privided data %v", userTotp)
+	}
+
+	return updateOne, nil
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	ApplicationServiceTotp "github.com/sergeygardner/meal-planner-api/application/service/totp"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAuthTotp(t *testing.T) {
+	tests := []struct {
+		name    string
+		userDTO dto.UserRegisterDTO
+	}{
+		{
+			name: "Test case with enrolling, confirming with and disabling the two-factor authentication",
+			userDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameTotp" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:     "NameTest",
+				Birthday: time.Now().UTC(),
+			},
+		},
+	}
+
+	codeAt := func(secret string, t time.Time) string {
+		code, _ := ApplicationServiceTotp.Code(secret, ApplicationServiceTotp.Counter(t))
+
+		return code
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.userDTO)
+
+				assert.Nil(t, errorUser)
+
+				_, errorAuthTotpVerifyNotEnrolled := AuthTotpVerify(&user.Id, dto.AuthTotpDTO{Code: "000000"})
+
+				assert.Equal(t, errorAuthTotpNotPending, errorAuthTotpVerifyNotEnrolled)
+
+				authTotpEnrol, errorAuthTotpEnrol := AuthTotpEnrol(&user.Id)
+
+				assert.Nil(t, errorAuthTotpEnrol)
+				assert.Contains(t, authTotpEnrol.Uri, authTotpEnrol.Secret)
+
+				_, errorAuthTotpVerifyWrong := AuthTotpVerify(&user.Id, dto.AuthTotpDTO{Code: "wrong"})
+
+				assert.Equal(t, errorAuthTotpWrong, errorAuthTotpVerifyWrong)
+
+				now := time.Now().UTC()
+				authTotpRecoveryCodes, errorAuthTotpVerify := AuthTotpVerify(&user.Id, dto.AuthTotpDTO{Code: codeAt(authTotpEnrol.Secret, now.Add(-30*time.Second))})
+
+				assert.Nil(t, errorAuthTotpVerify)
+				assert.Len(t, authTotpRecoveryCodes.RecoveryCodes, ApplicationServiceTotp.RecoveryCodeAmount)
+
+				_, errorAuthTotpEnrolAgain := AuthTotpEnrol(&user.Id)
+
+				assert.Equal(t, errorAuthTotpEnabled, errorAuthTotpEnrolAgain)
+
+				authConfirmation, userConfirmation, errorAuthCredentials := AuthCredentials(testCase.userDTO.UserCredentialsDTO, "")
+
+				assert.Nil(t, errorAuthCredentials)
+				assert.NotNil(t, authConfirmation)
+				assert.Nil(t, userConfirmation)
+
+				authConfirmationDTO := dto.AuthConfirmationDTO{UserCredentialsDTO: testCase.userDTO.UserCredentialsDTO}
+
+				authConfirmationDTO.Code = codeAt(authTotpEnrol.Secret, now)
+				_, errorAuthConfirmation := AuthConfirmation(authConfirmationDTO, "")
+
+				assert.Nil(t, errorAuthConfirmation)
+
+				_, errorAuthConfirmationReplay := AuthConfirmation(authConfirmationDTO, "")
+
+				assert.Equal(t, errorUserNotFound, errorAuthConfirmationReplay)
+
+				authConfirmationDTO.Code = authTotpRecoveryCodes.RecoveryCodes[0]
+				_, errorAuthConfirmationRecovery := AuthConfirmation(authConfirmationDTO, "")
+
+				assert.Nil(t, errorAuthConfirmationRecovery)
+
+				_, errorAuthConfirmationRecoveryUsed := AuthConfirmation(authConfirmationDTO, "")
+
+				assert.Equal(t, errorUserNotFound, errorAuthConfirmationRecoveryUsed)
+
+				_, errorAuthTotpDisableWrong := AuthTotpDisable(&user.Id, dto.AuthTotpDisableDTO{Password: "wrong", Code: authTotpRecoveryCodes.RecoveryCodes[1]})
+
+				assert.Equal(t, errorAuthPasswordWrong, errorAuthTotpDisableWrong)
+
+				_, errorAuthTotpDisableRecoveryUsed := AuthTotpDisable(
+					&user.Id,
+					dto.AuthTotpDisableDTO{Password: testCase.userDTO.Password, Code: authTotpRecoveryCodes.RecoveryCodes[0]},
+				)
+
+				assert.Equal(t, errorAuthTotpWrong, errorAuthTotpDisableRecoveryUsed)
+
+				authTotpDisableStatus, errorAuthTotpDisable := AuthTotpDisable(
+					&user.Id,
+					dto.AuthTotpDisableDTO{Password: testCase.userDTO.Password, Code: authTotpRecoveryCodes.RecoveryCodes[1]},
+				)
+
+				assert.True(t, authTotpDisableStatus)
+				assert.Nil(t, errorAuthTotpDisable)
+
+				_, userConfirmation, errorAuthCredentials = AuthCredentials(testCase.userDTO.UserCredentialsDTO, "")
+
+				assert.Nil(t, errorAuthCredentials)
+				assert.NotNil(t, userConfirmation)
+
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
@@ -4,17 +4,74 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	ApplicationServicePassword "github.com/sergeygardner/meal-planner-api/application/service/password"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/service"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
 	"time"
 )
 
+const (
+	userLimit    = 20
+	userLimitMax = 100
+)
+
 var (
 	errorUpdateRestored = errors.New("error has gotten while reflection datum is being restored")
+	errorUserStatus     = errors.New("user status must be one of register, need_confirmation or disabled")
+	errorUserEnable     = errors.New("user is not disabled")
+	errorUserRoleDelete = errors.New("role binding of the user cannot be deleted by provided data")
 )
 
+// UsersInfo returns a page of the users filtered by the query in the order of their usernames.
+func UsersInfo(query *aggregate.UserQuery) (*aggregate.Users, error) {
+	userRepository := InfrastructureService.GetFactoryRepository().GetUserRepository()
+
+	if query.Status != "" && query.Status.String() != string(query.Status) {
+		return nil, errorUserStatus
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = userLimit
+	} else if query.Limit > userLimitMax {
+		query.Limit = userLimitMax
+	}
+
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	criteria := userRepository.GetCriteriaByQuery(query.Query, query.Status)
+	total, errorTotal := userRepository.Count(criteria)
+
+	if errorTotal != nil {
+		return nil, errors.Wrapf(errorTotal, "an error occurred while counting users by privided data %v", query)
+	}
+
+	criteria.Limit = int(query.Limit)
+	criteria.Offset = int(query.Offset)
+	userEntities, errorUserEntities := userRepository.FindAll(criteria)
+
+	if errorUserEntities != nil {
+		return nil, errors.Wrapf(errorUserEntities, "an error occurred while getting users by privided data %v", query)
+	}
+
+	usersPage := &aggregate.Users{
+		Users:  make([]*entity.User, 0, len(userEntities)),
+		Total:  total,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+
+	for index := range userEntities {
+		usersPage.Users = append(usersPage.Users, &userEntities[index])
+	}
+
+	return usersPage, nil
+}
+
 func UserInfo(userId *uuid.UUID) (*entity.User, error) {
 	userRepository := InfrastructureService.GetFactoryRepository().GetUserRepository()
 
@@ -68,3 +125,146 @@ func UserDelete(userId *uuid.UUID) (bool, error) {
 
 	return userRepository.DeleteOne(userRepository.GetCriteriaByUserId(userId))
 }
+
+// UserAdminInfo returns the user with all of its confirmations and the roles bound to it.
+func UserAdminInfo(userId *uuid.UUID) (*aggregate.User, error) {
+	userConfirmationRepository := InfrastructureService.GetFactoryRepository().GetUserConfirmationRepository()
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while getting a user by provided data userId=%s", userId)
+	}
+
+	userConfirmations, errorUserConfirmations := userConfirmationRepository.FindAll(userConfirmationRepository.GetCriteriaByUserId(userId))
+
+	if errorUserConfirmations != nil {
+		return nil, errors.Wrapf(errorUserConfirmations, "an error occurred while getting confirmations of a user by provided data userId=%s", userId)
+	}
+
+	userToRoles, errorUserToRoles := userToRoleRepository.FindAll(userToRoleRepository.GetCriteria().GetCriteriaByUserId(userId, nil))
+
+	if errorUserToRoles != nil {
+		return nil, errors.Wrapf(errorUserToRoles, "an error occurred while getting roles of a user by provided data userId=%s", userId)
+	}
+
+	userAggregate := &aggregate.User{
+		Entity:        user,
+		Confirmations: []*entity.UserConfirmation{},
+		Roles:         []*entity.UserToRole{},
+	}
+
+	for index := range userConfirmations {
+		userAggregate.Confirmations = append(userAggregate.Confirmations, &userConfirmations[index])
+	}
+
+	if userToRoles != nil {
+		userAggregate.Roles = userToRoles
+	}
+
+	return userAggregate, nil
+}
+
+// UserDisable disables the user and logs it out, a disabled user cannot authenticate until it is enabled again.
+func UserDisable(userId *uuid.UUID) (*entity.User, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while disabling a user by provided data userId=%s", userId)
+	}
+
+	user.Status = kind.UserStatusDisabled
+	user.DateLogout = time.Now().UTC()
+
+	userSaved, errorUserSave := userSave(user)
+
+	if errorUserSave != nil {
+		return nil, errorUserSave
+	}
+
+	// the sessions are revoked after the user is saved, so a session made meanwhile is revoked as well
+	errorUserSessionsRevoke := userSessionsRevoke(userId)
+
+	if errorUserSessionsRevoke != nil {
+		return nil, errors.Wrapf(errorUserSessionsRevoke, "an error occurred while disabling a user by provided data userId=%s", userId)
+	}
+
+	return userSaved, nil
+}
+
+// UserEnable lets the disabled user authenticate again.
+func UserEnable(userId *uuid.UUID) (*entity.User, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while enabling a user by provided data userId=%s", userId)
+	}
+
+	if user.Status != kind.UserStatusDisabled {
+		return nil, errorUserEnable
+	}
+
+	user.Status = kind.UserStatusRegister
+
+	return userSave(user)
+}
+
+// UserLogout revokes all of the tokens which have been issued to the user so far.
+func UserLogout(userId *uuid.UUID) (*entity.User, error) {
+	user, errorUser := UserInfo(userId)
+
+	if errorUser != nil {
+		return nil, errors.Wrapf(errorUser, "an error occurred while logging out a user by provided data userId=%s", userId)
+	}
+
+	user.DateLogout = time.Now().UTC()
+
+	userSaved, errorUserSave := userSave(user)
+
+	if errorUserSave != nil {
+		return nil, errorUserSave
+	}
+
+	// the sessions are revoked after the user is saved, so a session made meanwhile is revoked as well
+	errorUserSessionsRevoke := userSessionsRevoke(userId)
+
+	if errorUserSessionsRevoke != nil {
+		return nil, errors.Wrapf(errorUserSessionsRevoke, "an error occurred while logging out a user by provided data userId=%s", userId)
+	}
+
+	return userSaved, nil
+}
+
+// UserRoleCreate binds the role of the binding to the user.
+func UserRoleCreate(userId *uuid.UUID, userToRoleDTO *entity.UserToRole) (*entity.UserToRole, error) {
+	userToRoleDTO.UserId = *userId
+
+	return RoleUserCreate(&userToRoleDTO.RoleId, userToRoleDTO)
+}
+
+func UserRoleDelete(id *uuid.UUID, userId *uuid.UUID) (bool, error) {
+	userToRoleRepository := InfrastructureService.GetFactoryRepository().GetUserToRoleRepository()
+
+	criteria := userToRoleRepository.GetCriteria().GetCriteriaById(id, nil)
+	criteria = userToRoleRepository.GetCriteria().GetCriteriaByUserId(userId, criteria)
+	_, errorUserToRole := userToRoleRepository.FindOne(criteria)
+
+	if errorUserToRole != nil {
+		return false, errorUserRoleDelete
+	}
+
+	return userToRoleRepository.DeleteOne(criteria)
+}
+
+func userSave(user *entity.User) (*entity.User, error) {
+	userRepository := InfrastructureService.GetFactoryRepository().GetUserRepository()
+	user.DateUpdate = time.Now().UTC()
+
+	updateOne, errorUpdateOne := userRepository.UpdateOne(userRepository.GetCriteriaByUserId(&user.Id), user)
+
+	if errorUpdateOne != nil {
+		return nil, errors.Wrapf(errorUpdateOne, "an error occurred while updating a user entity in the database %v", user)
+	}
+
+	return updateOne, nil
+}
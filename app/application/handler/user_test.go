@@ -1,9 +1,13 @@
 package handler
 
 import (
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/model"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -153,3 +157,90 @@ func TestUserDelete(t *testing.T) {
 		)
 	}
 }
+
+func TestUserAdmin(t *testing.T) {
+	tests := []struct {
+		name    string
+		userDTO dto.UserRegisterDTO
+	}{
+		{
+			name: "Test case with disabling, enabling and logging out a user",
+			userDTO: dto.UserRegisterDTO{
+				UserCredentialsDTO: dto.UserCredentialsDTO{
+					Username: "usernameAdmin" + uuid.NewString(),
+					Password: "passwordTest",
+				},
+				Name:       "NameTest",
+				Surname:    "SurnameTest",
+				MiddleName: "MiddleNameTest",
+				Birthday:   time.Now().UTC(),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				user, errorUser := AuthRegister(testCase.userDTO)
+
+				assert.Nil(t, errorUser)
+
+				users, errorUsers := UsersInfo(&aggregate.UserQuery{Query: testCase.userDTO.Username})
+
+				assert.Nil(t, errorUsers)
+				assert.Equal(t, int64(1), users.Total)
+				assert.Equal(t, user.Id, users.Users[0].Id)
+
+				_, errorUsersStatus := UsersInfo(&aggregate.UserQuery{Status: "unknown"})
+
+				assert.Equal(t, errorUserStatus, errorUsersStatus)
+
+				token := &model.Token{
+					UserId:           user.Id,
+					Type:             kind.TokenTypeAccess,
+					RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().UTC().Add(-time.Hour))},
+				}
+
+				assert.Nil(t, AuthTokenVerify(token, kind.TokenTypeAccess))
+
+				userDisabled, errorUserDisabled := UserDisable(&user.Id)
+
+				assert.Nil(t, errorUserDisabled)
+				assert.Equal(t, kind.UserStatusDisabled, userDisabled.Status)
+				assert.Equal(t, errorAuthTokenRevoked, AuthTokenVerify(token, kind.TokenTypeAccess))
+
+				_, _, errorAuthCredentials := AuthCredentials(testCase.userDTO.UserCredentialsDTO, "")
+
+				assert.Equal(t, errorUserDisabled, errorAuthCredentials)
+
+				userEnabled, errorUserEnabled := UserEnable(&user.Id)
+
+				assert.Nil(t, errorUserEnabled)
+				assert.Equal(t, kind.UserStatusRegister, userEnabled.Status)
+				assert.Equal(t, errorAuthTokenRevoked, AuthTokenVerify(token, kind.TokenTypeAccess))
+
+				_, errorUserEnabledAgain := UserEnable(&user.Id)
+
+				assert.Equal(t, errorUserEnable, errorUserEnabledAgain)
+
+				token.IssuedAt = jwt.NewNumericDate(time.Now().UTC().Add(time.Second))
+
+				assert.Nil(t, AuthTokenVerify(token, kind.TokenTypeAccess))
+
+				_, errorUserLogout := UserLogout(&user.Id)
+
+				assert.Nil(t, errorUserLogout)
+
+				userAdmin, errorUserAdmin := UserAdminInfo(&user.Id)
+
+				assert.Nil(t, errorUserAdmin)
+				assert.Equal(t, user.Id, userAdmin.Entity.Id)
+				assert.Empty(t, userAdmin.Confirmations)
+				assert.Empty(t, userAdmin.Roles)
+
+				_, _ = UserDelete(&user.Id)
+			},
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"time"
+)
+
+// AttemptLockout returns the minutes of the lockout after the failed attempts, there is no lockout until the maximum of
+// the attempts is reached and every next failed attempt doubles the previous lockout up to the ceiling.
+func AttemptLockout(attempts int64, maximum int64, base int64, ceiling int64) int64 {
+	if attempts < maximum {
+		return 0
+	}
+
+	lockout := base
+
+	for i := maximum; i < attempts && lockout < ceiling; i++ {
+		lockout *= 2
+	}
+
+	if lockout > ceiling {
+		return ceiling
+	}
+
+	return lockout
+}
+
+// UserConfirmationUsable reports whether the confirmation is active, has not expired and has not run out of
+// the attempts, the confirmation without the expiration date is considered as expired.
+func UserConfirmationUsable(userConfirmation *entity.UserConfirmation, now time.Time) bool {
+	return userConfirmation.Active &&
+		userConfirmation.DateExpire.After(now) &&
+		userConfirmation.Attempts < entity.UserConfirmationAttemptsMax
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAttemptLockout(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int64
+		expected int64
+	}{
+		{
+			name:     "Test case with attempts less than the maximum",
+			attempts: 4,
+			expected: 0,
+		},
+		{
+			name:     "Test case with attempts equal to the maximum",
+			attempts: 5,
+			expected: 1,
+		},
+		{
+			name:     "Test case with attempts over the maximum",
+			attempts: 8,
+			expected: 8,
+		},
+		{
+			name:     "Test case with attempts far over the maximum",
+			attempts: 500,
+			expected: 60,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, AttemptLockout(testCase.attempts, 5, 1, 60))
+			},
+		)
+	}
+}
+
+func TestUserConfirmationUsable(t *testing.T) {
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		userConfirmation entity.UserConfirmation
+		expected         bool
+	}{
+		{
+			name:             "Test case with an active confirmation",
+			userConfirmation: entity.UserConfirmation{Active: true, DateExpire: now.Add(time.Minute)},
+			expected:         true,
+		},
+		{
+			name:             "Test case with an inactive confirmation",
+			userConfirmation: entity.UserConfirmation{Active: false, DateExpire: now.Add(time.Minute)},
+			expected:         false,
+		},
+		{
+			name:             "Test case with an expired confirmation",
+			userConfirmation: entity.UserConfirmation{Active: true, DateExpire: now},
+			expected:         false,
+		},
+		{
+			name:             "Test case with a confirmation without the expiration date",
+			userConfirmation: entity.UserConfirmation{Active: true},
+			expected:         false,
+		},
+		{
+			name:             "Test case with a confirmation which has run out of the attempts",
+			userConfirmation: entity.UserConfirmation{Active: true, DateExpire: now.Add(time.Minute), Attempts: entity.UserConfirmationAttemptsMax},
+			expected:         false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, UserConfirmationUsable(&testCase.userConfirmation, now))
+			},
+		)
+	}
+}
@@ -3,29 +3,34 @@ package builder
 import (
 	"context"
 	"github.com/google/uuid"
+	ApplicationServiceHelper "github.com/sergeygardner/meal-planner-api/application/service"
 	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
+	"sort"
 	"sync"
 )
 
 var (
-	factoryRepository              = InfrastructureService.GetFactoryRepository()
-	errorBuildingRecipe            error
-	errorBuildingRecipeCategories  error
-	errorBuildingRecipeIngredients error
-	errorBuildingRecipeProcesses   error
-	errorBuildingPictures          error
-	errorBuildingRecipeMeasures    error
-	errorBuildingAltNames          error
-	errorBuildingUnits             error
-	errorBuildingCategories        error
-	errorBuildingIngredients       error
-	errorBuildingPlanners          error
-	errorBuildingPlannerIntervals  error
-	errorBuildingPlannerRecipes    error
+	factoryRepository                     = InfrastructureService.GetFactoryRepository()
+	errorBuildingRecipe                   error
+	errorBuildingRecipeCategories         error
+	errorBuildingRecipeIngredients        error
+	errorBuildingRecipeProcesses          error
+	errorBuildingPictures                 error
+	errorBuildingRecipeMeasures           error
+	errorBuildingAltNames                 error
+	errorBuildingUnits                    error
+	errorBuildingCategories               error
+	errorBuildingIngredients              error
+	errorBuildingPlanners                 error
+	errorBuildingPlannerIntervals         error
+	errorBuildingPlannerRecipes           error
+	errorBuildingPlannerTemplates         error
+	errorBuildingPlannerTemplateIntervals error
+	errorBuildingIngredientSubstitutes    error
 )
 
 type recipeComposite struct {
@@ -129,6 +134,63 @@ type plannerRecipeComposite struct {
 	Criteria *persistence.Criteria
 }
 
+type pantryItemComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	Entities *[]*DomainAggregate.PantryItem
+	Criteria *persistence.Criteria
+	Error    *error
+}
+
+type shoppingListComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	Entities *[]*DomainAggregate.ShoppingList
+	Criteria *persistence.Criteria
+	Error    *error
+}
+
+type ingredientPriceComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	EntityId *uuid.UUID
+	Entities *[]*DomainAggregate.IngredientPrice
+	Criteria *persistence.Criteria
+	Error    *error
+}
+
+type ingredientSubstituteComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	EntityId *uuid.UUID
+	Entities *[]*DomainAggregate.IngredientSubstitute
+	Criteria *persistence.Criteria
+}
+
+type plannerTemplateComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	Entities *[]*DomainAggregate.PlannerTemplate
+	Criteria *persistence.Criteria
+}
+
+type plannerTemplateIntervalComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	EntityId *uuid.UUID
+	Entities *[]*DomainEntity.PlannerTemplateInterval
+	Criteria *persistence.Criteria
+}
+
+type shoppingListItemComposite struct {
+	Id       *uuid.UUID
+	UserId   *uuid.UUID
+	EntityId *uuid.UUID
+	Entities *[]*DomainAggregate.ShoppingListItem
+	Criteria *persistence.Criteria
+	Error    *error
+}
+
 func BuildRecipesAggregate(
 	id *uuid.UUID,
 	userId *uuid.UUID,
@@ -226,11 +288,11 @@ func buildRecipeAggregate(
 
 					parentWaitGroup.Add(5)
 
-					channelRecipeCategory <- &recipeCategoryComposite{Entities: &recipeAggregate.Categories, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id}
-					channelRecipeIngredient <- &recipeIngredientComposite{Entities: &recipeAggregate.Ingredients, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id}
-					channelRecipeProcess <- &recipeProcessComposite{Entities: &recipeAggregate.Processes, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id}
-					channelPicture <- &pictureComposite{Entities: &recipeAggregate.Pictures, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id}
-					channelAltName <- &altNameComposite{Entities: &recipeAggregate.AltNames, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id}
+					channelRecipeCategory <- &recipeCategoryComposite{Entities: &recipeAggregate.Categories, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id, Criteria: childCriteria(recipeCompositeItem.Criteria)}
+					channelRecipeIngredient <- &recipeIngredientComposite{Entities: &recipeAggregate.Ingredients, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id, Criteria: childCriteria(recipeCompositeItem.Criteria)}
+					channelRecipeProcess <- &recipeProcessComposite{Entities: &recipeAggregate.Processes, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id, Criteria: childCriteria(recipeCompositeItem.Criteria)}
+					channelPicture <- &pictureComposite{Entities: &recipeAggregate.Pictures, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id, Criteria: childCriteria(recipeCompositeItem.Criteria)}
+					channelAltName <- &altNameComposite{Entities: &recipeAggregate.AltNames, UserId: &recipeEntity.UserId, EntityId: &recipeEntity.Id, Criteria: childCriteria(recipeCompositeItem.Criteria)}
 				}
 			}
 
@@ -313,7 +375,7 @@ func buildRecipeCategoriesAggregate(
 
 					parentWaitGroup.Add(1)
 
-					channelCategory <- &categoryComposite{Entity: &recipeCategoryAggregate.Derive, Id: &recipeCategoryEntity.DeriveId, UserId: &recipeCategoryEntity.UserId}
+					channelCategory <- &categoryComposite{Entity: &recipeCategoryAggregate.Derive, Id: &recipeCategoryEntity.DeriveId, UserId: &recipeCategoryEntity.UserId, Criteria: childCriteria(recipeCategoryCompositeItem.Criteria)}
 				}
 			}
 
@@ -405,10 +467,10 @@ func buildRecipeIngredientsAggregate(
 
 					parentWaitGroup.Add(4)
 
-					channelRecipeMeasure <- &recipeMeasureComposite{Entities: &recipeIngredientAggregate.Measures, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id}
-					channelIngredient <- &ingredientComposite{Entity: &recipeIngredientAggregate.Derive, Id: &recipeIngredientEntity.DeriveId, UserId: &recipeIngredientEntity.UserId}
-					channelPicture <- &pictureComposite{Entities: &recipeIngredientAggregate.Pictures, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id}
-					channelAltName <- &altNameComposite{Entities: &recipeIngredientAggregate.AltNames, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id}
+					channelRecipeMeasure <- &recipeMeasureComposite{Entities: &recipeIngredientAggregate.Measures, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id, Criteria: childCriteria(recipeIngredientCompositeItem.Criteria)}
+					channelIngredient <- &ingredientComposite{Entity: &recipeIngredientAggregate.Derive, Id: &recipeIngredientEntity.DeriveId, UserId: &recipeIngredientEntity.UserId, Criteria: childCriteria(recipeIngredientCompositeItem.Criteria)}
+					channelPicture <- &pictureComposite{Entities: &recipeIngredientAggregate.Pictures, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id, Criteria: childCriteria(recipeIngredientCompositeItem.Criteria)}
+					channelAltName <- &altNameComposite{Entities: &recipeIngredientAggregate.AltNames, UserId: &recipeIngredientEntity.UserId, EntityId: &recipeIngredientEntity.Id, Criteria: childCriteria(recipeIngredientCompositeItem.Criteria)}
 				}
 			}
 
@@ -489,8 +551,8 @@ func buildRecipeProcessesAggregate(
 
 					parentWaitGroup.Add(2)
 
-					channelPicture <- &pictureComposite{Entities: &recipeProcessAggregate.Pictures, UserId: &recipeProcessEntity.UserId, EntityId: &recipeProcessEntity.Id}
-					channelAltName <- &altNameComposite{Entities: &recipeProcessAggregate.AltNames, UserId: &recipeProcessEntity.UserId, EntityId: &recipeProcessEntity.Id}
+					channelPicture <- &pictureComposite{Entities: &recipeProcessAggregate.Pictures, UserId: &recipeProcessEntity.UserId, EntityId: &recipeProcessEntity.Id, Criteria: childCriteria(recipeProcessCompositeItem.Criteria)}
+					channelAltName <- &altNameComposite{Entities: &recipeProcessAggregate.AltNames, UserId: &recipeProcessEntity.UserId, EntityId: &recipeProcessEntity.Id, Criteria: childCriteria(recipeProcessCompositeItem.Criteria)}
 				}
 			}
 
@@ -567,7 +629,7 @@ func buildPicturesAggregate(
 
 					parentWaitGroup.Add(1)
 
-					channelAltName <- &altNameComposite{Entities: &pictureAggregate.AltNames, UserId: &pictureEntity.UserId, EntityId: &pictureEntity.Id}
+					channelAltName <- &altNameComposite{Entities: &pictureAggregate.AltNames, UserId: &pictureEntity.UserId, EntityId: &pictureEntity.Id, Criteria: childCriteria(pictureCompositeItem.Criteria)}
 				}
 			}
 
@@ -648,8 +710,8 @@ func buildRecipeMeasuresAggregate(
 
 					parentWaitGroup.Add(2)
 
-					channelUnit <- &unitComposite{Entity: &recipeMeasureAggregate.Unit, Id: &recipeMeasureEntity.UnitId}
-					channelAltName <- &altNameComposite{Entities: &recipeMeasureAggregate.AltNames, UserId: &recipeMeasureEntity.UserId, EntityId: &recipeMeasureEntity.Id}
+					channelUnit <- &unitComposite{Entity: &recipeMeasureAggregate.Unit, Id: &recipeMeasureEntity.UnitId, Criteria: childCriteria(recipeMeasureCompositeItem.Criteria)}
+					channelAltName <- &altNameComposite{Entities: &recipeMeasureAggregate.AltNames, UserId: &recipeMeasureEntity.UserId, EntityId: &recipeMeasureEntity.Id, Criteria: childCriteria(recipeMeasureCompositeItem.Criteria)}
 				}
 			}
 
@@ -803,8 +865,8 @@ func buildCategoryAggregate(
 
 					parentWaitGroup.Add(2)
 
-					channelPicture <- &pictureComposite{Entities: &recipeCategoryAggregate.Pictures, UserId: &categoryEntity.UserId, EntityId: &categoryEntity.Id}
-					channelAltName <- &altNameComposite{Entities: &recipeCategoryAggregate.AltNames, UserId: &categoryEntity.UserId, EntityId: &categoryEntity.Id}
+					channelPicture <- &pictureComposite{Entities: &recipeCategoryAggregate.Pictures, UserId: &categoryEntity.UserId, EntityId: &categoryEntity.Id, Criteria: childCriteria(categoryCompositeItem.Criteria)}
+					channelAltName <- &altNameComposite{Entities: &recipeCategoryAggregate.AltNames, UserId: &categoryEntity.UserId, EntityId: &categoryEntity.Id, Criteria: childCriteria(categoryCompositeItem.Criteria)}
 				}
 			}
 
@@ -1021,7 +1083,7 @@ func buildPlannersAggregate(
 
 					parentWaitGroup.Add(1)
 
-					channelPlannerInterval <- &plannerIntervalComposite{Entities: &plannerAggregate.Intervals, UserId: &plannerEntity.UserId, EntityId: &plannerEntity.Id}
+					channelPlannerInterval <- &plannerIntervalComposite{Entities: &plannerAggregate.Intervals, UserId: &plannerEntity.UserId, EntityId: &plannerEntity.Id, Criteria: childCriteria(plannerCompositeItem.Criteria)}
 				}
 			}
 
@@ -1098,7 +1160,7 @@ func buildPlannerIntervals(
 
 						parentWaitGroup.Add(1)
 
-						channelPlannerRecipe <- &plannerRecipeComposite{Entities: &plannerIntervalAggregate.Recipes, UserId: &plannerIntervalEntity.UserId, EntityId: &plannerIntervalEntity.Id}
+						channelPlannerRecipe <- &plannerRecipeComposite{Entities: &plannerIntervalAggregate.Recipes, UserId: &plannerIntervalEntity.UserId, EntityId: &plannerIntervalEntity.Id, Criteria: childCriteria(plannerIntervalCompositeItem.Criteria)}
 					}
 				}
 			}
@@ -1164,7 +1226,14 @@ func buildPlannerRecipes(
 				errorBuildingRecipe = errorPlannerRecipeEntities
 			} else {
 				for _, plannerRecipeEntity := range plannerRecipeEntities {
-					recipesAggregate, errorRecipesAggregate := BuildRecipesAggregate(&plannerRecipeEntity.RecipeId, &plannerRecipeEntity.UserId, nil)
+					if recipeVersion := buildPlannerRecipeVersion(plannerRecipeEntity); recipeVersion != nil {
+						plannerRecipeAggregate := &DomainAggregate.PlannerRecipe{Entity: plannerRecipeEntity, Recipe: recipeVersion}
+						*plannerRecipeCompositeItem.Entities = append(*plannerRecipeCompositeItem.Entities, plannerRecipeAggregate)
+
+						continue
+					}
+
+					recipesAggregate, errorRecipesAggregate := BuildRecipesAggregate(&plannerRecipeEntity.RecipeId, &plannerRecipeEntity.UserId, childCriteria(plannerRecipeCompositeItem.Criteria))
 					if errorRecipesAggregate == nil && len(recipesAggregate) == 1 {
 						plannerRecipeAggregate := &DomainAggregate.PlannerRecipe{Entity: plannerRecipeEntity, Recipe: recipesAggregate[0]}
 						*plannerRecipeCompositeItem.Entities = append(*plannerRecipeCompositeItem.Entities, plannerRecipeAggregate)
@@ -1179,6 +1248,555 @@ func buildPlannerRecipes(
 	}
 }
 
+// buildPlannerRecipeVersion returns the recipe of the version pinned by the planner recipe, nil is returned when
+// no version is pinned or the version cannot be restored.
+func buildPlannerRecipeVersion(plannerRecipeEntity *DomainEntity.PlannerRecipe) *DomainAggregate.Recipe {
+	if plannerRecipeEntity.RecipeVersionId == uuid.Nil {
+		return nil
+	}
+
+	recipeVersionRepository := factoryRepository.GetRecipeVersionRepository()
+	criteria := recipeVersionRepository.GetCriteria().GetCriteriaById(&plannerRecipeEntity.RecipeVersionId, nil)
+	criteria = recipeVersionRepository.GetCriteria().GetCriteriaByEntityId(&plannerRecipeEntity.RecipeId, criteria)
+	recipeVersion, errorRecipeVersion := recipeVersionRepository.FindOne(criteria)
+
+	if errorRecipeVersion != nil || recipeVersion == nil {
+		return nil
+	}
+
+	recipe, errorRecipe := ApplicationServiceHelper.RecipeFromSnapshot(recipeVersion.Snapshot)
+
+	if errorRecipe != nil {
+		return nil
+	}
+
+	return recipe
+}
+
+func BuildPantryItemsAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.PantryItem, error) {
+	var (
+		pantryItemsAggregate     []*DomainAggregate.PantryItem
+		errorBuildingPantryItems error
+	)
+	channelPantryItem := make(chan *pantryItemComposite)
+	channelIngredient := make(chan *ingredientComposite)
+	channelUnit := make(chan *unitComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext, aggregationCancel := context.WithCancel(context.TODO())
+
+	waitGroup.Add(1)
+
+	defer aggregationCancel()
+
+	go buildPantryItemsAggregate(aggregationContext, waitGroup, channelPantryItem, channelIngredient, channelUnit)
+	go buildIngredientEntities(aggregationContext, waitGroup, channelIngredient)
+	go buildUnitEntities(aggregationContext, waitGroup, channelUnit)
+
+	channelPantryItem <- &pantryItemComposite{Entities: &pantryItemsAggregate, Id: id, UserId: userId, Criteria: criteria, Error: &errorBuildingPantryItems}
+
+	waitGroup.Wait()
+
+	close(channelPantryItem)
+	close(channelIngredient)
+	close(channelUnit)
+
+	return pantryItemsAggregate, errorBuildingPantryItems
+}
+
+func buildPantryItemsAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelPantryItem chan *pantryItemComposite,
+	channelIngredient chan *ingredientComposite,
+	channelUnit chan *unitComposite,
+) {
+	var (
+		pantryItemEntities      []*DomainEntity.PantryItem
+		errorPantryItemEntities error
+	)
+	pantryItemRepository := factoryRepository.GetPantryItemRepository()
+	pantryItemRepositoryCriteria := pantryItemRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case pantryItemCompositeItem := <-channelPantryItem:
+			if pantryItemCompositeItem == nil {
+				continue
+			}
+			pantryItemEntities, errorPantryItemEntities = pantryItemRepository.FindAll(
+				composeCriteria(
+					pantryItemCompositeItem.Id,
+					pantryItemCompositeItem.UserId,
+					nil,
+					pantryItemCompositeItem.Criteria,
+					pantryItemRepositoryCriteria,
+				),
+			)
+
+			if errorPantryItemEntities != nil || len(pantryItemEntities) == 0 {
+				*pantryItemCompositeItem.Error = errorPantryItemEntities
+			} else {
+				for _, pantryItemEntity := range pantryItemEntities {
+					pantryItemAggregate := &DomainAggregate.PantryItem{Entity: pantryItemEntity}
+					*pantryItemCompositeItem.Entities = append(*pantryItemCompositeItem.Entities, pantryItemAggregate)
+
+					parentWaitGroup.Add(2)
+
+					channelIngredient <- &ingredientComposite{Entity: &pantryItemAggregate.Ingredient, Id: &pantryItemEntity.IngredientId, UserId: &pantryItemEntity.UserId, Criteria: childCriteria(pantryItemCompositeItem.Criteria)}
+					channelUnit <- &unitComposite{Entity: &pantryItemAggregate.Unit, Id: &pantryItemEntity.UnitId, Criteria: childCriteria(pantryItemCompositeItem.Criteria)}
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+func BuildShoppingListsAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.ShoppingList, error) {
+	var (
+		shoppingListsAggregate     []*DomainAggregate.ShoppingList
+		errorBuildingShoppingLists error
+	)
+	channelShoppingList := make(chan *shoppingListComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext, aggregationCancel := context.WithCancel(context.TODO())
+
+	waitGroup.Add(1)
+
+	defer aggregationCancel()
+
+	go buildShoppingListsAggregate(aggregationContext, waitGroup, channelShoppingList)
+
+	channelShoppingList <- &shoppingListComposite{Entities: &shoppingListsAggregate, Id: id, UserId: userId, Criteria: criteria, Error: &errorBuildingShoppingLists}
+
+	waitGroup.Wait()
+
+	close(channelShoppingList)
+
+	return shoppingListsAggregate, errorBuildingShoppingLists
+}
+
+func buildShoppingListsAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelShoppingList chan *shoppingListComposite,
+) {
+	var (
+		shoppingListEntities      []*DomainEntity.ShoppingList
+		errorShoppingListEntities error
+	)
+	shoppingListRepository := factoryRepository.GetShoppingListRepository()
+	shoppingListRepositoryCriteria := shoppingListRepository.GetCriteria()
+	categoryRepository := factoryRepository.GetCategoryRepository()
+	categoryRepositoryCriteria := categoryRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case shoppingListCompositeItem := <-channelShoppingList:
+			if shoppingListCompositeItem == nil {
+				continue
+			}
+			shoppingListEntities, errorShoppingListEntities = shoppingListRepository.FindAll(
+				composeCriteria(
+					shoppingListCompositeItem.Id,
+					shoppingListCompositeItem.UserId,
+					nil,
+					shoppingListCompositeItem.Criteria,
+					shoppingListRepositoryCriteria,
+				),
+			)
+
+			if errorShoppingListEntities != nil || len(shoppingListEntities) == 0 {
+				*shoppingListCompositeItem.Error = errorShoppingListEntities
+			} else {
+				for _, shoppingListEntity := range shoppingListEntities {
+					var categoryIds []*uuid.UUID
+
+					categories := map[uuid.UUID]*DomainEntity.Category{}
+					shoppingListItemsAggregate, _ := BuildShoppingListItemsAggregate(
+						nil,
+						&shoppingListEntity.UserId,
+						&shoppingListEntity.Id,
+						&persistence.Criteria{Uncached: true},
+					)
+
+					for _, shoppingListItemAggregate := range shoppingListItemsAggregate {
+						categoryId := shoppingListItemAggregate.Entity.CategoryId
+
+						if categoryId != uuid.Nil {
+							categoryIds = append(categoryIds, &categoryId)
+						}
+					}
+
+					if len(categoryIds) > 0 {
+						categoryEntities, errorCategoryEntities := categoryRepository.FindAll(
+							categoryRepositoryCriteria.GetCriteriaByIds(
+								categoryIds,
+								categoryRepositoryCriteria.GetCriteriaByUserId(&shoppingListEntity.UserId, nil),
+							),
+						)
+
+						if errorCategoryEntities == nil {
+							for _, categoryEntity := range categoryEntities {
+								categories[categoryEntity.Id] = categoryEntity
+							}
+						}
+					}
+
+					*shoppingListCompositeItem.Entities = append(
+						*shoppingListCompositeItem.Entities,
+						&DomainAggregate.ShoppingList{
+							Entity: shoppingListEntity,
+							Groups: ApplicationServiceHelper.ShoppingListGroup(shoppingListItemsAggregate, categories),
+						},
+					)
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+func BuildShoppingListItemsAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	entityId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.ShoppingListItem, error) {
+	var (
+		shoppingListItemsAggregate     []*DomainAggregate.ShoppingListItem
+		errorBuildingShoppingListItems error
+	)
+	channelShoppingListItem := make(chan *shoppingListItemComposite)
+	channelIngredient := make(chan *ingredientComposite)
+	channelUnit := make(chan *unitComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext, aggregationCancel := context.WithCancel(context.TODO())
+
+	waitGroup.Add(1)
+
+	defer aggregationCancel()
+
+	go buildShoppingListItemsAggregate(aggregationContext, waitGroup, channelShoppingListItem, channelIngredient, channelUnit)
+	go buildIngredientEntities(aggregationContext, waitGroup, channelIngredient)
+	go buildUnitEntities(aggregationContext, waitGroup, channelUnit)
+
+	channelShoppingListItem <- &shoppingListItemComposite{Entities: &shoppingListItemsAggregate, Id: id, UserId: userId, EntityId: entityId, Criteria: criteria, Error: &errorBuildingShoppingListItems}
+
+	waitGroup.Wait()
+
+	close(channelShoppingListItem)
+	close(channelIngredient)
+	close(channelUnit)
+
+	return shoppingListItemsAggregate, errorBuildingShoppingListItems
+}
+
+func buildShoppingListItemsAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelShoppingListItem chan *shoppingListItemComposite,
+	channelIngredient chan *ingredientComposite,
+	channelUnit chan *unitComposite,
+) {
+	var (
+		shoppingListItemEntities      []*DomainEntity.ShoppingListItem
+		errorShoppingListItemEntities error
+	)
+	shoppingListItemRepository := factoryRepository.GetShoppingListItemRepository()
+	shoppingListItemRepositoryCriteria := shoppingListItemRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case shoppingListItemCompositeItem := <-channelShoppingListItem:
+			if shoppingListItemCompositeItem == nil {
+				continue
+			}
+			shoppingListItemEntities, errorShoppingListItemEntities = shoppingListItemRepository.FindAll(
+				composeCriteria(
+					shoppingListItemCompositeItem.Id,
+					shoppingListItemCompositeItem.UserId,
+					shoppingListItemCompositeItem.EntityId,
+					shoppingListItemCompositeItem.Criteria,
+					shoppingListItemRepositoryCriteria,
+				),
+			)
+
+			if errorShoppingListItemEntities != nil || len(shoppingListItemEntities) == 0 {
+				*shoppingListItemCompositeItem.Error = errorShoppingListItemEntities
+			} else {
+				for _, shoppingListItemEntity := range shoppingListItemEntities {
+					shoppingListItemAggregate := &DomainAggregate.ShoppingListItem{Entity: shoppingListItemEntity}
+					*shoppingListItemCompositeItem.Entities = append(*shoppingListItemCompositeItem.Entities, shoppingListItemAggregate)
+
+					if shoppingListItemEntity.IngredientId != uuid.Nil {
+						parentWaitGroup.Add(1)
+
+						channelIngredient <- &ingredientComposite{Entity: &shoppingListItemAggregate.Ingredient, Id: &shoppingListItemEntity.IngredientId, UserId: &shoppingListItemEntity.UserId, Criteria: childCriteria(shoppingListItemCompositeItem.Criteria)}
+					}
+
+					if shoppingListItemEntity.UnitId != uuid.Nil {
+						parentWaitGroup.Add(1)
+
+						channelUnit <- &unitComposite{Entity: &shoppingListItemAggregate.Unit, Id: &shoppingListItemEntity.UnitId, Criteria: childCriteria(shoppingListItemCompositeItem.Criteria)}
+					}
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+func BuildIngredientPricesAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	entityId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.IngredientPrice, error) {
+	var (
+		ingredientPricesAggregate     []*DomainAggregate.IngredientPrice
+		errorBuildingIngredientPrices error
+	)
+	channelIngredientPrice := make(chan *ingredientPriceComposite)
+	channelUnit := make(chan *unitComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext, aggregationCancel := context.WithCancel(context.TODO())
+
+	waitGroup.Add(1)
+
+	defer aggregationCancel()
+
+	go buildIngredientPricesAggregate(aggregationContext, waitGroup, channelIngredientPrice, channelUnit)
+	go buildUnitEntities(aggregationContext, waitGroup, channelUnit)
+
+	channelIngredientPrice <- &ingredientPriceComposite{Entities: &ingredientPricesAggregate, Id: id, UserId: userId, EntityId: entityId, Criteria: criteria, Error: &errorBuildingIngredientPrices}
+
+	waitGroup.Wait()
+
+	close(channelIngredientPrice)
+	close(channelUnit)
+
+	return ingredientPricesAggregate, errorBuildingIngredientPrices
+}
+
+func buildIngredientPricesAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelIngredientPrice chan *ingredientPriceComposite,
+	channelUnit chan *unitComposite,
+) {
+	var (
+		ingredientPriceEntities      []*DomainEntity.IngredientPrice
+		errorIngredientPriceEntities error
+	)
+	ingredientPriceRepository := factoryRepository.GetIngredientPriceRepository()
+	ingredientPriceRepositoryCriteria := ingredientPriceRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case ingredientPriceCompositeItem := <-channelIngredientPrice:
+			if ingredientPriceCompositeItem == nil {
+				continue
+			}
+			ingredientPriceEntities, errorIngredientPriceEntities = ingredientPriceRepository.FindAll(
+				composeCriteria(
+					ingredientPriceCompositeItem.Id,
+					ingredientPriceCompositeItem.UserId,
+					ingredientPriceCompositeItem.EntityId,
+					ingredientPriceCompositeItem.Criteria,
+					ingredientPriceRepositoryCriteria,
+				),
+			)
+
+			if errorIngredientPriceEntities != nil || len(ingredientPriceEntities) == 0 {
+				*ingredientPriceCompositeItem.Error = errorIngredientPriceEntities
+			} else {
+				for _, ingredientPriceEntity := range ingredientPriceEntities {
+					ingredientPriceAggregate := &DomainAggregate.IngredientPrice{Entity: ingredientPriceEntity}
+					*ingredientPriceCompositeItem.Entities = append(*ingredientPriceCompositeItem.Entities, ingredientPriceAggregate)
+
+					parentWaitGroup.Add(1)
+
+					channelUnit <- &unitComposite{Entity: &ingredientPriceAggregate.Unit, Id: &ingredientPriceEntity.UnitId, Criteria: childCriteria(ingredientPriceCompositeItem.Criteria)}
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+func BuildPlannerTemplatesAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.PlannerTemplate, error) {
+	var plannerTemplatesAggregate []*DomainAggregate.PlannerTemplate
+	channelPlannerTemplate := make(chan *plannerTemplateComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext := context.TODO()
+
+	waitGroup.Add(1)
+
+	go buildPlannerTemplatesAggregate(aggregationContext, waitGroup, channelPlannerTemplate)
+
+	channelPlannerTemplate <- &plannerTemplateComposite{Entities: &plannerTemplatesAggregate, Id: id, UserId: userId, Criteria: criteria}
+
+	waitGroup.Wait()
+
+	close(channelPlannerTemplate)
+
+	return plannerTemplatesAggregate, errorBuildingPlannerTemplates
+}
+
+func buildPlannerTemplatesAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelPlannerTemplate chan *plannerTemplateComposite,
+) {
+	var (
+		plannerTemplateEntities      []*DomainEntity.PlannerTemplate
+		errorPlannerTemplateEntities error
+	)
+	plannerTemplateRepository := factoryRepository.GetPlannerTemplateRepository()
+	plannerTemplateRepositoryCriteria := plannerTemplateRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case plannerTemplateCompositeItem := <-channelPlannerTemplate:
+			if plannerTemplateCompositeItem == nil {
+				continue
+			}
+			plannerTemplateEntities, errorPlannerTemplateEntities = plannerTemplateRepository.FindAll(
+				composeCriteria(
+					plannerTemplateCompositeItem.Id,
+					plannerTemplateCompositeItem.UserId,
+					nil,
+					plannerTemplateCompositeItem.Criteria,
+					plannerTemplateRepositoryCriteria,
+				),
+			)
+
+			if errorPlannerTemplateEntities != nil || len(plannerTemplateEntities) == 0 {
+				errorBuildingPlannerTemplates = errorPlannerTemplateEntities
+			} else {
+				for _, plannerTemplateEntity := range plannerTemplateEntities {
+					plannerTemplateIntervalEntities, _ := BuildPlannerTemplateIntervalEntities(nil, &plannerTemplateEntity.UserId, &plannerTemplateEntity.Id, nil)
+
+					*plannerTemplateCompositeItem.Entities = append(
+						*plannerTemplateCompositeItem.Entities,
+						&DomainAggregate.PlannerTemplate{Entity: plannerTemplateEntity, Intervals: plannerTemplateIntervalEntities},
+					)
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+func BuildPlannerTemplateIntervalEntities(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	entityId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainEntity.PlannerTemplateInterval, error) {
+	var plannerTemplateIntervalEntities []*DomainEntity.PlannerTemplateInterval
+	channelPlannerTemplateInterval := make(chan *plannerTemplateIntervalComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext := context.TODO()
+
+	waitGroup.Add(1)
+
+	go buildPlannerTemplateIntervalEntities(aggregationContext, waitGroup, channelPlannerTemplateInterval)
+
+	channelPlannerTemplateInterval <- &plannerTemplateIntervalComposite{Entities: &plannerTemplateIntervalEntities, Id: id, UserId: userId, EntityId: entityId, Criteria: criteria}
+
+	waitGroup.Wait()
+
+	close(channelPlannerTemplateInterval)
+
+	sort.SliceStable(
+		plannerTemplateIntervalEntities,
+		func(i, j int) bool {
+			return plannerTemplateIntervalEntities[i].StartOffset < plannerTemplateIntervalEntities[j].StartOffset
+		},
+	)
+
+	return plannerTemplateIntervalEntities, errorBuildingPlannerTemplateIntervals
+}
+
+func buildPlannerTemplateIntervalEntities(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelPlannerTemplateInterval chan *plannerTemplateIntervalComposite,
+) {
+	var (
+		plannerTemplateIntervalEntities      []*DomainEntity.PlannerTemplateInterval
+		errorPlannerTemplateIntervalEntities error
+	)
+	plannerTemplateIntervalRepository := factoryRepository.GetPlannerTemplateIntervalRepository()
+	plannerTemplateIntervalRepositoryCriteria := plannerTemplateIntervalRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case plannerTemplateIntervalCompositeItem := <-channelPlannerTemplateInterval:
+			if plannerTemplateIntervalCompositeItem == nil {
+				continue
+			}
+			plannerTemplateIntervalEntities, errorPlannerTemplateIntervalEntities = plannerTemplateIntervalRepository.FindAll(
+				composeCriteria(
+					plannerTemplateIntervalCompositeItem.Id,
+					plannerTemplateIntervalCompositeItem.UserId,
+					plannerTemplateIntervalCompositeItem.EntityId,
+					plannerTemplateIntervalCompositeItem.Criteria,
+					plannerTemplateIntervalRepositoryCriteria,
+				),
+			)
+
+			if errorPlannerTemplateIntervalEntities != nil || len(plannerTemplateIntervalEntities) == 0 {
+				errorBuildingPlannerTemplateIntervals = errorPlannerTemplateIntervalEntities
+			} else {
+				*plannerTemplateIntervalCompositeItem.Entities = append(*plannerTemplateIntervalCompositeItem.Entities, plannerTemplateIntervalEntities...)
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
+
+// childCriteria passes the bypassing of the cache to the children of the entity, the other criteria are of the entity
+// only.
+func childCriteria(criteria *persistence.Criteria) *persistence.Criteria {
+	if criteria != nil && criteria.Uncached {
+		return &persistence.Criteria{Uncached: true}
+	}
+
+	return nil
+}
+
 func composeCriteria(
 	id *uuid.UUID,
 	userId *uuid.UUID,
@@ -1204,3 +1822,79 @@ func composeCriteria(
 
 	return criteria
 }
+
+func BuildIngredientSubstitutesAggregate(
+	id *uuid.UUID,
+	userId *uuid.UUID,
+	entityId *uuid.UUID,
+	criteria *persistence.Criteria,
+) ([]*DomainAggregate.IngredientSubstitute, error) {
+	var ingredientSubstitutesAggregate []*DomainAggregate.IngredientSubstitute
+	channelIngredientSubstitute := make(chan *ingredientSubstituteComposite)
+	channelIngredient := make(chan *ingredientComposite)
+	waitGroup := &sync.WaitGroup{}
+	aggregationContext := context.TODO()
+
+	waitGroup.Add(1)
+
+	go buildIngredientSubstitutesAggregate(aggregationContext, waitGroup, channelIngredientSubstitute, channelIngredient)
+	go buildIngredientEntities(aggregationContext, waitGroup, channelIngredient)
+
+	channelIngredientSubstitute <- &ingredientSubstituteComposite{Entities: &ingredientSubstitutesAggregate, Id: id, UserId: userId, EntityId: entityId, Criteria: criteria}
+
+	waitGroup.Wait()
+
+	close(channelIngredientSubstitute)
+	close(channelIngredient)
+
+	return ingredientSubstitutesAggregate, errorBuildingIngredientSubstitutes
+}
+
+func buildIngredientSubstitutesAggregate(
+	aggregationContext context.Context,
+	parentWaitGroup *sync.WaitGroup,
+	channelIngredientSubstitute chan *ingredientSubstituteComposite,
+	channelIngredient chan *ingredientComposite,
+) {
+	var (
+		ingredientSubstituteEntities      []*DomainEntity.IngredientSubstitute
+		errorIngredientSubstituteEntities error
+	)
+	ingredientSubstituteRepository := factoryRepository.GetIngredientSubstituteRepository()
+	ingredientSubstituteRepositoryCriteria := ingredientSubstituteRepository.GetCriteria()
+
+	for {
+		select {
+		case <-aggregationContext.Done():
+			return
+		case ingredientSubstituteCompositeItem := <-channelIngredientSubstitute:
+			if ingredientSubstituteCompositeItem == nil {
+				continue
+			}
+			ingredientSubstituteEntities, errorIngredientSubstituteEntities = ingredientSubstituteRepository.FindAll(
+				composeCriteria(
+					ingredientSubstituteCompositeItem.Id,
+					ingredientSubstituteCompositeItem.UserId,
+					ingredientSubstituteCompositeItem.EntityId,
+					ingredientSubstituteCompositeItem.Criteria,
+					ingredientSubstituteRepositoryCriteria,
+				),
+			)
+
+			if errorIngredientSubstituteEntities != nil || len(ingredientSubstituteEntities) == 0 {
+				errorBuildingIngredientSubstitutes = errorIngredientSubstituteEntities
+			} else {
+				for _, ingredientSubstituteEntity := range ingredientSubstituteEntities {
+					ingredientSubstituteAggregate := &DomainAggregate.IngredientSubstitute{Entity: ingredientSubstituteEntity}
+					*ingredientSubstituteCompositeItem.Entities = append(*ingredientSubstituteCompositeItem.Entities, ingredientSubstituteAggregate)
+
+					parentWaitGroup.Add(1)
+
+					channelIngredient <- &ingredientComposite{Entity: &ingredientSubstituteAggregate.Substitute, Id: &ingredientSubstituteEntity.SubstituteId, UserId: &ingredientSubstituteEntity.UserId, Criteria: childCriteria(ingredientSubstituteCompositeItem.Criteria)}
+				}
+			}
+
+			parentWaitGroup.Done()
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"strings"
+	"time"
+)
+
+const (
+	calendarProductId   = "-//meal-planner-api//Planner//EN"
+	calendarTimeLayout  = "20060102T150405Z"
+	calendarLineLength  = 75
+	calendarLineBreak   = "\r\n"
+	calendarRefreshTime = "PT1H"
+	calendarTokenSize   = 32
+)
+
+// PlannerCalendar returns the planner as an RFC 5545 calendar with an event for every active interval. The
+// description of an event lists the scheduled recipes with links which are made from the link of the API.
+func PlannerCalendar(planner *aggregate.Planner, link string, now time.Time) string {
+	var builder strings.Builder
+
+	link = strings.TrimRight(link, "/")
+	plannerLink := fmt.Sprintf("%s/planners/%s", link, planner.Entity.Id)
+
+	calendarLine(&builder, "BEGIN", "VCALENDAR")
+	calendarLine(&builder, "VERSION", "2.0")
+	calendarLine(&builder, "PRODID", calendarProductId)
+	calendarLine(&builder, "CALSCALE", "GREGORIAN")
+	calendarLine(&builder, "METHOD", "PUBLISH")
+	calendarLine(&builder, "X-WR-CALNAME", CalendarEscape(planner.Entity.Name))
+	calendarLine(&builder, "REFRESH-INTERVAL;VALUE=DURATION", calendarRefreshTime)
+	calendarLine(&builder, "X-PUBLISHED-TTL", calendarRefreshTime)
+
+	for _, plannerInterval := range planner.Intervals {
+		if plannerInterval == nil || plannerInterval.Entity == nil || plannerInterval.Entity.Status != kind.PlannerIntervalStatusActive {
+			continue
+		}
+
+		var (
+			recipeNames  []string
+			descriptions []string
+		)
+
+		for _, plannerRecipe := range plannerInterval.Recipes {
+			if plannerRecipe == nil || plannerRecipe.Entity == nil || plannerRecipe.Entity.Status == kind.PlannerRecipeStatusInActive {
+				continue
+			}
+
+			recipeName := plannerRecipe.Entity.RecipeId.String()
+
+			if plannerRecipe.Recipe != nil && plannerRecipe.Recipe.Entity != nil {
+				recipeName = plannerRecipe.Recipe.Entity.Name
+			}
+
+			recipeNames = append(recipeNames, recipeName)
+			descriptions = append(descriptions, fmt.Sprintf("%s: %s/recipes/%s", recipeName, link, plannerRecipe.Entity.RecipeId))
+		}
+
+		summary := plannerInterval.Entity.Name
+
+		if len(recipeNames) > 0 {
+			summary = fmt.Sprintf("%s: %s", summary, strings.Join(recipeNames, ", "))
+		}
+
+		descriptions = append(descriptions, plannerLink)
+
+		calendarLine(&builder, "BEGIN", "VEVENT")
+		calendarLine(&builder, "UID", fmt.Sprintf("%s@meal-planner-api", plannerInterval.Entity.Id))
+		calendarLine(&builder, "DTSTAMP", now.UTC().Format(calendarTimeLayout))
+		calendarLine(&builder, "LAST-MODIFIED", plannerInterval.Entity.DateUpdate.UTC().Format(calendarTimeLayout))
+		calendarLine(&builder, "DTSTART", plannerInterval.Entity.StartTime.UTC().Format(calendarTimeLayout))
+		calendarLine(&builder, "DTEND", plannerInterval.Entity.EndTime.UTC().Format(calendarTimeLayout))
+		calendarLine(&builder, "SUMMARY", CalendarEscape(summary))
+		calendarLine(&builder, "DESCRIPTION", CalendarEscape(strings.Join(descriptions, "\n")))
+		calendarLine(&builder, "URL", plannerLink)
+		calendarLine(&builder, "END", "VEVENT")
+	}
+
+	calendarLine(&builder, "END", "VCALENDAR")
+
+	return builder.String()
+}
+
+// CalendarEscape escapes a text value of a calendar property by RFC 5545 section 3.3.11.
+func CalendarEscape(value string) string {
+	return strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n",
+	).Replace(value)
+}
+
+// CalendarFold folds a content line into lines of at most 75 octets, a folded line starts with a space. The
+// line is split between characters only, so multibyte characters are kept whole.
+func CalendarFold(line string) string {
+	var builder strings.Builder
+
+	length := 0
+
+	for _, character := range line {
+		size := len(string(character))
+
+		if length+size > calendarLineLength {
+			builder.WriteString(calendarLineBreak)
+			builder.WriteString(" ")
+			length = 1
+		}
+
+		builder.WriteRune(character)
+		length += size
+	}
+
+	return builder.String()
+}
+
+// CalendarToken returns a random token of a calendar subscription.
+func CalendarToken() (string, error) {
+	token := make([]byte, calendarTokenSize)
+
+	if _, errorRead := rand.Read(token); errorRead != nil {
+		return "", errorRead
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func calendarLine(builder *strings.Builder, name string, value string) {
+	builder.WriteString(CalendarFold(name + ":" + value))
+	builder.WriteString(calendarLineBreak)
+}
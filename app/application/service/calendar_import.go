@@ -0,0 +1,515 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	calendarDateLayout          = "20060102"
+	calendarLocalTimeLayout     = "20060102T150405"
+	calendarRecurrenceLimit     = 10000
+	calendarScannerBufferLength = 1024 * 1024
+)
+
+var (
+	errorCalendarInvalid     = errors.New("the calendar is not an RFC 5545 calendar")
+	errorCalendarDuration    = errors.New("the duration of the calendar event is invalid")
+	calendarDurationPattern  = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+	calendarWeekdays         = map[string]time.Weekday{"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday}
+	calendarFrequencyPeriods = map[string][3]int{"DAILY": {0, 0, 1}, "WEEKLY": {0, 0, 7}, "MONTHLY": {0, 1, 0}, "YEARLY": {1, 0, 0}}
+)
+
+type calendarProperty struct {
+	name       string
+	parameters map[string]string
+	value      string
+}
+
+type calendarEvent struct {
+	summary        string
+	startTime      time.Time
+	endTime        time.Time
+	date           bool
+	duration       *time.Duration
+	rule           map[string]string
+	exceptionTimes []time.Time
+	cancelled      bool
+}
+
+// PlannerCalendarEvents parses an RFC 5545 calendar and returns the occurrences of its events which start within
+// the window. Recurring events are expanded by their RRULE and EXDATE properties, times without a zone are UTC.
+func PlannerCalendarEvents(data io.Reader, startTime time.Time, endTime time.Time) ([]*aggregate.PlannerCalendarEvent, error) {
+	var occurrences []*aggregate.PlannerCalendarEvent
+
+	events, errorEvents := calendarEvents(data)
+
+	if errorEvents != nil {
+		return nil, errorEvents
+	}
+
+	for _, event := range events {
+		if event.cancelled || event.startTime.IsZero() {
+			continue
+		}
+
+		length := calendarEventLength(event)
+
+		for _, occurrenceTime := range calendarEventTimes(event, endTime) {
+			if occurrenceTime.Before(startTime) || occurrenceTime.After(endTime) {
+				continue
+			}
+
+			occurrences = append(
+				occurrences,
+				&aggregate.PlannerCalendarEvent{
+					Summary:   event.summary,
+					StartTime: occurrenceTime.UTC(),
+					EndTime:   occurrenceTime.Add(length).UTC(),
+				},
+			)
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if occurrences[i].StartTime.Equal(occurrences[j].StartTime) {
+			return occurrences[i].Summary < occurrences[j].Summary
+		}
+
+		return occurrences[i].StartTime.Before(occurrences[j].StartTime)
+	})
+
+	return occurrences, nil
+}
+
+// PlannerCalendarEventRecipes splits the summary of an event into a name of an interval and the recipes which are
+// matched by their names or alternative names. A summary is matched as a whole first, then a summary such as
+// "Dinner: Soup, Bread" is split by the colon and the commas. The parts which are not matched are returned too.
+func PlannerCalendarEventRecipes(summary string, recipes []*aggregate.Recipe) (string, []*aggregate.Recipe, []string) {
+	var (
+		matched   []*aggregate.Recipe
+		unmatched []string
+	)
+
+	recipeNames := make(map[string]*aggregate.Recipe)
+
+	for _, recipe := range recipes {
+		if recipe == nil || recipe.Entity == nil {
+			continue
+		}
+
+		for _, altName := range recipe.AltNames {
+			if altName != nil {
+				recipeNames[calendarRecipeName(altName.Name)] = recipe
+			}
+		}
+	}
+
+	for _, recipe := range recipes {
+		if recipe != nil && recipe.Entity != nil {
+			recipeNames[calendarRecipeName(recipe.Entity.Name)] = recipe
+		}
+	}
+
+	summary = strings.TrimSpace(summary)
+
+	if recipe, ok := recipeNames[calendarRecipeName(summary)]; ok {
+		return summary, []*aggregate.Recipe{recipe}, nil
+	}
+
+	separator := strings.Index(summary, ":")
+
+	if separator < 0 {
+		return summary, nil, nil
+	}
+
+	name := strings.TrimSpace(summary[:separator])
+	recipesPart := strings.TrimSpace(summary[separator+1:])
+
+	if name == "" {
+		name = summary
+	}
+
+	if recipe, ok := recipeNames[calendarRecipeName(recipesPart)]; ok {
+		return name, []*aggregate.Recipe{recipe}, nil
+	}
+
+	seen := make(map[*aggregate.Recipe]bool)
+
+	for _, part := range strings.Split(recipesPart, ",") {
+		part = strings.TrimSpace(part)
+
+		if part == "" {
+			continue
+		}
+
+		if recipe, ok := recipeNames[calendarRecipeName(part)]; ok {
+			if !seen[recipe] {
+				seen[recipe] = true
+				matched = append(matched, recipe)
+			}
+		} else {
+			unmatched = append(unmatched, part)
+		}
+	}
+
+	return name, matched, unmatched
+}
+
+func calendarRecipeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func calendarEvents(data io.Reader) ([]*calendarEvent, error) {
+	var (
+		events   []*calendarEvent
+		event    *calendarEvent
+		calendar bool
+		depth    int
+	)
+
+	lines, errorLines := calendarLines(data)
+
+	if errorLines != nil {
+		return nil, errorLines
+	}
+
+	for _, line := range lines {
+		property := calendarParseProperty(line)
+
+		switch {
+		case property.name == "BEGIN" && strings.EqualFold(property.value, "VCALENDAR"):
+			calendar = true
+		case property.name == "BEGIN" && strings.EqualFold(property.value, "VEVENT") && event == nil:
+			event = &calendarEvent{}
+		case property.name == "BEGIN" && event != nil:
+			depth++
+		case property.name == "END" && event != nil && depth > 0:
+			depth--
+		case property.name == "END" && strings.EqualFold(property.value, "VEVENT") && event != nil:
+			events = append(events, event)
+			event = nil
+		case event != nil && depth == 0:
+			errorProperty := calendarEventProperty(event, property)
+
+			if errorProperty != nil {
+				return nil, errorProperty
+			}
+		}
+	}
+
+	if !calendar {
+		return nil, errorCalendarInvalid
+	}
+
+	return events, nil
+}
+
+func calendarLines(data io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 0, 64*1024), calendarScannerBufferLength)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+		} else if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+func calendarParseProperty(line string) *calendarProperty {
+	property := &calendarProperty{parameters: map[string]string{}}
+	quoted := false
+	separator := -1
+
+	for index, character := range line {
+		if character == '"' {
+			quoted = !quoted
+		} else if character == ':' && !quoted {
+			separator = index
+
+			break
+		}
+	}
+
+	if separator < 0 {
+		property.name = strings.ToUpper(line)
+
+		return property
+	}
+
+	property.value = line[separator+1:]
+	parts := strings.Split(line[:separator], ";")
+	property.name = strings.ToUpper(parts[0])
+
+	for _, parameter := range parts[1:] {
+		if key, value, ok := strings.Cut(parameter, "="); ok {
+			property.parameters[strings.ToUpper(key)] = strings.Trim(value, "\"")
+		}
+	}
+
+	return property
+}
+
+func calendarEventProperty(event *calendarEvent, property *calendarProperty) error {
+	var errorProperty error
+
+	switch property.name {
+	case "SUMMARY":
+		event.summary = calendarUnescape(property.value)
+	case "STATUS":
+		event.cancelled = strings.EqualFold(property.value, "CANCELLED")
+	case "DTSTART":
+		event.startTime, event.date, errorProperty = calendarParseTime(property.value, property.parameters)
+	case "DTEND":
+		event.endTime, _, errorProperty = calendarParseTime(property.value, property.parameters)
+	case "DURATION":
+		var duration time.Duration
+
+		duration, errorProperty = calendarParseDuration(property.value)
+		event.duration = &duration
+	case "RRULE":
+		event.rule = map[string]string{}
+
+		for _, part := range strings.Split(property.value, ";") {
+			if key, value, ok := strings.Cut(part, "="); ok {
+				event.rule[strings.ToUpper(key)] = strings.ToUpper(value)
+			}
+		}
+	case "EXDATE":
+		for _, value := range strings.Split(property.value, ",") {
+			exceptionTime, _, errorExceptionTime := calendarParseTime(value, property.parameters)
+
+			if errorExceptionTime != nil {
+				return errorExceptionTime
+			}
+
+			event.exceptionTimes = append(event.exceptionTimes, exceptionTime)
+		}
+	}
+
+	return errorProperty
+}
+
+func calendarParseTime(value string, parameters map[string]string) (time.Time, bool, error) {
+	location := time.UTC
+
+	if zone, ok := parameters["TZID"]; ok {
+		if loadedLocation, errorLocation := time.LoadLocation(zone); errorLocation == nil {
+			location = loadedLocation
+		}
+	}
+
+	if parameters["VALUE"] == "DATE" || len(value) == len(calendarDateLayout) {
+		parsedDate, errorParsedDate := time.ParseInLocation(calendarDateLayout, value, location)
+
+		return parsedDate, true, errorParsedDate
+	} else if strings.HasSuffix(value, "Z") {
+		parsedTime, errorParsedTime := time.Parse(calendarTimeLayout, value)
+
+		return parsedTime, false, errorParsedTime
+	}
+
+	parsedTime, errorParsedTime := time.ParseInLocation(calendarLocalTimeLayout, value, location)
+
+	return parsedTime, false, errorParsedTime
+}
+
+func calendarParseDuration(value string) (time.Duration, error) {
+	matches := calendarDurationPattern.FindStringSubmatch(strings.ToUpper(value))
+
+	if matches == nil || value == "P" {
+		return 0, errorCalendarDuration
+	}
+
+	var duration time.Duration
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	for index, unit := range units {
+		if matches[index+2] == "" {
+			continue
+		}
+
+		amount, errorAmount := strconv.ParseInt(matches[index+2], 10, 64)
+
+		if errorAmount != nil {
+			return 0, errorCalendarDuration
+		}
+
+		duration += time.Duration(amount) * unit
+	}
+
+	if matches[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
+
+func calendarUnescape(value string) string {
+	return strings.NewReplacer(
+		"\\\\", "\\",
+		"\\;", ";",
+		"\\,", ",",
+		"\\n", "\n",
+		"\\N", "\n",
+	).Replace(value)
+}
+
+func calendarEventLength(event *calendarEvent) time.Duration {
+	if !event.endTime.IsZero() && event.endTime.After(event.startTime) {
+		return event.endTime.Sub(event.startTime)
+	} else if event.duration != nil && *event.duration > 0 {
+		return *event.duration
+	} else if event.date {
+		return 24*time.Hour - time.Second
+	}
+
+	return 0
+}
+
+// calendarEventTimes returns the start times of the occurrences of the event till the end time. The count of a rule
+// takes the occurrences before the window into account, the exception times are removed after that.
+func calendarEventTimes(event *calendarEvent, endTime time.Time) []time.Time {
+	var occurrenceTimes []time.Time
+
+	if event.rule == nil {
+		return calendarEventTimesExcept(event, []time.Time{event.startTime})
+	}
+
+	period, ok := calendarFrequencyPeriods[event.rule["FREQ"]]
+
+	if !ok {
+		return calendarEventTimesExcept(event, []time.Time{event.startTime})
+	}
+
+	interval, _ := strconv.Atoi(event.rule["INTERVAL"])
+	interval = int(MathMaxInt(1, int64(interval)))
+	count, _ := strconv.Atoi(event.rule["COUNT"])
+	untilTime := endTime
+
+	if until, okUntil := event.rule["UNTIL"]; okUntil {
+		parsedUntil, _, errorParsedUntil := calendarParseTime(until, map[string]string{})
+
+		if errorParsedUntil == nil {
+			if len(until) == len(calendarDateLayout) {
+				parsedUntil = parsedUntil.AddDate(0, 0, 1).Add(-time.Second)
+			}
+
+			if parsedUntil.Before(untilTime) {
+				untilTime = parsedUntil
+			}
+		}
+	}
+
+	weekdays := calendarRuleWeekdays(event.rule["BYDAY"])
+
+	for step := 0; step < calendarRecurrenceLimit; step++ {
+		var candidates []time.Time
+
+		periodTime := event.startTime.AddDate(period[0]*interval*step, period[1]*interval*step, period[2]*interval*step)
+
+		switch {
+		case event.rule["FREQ"] == "WEEKLY" && len(weekdays) > 0:
+			periodTime = periodTime.AddDate(0, 0, -calendarWeekdayOffset(periodTime.Weekday()))
+
+			for _, weekday := range weekdays {
+				candidates = append(candidates, periodTime.AddDate(0, 0, calendarWeekdayOffset(weekday)))
+			}
+		case event.rule["FREQ"] == "DAILY" && len(weekdays) > 0:
+			for _, weekday := range weekdays {
+				if periodTime.Weekday() == weekday {
+					candidates = append(candidates, periodTime)
+				}
+			}
+		case period[2] == 0 && periodTime.Day() != event.startTime.Day():
+			// a month without the day of the start is skipped
+		default:
+			candidates = append(candidates, periodTime)
+		}
+
+		if periodTime.After(untilTime) {
+			break
+		}
+
+		for _, candidate := range candidates {
+			if candidate.Before(event.startTime) || candidate.After(untilTime) {
+				continue
+			}
+
+			occurrenceTimes = append(occurrenceTimes, candidate)
+
+			if count > 0 && len(occurrenceTimes) >= count {
+				return calendarEventTimesExcept(event, occurrenceTimes)
+			}
+		}
+	}
+
+	return calendarEventTimesExcept(event, occurrenceTimes)
+}
+
+func calendarEventTimesExcept(event *calendarEvent, occurrenceTimes []time.Time) []time.Time {
+	var keptTimes []time.Time
+
+	for _, occurrenceTime := range occurrenceTimes {
+		excepted := false
+
+		for _, exceptionTime := range event.exceptionTimes {
+			if exceptionTime.Equal(occurrenceTime) {
+				excepted = true
+
+				break
+			}
+		}
+
+		if !excepted {
+			keptTimes = append(keptTimes, occurrenceTime)
+		}
+	}
+
+	return keptTimes
+}
+
+func calendarRuleWeekdays(value string) []time.Weekday {
+	var weekdays []time.Weekday
+
+	for _, day := range strings.Split(value, ",") {
+		day = strings.TrimLeft(strings.TrimSpace(day), "+-0123456789")
+
+		if weekday, ok := calendarWeekdays[day]; ok {
+			weekdays = append(weekdays, weekday)
+		}
+	}
+
+	sort.Slice(weekdays, func(i, j int) bool {
+		return calendarWeekdayOffset(weekdays[i]) < calendarWeekdayOffset(weekdays[j])
+	})
+
+	return weekdays
+}
+
+// calendarWeekdayOffset returns the days from Monday which a week starts on by default.
+func calendarWeekdayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+// PlannerCalendarIntervalName returns the name of an interval which is made unique by the start time.
+func PlannerCalendarIntervalName(name string, startTime time.Time) string {
+	return fmt.Sprintf("%s %s", name, startTime.Format("2006-01-02 15:04"))
+}
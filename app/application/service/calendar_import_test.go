@@ -0,0 +1,175 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlannerCalendarEvents(t *testing.T) {
+	startTime := time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2000, time.January, 16, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		calendar []string
+		expected []*aggregate.PlannerCalendarEvent
+	}{
+		{
+			name: "Test case with a single event and a folded summary",
+			calendar: []string{
+				"BEGIN:VEVENT",
+				"SUMMARY:Breakfast: Porridge\\, sweet",
+				" ened",
+				"DTSTART:20000110T070000Z",
+				"DTEND:20000110T080000Z",
+				"END:VEVENT",
+			},
+			expected: []*aggregate.PlannerCalendarEvent{
+				{Summary: "Breakfast: Porridge, sweetened", StartTime: time.Date(2000, time.January, 10, 7, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 10, 8, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "Test case with a daily rule, a count, an exception and an alarm",
+			calendar: []string{
+				"BEGIN:VEVENT",
+				"SUMMARY:Dinner",
+				"DTSTART:20000108T180000Z",
+				"DURATION:PT1H30M",
+				"RRULE:FREQ=DAILY;INTERVAL=2;COUNT=4",
+				"EXDATE:20000112T180000Z",
+				"BEGIN:VALARM",
+				"SUMMARY:Alarm",
+				"END:VALARM",
+				"END:VEVENT",
+			},
+			expected: []*aggregate.PlannerCalendarEvent{
+				{Summary: "Dinner", StartTime: time.Date(2000, time.January, 10, 18, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 10, 19, 30, 0, 0, time.UTC)},
+				{Summary: "Dinner", StartTime: time.Date(2000, time.January, 14, 18, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 14, 19, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "Test case with a weekly rule by days in a time zone till a date",
+			calendar: []string{
+				"BEGIN:VEVENT",
+				"SUMMARY:Lunch",
+				"DTSTART;TZID=Europe/Berlin:19991227T120000",
+				"DTEND;TZID=Europe/Berlin:19991227T130000",
+				"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,SU;UNTIL=20000112",
+				"END:VEVENT",
+			},
+			expected: []*aggregate.PlannerCalendarEvent{
+				{Summary: "Lunch", StartTime: time.Date(2000, time.January, 10, 11, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 10, 12, 0, 0, 0, time.UTC)},
+				{Summary: "Lunch", StartTime: time.Date(2000, time.January, 12, 11, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 12, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "Test case with an all day event, a monthly rule and a cancelled event",
+			calendar: []string{
+				"BEGIN:VEVENT",
+				"SUMMARY:Batch cooking",
+				"DTSTART;VALUE=DATE:19991116",
+				"RRULE:FREQ=MONTHLY",
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"SUMMARY:Cancelled",
+				"STATUS:CANCELLED",
+				"DTSTART:20000111T070000Z",
+				"END:VEVENT",
+			},
+			expected: []*aggregate.PlannerCalendarEvent{
+				{Summary: "Batch cooking", StartTime: time.Date(2000, time.January, 16, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2000, time.January, 16, 23, 59, 59, 0, time.UTC)},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				lines := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, testCase.calendar...)
+				lines = append(lines, "END:VCALENDAR")
+
+				events, errorEvents := PlannerCalendarEvents(strings.NewReader(strings.Join(lines, "\r\n")), startTime, endTime)
+
+				assert.Nil(t, errorEvents)
+				assert.Equal(t, testCase.expected, events)
+			},
+		)
+	}
+
+	_, errorEvents := PlannerCalendarEvents(strings.NewReader("not a calendar"), startTime, endTime)
+
+	assert.Equal(t, errorCalendarInvalid, errorEvents)
+}
+
+func TestPlannerCalendarEventsRoundTrip(t *testing.T) {
+	planner := &aggregate.Planner{
+		Entity: &entity.Planner{Id: uuid.New(), Name: "Week"},
+		Intervals: []*aggregate.PlannerInterval{
+			{
+				Entity: &entity.PlannerInterval{
+					Id:        uuid.New(),
+					StartTime: time.Date(2000, time.January, 10, 7, 0, 0, 0, time.UTC),
+					EndTime:   time.Date(2000, time.January, 10, 8, 0, 0, 0, time.UTC),
+					Name:      "Breakfast, early",
+					Status:    "active",
+				},
+			},
+		},
+	}
+	calendar := PlannerCalendar(planner, "https://example.com/api/v1", time.Now())
+	events, errorEvents := PlannerCalendarEvents(strings.NewReader(calendar), time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, errorEvents)
+	assert.Equal(t, []*aggregate.PlannerCalendarEvent{{Summary: "Breakfast, early", StartTime: planner.Intervals[0].Entity.StartTime, EndTime: planner.Intervals[0].Entity.EndTime}}, events)
+}
+
+func TestPlannerCalendarEventRecipes(t *testing.T) {
+	porridge := &aggregate.Recipe{Entity: &entity.Recipe{Name: "Porridge"}, AltNames: []*entity.AltName{{Name: "Oatmeal"}}}
+	soup := &aggregate.Recipe{Entity: &entity.Recipe{Name: "Soup, tomato"}}
+	recipes := []*aggregate.Recipe{porridge, soup}
+
+	tests := []struct {
+		name              string
+		summary           string
+		expectedName      string
+		expectedRecipes   []*aggregate.Recipe
+		expectedUnmatched []string
+	}{
+		{name: "Test case with a summary matching a recipe", summary: " porridge ", expectedName: "porridge", expectedRecipes: []*aggregate.Recipe{porridge}},
+		{name: "Test case with a summary matching an alternative name", summary: "Breakfast: OATMEAL", expectedName: "Breakfast", expectedRecipes: []*aggregate.Recipe{porridge}},
+		{name: "Test case with a recipe name containing a comma", summary: "Dinner: Soup, tomato", expectedName: "Dinner", expectedRecipes: []*aggregate.Recipe{soup}},
+		{name: "Test case with several recipes", summary: "Dinner: Oatmeal, Bread", expectedName: "Dinner", expectedRecipes: []*aggregate.Recipe{porridge}, expectedUnmatched: []string{"Bread"}},
+		{name: "Test case with a recipe named twice", summary: "Dinner: Oatmeal, Porridge", expectedName: "Dinner", expectedRecipes: []*aggregate.Recipe{porridge}},
+		{name: "Test case without recipes", summary: "Lunch", expectedName: "Lunch"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				name, matched, unmatched := PlannerCalendarEventRecipes(testCase.summary, recipes)
+
+				assert.Equal(t, testCase.expectedName, name)
+				assert.Equal(t, testCase.expectedRecipes, matched)
+				assert.Equal(t, testCase.expectedUnmatched, unmatched)
+			},
+		)
+	}
+}
+
+func TestCalendarParseDuration(t *testing.T) {
+	duration, errorDuration := calendarParseDuration("P1W2DT3H4M5S")
+
+	assert.Nil(t, errorDuration)
+	assert.Equal(t, 9*24*time.Hour+3*time.Hour+4*time.Minute+5*time.Second, duration)
+
+	_, errorDuration = calendarParseDuration("1H")
+
+	assert.Equal(t, errorCalendarDuration, errorDuration)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlannerCalendar(t *testing.T) {
+	now := time.Date(2000, time.January, 5, 12, 0, 0, 0, time.UTC)
+	recipeId := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	planner := &aggregate.Planner{
+		Entity: &entity.Planner{
+			Id:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Name:   "Week, first",
+			Status: kind.PlannerStatusActive,
+		},
+		Intervals: []*aggregate.PlannerInterval{
+			{
+				Entity: &entity.PlannerInterval{
+					Id:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					DateUpdate: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					StartTime:  time.Date(2000, time.January, 10, 7, 0, 0, 0, time.UTC),
+					EndTime:    time.Date(2000, time.January, 10, 8, 0, 0, 0, time.UTC),
+					Name:       "Breakfast",
+					Status:     kind.PlannerIntervalStatusActive,
+				},
+				Recipes: []*aggregate.PlannerRecipe{
+					{
+						Entity: &entity.PlannerRecipe{RecipeId: recipeId, Status: kind.PlannerRecipeStatusActive},
+						Recipe: &aggregate.Recipe{Entity: &entity.Recipe{Name: "Porridge"}},
+					},
+					{
+						Entity: &entity.PlannerRecipe{RecipeId: uuid.New(), Status: kind.PlannerRecipeStatusInActive},
+						Recipe: &aggregate.Recipe{Entity: &entity.Recipe{Name: "Skipped"}},
+					},
+				},
+			},
+			{
+				Entity: &entity.PlannerInterval{
+					Id:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Name:   "Inactive",
+					Status: kind.PlannerIntervalStatusInActive,
+				},
+			},
+		},
+	}
+
+	expected := strings.Join(
+		[]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//meal-planner-api//Planner//EN",
+			"CALSCALE:GREGORIAN",
+			"METHOD:PUBLISH",
+			"X-WR-CALNAME:Week\\, first",
+			"REFRESH-INTERVAL;VALUE=DURATION:PT1H",
+			"X-PUBLISHED-TTL:PT1H",
+			"BEGIN:VEVENT",
+			"UID:00000000-0000-0000-0000-000000000002@meal-planner-api",
+			"DTSTAMP:20000105T120000Z",
+			"LAST-MODIFIED:20000101T000000Z",
+			"DTSTART:20000110T070000Z",
+			"DTEND:20000110T080000Z",
+			"SUMMARY:Breakfast: Porridge",
+			"DESCRIPTION:Porridge: https://example.com/api/v1/recipes/00000000-0000-0000",
+			" -0000-000000000004\\nhttps://example.com/api/v1/planners/00000000-0000-0000",
+			" -0000-000000000001",
+			"URL:https://example.com/api/v1/planners/00000000-0000-0000-0000-00000000000",
+			" 1",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		},
+		"\r\n",
+	)
+
+	assert.Equal(t, expected, PlannerCalendar(planner, "https://example.com/api/v1/", now))
+}
+
+func TestCalendarEscape(t *testing.T) {
+	assert.Equal(t, "a\\;b\\,c\\\\d\\ne", CalendarEscape("a;b,c\\d\ne"))
+}
+
+func TestCalendarFold(t *testing.T) {
+	line := strings.Repeat("a", 74) + "éb"
+	folded := CalendarFold(line)
+
+	assert.Equal(t, strings.Repeat("a", 74)+"\r\n éb", folded)
+	assert.Equal(t, "short", CalendarFold("short"))
+
+	for _, foldedLine := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(foldedLine), 75)
+	}
+}
+
+func TestCalendarToken(t *testing.T) {
+	token, errorToken := CalendarToken()
+	otherToken, errorOtherToken := CalendarToken()
+
+	assert.Nil(t, errorToken)
+	assert.Nil(t, errorOtherToken)
+	assert.Len(t, token, 64)
+	assert.NotEqual(t, token, otherToken)
+}
@@ -0,0 +1,404 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"golang.org/x/exp/slices"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	catalogueNameLength = 255
+	catalogueLineLength = 1024 * 1024
+)
+
+var (
+	errorCatalogueEntity = errors.New("catalogue entity is not supported")
+	errorCatalogueFormat = errors.New("catalogue format is not supported")
+	errorCatalogueHeader = errors.New("catalogue has no name column")
+	errorCatalogueName   = errors.New("name is required and cannot be longer than 255 characters")
+	errorCatalogueStatus = errors.New("status has to be published or unpublished")
+	errorCatalogueNumber = errors.New("value has to be a non-negative integer")
+	catalogueColumns     = map[kind.CatalogueEntity][]string{
+		kind.CatalogueEntityUnits:       {"name", "status"},
+		kind.CatalogueEntityCategories:  {"name", "status"},
+		kind.CatalogueEntityIngredients: {"name", "category", "status"},
+		kind.CatalogueEntityRecipes:     {"name", "description", "notes", "servings", "calories", "status"},
+	}
+	catalogueNumberColumns = map[string]bool{"servings": true, "calories": true}
+)
+
+// CatalogueRecord keeps the values of a row of a catalogue by the names of the columns.
+type CatalogueRecord map[string]string
+
+// CatalogueRow is a record read from a catalogue with its line, a malformed row has the error instead of the record.
+type CatalogueRow struct {
+	Line   int64
+	Record CatalogueRecord
+	Error  error
+}
+
+type CatalogueReader struct {
+	format    kind.CatalogueFormat
+	csvReader *csv.Reader
+	scanner   *bufio.Scanner
+	header    []string
+	line      int64
+}
+
+type CatalogueWriter struct {
+	format    kind.CatalogueFormat
+	columns   []string
+	writer    io.Writer
+	csvWriter *csv.Writer
+}
+
+// CatalogueColumns returns the columns of the catalogue of the entity in the order they are exported.
+func CatalogueColumns(catalogueEntity kind.CatalogueEntity) ([]string, error) {
+	columns, ok := catalogueColumns[catalogueEntity]
+
+	if !ok {
+		return nil, errors.Wrapf(errorCatalogueEntity, "entity=%s", catalogueEntity)
+	}
+
+	return columns, nil
+}
+
+// NewCatalogueReader starts reading a catalogue in CSV with a header row or in NDJSON with an object per line.
+func NewCatalogueReader(data io.Reader, format kind.CatalogueFormat) (*CatalogueReader, error) {
+	catalogueReader := &CatalogueReader{format: format}
+
+	switch format {
+	case kind.CatalogueFormatCSV:
+		catalogueReader.csvReader = csv.NewReader(data)
+		catalogueReader.csvReader.FieldsPerRecord = -1
+		catalogueReader.csvReader.TrimLeadingSpace = true
+
+		header, errorHeader := catalogueReader.csvReader.Read()
+
+		if errorHeader != nil {
+			return nil, errors.Wrap(errorCatalogueHeader, errorHeader.Error())
+		}
+
+		for index, column := range header {
+			if index == 0 {
+				column = strings.TrimPrefix(column, "\ufeff")
+			}
+
+			catalogueReader.header = append(catalogueReader.header, strings.ToLower(strings.TrimSpace(column)))
+		}
+
+		if !slices.Contains(catalogueReader.header, "name") {
+			return nil, errorCatalogueHeader
+		}
+	case kind.CatalogueFormatNDJSON:
+		catalogueReader.scanner = bufio.NewScanner(data)
+		catalogueReader.scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), catalogueLineLength)
+	default:
+		return nil, errors.Wrapf(errorCatalogueFormat, "format=%s", format)
+	}
+
+	return catalogueReader, nil
+}
+
+// Read returns the next row of the catalogue. A malformed row is returned with its error, so the reading can go on,
+// the error is returned when the catalogue cannot be read anymore and io.EOF is returned at the end.
+func (cr *CatalogueReader) Read() (*CatalogueRow, error) {
+	if cr.format == kind.CatalogueFormatCSV {
+		values, errorValues := cr.csvReader.Read()
+
+		if errorValues == io.EOF {
+			return nil, io.EOF
+		}
+
+		var parseError *csv.ParseError
+
+		if errors.As(errorValues, &parseError) {
+			return &CatalogueRow{Line: int64(parseError.StartLine), Error: parseError.Err}, nil
+		} else if errorValues != nil {
+			return nil, errorValues
+		}
+
+		line, _ := cr.csvReader.FieldPos(0)
+		record := CatalogueRecord{}
+
+		for index, value := range values {
+			if index < len(cr.header) {
+				record[cr.header[index]] = strings.TrimSpace(value)
+			}
+		}
+
+		return &CatalogueRow{Line: int64(line), Record: record}, nil
+	}
+
+	for cr.scanner.Scan() {
+		cr.line++
+
+		if strings.TrimSpace(cr.scanner.Text()) == "" {
+			continue
+		}
+
+		var values map[string]any
+
+		if errorValues := json.Unmarshal(cr.scanner.Bytes(), &values); errorValues != nil {
+			return &CatalogueRow{Line: cr.line, Error: errorValues}, nil
+		}
+
+		record := CatalogueRecord{}
+
+		for column, value := range values {
+			record[strings.ToLower(strings.TrimSpace(column))] = strings.TrimSpace(catalogueValue(value))
+		}
+
+		return &CatalogueRow{Line: cr.line, Record: record}, nil
+	}
+
+	if errorScanner := cr.scanner.Err(); errorScanner != nil {
+		return nil, errorScanner
+	}
+
+	return nil, io.EOF
+}
+
+// NewCatalogueWriter starts writing a catalogue of the entity, a CSV catalogue starts with the header row.
+func NewCatalogueWriter(w io.Writer, catalogueEntity kind.CatalogueEntity, format kind.CatalogueFormat) (*CatalogueWriter, error) {
+	columns, errorColumns := CatalogueColumns(catalogueEntity)
+
+	if errorColumns != nil {
+		return nil, errorColumns
+	}
+
+	catalogueWriter := &CatalogueWriter{format: format, columns: columns, writer: w}
+
+	switch format {
+	case kind.CatalogueFormatCSV:
+		catalogueWriter.csvWriter = csv.NewWriter(w)
+
+		if errorHeader := catalogueWriter.csvWriter.Write(columns); errorHeader != nil {
+			return nil, errorHeader
+		}
+	case kind.CatalogueFormatNDJSON:
+	default:
+		return nil, errors.Wrapf(errorCatalogueFormat, "format=%s", format)
+	}
+
+	return catalogueWriter, nil
+}
+
+// Write writes the record as a row, the numbers are written as JSON numbers in NDJSON.
+func (cw *CatalogueWriter) Write(record CatalogueRecord) error {
+	if cw.format == kind.CatalogueFormatCSV {
+		values := make([]string, 0, len(cw.columns))
+
+		for _, column := range cw.columns {
+			values = append(values, record[column])
+		}
+
+		return cw.csvWriter.Write(values)
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("{")
+
+	for index, column := range cw.columns {
+		if index > 0 {
+			builder.WriteString(",")
+		}
+
+		name, _ := json.Marshal(column)
+		value, _ := json.Marshal(record[column])
+
+		if _, errorNumber := strconv.ParseInt(record[column], 10, 64); errorNumber == nil && catalogueNumberColumns[column] {
+			value = []byte(record[column])
+		}
+
+		builder.Write(name)
+		builder.WriteString(":")
+		builder.Write(value)
+	}
+
+	builder.WriteString("}\n")
+
+	_, errorWrite := io.WriteString(cw.writer, builder.String())
+
+	return errorWrite
+}
+
+// Flush writes the buffered rows.
+func (cw *CatalogueWriter) Flush() error {
+	if cw.csvWriter != nil {
+		cw.csvWriter.Flush()
+
+		return cw.csvWriter.Error()
+	}
+
+	return nil
+}
+
+func CatalogueRecordUnit(record CatalogueRecord) (*entity.Unit, error) {
+	name, errorName := catalogueName(record)
+
+	if errorName != nil {
+		return nil, errorName
+	}
+
+	status, errorStatus := catalogueStatus(record, string(kind.UnitStatusPublished), string(kind.UnitStatusUnPublished))
+
+	if errorStatus != nil {
+		return nil, errorStatus
+	}
+
+	return &entity.Unit{Name: name, Status: kind.UnitStatus(status)}, nil
+}
+
+func CatalogueRecordCategory(record CatalogueRecord) (*entity.Category, error) {
+	name, errorName := catalogueName(record)
+
+	if errorName != nil {
+		return nil, errorName
+	}
+
+	status, errorStatus := catalogueStatus(record, string(kind.CategoryStatusPublished), string(kind.CategoryStatusUnPublished))
+
+	if errorStatus != nil {
+		return nil, errorStatus
+	}
+
+	return &entity.Category{Name: name, Status: kind.CategoryStatus(status)}, nil
+}
+
+// CatalogueRecordIngredient returns the ingredient of the record and the name of its category.
+func CatalogueRecordIngredient(record CatalogueRecord) (*entity.Ingredient, string, error) {
+	name, errorName := catalogueName(record)
+
+	if errorName != nil {
+		return nil, "", errorName
+	}
+
+	status, errorStatus := catalogueStatus(record, string(kind.IngredientStatusPublished), string(kind.IngredientStatusUnPublished))
+
+	if errorStatus != nil {
+		return nil, "", errorStatus
+	}
+
+	return &entity.Ingredient{Name: name, Status: kind.IngredientStatus(status)}, record["category"], nil
+}
+
+func CatalogueRecordRecipe(record CatalogueRecord) (*entity.Recipe, error) {
+	name, errorName := catalogueName(record)
+
+	if errorName != nil {
+		return nil, errorName
+	}
+
+	status, errorStatus := catalogueStatus(record, string(kind.RecipeStatusPublished), string(kind.RecipeStatusUnPublished))
+
+	if errorStatus != nil {
+		return nil, errorStatus
+	}
+
+	servings, errorServings := catalogueNumber(record, "servings")
+
+	if errorServings != nil {
+		return nil, errorServings
+	}
+
+	calories, errorCalories := catalogueNumber(record, "calories")
+
+	if errorCalories != nil {
+		return nil, errorCalories
+	}
+
+	return &entity.Recipe{
+		Name:        name,
+		Description: record["description"],
+		Notes:       record["notes"],
+		Servings:    servings,
+		Calories:    calories,
+		Status:      kind.RecipeStatus(status),
+	}, nil
+}
+
+func CatalogueUnitRecord(unit *entity.Unit) CatalogueRecord {
+	return CatalogueRecord{"name": unit.Name, "status": string(unit.Status)}
+}
+
+func CatalogueCategoryRecord(category *entity.Category) CatalogueRecord {
+	return CatalogueRecord{"name": category.Name, "status": string(category.Status)}
+}
+
+func CatalogueIngredientRecord(ingredient *entity.Ingredient, categoryName string) CatalogueRecord {
+	return CatalogueRecord{"name": ingredient.Name, "category": categoryName, "status": string(ingredient.Status)}
+}
+
+func CatalogueRecipeRecord(recipe *entity.Recipe) CatalogueRecord {
+	return CatalogueRecord{
+		"name":        recipe.Name,
+		"description": recipe.Description,
+		"notes":       recipe.Notes,
+		"servings":    strconv.FormatInt(recipe.Servings, 10),
+		"calories":    strconv.FormatInt(recipe.Calories, 10),
+		"status":      string(recipe.Status),
+	}
+}
+
+func catalogueName(record CatalogueRecord) (string, error) {
+	name := strings.TrimSpace(record["name"])
+
+	if name == "" || len([]rune(name)) > catalogueNameLength {
+		return "", errorCatalogueName
+	}
+
+	return name, nil
+}
+
+// catalogueStatus returns the status of the record, an empty status is kept empty, so an update keeps the status
+// of the entity and a creation takes the published one.
+func catalogueStatus(record CatalogueRecord, published string, unpublished string) (string, error) {
+	switch status := strings.ToLower(strings.TrimSpace(record["status"])); status {
+	case "", published, unpublished:
+		return status, nil
+	default:
+		return "", errors.Wrapf(errorCatalogueStatus, "status=%s", record["status"])
+	}
+}
+
+func catalogueNumber(record CatalogueRecord, column string) (int64, error) {
+	value := strings.TrimSpace(record[column])
+
+	if value == "" {
+		return 0, nil
+	}
+
+	number, errorNumber := strconv.ParseInt(value, 10, 64)
+
+	if errorNumber != nil || number < 0 {
+		return 0, errors.Wrapf(errorCatalogueNumber, "%s=%s", column, value)
+	}
+
+	return number, nil
+}
+
+func catalogueValue(value any) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedValue
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(typedValue)
+	default:
+		encoded, _ := json.Marshal(typedValue)
+
+		return string(encoded)
+	}
+}
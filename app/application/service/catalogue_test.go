@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func getCatalogueRows(t *testing.T, data string, format kind.CatalogueFormat) []*CatalogueRow {
+	catalogueReader, errorCatalogueReader := NewCatalogueReader(strings.NewReader(data), format)
+
+	assert.Nil(t, errorCatalogueReader)
+
+	var rows []*CatalogueRow
+
+	for {
+		row, errorRow := catalogueReader.Read()
+
+		if errorRow == io.EOF {
+			break
+		}
+
+		assert.Nil(t, errorRow)
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func TestCatalogueReaderCSV(t *testing.T) {
+	rows := getCatalogueRows(t, "\ufeffName, Status\nFlour,published\nSugar \"fine,unpublished\nSalt\nEgg,published,extra\n", kind.CatalogueFormatCSV)
+
+	assert.Equal(t, 4, len(rows))
+	assert.Equal(t, &CatalogueRow{Line: 2, Record: CatalogueRecord{"name": "Flour", "status": "published"}}, rows[0])
+	assert.Equal(t, int64(3), rows[1].Line)
+	assert.NotNil(t, rows[1].Error)
+	assert.Equal(t, &CatalogueRow{Line: 4, Record: CatalogueRecord{"name": "Salt"}}, rows[2])
+	assert.Equal(t, &CatalogueRow{Line: 5, Record: CatalogueRecord{"name": "Egg", "status": "published"}}, rows[3])
+}
+
+func TestCatalogueReaderCSVHeader(t *testing.T) {
+	_, errorCatalogueReader := NewCatalogueReader(strings.NewReader("title,status\nFlour,published\n"), kind.CatalogueFormatCSV)
+
+	assert.True(t, errors.Is(errorCatalogueReader, errorCatalogueHeader))
+
+	_, errorCatalogueReader = NewCatalogueReader(strings.NewReader(""), kind.CatalogueFormatCSV)
+
+	assert.NotNil(t, errorCatalogueReader)
+
+	_, errorCatalogueReader = NewCatalogueReader(strings.NewReader(""), "xml")
+
+	assert.True(t, errors.Is(errorCatalogueReader, errorCatalogueFormat))
+}
+
+func TestCatalogueReaderNDJSON(t *testing.T) {
+	rows := getCatalogueRows(t, "{\"name\":\"Pancakes\",\"servings\":4,\"Calories\":350.5}\n\n{\"name\":\n{\"name\":null}\n", kind.CatalogueFormatNDJSON)
+
+	assert.Equal(t, 3, len(rows))
+	assert.Equal(t, &CatalogueRow{Line: 1, Record: CatalogueRecord{"name": "Pancakes", "servings": "4", "calories": "350.5"}}, rows[0])
+	assert.Equal(t, int64(3), rows[1].Line)
+	assert.NotNil(t, rows[1].Error)
+	assert.Equal(t, &CatalogueRow{Line: 4, Record: CatalogueRecord{"name": ""}}, rows[2])
+}
+
+func TestCatalogueWriter(t *testing.T) {
+	recipe := &entity.Recipe{Name: "Pancakes, fluffy", Description: "Say \"hi\"", Servings: 4, Status: kind.RecipeStatusPublished}
+
+	for format, expected := range map[kind.CatalogueFormat]string{
+		kind.CatalogueFormatCSV: "name,description,notes,servings,calories,status\n" +
+			"\"Pancakes, fluffy\",\"Say \"\"hi\"\"\",,4,0,published\n",
+		kind.CatalogueFormatNDJSON: "{\"name\":\"Pancakes, fluffy\",\"description\":\"Say \\\"hi\\\"\",\"notes\":\"\",\"servings\":4,\"calories\":0,\"status\":\"published\"}\n",
+	} {
+		var buffer bytes.Buffer
+
+		catalogueWriter, errorCatalogueWriter := NewCatalogueWriter(&buffer, kind.CatalogueEntityRecipes, format)
+
+		assert.Nil(t, errorCatalogueWriter)
+		assert.Nil(t, catalogueWriter.Write(CatalogueRecipeRecord(recipe)))
+		assert.Nil(t, catalogueWriter.Flush())
+		assert.Equal(t, expected, buffer.String())
+
+		rows := getCatalogueRows(t, buffer.String(), format)
+		recipeRecord, errorRecipeRecord := CatalogueRecordRecipe(rows[0].Record)
+
+		assert.Nil(t, errorRecipeRecord)
+		assert.Equal(t, recipe, recipeRecord)
+	}
+
+	_, errorCatalogueWriter := NewCatalogueWriter(io.Discard, "pictures", kind.CatalogueFormatCSV)
+
+	assert.True(t, errors.Is(errorCatalogueWriter, errorCatalogueEntity))
+}
+
+func TestCatalogueRecord(t *testing.T) {
+	unit, errorUnit := CatalogueRecordUnit(CatalogueRecord{"name": " g ", "status": "Published"})
+
+	assert.Nil(t, errorUnit)
+	assert.Equal(t, &entity.Unit{Name: "g", Status: kind.UnitStatusPublished}, unit)
+
+	category, errorCategory := CatalogueRecordCategory(CatalogueRecord{"name": "Breakfast"})
+
+	assert.Nil(t, errorCategory)
+	assert.Equal(t, &entity.Category{Name: "Breakfast"}, category)
+
+	ingredient, categoryName, errorIngredient := CatalogueRecordIngredient(CatalogueRecord{"name": "Egg", "category": "Dairy", "status": "unpublished"})
+
+	assert.Nil(t, errorIngredient)
+	assert.Equal(t, &entity.Ingredient{Name: "Egg", Status: kind.IngredientStatusUnPublished}, ingredient)
+	assert.Equal(t, "Dairy", categoryName)
+
+	_, errorUnit = CatalogueRecordUnit(CatalogueRecord{"name": ""})
+
+	assert.True(t, errors.Is(errorUnit, errorCatalogueName))
+
+	_, errorCategory = CatalogueRecordCategory(CatalogueRecord{"name": strings.Repeat("a", 256)})
+
+	assert.True(t, errors.Is(errorCategory, errorCatalogueName))
+
+	_, _, errorIngredient = CatalogueRecordIngredient(CatalogueRecord{"name": "Egg", "status": "draft"})
+
+	assert.True(t, errors.Is(errorIngredient, errorCatalogueStatus))
+
+	_, errorRecipe := CatalogueRecordRecipe(CatalogueRecord{"name": "Pancakes", "servings": "-1"})
+
+	assert.True(t, errors.Is(errorRecipe, errorCatalogueNumber))
+
+	_, errorRecipe = CatalogueRecordRecipe(CatalogueRecord{"name": "Pancakes", "calories": "350.5"})
+
+	assert.True(t, errors.Is(errorRecipe, errorCatalogueNumber))
+}
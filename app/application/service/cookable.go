@@ -0,0 +1,137 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"sort"
+	"time"
+)
+
+const (
+	// cookableSubstituteWeight is the part of an ingredient which is covered by its substitute.
+	cookableSubstituteWeight = 0.5
+	// cookableMissingPenalty lowers the score for every missing ingredient, so a recipe which needs to buy fewer
+	// ingredients comes first among the recipes of the same coverage.
+	cookableMissingPenalty = 0.1
+)
+
+type CookableMatch struct {
+	RecipeId    uuid.UUID
+	Score       float64
+	Coverage    float64
+	Available   []uuid.UUID
+	Substituted []*aggregate.CookableSubstitute
+	Missing     []*entity.RecipeIngredient
+}
+
+// CookableAvailable returns the ingredients given together with the ingredients of the pantry items.
+// Expired and inactive pantry items are not taken into account.
+func CookableAvailable(ingredientIds []uuid.UUID, pantryItems []*aggregate.PantryItem, now time.Time) map[uuid.UUID]bool {
+	available := make(map[uuid.UUID]bool, len(ingredientIds)+len(pantryItems))
+
+	for _, ingredientId := range ingredientIds {
+		available[ingredientId] = true
+	}
+
+	for _, pantryItem := range pantryAvailable(pantryItems, now) {
+		available[pantryItem.Entity.IngredientId] = true
+	}
+
+	return available
+}
+
+// CookableRank ranks the recipes by their ingredients, the score is the fraction of the required ingredients which
+// are available, a substitute covers a half of an ingredient, minus the penalty for every missing ingredient.
+// The recipes without any available ingredient and the recipes missing more than maxMissing ingredients are skipped,
+// maxMissing is not applied when it is not positive.
+func CookableRank(
+	recipeIngredients []*entity.RecipeIngredient,
+	available map[uuid.UUID]bool,
+	substitutes []*aggregate.CookableSubstitute,
+	maxMissing int64,
+) []*CookableMatch {
+	var matches []*CookableMatch
+
+	requirements := map[uuid.UUID][]*entity.RecipeIngredient{}
+	substitutesByIngredient := map[uuid.UUID][]uuid.UUID{}
+
+	for _, recipeIngredient := range recipeIngredients {
+		if recipeIngredient != nil && recipeIngredient.DeriveId != uuid.Nil {
+			requirements[recipeIngredient.EntityId] = append(requirements[recipeIngredient.EntityId], recipeIngredient)
+		}
+	}
+
+	for _, substitute := range substitutes {
+		if substitute != nil {
+			substitutesByIngredient[substitute.IngredientId] = append(substitutesByIngredient[substitute.IngredientId], substitute.SubstituteId)
+		}
+	}
+
+	for recipeId, requirement := range requirements {
+		var covered float64
+
+		match := &CookableMatch{RecipeId: recipeId}
+		required := map[uuid.UUID]bool{}
+
+		for _, recipeIngredient := range requirement {
+			if required[recipeIngredient.DeriveId] {
+				continue
+			}
+
+			required[recipeIngredient.DeriveId] = true
+
+			if available[recipeIngredient.DeriveId] {
+				match.Available = append(match.Available, recipeIngredient.DeriveId)
+				covered++
+			} else if substituteId, ok := cookableSubstitute(recipeIngredient.DeriveId, available, substitutesByIngredient); ok {
+				match.Substituted = append(
+					match.Substituted,
+					&aggregate.CookableSubstitute{IngredientId: recipeIngredient.DeriveId, SubstituteId: substituteId},
+				)
+				covered += cookableSubstituteWeight
+			} else {
+				match.Missing = append(match.Missing, recipeIngredient)
+			}
+		}
+
+		if len(match.Available) == 0 && len(match.Substituted) == 0 {
+			continue
+		}
+
+		if maxMissing > 0 && int64(len(match.Missing)) > maxMissing {
+			continue
+		}
+
+		match.Coverage = covered / float64(len(required))
+		match.Score = match.Coverage - cookableMissingPenalty*float64(len(match.Missing))
+		matches = append(matches, match)
+	}
+
+	sort.Slice(
+		matches,
+		func(i, j int) bool {
+			if matches[i].Score != matches[j].Score {
+				return matches[i].Score > matches[j].Score
+			}
+
+			if len(matches[i].Missing) != len(matches[j].Missing) {
+				return len(matches[i].Missing) < len(matches[j].Missing)
+			}
+
+			return matches[i].RecipeId.String() < matches[j].RecipeId.String()
+		},
+	)
+
+	return matches
+}
+
+func cookableSubstitute(ingredientId uuid.UUID, available map[uuid.UUID]bool, substitutes map[uuid.UUID][]uuid.UUID) (uuid.UUID, bool) {
+	for _, substituteId := range substitutes[ingredientId] {
+		if available[substituteId] {
+			return substituteId, true
+		}
+	}
+
+	return uuid.Nil, false
+}
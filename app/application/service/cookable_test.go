@@ -0,0 +1,86 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testCookableOmelette = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	testCookablePancakes = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	testCookableBread    = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	testCookableSalad    = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	testCookableEgg      = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+	testCookableMilk     = uuid.MustParse("00000000-0000-0000-0000-000000000012")
+	testCookableFlour    = uuid.MustParse("00000000-0000-0000-0000-000000000013")
+	testCookableOatMilk  = uuid.MustParse("00000000-0000-0000-0000-000000000014")
+	testCookableYeast    = uuid.MustParse("00000000-0000-0000-0000-000000000015")
+	testCookableTomato   = uuid.MustParse("00000000-0000-0000-0000-000000000016")
+)
+
+func testCookableRecipeIngredient(recipeId uuid.UUID, ingredientId uuid.UUID) *entity.RecipeIngredient {
+	return &entity.RecipeIngredient{Id: uuid.New(), EntityId: recipeId, DeriveId: ingredientId}
+}
+
+func TestCookableAvailable(t *testing.T) {
+	now := time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	pantryItems := []*aggregate.PantryItem{
+		{Entity: &entity.PantryItem{IngredientId: testCookableMilk, Status: kind.PantryItemStatusActive}},
+		{Entity: &entity.PantryItem{IngredientId: testCookableFlour, Status: kind.PantryItemStatusActive, ExpiryTime: now.Add(-time.Hour)}},
+		{Entity: &entity.PantryItem{IngredientId: testCookableYeast, Status: kind.PantryItemStatusInActive}},
+	}
+
+	assert.Equal(
+		t,
+		map[uuid.UUID]bool{testCookableEgg: true, testCookableMilk: true},
+		CookableAvailable([]uuid.UUID{testCookableEgg}, pantryItems, now),
+	)
+}
+
+func TestCookableRank(t *testing.T) {
+	recipeIngredients := []*entity.RecipeIngredient{
+		testCookableRecipeIngredient(testCookableOmelette, testCookableEgg),
+		testCookableRecipeIngredient(testCookableOmelette, testCookableMilk),
+		testCookableRecipeIngredient(testCookablePancakes, testCookableEgg),
+		testCookableRecipeIngredient(testCookablePancakes, testCookableMilk),
+		testCookableRecipeIngredient(testCookablePancakes, testCookableFlour),
+		testCookableRecipeIngredient(testCookablePancakes, testCookableFlour),
+		testCookableRecipeIngredient(testCookableBread, testCookableFlour),
+		testCookableRecipeIngredient(testCookableBread, testCookableYeast),
+		testCookableRecipeIngredient(testCookableSalad, testCookableTomato),
+		testCookableRecipeIngredient(testCookableSalad, uuid.Nil),
+		nil,
+	}
+	available := map[uuid.UUID]bool{testCookableEgg: true, testCookableMilk: true, testCookableTomato: true}
+
+	matches := CookableRank(recipeIngredients, available, nil, 0)
+
+	assert.Equal(t, 3, len(matches))
+	assert.Equal(t, testCookableOmelette, matches[0].RecipeId)
+	assert.Equal(t, testCookableSalad, matches[1].RecipeId)
+	assert.Equal(t, testCookablePancakes, matches[2].RecipeId)
+	assert.Equal(t, 1.0, matches[0].Coverage)
+	assert.Equal(t, []uuid.UUID{testCookableEgg, testCookableMilk}, matches[0].Available)
+	assert.InDelta(t, 2.0/3.0, matches[2].Coverage, 0.0001)
+	assert.InDelta(t, 2.0/3.0-cookableMissingPenalty, matches[2].Score, 0.0001)
+	assert.Equal(t, 1, len(matches[2].Missing))
+	assert.Equal(t, testCookableFlour, matches[2].Missing[0].DeriveId)
+
+	available = map[uuid.UUID]bool{testCookableEgg: true, testCookableOatMilk: true}
+	substitutes := []*aggregate.CookableSubstitute{{IngredientId: testCookableMilk, SubstituteId: testCookableOatMilk}}
+
+	matches = CookableRank(recipeIngredients, available, substitutes, 1)
+
+	assert.Equal(t, 2, len(matches))
+	assert.Equal(t, testCookableOmelette, matches[0].RecipeId)
+	assert.Equal(t, 0.75, matches[0].Coverage)
+	assert.Equal(t, substitutes, matches[0].Substituted)
+	assert.Equal(t, testCookablePancakes, matches[1].RecipeId)
+
+	assert.Nil(t, CookableRank(recipeIngredients, map[uuid.UUID]bool{}, nil, 0))
+}
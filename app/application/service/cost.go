@@ -0,0 +1,179 @@
+package service
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"math"
+	"strings"
+	"time"
+)
+
+const costPrecision = 1000
+
+var errorIngredientCost = errors.New("the cost of the ingredient cannot be calculated by the price")
+
+// RecipeCalculate sums the measures of the recipe up by ingredient and unit.
+func RecipeCalculate(recipe *aggregate.Recipe) []*aggregate.PlannerCalculation {
+	var recipeCalculations []*aggregate.PlannerCalculation
+
+	if recipe == nil {
+		return nil
+	}
+
+	for _, ingredient := range recipe.Ingredients {
+		for _, measure := range ingredient.Measures {
+			recipeCalculations = append(
+				recipeCalculations,
+				&aggregate.PlannerCalculation{
+					Ingredient: ingredient.Derive,
+					Unit:       measure.Unit,
+					Amount:     measure.Entity.Value,
+				},
+			)
+		}
+	}
+
+	return PlannerCalculationMerge(recipeCalculations)
+}
+
+// IngredientPriceLatest returns the latest active price of the ingredient which is known at the time.
+// When the currency is not empty only the prices in that currency are taken into account.
+func IngredientPriceLatest(
+	ingredientPrices []*aggregate.IngredientPrice,
+	ingredientId uuid.UUID,
+	currency string,
+	now time.Time,
+) *aggregate.IngredientPrice {
+	var latest *aggregate.IngredientPrice
+
+	for _, ingredientPrice := range ingredientPrices {
+		if ingredientPrice == nil || ingredientPrice.Entity == nil || ingredientPrice.Entity.EntityId != ingredientId {
+			continue
+		}
+
+		if ingredientPrice.Entity.Status != kind.IngredientPriceStatusActive || ingredientPrice.Entity.PriceTime.After(now) {
+			continue
+		}
+
+		if currency != "" && !strings.EqualFold(ingredientPrice.Entity.Currency, currency) {
+			continue
+		}
+
+		if latest == nil || ingredientPrice.Entity.PriceTime.After(latest.Entity.PriceTime) {
+			latest = ingredientPrice
+		}
+	}
+
+	return latest
+}
+
+// IngredientCost returns the cost of the amount in the unit by the price. The quantity of the price
+// is converted to the unit, so the units have to be convertible between each other.
+func IngredientCost(amount int64, unit *entity.Unit, ingredientPrice *aggregate.IngredientPrice) (int64, error) {
+	if ingredientPrice == nil || ingredientPrice.Entity == nil || ingredientPrice.Entity.Quantity <= 0 {
+		return 0, errorIngredientCost
+	}
+
+	quantity, errorUnitConvert := UnitConvert(ingredientPrice.Entity.Quantity*costPrecision, ingredientPrice.Unit, unit)
+
+	if errorUnitConvert != nil {
+		return 0, errors.Wrapf(errorIngredientCost, "%s", errorUnitConvert)
+	} else if quantity <= 0 {
+		return 0, errorIngredientCost
+	}
+
+	return int64(math.Round(float64(amount) * float64(ingredientPrice.Entity.Price) * costPrecision / float64(quantity))), nil
+}
+
+// PlannerCalculationCost fills the cost of every calculation in by the latest price of its ingredient and returns
+// the total cost, the currency of the cost and ingredients which cannot be priced. When the currency is empty
+// the currency of the first found price is used for all calculations.
+func PlannerCalculationCost(
+	plannerCalculations []*aggregate.PlannerCalculation,
+	ingredientPrices []*aggregate.IngredientPrice,
+	currency string,
+	now time.Time,
+) (int64, string, []*entity.Ingredient) {
+	var (
+		total    int64
+		unpriced []*entity.Ingredient
+	)
+
+	mapUnpriced := map[uuid.UUID]bool{}
+	currency = strings.ToUpper(currency)
+
+	for _, plannerCalculation := range plannerCalculations {
+		if plannerCalculation == nil || plannerCalculation.Ingredient == nil {
+			continue
+		}
+
+		plannerCalculation.Cost = 0
+		plannerCalculation.Currency = ""
+		ingredientPrice := IngredientPriceLatest(ingredientPrices, plannerCalculation.Ingredient.Id, currency, now)
+		cost, errorIngredientCost := IngredientCost(plannerCalculation.Amount, plannerCalculation.Unit, ingredientPrice)
+
+		if errorIngredientCost != nil {
+			if !mapUnpriced[plannerCalculation.Ingredient.Id] {
+				mapUnpriced[plannerCalculation.Ingredient.Id] = true
+				unpriced = append(unpriced, plannerCalculation.Ingredient)
+			}
+
+			continue
+		}
+
+		if currency == "" {
+			currency = strings.ToUpper(ingredientPrice.Entity.Currency)
+		}
+
+		plannerCalculation.Cost = cost
+		plannerCalculation.Currency = currency
+		total += cost
+	}
+
+	return total, currency, unpriced
+}
+
+// CostPerServing divides the total cost by the servings, a recipe without servings is considered as one serving.
+func CostPerServing(total int64, servings int64) int64 {
+	if servings <= 0 {
+		return total
+	}
+
+	return int64(math.Round(float64(total) / float64(servings)))
+}
+
+// PlannerBudgetWarnings returns warnings when the estimated cost exceeds the budget or the cost cannot be
+// estimated completely. A planner without a budget has no warnings.
+func PlannerBudgetWarnings(budget int64, total int64, currency string, unpriced []*entity.Ingredient) []string {
+	var warnings []string
+
+	if budget <= 0 {
+		return nil
+	}
+
+	if total > budget {
+		warnings = append(
+			warnings,
+			fmt.Sprintf("the estimated cost %d %s exceeds the budget %d %s by %d %s", total, currency, budget, currency, total-budget, currency),
+		)
+	}
+
+	if len(unpriced) > 0 {
+		names := make([]string, 0, len(unpriced))
+
+		for _, ingredient := range unpriced {
+			names = append(names, ingredient.Name)
+		}
+
+		warnings = append(
+			warnings,
+			fmt.Sprintf("the estimated cost is incomplete, there are no prices in %s for: %s", currency, strings.Join(names, ", ")),
+		)
+	}
+
+	return warnings
+}
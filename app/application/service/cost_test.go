@@ -0,0 +1,245 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testCostNow      = time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	testCostGram     = &entity.Unit{Id: uuid.New(), Name: "g"}
+	testCostKilogram = &entity.Unit{Id: uuid.New(), Name: "kg"}
+	testCostPiece    = &entity.Unit{Id: uuid.New(), Name: "pcs"}
+	testCostFlour    = &entity.Ingredient{Id: uuid.New(), Name: "Flour"}
+	testCostEgg      = &entity.Ingredient{Id: uuid.New(), Name: "Egg"}
+	testCostSaffron  = &entity.Ingredient{Id: uuid.New(), Name: "Saffron"}
+)
+
+func testIngredientPrice(ingredient *entity.Ingredient, unit *entity.Unit, price int64, quantity int64, currency string, priceTime time.Time, status kind.IngredientPriceStatus) *aggregate.IngredientPrice {
+	return &aggregate.IngredientPrice{
+		Entity: &entity.IngredientPrice{
+			Id:        uuid.New(),
+			EntityId:  ingredient.Id,
+			UnitId:    unit.Id,
+			PriceTime: priceTime,
+			Price:     price,
+			Quantity:  quantity,
+			Currency:  currency,
+			Status:    status,
+		},
+		Unit: unit,
+	}
+}
+
+func TestRecipeCalculate(t *testing.T) {
+	recipe := &aggregate.Recipe{
+		Ingredients: []*aggregate.RecipeIngredient{
+			{
+				Derive: testCostFlour,
+				Measures: []*aggregate.RecipeMeasure{
+					{Entity: &entity.RecipeMeasure{Value: 200}, Unit: testCostGram},
+					{Entity: &entity.RecipeMeasure{Value: 300}, Unit: testCostGram},
+				},
+			},
+			{
+				Derive: testCostEgg,
+				Measures: []*aggregate.RecipeMeasure{
+					{Entity: &entity.RecipeMeasure{Value: 2}, Unit: testCostPiece},
+				},
+			},
+		},
+	}
+
+	actual := RecipeCalculate(recipe)
+
+	assert.Len(t, actual, 2)
+	assert.Equal(t, testCostFlour, actual[0].Ingredient)
+	assert.Equal(t, int64(500), actual[0].Amount)
+	assert.Equal(t, testCostEgg, actual[1].Ingredient)
+	assert.Equal(t, int64(2), actual[1].Amount)
+	assert.Nil(t, RecipeCalculate(nil))
+}
+
+func TestIngredientPriceLatest(t *testing.T) {
+	older := testIngredientPrice(testCostFlour, testCostKilogram, 100, 1, "EUR", testCostNow.Add(-time.Hour*48), kind.IngredientPriceStatusActive)
+	latest := testIngredientPrice(testCostFlour, testCostKilogram, 120, 1, "EUR", testCostNow.Add(-time.Hour), kind.IngredientPriceStatusActive)
+	future := testIngredientPrice(testCostFlour, testCostKilogram, 150, 1, "EUR", testCostNow.Add(time.Hour), kind.IngredientPriceStatusActive)
+	inactive := testIngredientPrice(testCostFlour, testCostKilogram, 90, 1, "EUR", testCostNow.Add(-time.Minute), kind.IngredientPriceStatusInActive)
+	dollar := testIngredientPrice(testCostFlour, testCostKilogram, 130, 1, "USD", testCostNow.Add(-time.Minute), kind.IngredientPriceStatusActive)
+	prices := []*aggregate.IngredientPrice{older, latest, future, inactive, dollar}
+
+	tests := []struct {
+		Name       string
+		Ingredient *entity.Ingredient
+		Currency   string
+		Expected   *aggregate.IngredientPrice
+	}{
+		{
+			Name:       "Test case with IngredientPriceLatest in the currency",
+			Ingredient: testCostFlour,
+			Currency:   "eur",
+			Expected:   latest,
+		},
+		{
+			Name:       "Test case with IngredientPriceLatest in any currency",
+			Ingredient: testCostFlour,
+			Currency:   "",
+			Expected:   dollar,
+		},
+		{
+			Name:       "Test case with IngredientPriceLatest without prices",
+			Ingredient: testCostEgg,
+			Currency:   "EUR",
+			Expected:   nil,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, IngredientPriceLatest(prices, testCase.Ingredient.Id, testCase.Currency, testCostNow))
+			},
+		)
+	}
+}
+
+func TestIngredientCost(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Amount   int64
+		Unit     *entity.Unit
+		Price    *aggregate.IngredientPrice
+		Expected int64
+		Error    bool
+	}{
+		{
+			Name:     "Test case with IngredientCost in another unit",
+			Amount:   500,
+			Unit:     testCostGram,
+			Price:    testIngredientPrice(testCostFlour, testCostKilogram, 120, 1, "EUR", testCostNow, kind.IngredientPriceStatusActive),
+			Expected: 60,
+		},
+		{
+			Name:     "Test case with IngredientCost for a quantity of the unit",
+			Amount:   3,
+			Unit:     testCostPiece,
+			Price:    testIngredientPrice(testCostEgg, testCostPiece, 250, 10, "EUR", testCostNow, kind.IngredientPriceStatusActive),
+			Expected: 75,
+		},
+		{
+			Name:   "Test case with IngredientCost in an unconvertible unit",
+			Amount: 3,
+			Unit:   testCostPiece,
+			Price:  testIngredientPrice(testCostFlour, testCostKilogram, 120, 1, "EUR", testCostNow, kind.IngredientPriceStatusActive),
+			Error:  true,
+		},
+		{
+			Name:   "Test case with IngredientCost without a price",
+			Amount: 3,
+			Unit:   testCostPiece,
+			Price:  nil,
+			Error:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				actual, errorActual := IngredientCost(testCase.Amount, testCase.Unit, testCase.Price)
+
+				if testCase.Error {
+					assert.NotNil(t, errorActual)
+				} else {
+					assert.Nil(t, errorActual)
+					assert.Equal(t, testCase.Expected, actual)
+				}
+			},
+		)
+	}
+}
+
+func TestPlannerCalculationCost(t *testing.T) {
+	prices := []*aggregate.IngredientPrice{
+		testIngredientPrice(testCostFlour, testCostKilogram, 120, 1, "EUR", testCostNow, kind.IngredientPriceStatusActive),
+		testIngredientPrice(testCostEgg, testCostPiece, 250, 10, "EUR", testCostNow, kind.IngredientPriceStatusActive),
+		testIngredientPrice(testCostSaffron, testCostGram, 900, 1, "USD", testCostNow, kind.IngredientPriceStatusActive),
+	}
+	plannerCalculations := []*aggregate.PlannerCalculation{
+		{Ingredient: testCostFlour, Unit: testCostGram, Amount: 500},
+		{Ingredient: testCostEgg, Unit: testCostPiece, Amount: 3},
+		{Ingredient: testCostSaffron, Unit: testCostGram, Amount: 1},
+	}
+
+	total, currency, unpriced := PlannerCalculationCost(plannerCalculations, prices, "eur", testCostNow)
+
+	assert.Equal(t, int64(135), total)
+	assert.Equal(t, "EUR", currency)
+	assert.Equal(t, []*entity.Ingredient{testCostSaffron}, unpriced)
+	assert.Equal(t, int64(60), plannerCalculations[0].Cost)
+	assert.Equal(t, "EUR", plannerCalculations[0].Currency)
+	assert.Equal(t, int64(75), plannerCalculations[1].Cost)
+	assert.Equal(t, int64(0), plannerCalculations[2].Cost)
+	assert.Equal(t, "", plannerCalculations[2].Currency)
+
+	total, currency, unpriced = PlannerCalculationCost(plannerCalculations[:2], prices, "", testCostNow)
+
+	assert.Equal(t, int64(135), total)
+	assert.Equal(t, "EUR", currency)
+	assert.Nil(t, unpriced)
+}
+
+func TestCostPerServing(t *testing.T) {
+	assert.Equal(t, int64(250), CostPerServing(1000, 4))
+	assert.Equal(t, int64(333), CostPerServing(1000, 3))
+	assert.Equal(t, int64(1000), CostPerServing(1000, 0))
+}
+
+func TestPlannerBudgetWarnings(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Budget   int64
+		Total    int64
+		Unpriced []*entity.Ingredient
+		Expected []string
+	}{
+		{
+			Name:     "Test case with PlannerBudgetWarnings without a budget",
+			Budget:   0,
+			Total:    1500,
+			Unpriced: []*entity.Ingredient{testCostSaffron},
+			Expected: nil,
+		},
+		{
+			Name:     "Test case with PlannerBudgetWarnings within the budget",
+			Budget:   2000,
+			Total:    1500,
+			Expected: nil,
+		},
+		{
+			Name:     "Test case with PlannerBudgetWarnings over the budget and unpriced ingredients",
+			Budget:   1000,
+			Total:    1500,
+			Unpriced: []*entity.Ingredient{testCostSaffron, testCostEgg},
+			Expected: []string{
+				"the estimated cost 1500 EUR exceeds the budget 1000 EUR by 500 EUR",
+				"the estimated cost is incomplete, there are no prices in EUR for: Saffron, Egg",
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, PlannerBudgetWarnings(testCase.Budget, testCase.Total, "EUR", testCase.Unpriced))
+			},
+		)
+	}
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// fuzzyDistanceLength is the shortest name compared by the edit distance, shorter names differ by a letter too
+	// often, e.g. "ham" and "jam".
+	fuzzyDistanceLength = 4
+	// fuzzyDistanceLong is the length of a name from which two edits are allowed instead of one.
+	fuzzyDistanceLong = 8
+)
+
+var fuzzyReasons = map[kind.FuzzyReason]int{
+	kind.FuzzyReasonName:     0,
+	kind.FuzzyReasonAltName:  1,
+	kind.FuzzyReasonDistance: 2,
+}
+
+// FuzzyCandidate is an ingredient or a category which is compared by its name and by its alternative names.
+type FuzzyCandidate struct {
+	Id         uuid.UUID
+	Name       string
+	AltNames   []string
+	DateInsert time.Time
+}
+
+// FuzzyNormalize returns the name in lower case with every word stemmed and without punctuation, so "Tomatoes",
+// "tomato" and "Tomatoe!" have the same form.
+func FuzzyNormalize(name string) string {
+	words := searchWord.FindAllString(strings.ToLower(name), -1)
+
+	for index, word := range words {
+		words[index] = SearchStem(word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// FuzzyDistance returns the Levenshtein distance between the strings counted in runes.
+func FuzzyDistance(a string, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(runesA); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(runesB); j++ {
+			cost := 1
+
+			if runesA[i-1] == runesB[j-1] {
+				cost = 0
+			}
+
+			current[j] = fuzzyMin(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(runesB)]
+}
+
+// FuzzyMatch returns the candidates which are similar to the name, the ones with the same normalised name or
+// alternative name come first, then the ones which are a few edits away.
+func FuzzyMatch(name string, candidates []*FuzzyCandidate) []*aggregate.FuzzyMatch {
+	var matches []*aggregate.FuzzyMatch
+
+	normalized := FuzzyNormalize(name)
+
+	if normalized == "" {
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		if reason, distance, ok := fuzzyCompare(normalized, candidate); ok {
+			matches = append(
+				matches,
+				&aggregate.FuzzyMatch{Id: candidate.Id, Name: candidate.Name, Reason: reason, Distance: int64(distance)},
+			)
+		}
+	}
+
+	sort.SliceStable(
+		matches,
+		func(i, j int) bool {
+			if matches[i].Reason != matches[j].Reason {
+				return fuzzyReasons[matches[i].Reason] < fuzzyReasons[matches[j].Reason]
+			}
+
+			if matches[i].Distance != matches[j].Distance {
+				return matches[i].Distance < matches[j].Distance
+			}
+
+			return matches[i].Name < matches[j].Name
+		},
+	)
+
+	return matches
+}
+
+// FuzzyDuplicates returns the pairs of the similar candidates to merge, the candidate which has been created first
+// survives.
+func FuzzyDuplicates(candidates []*FuzzyCandidate) []*aggregate.MergeSuggestion {
+	var suggestions []*aggregate.MergeSuggestion
+
+	sorted := make([]*FuzzyCandidate, len(candidates))
+	copy(sorted, candidates)
+
+	sort.SliceStable(
+		sorted,
+		func(i, j int) bool {
+			if !sorted[i].DateInsert.Equal(sorted[j].DateInsert) {
+				return sorted[i].DateInsert.Before(sorted[j].DateInsert)
+			}
+
+			return sorted[i].Id.String() < sorted[j].Id.String()
+		},
+	)
+
+	for i, survivor := range sorted {
+		normalized := FuzzyNormalize(survivor.Name)
+
+		if normalized == "" {
+			continue
+		}
+
+		for _, duplicate := range sorted[i+1:] {
+			reason, distance, ok := fuzzyCompare(normalized, duplicate)
+
+			if !ok {
+				reason, distance, ok = fuzzyCompare(FuzzyNormalize(duplicate.Name), survivor)
+			}
+
+			if ok {
+				suggestions = append(
+					suggestions,
+					&aggregate.MergeSuggestion{
+						SurvivorId:    survivor.Id,
+						SurvivorName:  survivor.Name,
+						DuplicateId:   duplicate.Id,
+						DuplicateName: duplicate.Name,
+						Reason:        reason,
+						Distance:      int64(distance),
+					},
+				)
+			}
+		}
+	}
+
+	return suggestions
+}
+
+func fuzzyCompare(normalized string, candidate *FuzzyCandidate) (kind.FuzzyReason, int, bool) {
+	candidateNormalized := FuzzyNormalize(candidate.Name)
+
+	if candidateNormalized == normalized {
+		return kind.FuzzyReasonName, 0, true
+	}
+
+	for _, altName := range candidate.AltNames {
+		if FuzzyNormalize(altName) == normalized {
+			return kind.FuzzyReasonAltName, 0, true
+		}
+	}
+
+	length := fuzzyMin(utf8.RuneCountInString(normalized), utf8.RuneCountInString(candidateNormalized))
+
+	if length < fuzzyDistanceLength {
+		return "", 0, false
+	}
+
+	allowed := 1
+
+	if length >= fuzzyDistanceLong {
+		allowed = 2
+	}
+
+	if distance := FuzzyDistance(normalized, candidateNormalized); distance <= allowed {
+		return kind.FuzzyReasonDistance, distance, true
+	}
+
+	return "", 0, false
+}
+
+func fuzzyMin(values ...int) int {
+	result := values[0]
+
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testFuzzyTomato   = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "Tomato", DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	testFuzzyTomatoes = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Name: "tomatoes", DateInsert: time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC)}
+	testFuzzyTomatoe  = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000003"), Name: "Tomatoe", DateInsert: time.Date(2000, time.January, 3, 0, 0, 0, 0, time.UTC)}
+	testFuzzyPotato   = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000004"), Name: "Potato", DateInsert: time.Date(2000, time.January, 4, 0, 0, 0, 0, time.UTC)}
+	testFuzzyScallion = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000005"), Name: "Scallion", AltNames: []string{"Green onions"}, DateInsert: time.Date(2000, time.January, 5, 0, 0, 0, 0, time.UTC)}
+	testFuzzyHam      = &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000006"), Name: "Ham", DateInsert: time.Date(2000, time.January, 6, 0, 0, 0, 0, time.UTC)}
+)
+
+func TestFuzzyNormalize(t *testing.T) {
+	assert.Equal(t, "tomato", FuzzyNormalize("Tomatoes"))
+	assert.Equal(t, "tomato", FuzzyNormalize(" Tomatoe! "))
+	assert.Equal(t, "green onion", FuzzyNormalize("Green  onions"))
+	assert.Equal(t, "", FuzzyNormalize("--"))
+}
+
+func TestFuzzyDistance(t *testing.T) {
+	assert.Equal(t, 0, FuzzyDistance("tomato", "tomato"))
+	assert.Equal(t, 1, FuzzyDistance("tomato", "tomatto"))
+	assert.Equal(t, 2, FuzzyDistance("tomato", "potato"))
+	assert.Equal(t, 3, FuzzyDistance("", "ham"))
+	assert.Equal(t, 1, FuzzyDistance("crème", "creme"))
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	candidates := []*FuzzyCandidate{testFuzzyPotato, testFuzzyTomato, testFuzzyScallion, testFuzzyHam}
+
+	assert.Equal(
+		t,
+		[]*aggregate.FuzzyMatch{{Id: testFuzzyTomato.Id, Name: "Tomato", Reason: kind.FuzzyReasonName}},
+		FuzzyMatch("tomatoes", candidates),
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.FuzzyMatch{{Id: testFuzzyScallion.Id, Name: "Scallion", Reason: kind.FuzzyReasonAltName}},
+		FuzzyMatch("green onion", candidates),
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.FuzzyMatch{{Id: testFuzzyTomato.Id, Name: "Tomato", Reason: kind.FuzzyReasonDistance, Distance: 1}},
+		FuzzyMatch("Tomatto", candidates),
+	)
+	assert.Nil(t, FuzzyMatch("Jam", candidates))
+	assert.Nil(t, FuzzyMatch("!", candidates))
+}
+
+func TestFuzzyDuplicates(t *testing.T) {
+	onion := &FuzzyCandidate{Id: uuid.MustParse("00000000-0000-0000-0000-000000000007"), Name: "green onion", DateInsert: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	suggestions := FuzzyDuplicates([]*FuzzyCandidate{testFuzzyTomatoe, testFuzzyScallion, testFuzzyTomatoes, testFuzzyTomato, testFuzzyPotato, testFuzzyHam, onion})
+
+	assert.Equal(
+		t,
+		[]*aggregate.MergeSuggestion{
+			{SurvivorId: onion.Id, SurvivorName: "green onion", DuplicateId: testFuzzyScallion.Id, DuplicateName: "Scallion", Reason: kind.FuzzyReasonAltName},
+			{SurvivorId: testFuzzyTomato.Id, SurvivorName: "Tomato", DuplicateId: testFuzzyTomatoes.Id, DuplicateName: "tomatoes", Reason: kind.FuzzyReasonName},
+			{SurvivorId: testFuzzyTomato.Id, SurvivorName: "Tomato", DuplicateId: testFuzzyTomatoe.Id, DuplicateName: "Tomatoe", Reason: kind.FuzzyReasonName},
+			{SurvivorId: testFuzzyTomatoes.Id, SurvivorName: "tomatoes", DuplicateId: testFuzzyTomatoe.Id, DuplicateName: "Tomatoe", Reason: kind.FuzzyReasonName},
+		},
+		suggestions,
+	)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+// HouseholdRightAllows reports whether the granted right is enough for the required one, the write right allows to
+// read as well.
+func HouseholdRightAllows(granted kind.UserRight, required kind.UserRight) bool {
+	switch granted {
+	case kind.UserRightWrite:
+		return required == kind.UserRightWrite || required == kind.UserRightRead
+	case kind.UserRightRead:
+		return required == kind.UserRightRead
+	default:
+		return false
+	}
+}
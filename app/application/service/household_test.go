@@ -0,0 +1,56 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHouseholdRightAllows(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  kind.UserRight
+		required kind.UserRight
+		expected bool
+	}{
+		{
+			name:     "Test case with write grant and write requirement",
+			granted:  kind.UserRightWrite,
+			required: kind.UserRightWrite,
+			expected: true,
+		},
+		{
+			name:     "Test case with write grant and read requirement",
+			granted:  kind.UserRightWrite,
+			required: kind.UserRightRead,
+			expected: true,
+		},
+		{
+			name:     "Test case with read grant and read requirement",
+			granted:  kind.UserRightRead,
+			required: kind.UserRightRead,
+			expected: true,
+		},
+		{
+			name:     "Test case with read grant and write requirement",
+			granted:  kind.UserRightRead,
+			required: kind.UserRightWrite,
+			expected: false,
+		},
+		{
+			name:     "Test case with unknown grant",
+			granted:  "random",
+			required: kind.UserRightRead,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, HouseholdRightAllows(testCase.granted, testCase.required))
+			},
+		)
+	}
+}
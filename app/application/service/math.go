@@ -19,3 +19,19 @@ func MathRandomInt(min int64, max int64) int64 {
 func MathRandomIntAsString(min int64, max int64) string {
 	return strconv.FormatInt(MathRandomInt(min, max), 10)
 }
+
+func MathMinInt(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func MathMaxInt(a int64, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
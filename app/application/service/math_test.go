@@ -109,3 +109,65 @@ func TestMathRandomIntAsString(t *testing.T) {
 		)
 	}
 }
+
+func TestMathMinInt(t *testing.T) {
+	tests := []struct {
+		Name     string
+		A        int64
+		B        int64
+		Expected int64
+	}{
+		{
+			Name:     "Test case with MathMinInt when the first is less",
+			A:        1,
+			B:        2,
+			Expected: 1,
+		},
+		{
+			Name:     "Test case with MathMinInt when the second is less",
+			A:        2,
+			B:        -1,
+			Expected: -1,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, MathMinInt(testCase.A, testCase.B))
+			},
+		)
+	}
+}
+
+func TestMathMaxInt(t *testing.T) {
+	tests := []struct {
+		Name     string
+		A        int64
+		B        int64
+		Expected int64
+	}{
+		{
+			Name:     "Test case with MathMaxInt when the first is greater",
+			A:        2,
+			B:        1,
+			Expected: 2,
+		},
+		{
+			Name:     "Test case with MathMaxInt when the second is greater",
+			A:        -1,
+			B:        0,
+			Expected: 0,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, MathMaxInt(testCase.A, testCase.B))
+			},
+		)
+	}
+}
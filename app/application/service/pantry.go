@@ -0,0 +1,192 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"math"
+	"sort"
+	"time"
+)
+
+// pantryPrecision is the difference from a whole number the quantity is considered as whole within.
+const pantryPrecision = 1e-9
+
+// PantrySubtract returns the shopping list which is the planner calculations minus the pantry stock.
+// Expired and inactive pantry items are not taken into account, the stock is converted to the unit of a calculation.
+func PantrySubtract(
+	plannerCalculations []*aggregate.PlannerCalculation,
+	pantryItems []*aggregate.PantryItem,
+	now time.Time,
+) []*aggregate.PlannerCalculation {
+	var shoppingList []*aggregate.PlannerCalculation
+
+	pantryItems = pantryAvailable(pantryItems, now)
+	remains := pantryRemains(pantryItems)
+
+	for _, plannerCalculation := range plannerCalculations {
+		amount := plannerCalculation.Amount
+
+		if plannerCalculation.Ingredient != nil {
+			amount -= pantryTake(pantryItems, remains, plannerCalculation.Ingredient.Id, plannerCalculation.Unit, amount)
+		}
+
+		if amount > 0 {
+			shoppingList = append(
+				shoppingList,
+				&aggregate.PlannerCalculation{
+					Ingredient: plannerCalculation.Ingredient,
+					Unit:       plannerCalculation.Unit,
+					Amount:     amount,
+				},
+			)
+		}
+	}
+
+	return shoppingList
+}
+
+// PantryConsume decrements the pantry stock of the ingredient by the amount which is in the unit.
+// The items that expire first are consumed first, the changed items are returned. The stock is subtracted without
+// rounding and the item is kept in the smaller unit when the quantity left is not whole in the unit of the item.
+func PantryConsume(
+	pantryItems []*aggregate.PantryItem,
+	ingredientId uuid.UUID,
+	unit *entity.Unit,
+	amount int64,
+	now time.Time,
+) []*aggregate.PantryItem {
+	var consumed []*aggregate.PantryItem
+
+	remaining := float64(amount)
+
+	for _, pantryItem := range pantryAvailable(pantryItems, now) {
+		if remaining <= 0 {
+			break
+		}
+
+		if pantryItem.Entity.IngredientId != ingredientId {
+			continue
+		}
+
+		factor, errorUnitFactor := unitFactor(pantryItem.Unit, unit)
+
+		if errorUnitFactor != nil {
+			continue
+		}
+
+		available := float64(pantryItem.Entity.Quantity) * factor
+
+		if available <= 0 {
+			continue
+		}
+
+		taken := math.Min(available, remaining)
+		remaining -= taken
+
+		pantryStore(pantryItem, unit, factor, available-taken)
+		pantryItem.Entity.DateUpdate = now
+
+		consumed = append(consumed, pantryItem)
+	}
+
+	return consumed
+}
+
+// pantryStore sets the quantity left of the pantry item, the quantity is in the unit which is the factor times smaller
+// than the unit of the item. The quantity is rounded only here, the unit of the item is replaced by the smaller one
+// when the quantity left would be rounded in the unit of the item.
+func pantryStore(pantryItem *aggregate.PantryItem, unit *entity.Unit, factor float64, left float64) {
+	quantity := left / factor
+
+	if factor > 1 && math.Abs(quantity-math.Round(quantity)) > pantryPrecision {
+		pantryItem.Unit = unit
+		pantryItem.Entity.UnitId = unit.Id
+		quantity = left
+	}
+
+	pantryItem.Entity.Quantity = MathMaxInt(int64(math.Round(quantity)), 0)
+}
+
+func pantryAvailable(pantryItems []*aggregate.PantryItem, now time.Time) []*aggregate.PantryItem {
+	var available []*aggregate.PantryItem
+
+	for _, pantryItem := range pantryItems {
+		if pantryItem == nil || pantryItem.Entity == nil || pantryItem.Entity.Status != kind.PantryItemStatusActive {
+			continue
+		}
+
+		if !pantryItem.Entity.ExpiryTime.IsZero() && pantryItem.Entity.ExpiryTime.Before(now) {
+			continue
+		}
+
+		available = append(available, pantryItem)
+	}
+
+	sort.SliceStable(
+		available,
+		func(i, j int) bool {
+			if available[j].Entity.ExpiryTime.IsZero() {
+				return !available[i].Entity.ExpiryTime.IsZero()
+			} else if available[i].Entity.ExpiryTime.IsZero() {
+				return false
+			}
+
+			return available[i].Entity.ExpiryTime.Before(available[j].Entity.ExpiryTime)
+		},
+	)
+
+	return available
+}
+
+func pantryRemains(pantryItems []*aggregate.PantryItem) map[uuid.UUID]float64 {
+	remains := make(map[uuid.UUID]float64, len(pantryItems))
+
+	for _, pantryItem := range pantryItems {
+		remains[pantryItem.Entity.Id] = float64(pantryItem.Entity.Quantity)
+	}
+
+	return remains
+}
+
+// pantryTake takes the amount of the ingredient which is in the unit from the remains of the pantry items
+// and returns the amount which has been taken. The remains are kept without rounding, so taking small amounts many
+// times subtracts as much as taking them at once.
+func pantryTake(
+	pantryItems []*aggregate.PantryItem,
+	remains map[uuid.UUID]float64,
+	ingredientId uuid.UUID,
+	unit *entity.Unit,
+	amount int64,
+) int64 {
+	var taken float64
+
+	for _, pantryItem := range pantryItems {
+		if taken >= float64(amount) {
+			break
+		}
+
+		if pantryItem.Entity.IngredientId != ingredientId {
+			continue
+		}
+
+		factor, errorUnitFactor := unitFactor(pantryItem.Unit, unit)
+
+		if errorUnitFactor != nil {
+			continue
+		}
+
+		available := remains[pantryItem.Entity.Id] * factor
+
+		if available <= 0 {
+			continue
+		}
+
+		takenFromItem := math.Min(available, float64(amount)-taken)
+		taken += takenFromItem
+		remains[pantryItem.Entity.Id] = math.Max(remains[pantryItem.Entity.Id]-takenFromItem/factor, 0)
+	}
+
+	return int64(math.Round(taken))
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testPantryNow        = time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	testPantryGram       = &entity.Unit{Id: uuid.New(), Name: "g"}
+	testPantryKilogram   = &entity.Unit{Id: uuid.New(), Name: "kg"}
+	testPantryPiece      = &entity.Unit{Id: uuid.New(), Name: "pcs"}
+	testPantryFlour      = &entity.Ingredient{Id: uuid.New(), Name: "Flour"}
+	testPantryEgg        = &entity.Ingredient{Id: uuid.New(), Name: "Egg"}
+	testPantryMilk       = &entity.Ingredient{Id: uuid.New(), Name: "Milk"}
+	testPantryExpired    = time.Date(2000, time.January, 9, 0, 0, 0, 0, time.UTC)
+	testPantryNotExpired = time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC)
+)
+
+func testPantryItem(ingredient *entity.Ingredient, unit *entity.Unit, quantity int64, expiryTime time.Time, status kind.PantryItemStatus) *aggregate.PantryItem {
+	return &aggregate.PantryItem{
+		Entity: &entity.PantryItem{
+			Id:           uuid.New(),
+			IngredientId: ingredient.Id,
+			UnitId:       unit.Id,
+			ExpiryTime:   expiryTime,
+			Quantity:     quantity,
+			Status:       status,
+		},
+		Ingredient: ingredient,
+		Unit:       unit,
+	}
+}
+
+func TestPantrySubtract(t *testing.T) {
+	tests := []struct {
+		Name                string
+		PlannerCalculations []*aggregate.PlannerCalculation
+		PantryItems         []*aggregate.PantryItem
+		Expected            map[uuid.UUID]int64
+	}{
+		{
+			Name: "Test case with PantrySubtract with the pantry in another unit",
+			PlannerCalculations: []*aggregate.PlannerCalculation{
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 1500},
+				{Ingredient: testPantryEgg, Unit: testPantryPiece, Amount: 6},
+			},
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryFlour, testPantryKilogram, 1, time.Time{}, kind.PantryItemStatusActive),
+				testPantryItem(testPantryEgg, testPantryPiece, 10, testPantryNotExpired, kind.PantryItemStatusActive),
+			},
+			Expected: map[uuid.UUID]int64{testPantryFlour.Id: 500},
+		},
+		{
+			Name: "Test case with PantrySubtract with expired and inactive items",
+			PlannerCalculations: []*aggregate.PlannerCalculation{
+				{Ingredient: testPantryEgg, Unit: testPantryPiece, Amount: 6},
+				{Ingredient: testPantryMilk, Unit: testPantryGram, Amount: 200},
+			},
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryEgg, testPantryPiece, 10, testPantryExpired, kind.PantryItemStatusActive),
+				testPantryItem(testPantryMilk, testPantryGram, 500, time.Time{}, kind.PantryItemStatusInActive),
+			},
+			Expected: map[uuid.UUID]int64{testPantryEgg.Id: 6, testPantryMilk.Id: 200},
+		},
+		{
+			Name: "Test case with PantrySubtract with the same stock for different units",
+			PlannerCalculations: []*aggregate.PlannerCalculation{
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 700},
+				{Ingredient: testPantryFlour, Unit: testPantryKilogram, Amount: 1},
+			},
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryFlour, testPantryGram, 1000, time.Time{}, kind.PantryItemStatusActive),
+			},
+			Expected: map[uuid.UUID]int64{testPantryFlour.Id: 1},
+		},
+		{
+			Name: "Test case with PantrySubtract with small amounts from the pantry in a larger unit",
+			PlannerCalculations: []*aggregate.PlannerCalculation{
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 300},
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 300},
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 300},
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 300},
+			},
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryFlour, testPantryKilogram, 1, time.Time{}, kind.PantryItemStatusActive),
+			},
+			Expected: map[uuid.UUID]int64{testPantryFlour.Id: 200},
+		},
+		{
+			Name: "Test case with PantrySubtract with the incompatible units",
+			PlannerCalculations: []*aggregate.PlannerCalculation{
+				{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 100},
+			},
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryFlour, testPantryPiece, 1, time.Time{}, kind.PantryItemStatusActive),
+			},
+			Expected: map[uuid.UUID]int64{testPantryFlour.Id: 100},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				shoppingList := PantrySubtract(testCase.PlannerCalculations, testCase.PantryItems, testPantryNow)
+
+				assert.Len(t, shoppingList, len(testCase.Expected))
+
+				for _, shoppingListItem := range shoppingList {
+					assert.Equal(t, testCase.Expected[shoppingListItem.Ingredient.Id], shoppingListItem.Amount)
+				}
+			},
+		)
+	}
+}
+
+func TestPantryConsume(t *testing.T) {
+	tests := []struct {
+		Name         string
+		PantryItems  []*aggregate.PantryItem
+		IngredientId uuid.UUID
+		Unit         *entity.Unit
+		Amount       int64
+		Expected     []int64
+		Consumed     int
+	}{
+		{
+			Name: "Test case with PantryConsume with the items that expire first",
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryFlour, testPantryGram, 300, time.Time{}, kind.PantryItemStatusActive),
+				testPantryItem(testPantryFlour, testPantryKilogram, 1, testPantryNotExpired, kind.PantryItemStatusActive),
+			},
+			IngredientId: testPantryFlour.Id,
+			Unit:         testPantryGram,
+			Amount:       1200,
+			Expected:     []int64{100, 0},
+			Consumed:     2,
+		},
+		{
+			Name: "Test case with PantryConsume with more than the pantry has",
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryEgg, testPantryPiece, 2, time.Time{}, kind.PantryItemStatusActive),
+				testPantryItem(testPantryFlour, testPantryGram, 300, time.Time{}, kind.PantryItemStatusActive),
+			},
+			IngredientId: testPantryEgg.Id,
+			Unit:         testPantryPiece,
+			Amount:       5,
+			Expected:     []int64{0, 300},
+			Consumed:     1,
+		},
+		{
+			Name: "Test case with PantryConsume with the expired item",
+			PantryItems: []*aggregate.PantryItem{
+				testPantryItem(testPantryEgg, testPantryPiece, 2, testPantryExpired, kind.PantryItemStatusActive),
+			},
+			IngredientId: testPantryEgg.Id,
+			Unit:         testPantryPiece,
+			Amount:       1,
+			Expected:     []int64{2},
+			Consumed:     0,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				consumed := PantryConsume(testCase.PantryItems, testCase.IngredientId, testCase.Unit, testCase.Amount, testPantryNow)
+
+				assert.Len(t, consumed, testCase.Consumed)
+
+				for i, pantryItem := range testCase.PantryItems {
+					assert.Equal(t, testCase.Expected[i], pantryItem.Entity.Quantity)
+				}
+			},
+		)
+	}
+}
+
+func TestPantryConsumeRepeatedly(t *testing.T) {
+	pantryItem := testPantryItem(testPantryFlour, testPantryKilogram, 1, time.Time{}, kind.PantryItemStatusActive)
+	pantryItems := []*aggregate.PantryItem{pantryItem}
+
+	for _, expected := range []int64{700, 400, 100} {
+		consumed := PantryConsume(pantryItems, testPantryFlour.Id, testPantryGram, 300, testPantryNow)
+
+		assert.Len(t, consumed, 1)
+		assert.Equal(t, expected, pantryItem.Entity.Quantity)
+		assert.Equal(t, testPantryGram.Id, pantryItem.Entity.UnitId)
+	}
+
+	consumed := PantryConsume(pantryItems, testPantryFlour.Id, testPantryKilogram, 1, testPantryNow)
+
+	assert.Len(t, consumed, 1)
+	assert.Equal(t, int64(0), pantryItem.Entity.Quantity)
+}
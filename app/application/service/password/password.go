@@ -3,12 +3,15 @@ package password
 import (
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
+	"sync"
 )
 
 const UserPasswordCost = 14
 
 var (
 	passwordSalt      string
+	passwordDummy     []byte
+	passwordDummyOnce sync.Once
 	errorCostOfHashed = errors.New("password hash is not properly hashed")
 )
 
@@ -22,8 +25,23 @@ func CastPassword(password string) ([]byte, error) {
 	return bytes, errorGenerateFromPassword
 }
 
+// CheckPassword reports whether the password matches the hash.
 func CheckPassword(hash string, password string) bool {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(passwordSalt+password)) != nil
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(passwordSalt+password)) == nil
+}
+
+// CheckPasswordDummy takes as long as CheckPassword and always fails, it is called when there is no user to check
+// the password of, so the response time doesn't reveal whether the user exists.
+func CheckPasswordDummy(password string) bool {
+	passwordDummyOnce.Do(
+		func() {
+			passwordDummy, _ = bcrypt.GenerateFromPassword([]byte(passwordSalt), UserPasswordCost)
+		},
+	)
+
+	_ = bcrypt.CompareHashAndPassword(passwordDummy, []byte(passwordSalt+password))
+
+	return false
 }
 
 func CheckHashedPassword(password *string) (bool, error) {
@@ -52,3 +52,48 @@ func TestCheckHashedPassword(t *testing.T) {
 		)
 	}
 }
+
+func TestCheckPassword(t *testing.T) {
+	password := "password"
+	hashedPassword, _ := CastPassword(password)
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		expected bool
+	}{
+		{
+			name:     "Test case with the right password",
+			hash:     string(hashedPassword),
+			password: password,
+			expected: true,
+		},
+		{
+			name:     "Test case with a wrong password",
+			hash:     string(hashedPassword),
+			password: "wrong",
+			expected: false,
+		},
+		{
+			name:     "Test case with a plain hash",
+			hash:     password,
+			password: password,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, CheckPassword(testCase.hash, testCase.password))
+			},
+		)
+	}
+}
+
+func TestCheckPasswordDummy(t *testing.T) {
+	assert.False(t, CheckPasswordDummy(""))
+	assert.False(t, CheckPasswordDummy("password"))
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// PantryIngredients returns the ids of ingredients which are available in the pantry at the moment.
+func PantryIngredients(pantryItems []*aggregate.PantryItem, now time.Time) map[uuid.UUID]bool {
+	pantryIngredients := map[uuid.UUID]bool{}
+
+	for _, pantryItem := range pantryAvailable(pantryItems, now) {
+		if pantryItem.Entity.Quantity > 0 {
+			pantryIngredients[pantryItem.Entity.IngredientId] = true
+		}
+	}
+
+	return pantryIngredients
+}
+
+// PlannerGenerate picks a recipe for every empty interval of a planner satisfying the constraints.
+// The recipes are shuffled by the seed of the constraints, so the same seed and the same data give the same plan.
+// Among the suitable recipes the ones keeping the budget for the rest of intervals are preferred, then the ones
+// with more ingredients in the pantry when it is requested and then the less repeated ones. The intervals which cannot be filled are reported as warnings.
+// The recipes of the user are suitable whatever their status, the other ones only when they are published.
+func PlannerGenerate(
+	intervals []*aggregate.PlannerInterval,
+	recipes []*aggregate.Recipe,
+	userId *uuid.UUID,
+	constraints *aggregate.PlannerGenerateConstraints,
+	recipeCosts map[uuid.UUID]int64,
+	pantryIngredients map[uuid.UUID]bool,
+) (assignments []*aggregate.PlannerGenerateAssignment, total int64, warnings []string) {
+	var candidates []*aggregate.Recipe
+
+	repeats := map[uuid.UUID]int64{}
+	slots := map[uuid.UUID]*aggregate.PlannerGenerateSlot{}
+
+	for _, slot := range constraints.Slots {
+		if slot != nil {
+			slots[slot.IntervalId] = slot
+		}
+	}
+
+	for _, recipe := range recipes {
+		if recipe == nil || recipe.Entity == nil {
+			continue
+		} else if recipe.Entity.Status != kind.RecipeStatusPublished && (userId == nil || recipe.Entity.UserId != *userId) {
+			continue
+		}
+
+		if recipeHasCategories(recipe, constraints.Tags, true) {
+			candidates = append(candidates, recipe)
+		}
+	}
+
+	sort.SliceStable(
+		candidates,
+		func(i, j int) bool {
+			return candidates[i].Entity.Id.String() < candidates[j].Entity.Id.String()
+		},
+	)
+
+	random := rand.New(rand.NewSource(constraints.Seed))
+	random.Shuffle(
+		len(candidates),
+		func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		},
+	)
+
+	var plannerIntervals []*aggregate.PlannerInterval
+
+	for _, interval := range intervals {
+		if interval == nil || interval.Entity == nil || interval.Entity.Status != kind.PlannerIntervalStatusActive {
+			continue
+		}
+
+		plannerIntervals = append(plannerIntervals, interval)
+	}
+
+	sort.SliceStable(
+		plannerIntervals,
+		func(i, j int) bool {
+			return plannerIntervals[i].Entity.StartTime.Before(plannerIntervals[j].Entity.StartTime)
+		},
+	)
+
+	var emptyIntervals []*aggregate.PlannerInterval
+
+	for _, interval := range plannerIntervals {
+		if plannerIntervalKept(interval, constraints.Replace) {
+			for _, plannerRecipe := range interval.Recipes {
+				if plannerRecipe == nil || plannerRecipe.Entity == nil {
+					continue
+				}
+
+				repeats[plannerRecipe.Entity.RecipeId]++
+				total += recipeCosts[plannerRecipe.Entity.RecipeId]
+			}
+		} else {
+			emptyIntervals = append(emptyIntervals, interval)
+		}
+	}
+
+	for index, interval := range emptyIntervals {
+		var (
+			chosen      *aggregate.Recipe
+			chosenScore [3]int64
+		)
+
+		slot := slots[interval.Entity.Id]
+		categories, caloriesMin, caloriesMax := plannerGenerateSlotConstraints(slot, constraints)
+		budgetShare := int64(0)
+
+		if constraints.Budget > 0 {
+			budgetShare = (constraints.Budget - total) / int64(len(emptyIntervals)-index)
+		}
+
+		for _, recipe := range candidates {
+			recipeId := recipe.Entity.Id
+
+			if constraints.MaxRepeats > 0 && repeats[recipeId] >= constraints.MaxRepeats {
+				continue
+			} else if !recipeHasCategories(recipe, categories, false) {
+				continue
+			} else if caloriesMin > 0 && recipe.Entity.Calories < caloriesMin {
+				continue
+			} else if caloriesMax > 0 && recipe.Entity.Calories > caloriesMax {
+				continue
+			} else if constraints.Budget > 0 && total+recipeCosts[recipeId] > constraints.Budget {
+				continue
+			}
+
+			// the recipes which keep the budget for the rest of intervals go first, then the ones with
+			// ingredients from the pantry and then the less repeated ones
+			score := [3]int64{0, 0, -repeats[recipeId]}
+
+			if constraints.Budget > 0 && recipeCosts[recipeId] <= budgetShare {
+				score[0] = 1
+			}
+
+			if constraints.PreferPantry {
+				score[1] = recipePantryScore(recipe, pantryIngredients)
+			}
+
+			if chosen == nil || plannerGenerateScoreGreater(score, chosenScore) {
+				chosen = recipe
+				chosenScore = score
+			}
+		}
+
+		if chosen == nil {
+			warnings = append(
+				warnings,
+				fmt.Sprintf("there is no recipe satisfying the constraints for the interval %s", interval.Entity.Name),
+			)
+
+			continue
+		}
+
+		repeats[chosen.Entity.Id]++
+		total += recipeCosts[chosen.Entity.Id]
+		assignments = append(
+			assignments,
+			&aggregate.PlannerGenerateAssignment{
+				Interval: interval.Entity,
+				Recipe:   chosen.Entity,
+				Cost:     recipeCosts[chosen.Entity.Id],
+			},
+		)
+	}
+
+	return assignments, total, warnings
+}
+
+func plannerGenerateScoreGreater(score [3]int64, chosenScore [3]int64) bool {
+	for i := range score {
+		if score[i] != chosenScore[i] {
+			return score[i] > chosenScore[i]
+		}
+	}
+
+	return false
+}
+
+func plannerIntervalKept(interval *aggregate.PlannerInterval, replace bool) bool {
+	for _, plannerRecipe := range interval.Recipes {
+		if plannerRecipe == nil || plannerRecipe.Entity == nil {
+			continue
+		}
+
+		if !replace || plannerRecipe.Entity.Status == kind.PlannerRecipeStatusCooked {
+			return true
+		}
+	}
+
+	return false
+}
+
+func plannerGenerateSlotConstraints(
+	slot *aggregate.PlannerGenerateSlot,
+	constraints *aggregate.PlannerGenerateConstraints,
+) ([]uuid.UUID, int64, int64) {
+	caloriesMin, caloriesMax := constraints.CaloriesMin, constraints.CaloriesMax
+
+	if slot == nil {
+		return nil, caloriesMin, caloriesMax
+	}
+
+	if slot.CaloriesMin > 0 {
+		caloriesMin = slot.CaloriesMin
+	}
+
+	if slot.CaloriesMax > 0 {
+		caloriesMax = slot.CaloriesMax
+	}
+
+	return slot.Categories, caloriesMin, caloriesMax
+}
+
+// recipeHasCategories checks the categories of a recipe, all of them are required when the flag is set,
+// otherwise any of them is enough. An empty list of categories is always satisfied.
+func recipeHasCategories(recipe *aggregate.Recipe, categories []uuid.UUID, all bool) bool {
+	if len(categories) == 0 {
+		return true
+	}
+
+	recipeCategories := map[uuid.UUID]bool{}
+
+	for _, recipeCategory := range recipe.Categories {
+		if recipeCategory != nil && recipeCategory.Entity != nil {
+			recipeCategories[recipeCategory.Entity.DeriveId] = true
+		}
+	}
+
+	for _, category := range categories {
+		if recipeCategories[category] && !all {
+			return true
+		} else if !recipeCategories[category] && all {
+			return false
+		}
+	}
+
+	return all
+}
+
+func recipePantryScore(recipe *aggregate.Recipe, pantryIngredients map[uuid.UUID]bool) int64 {
+	var score int64
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if recipeIngredient != nil && recipeIngredient.Entity != nil && pantryIngredients[recipeIngredient.Entity.DeriveId] {
+			score++
+		}
+	}
+
+	return score
+}
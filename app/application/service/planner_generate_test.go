@@ -0,0 +1,238 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testGenerateBreakfast  = uuid.New()
+	testGenerateDinner     = uuid.New()
+	testGenerateVegetarian = uuid.New()
+	testGenerateEgg        = uuid.New()
+	testGenerateBeef       = uuid.New()
+)
+
+func testGenerateRecipe(name string, calories int64, categories []uuid.UUID, ingredients []uuid.UUID) *aggregate.Recipe {
+	recipe := &aggregate.Recipe{
+		Entity: &entity.Recipe{Id: uuid.New(), Name: name, Calories: calories, Status: kind.RecipeStatusPublished},
+	}
+
+	for _, category := range categories {
+		recipe.Categories = append(
+			recipe.Categories,
+			&aggregate.RecipeCategory{Entity: &entity.RecipeCategory{EntityId: recipe.Entity.Id, DeriveId: category}},
+		)
+	}
+
+	for _, ingredient := range ingredients {
+		recipe.Ingredients = append(
+			recipe.Ingredients,
+			&aggregate.RecipeIngredient{Entity: &entity.RecipeIngredient{EntityId: recipe.Entity.Id, DeriveId: ingredient}},
+		)
+	}
+
+	return recipe
+}
+
+func testGenerateIntervals(count int) []*aggregate.PlannerInterval {
+	var intervals []*aggregate.PlannerInterval
+
+	for i := 0; i < count; i++ {
+		intervals = append(
+			intervals,
+			&aggregate.PlannerInterval{
+				Entity: &entity.PlannerInterval{
+					Id:        uuid.New(),
+					Name:      "Interval",
+					StartTime: time.Date(2000, time.January, 10+i, 0, 0, 0, 0, time.UTC),
+					Status:    kind.PlannerIntervalStatusActive,
+				},
+			},
+		)
+	}
+
+	return intervals
+}
+
+func testGenerateRecipeNames(assignments []*aggregate.PlannerGenerateAssignment) []string {
+	var names []string
+
+	for _, assignment := range assignments {
+		names = append(names, assignment.Recipe.Name)
+	}
+
+	return names
+}
+
+func TestPlannerGenerateDeterministic(t *testing.T) {
+	var recipes []*aggregate.Recipe
+
+	for _, name := range []string{"A", "B", "C", "D", "E", "F"} {
+		recipes = append(recipes, testGenerateRecipe(name, 0, nil, nil))
+	}
+
+	intervals := testGenerateIntervals(6)
+	constraints := &aggregate.PlannerGenerateConstraints{Seed: 42}
+
+	first, _, warnings := PlannerGenerate(intervals, recipes, nil, constraints, nil, nil)
+	second, _, _ := PlannerGenerate(intervals, recipes, nil, constraints, nil, nil)
+
+	assert.Empty(t, warnings)
+	assert.Len(t, first, 6)
+	assert.Equal(t, testGenerateRecipeNames(first), testGenerateRecipeNames(second))
+	assert.ElementsMatch(t, []string{"A", "B", "C", "D", "E", "F"}, testGenerateRecipeNames(first))
+}
+
+func TestPlannerGenerateConstraints(t *testing.T) {
+	omelette := testGenerateRecipe("Omelette", 350, []uuid.UUID{testGenerateBreakfast, testGenerateVegetarian}, []uuid.UUID{testGenerateEgg})
+	porridge := testGenerateRecipe("Porridge", 250, []uuid.UUID{testGenerateBreakfast, testGenerateVegetarian}, nil)
+	steak := testGenerateRecipe("Steak", 900, []uuid.UUID{testGenerateDinner}, []uuid.UUID{testGenerateBeef})
+	salad := testGenerateRecipe("Salad", 300, []uuid.UUID{testGenerateDinner, testGenerateVegetarian}, nil)
+	draft := testGenerateRecipe("Draft", 300, []uuid.UUID{testGenerateDinner, testGenerateVegetarian}, nil)
+	draft.Entity.Status = kind.RecipeStatusUnPublished
+	recipes := []*aggregate.Recipe{omelette, porridge, steak, salad, draft}
+
+	t.Run(
+		"Test case with slot categories and dietary tags",
+		func(t *testing.T) {
+			intervals := testGenerateIntervals(2)
+			assignments, _, warnings := PlannerGenerate(
+				intervals,
+				recipes,
+				nil,
+				&aggregate.PlannerGenerateConstraints{
+					Seed: 1,
+					Tags: []uuid.UUID{testGenerateVegetarian},
+					Slots: []*aggregate.PlannerGenerateSlot{
+						{IntervalId: intervals[0].Entity.Id, Categories: []uuid.UUID{testGenerateBreakfast}},
+						{IntervalId: intervals[1].Entity.Id, Categories: []uuid.UUID{testGenerateDinner}},
+					},
+				},
+				nil,
+				nil,
+			)
+
+			assert.Empty(t, warnings)
+			assert.Len(t, assignments, 2)
+			assert.Contains(t, []string{"Omelette", "Porridge"}, assignments[0].Recipe.Name)
+			assert.Equal(t, "Salad", assignments[1].Recipe.Name)
+		},
+	)
+
+	t.Run(
+		"Test case with max repeats and calories",
+		func(t *testing.T) {
+			assignments, _, warnings := PlannerGenerate(
+				testGenerateIntervals(3),
+				recipes,
+				nil,
+				&aggregate.PlannerGenerateConstraints{Seed: 1, MaxRepeats: 1, CaloriesMin: 300, CaloriesMax: 400},
+				nil,
+				nil,
+			)
+
+			assert.ElementsMatch(t, []string{"Omelette", "Salad"}, testGenerateRecipeNames(assignments))
+			assert.Len(t, warnings, 1)
+		},
+	)
+
+	t.Run(
+		"Test case with an unpublished recipe of the user",
+		func(t *testing.T) {
+			userId := uuid.New()
+			draft.Entity.UserId = userId
+
+			assignments, _, warnings := PlannerGenerate(
+				testGenerateIntervals(3),
+				recipes,
+				&userId,
+				&aggregate.PlannerGenerateConstraints{Seed: 1, MaxRepeats: 1, CaloriesMin: 300, CaloriesMax: 400},
+				nil,
+				nil,
+			)
+
+			draft.Entity.UserId = uuid.Nil
+
+			assert.ElementsMatch(t, []string{"Omelette", "Salad", "Draft"}, testGenerateRecipeNames(assignments))
+			assert.Empty(t, warnings)
+		},
+	)
+
+	t.Run(
+		"Test case with budget",
+		func(t *testing.T) {
+			assignments, total, warnings := PlannerGenerate(
+				testGenerateIntervals(2),
+				recipes,
+				nil,
+				&aggregate.PlannerGenerateConstraints{Seed: 1, MaxRepeats: 1, Budget: 500},
+				map[uuid.UUID]int64{omelette.Entity.Id: 300, porridge.Entity.Id: 400, steak.Entity.Id: 1500, salad.Entity.Id: 200},
+				nil,
+			)
+
+			assert.Len(t, assignments, 2)
+			assert.LessOrEqual(t, total, int64(500))
+			assert.Empty(t, warnings)
+		},
+	)
+
+	t.Run(
+		"Test case with pantry preference",
+		func(t *testing.T) {
+			assignments, _, _ := PlannerGenerate(
+				testGenerateIntervals(1),
+				recipes,
+				nil,
+				&aggregate.PlannerGenerateConstraints{Seed: 7, PreferPantry: true},
+				nil,
+				map[uuid.UUID]bool{testGenerateBeef: true},
+			)
+
+			assert.Equal(t, []string{"Steak"}, testGenerateRecipeNames(assignments))
+		},
+	)
+
+	t.Run(
+		"Test case with kept and replaced intervals",
+		func(t *testing.T) {
+			intervals := testGenerateIntervals(2)
+			intervals[0].Recipes = []*aggregate.PlannerRecipe{
+				{Entity: &entity.PlannerRecipe{RecipeId: steak.Entity.Id, Status: kind.PlannerRecipeStatusCooked}},
+			}
+			intervals[1].Recipes = []*aggregate.PlannerRecipe{
+				{Entity: &entity.PlannerRecipe{RecipeId: steak.Entity.Id, Status: kind.PlannerRecipeStatusActive}},
+			}
+
+			assignments, _, _ := PlannerGenerate(intervals, recipes, nil, &aggregate.PlannerGenerateConstraints{Seed: 1}, nil, nil)
+			assert.Empty(t, assignments)
+
+			assignments, _, _ = PlannerGenerate(
+				intervals,
+				recipes,
+				nil,
+				&aggregate.PlannerGenerateConstraints{Seed: 1, Replace: true, MaxRepeats: 1},
+				nil,
+				nil,
+			)
+			assert.Len(t, assignments, 1)
+			assert.Equal(t, intervals[1].Entity.Id, assignments[0].Interval.Id)
+			assert.NotEqual(t, "Steak", assignments[0].Recipe.Name)
+		},
+	)
+}
+
+func TestPantryIngredients(t *testing.T) {
+	now := time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	pantryItems := []*aggregate.PantryItem{
+		{Entity: &entity.PantryItem{IngredientId: testGenerateEgg, Quantity: 2, Status: kind.PantryItemStatusActive}},
+		{Entity: &entity.PantryItem{IngredientId: testGenerateBeef, Quantity: 1, ExpiryTime: now.AddDate(0, 0, -1), Status: kind.PantryItemStatusActive}},
+	}
+
+	assert.Equal(t, map[uuid.UUID]bool{testGenerateEgg: true}, PantryIngredients(pantryItems, now))
+}
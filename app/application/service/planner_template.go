@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+const minutesInDay = 24 * 60
+
+// PlannerTemplateDays returns the number of days of a planner made by the template. The days of the template
+// are used when they are set, otherwise the days of the recurrence or the days which the intervals take.
+func PlannerTemplateDays(plannerTemplate *aggregate.PlannerTemplate) int64 {
+	if plannerTemplate.Entity.Days > 0 {
+		return plannerTemplate.Entity.Days
+	} else if days := plannerTemplate.Entity.Recurrence.Days(); days > 0 {
+		return int64(days)
+	}
+
+	var endOffset int64
+
+	for _, plannerTemplateInterval := range plannerTemplate.Intervals {
+		endOffset = MathMaxInt(endOffset, MathMaxInt(plannerTemplateInterval.StartOffset, plannerTemplateInterval.EndOffset))
+	}
+
+	return MathMaxInt(1, (endOffset+minutesInDay-1)/minutesInDay)
+}
+
+// PlannerTemplateInstantiate makes a planner with intervals and recipes from the template for the start time.
+// The entities have not got ids, they are assigned while inserting.
+func PlannerTemplateInstantiate(plannerTemplate *aggregate.PlannerTemplate, startTime time.Time, now time.Time) *aggregate.Planner {
+	days := PlannerTemplateDays(plannerTemplate)
+	planner := &aggregate.Planner{
+		Entity: &entity.Planner{
+			UserId:     plannerTemplate.Entity.UserId,
+			DateInsert: now,
+			DateUpdate: now,
+			StartTime:  startTime,
+			EndTime:    startTime.AddDate(0, 0, int(days)).Add(-time.Second),
+			Name:       fmt.Sprintf("%s %s", plannerTemplate.Entity.Name, startTime.Format("2006-01-02")),
+			Status:     kind.PlannerStatusActive,
+		},
+	}
+
+	for _, plannerTemplateInterval := range plannerTemplate.Intervals {
+		if plannerTemplateInterval == nil || plannerTemplateInterval.Status != kind.PlannerTemplateIntervalStatusActive {
+			continue
+		}
+
+		endOffset := MathMaxInt(plannerTemplateInterval.StartOffset, plannerTemplateInterval.EndOffset)
+		plannerInterval := &aggregate.PlannerInterval{
+			Entity: &entity.PlannerInterval{
+				UserId:     plannerTemplate.Entity.UserId,
+				DateInsert: now,
+				DateUpdate: now,
+				StartTime:  startTime.Add(time.Duration(plannerTemplateInterval.StartOffset) * time.Minute),
+				EndTime:    startTime.Add(time.Duration(endOffset) * time.Minute),
+				Name:       plannerTemplateInterval.Name,
+				Status:     kind.PlannerIntervalStatusActive,
+			},
+		}
+
+		for _, recipeId := range plannerTemplateInterval.RecipeIds {
+			plannerInterval.Recipes = append(
+				plannerInterval.Recipes,
+				&aggregate.PlannerRecipe{
+					Entity: &entity.PlannerRecipe{
+						UserId:     plannerTemplate.Entity.UserId,
+						RecipeId:   recipeId,
+						DateInsert: now,
+						DateUpdate: now,
+						Status:     kind.PlannerRecipeStatusActive,
+					},
+				},
+			)
+		}
+
+		planner.Intervals = append(planner.Intervals, plannerInterval)
+	}
+
+	return planner
+}
+
+// PlannerTemplateSchedule returns the start times of planners which the recurrence of the template has to make
+// by the moment and the next time of the template after them. The next planner is made as soon as the previous
+// one has started, the planners which would have already ended are skipped.
+func PlannerTemplateSchedule(plannerTemplate *entity.PlannerTemplate, now time.Time) ([]time.Time, time.Time) {
+	var startTimes []time.Time
+
+	days := plannerTemplate.Recurrence.Days()
+	nextTime := plannerTemplate.NextTime
+
+	if days == 0 || nextTime.IsZero() || plannerTemplate.Status != kind.PlannerTemplateStatusActive {
+		return nil, nextTime
+	}
+
+	for !nextTime.AddDate(0, 0, -days).After(now) {
+		if nextTime.AddDate(0, 0, days).After(now) {
+			startTimes = append(startTimes, nextTime)
+		}
+
+		nextTime = nextTime.AddDate(0, 0, days)
+	}
+
+	return startTimes, nextTime
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testPlannerTemplate(days int64, recurrence kind.PlannerTemplateRecurrence) *aggregate.PlannerTemplate {
+	return &aggregate.PlannerTemplate{
+		Entity: &entity.PlannerTemplate{
+			Id:         uuid.New(),
+			UserId:     uuid.New(),
+			Name:       "Week",
+			Days:       days,
+			Recurrence: recurrence,
+			Status:     kind.PlannerTemplateStatusActive,
+		},
+		Intervals: []*entity.PlannerTemplateInterval{
+			{Name: "Monday breakfast", StartOffset: 420, EndOffset: 480, RecipeIds: []uuid.UUID{uuid.New()}, Status: kind.PlannerTemplateIntervalStatusActive},
+			{Name: "Tuesday dinner", StartOffset: 2520, EndOffset: 2640, Status: kind.PlannerTemplateIntervalStatusActive},
+			{Name: "Skipped", StartOffset: 5000, EndOffset: 5100, Status: kind.PlannerTemplateIntervalStatusInActive},
+		},
+	}
+}
+
+func TestPlannerTemplateDays(t *testing.T) {
+	assert.Equal(t, int64(3), PlannerTemplateDays(testPlannerTemplate(3, kind.PlannerTemplateRecurrenceWeekly)))
+	assert.Equal(t, int64(14), PlannerTemplateDays(testPlannerTemplate(0, kind.PlannerTemplateRecurrenceFortnightly)))
+	assert.Equal(t, int64(4), PlannerTemplateDays(testPlannerTemplate(0, kind.PlannerTemplateRecurrenceNone)))
+	assert.Equal(t, int64(1), PlannerTemplateDays(&aggregate.PlannerTemplate{Entity: &entity.PlannerTemplate{}}))
+}
+
+func TestPlannerTemplateInstantiate(t *testing.T) {
+	now := time.Date(2000, time.January, 5, 12, 0, 0, 0, time.UTC)
+	startTime := time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	plannerTemplate := testPlannerTemplate(0, kind.PlannerTemplateRecurrenceWeekly)
+	planner := PlannerTemplateInstantiate(plannerTemplate, startTime, now)
+
+	assert.Equal(t, "Week 2000-01-10", planner.Entity.Name)
+	assert.Equal(t, plannerTemplate.Entity.UserId, planner.Entity.UserId)
+	assert.Equal(t, startTime, planner.Entity.StartTime)
+	assert.Equal(t, time.Date(2000, time.January, 16, 23, 59, 59, 0, time.UTC), planner.Entity.EndTime)
+	assert.Equal(t, kind.PlannerStatusActive, planner.Entity.Status)
+	assert.Len(t, planner.Intervals, 2)
+
+	assert.Equal(t, "Monday breakfast", planner.Intervals[0].Entity.Name)
+	assert.Equal(t, time.Date(2000, time.January, 10, 7, 0, 0, 0, time.UTC), planner.Intervals[0].Entity.StartTime)
+	assert.Equal(t, time.Date(2000, time.January, 10, 8, 0, 0, 0, time.UTC), planner.Intervals[0].Entity.EndTime)
+	assert.Len(t, planner.Intervals[0].Recipes, 1)
+	assert.Equal(t, plannerTemplate.Intervals[0].RecipeIds[0], planner.Intervals[0].Recipes[0].Entity.RecipeId)
+	assert.Equal(t, kind.PlannerRecipeStatusActive, planner.Intervals[0].Recipes[0].Entity.Status)
+
+	assert.Equal(t, time.Date(2000, time.January, 11, 18, 0, 0, 0, time.UTC), planner.Intervals[1].Entity.StartTime)
+	assert.Empty(t, planner.Intervals[1].Recipes)
+}
+
+func TestPlannerTemplateSchedule(t *testing.T) {
+	nextTime := time.Date(2000, time.January, 17, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		recurrence         kind.PlannerTemplateRecurrence
+		status             kind.PlannerTemplateStatus
+		now                time.Time
+		expectedStartTimes []time.Time
+		expectedNextTime   time.Time
+	}{
+		{
+			name:             "Test case with weekly recurrence before the previous planner has started",
+			recurrence:       kind.PlannerTemplateRecurrenceWeekly,
+			status:           kind.PlannerTemplateStatusActive,
+			now:              time.Date(2000, time.January, 9, 0, 0, 0, 0, time.UTC),
+			expectedNextTime: nextTime,
+		},
+		{
+			name:               "Test case with weekly recurrence when the previous planner has started",
+			recurrence:         kind.PlannerTemplateRecurrenceWeekly,
+			status:             kind.PlannerTemplateStatusActive,
+			now:                time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			expectedStartTimes: []time.Time{nextTime},
+			expectedNextTime:   time.Date(2000, time.January, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "Test case with weekly recurrence skipping ended planners",
+			recurrence:         kind.PlannerTemplateRecurrenceWeekly,
+			status:             kind.PlannerTemplateStatusActive,
+			now:                time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedStartTimes: []time.Time{time.Date(2000, time.January, 31, 0, 0, 0, 0, time.UTC), time.Date(2000, time.February, 7, 0, 0, 0, 0, time.UTC)},
+			expectedNextTime:   time.Date(2000, time.February, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "Test case with fortnightly recurrence",
+			recurrence:         kind.PlannerTemplateRecurrenceFortnightly,
+			status:             kind.PlannerTemplateStatusActive,
+			now:                time.Date(2000, time.January, 3, 0, 0, 0, 0, time.UTC),
+			expectedStartTimes: []time.Time{nextTime},
+			expectedNextTime:   time.Date(2000, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Test case without recurrence",
+			recurrence:       kind.PlannerTemplateRecurrenceNone,
+			status:           kind.PlannerTemplateStatusActive,
+			now:              time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedNextTime: nextTime,
+		},
+		{
+			name:             "Test case with inactive template",
+			recurrence:       kind.PlannerTemplateRecurrenceWeekly,
+			status:           kind.PlannerTemplateStatusInActive,
+			now:              time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedNextTime: nextTime,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				startTimes, actualNextTime := PlannerTemplateSchedule(
+					&entity.PlannerTemplate{NextTime: nextTime, Recurrence: testCase.recurrence, Status: testCase.status},
+					testCase.now,
+				)
+
+				assert.Equal(t, testCase.expectedStartTimes, startTimes)
+				assert.Equal(t, testCase.expectedNextTime, actualNextTime)
+			},
+		)
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+var (
+	policyResourcesAdmin = []kind.UserResource{
+		kind.UserResourceUser,
+		kind.UserResourceRole,
+		kind.UserResourceMerge,
+		kind.UserResourceRecipe,
+		kind.UserResourcePlanner,
+		kind.UserResourcePantry,
+		kind.UserResourceShoppingList,
+		kind.UserResourceHousehold,
+		kind.UserResourceAccount,
+	}
+	policyResourcesCommon = []kind.UserResource{
+		kind.UserResourceRecipe,
+		kind.UserResourcePlanner,
+		kind.UserResourcePantry,
+		kind.UserResourceShoppingList,
+		kind.UserResourceHousehold,
+		kind.UserResourceAccount,
+	}
+)
+
+// PolicyDefaultPermissions returns the permissions of a built-in role, they are used until a role with the same code
+// is stored in the database.
+func PolicyDefaultPermissions(role kind.UserRole) []DomainEntity.UserPermission {
+	var resources []kind.UserResource
+
+	switch role {
+	case kind.UserRoleAdmin:
+		resources = policyResourcesAdmin
+	case kind.UserRoleCommon:
+		resources = policyResourcesCommon
+	default:
+		return nil
+	}
+
+	permissions := make([]DomainEntity.UserPermission, 0, len(resources))
+
+	for _, resource := range resources {
+		permissions = append(permissions, DomainEntity.UserPermission{Resource: resource, Rights: []kind.UserRight{kind.UserRightWrite}})
+	}
+
+	return permissions
+}
+
+// PolicyAllows reports whether the permissions give the right on the resource. The rights of a user to role binding
+// narrow the permissions of the role down when they are not empty.
+func PolicyAllows(permissions []DomainEntity.UserPermission, bindingRights []kind.UserRight, resource kind.UserResource, right kind.UserRight) bool {
+	if len(bindingRights) > 0 && !policyRightsAllow(bindingRights, right) {
+		return false
+	}
+
+	for _, permission := range permissions {
+		if permission.Resource == resource && policyRightsAllow(permission.Rights, right) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyRightsAllow(rights []kind.UserRight, required kind.UserRight) bool {
+	for _, right := range rights {
+		if HouseholdRightAllows(right, required) {
+			return true
+		}
+	}
+
+	return false
+}
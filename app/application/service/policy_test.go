@@ -0,0 +1,125 @@
+package service
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPolicyDefaultPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     kind.UserRole
+		resource kind.UserResource
+		right    kind.UserRight
+		expected bool
+	}{
+		{
+			name:     "Test case with admin role and role resource",
+			role:     kind.UserRoleAdmin,
+			resource: kind.UserResourceRole,
+			right:    kind.UserRightWrite,
+			expected: true,
+		},
+		{
+			name:     "Test case with admin role and planner resource",
+			role:     kind.UserRoleAdmin,
+			resource: kind.UserResourcePlanner,
+			right:    kind.UserRightRead,
+			expected: true,
+		},
+		{
+			name:     "Test case with common role and planner resource",
+			role:     kind.UserRoleCommon,
+			resource: kind.UserResourcePlanner,
+			right:    kind.UserRightWrite,
+			expected: true,
+		},
+		{
+			name:     "Test case with common role and user resource",
+			role:     kind.UserRoleCommon,
+			resource: kind.UserResourceUser,
+			right:    kind.UserRightRead,
+			expected: false,
+		},
+		{
+			name:     "Test case with unknown role",
+			role:     "random",
+			resource: kind.UserResourcePlanner,
+			right:    kind.UserRightRead,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, PolicyAllows(PolicyDefaultPermissions(testCase.role), nil, testCase.resource, testCase.right))
+			},
+		)
+	}
+}
+
+func TestPolicyAllows(t *testing.T) {
+	permissions := []DomainEntity.UserPermission{
+		{Resource: kind.UserResourceRecipe, Rights: []kind.UserRight{kind.UserRightRead}},
+		{Resource: kind.UserResourcePlanner, Rights: []kind.UserRight{kind.UserRightWrite}},
+	}
+	tests := []struct {
+		name          string
+		bindingRights []kind.UserRight
+		resource      kind.UserResource
+		right         kind.UserRight
+		expected      bool
+	}{
+		{
+			name:     "Test case with read permission and read requirement",
+			resource: kind.UserResourceRecipe,
+			right:    kind.UserRightRead,
+			expected: true,
+		},
+		{
+			name:     "Test case with read permission and write requirement",
+			resource: kind.UserResourceRecipe,
+			right:    kind.UserRightWrite,
+			expected: false,
+		},
+		{
+			name:     "Test case with write permission and read requirement",
+			resource: kind.UserResourcePlanner,
+			right:    kind.UserRightRead,
+			expected: true,
+		},
+		{
+			name:          "Test case with write permission narrowed down to read by binding",
+			bindingRights: []kind.UserRight{kind.UserRightRead},
+			resource:      kind.UserResourcePlanner,
+			right:         kind.UserRightWrite,
+			expected:      false,
+		},
+		{
+			name:          "Test case with write permission and write binding",
+			bindingRights: []kind.UserRight{kind.UserRightWrite},
+			resource:      kind.UserResourcePlanner,
+			right:         kind.UserRightWrite,
+			expected:      true,
+		},
+		{
+			name:     "Test case with resource without permission",
+			resource: kind.UserResourcePantry,
+			right:    kind.UserRightRead,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, PolicyAllows(permissions, testCase.bindingRights, testCase.resource, testCase.right))
+			},
+		)
+	}
+}
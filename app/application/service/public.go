@@ -0,0 +1,149 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+// PublicRecipe returns a copy of the published recipe with its published children only, the ids of the users are
+// cleared in all of the entities. A recipe which is not published has nothing public, so nil is returned.
+func PublicRecipe(recipe *aggregate.Recipe) *aggregate.Recipe {
+	if recipe == nil || recipe.Entity == nil || recipe.Entity.Status != kind.RecipeStatusPublished {
+		return nil
+	}
+
+	recipeEntity := *recipe.Entity
+	recipeEntity.UserId = uuid.Nil
+	recipeEntity.ForkedFromId = uuid.Nil
+	recipeEntity.ForkedFromVersionId = uuid.Nil
+
+	public := &aggregate.Recipe{
+		AltNames:    publicAltNames(recipe.AltNames),
+		Categories:  []*aggregate.RecipeCategory{},
+		Entity:      &recipeEntity,
+		Ingredients: []*aggregate.RecipeIngredient{},
+		Processes:   []*aggregate.RecipeProcess{},
+		Pictures:    publicPictures(recipe.Pictures),
+	}
+
+	for _, recipeCategory := range recipe.Categories {
+		if recipeCategory.Entity == nil || recipeCategory.Entity.Status != kind.RecipeCategoryStatusPublished {
+			continue
+		} else if recipeCategory.Derive == nil || recipeCategory.Derive.Entity == nil || recipeCategory.Derive.Entity.Status != kind.CategoryStatusPublished {
+			continue
+		}
+
+		recipeCategoryEntity := *recipeCategory.Entity
+		recipeCategoryEntity.UserId = uuid.Nil
+		categoryEntity := *recipeCategory.Derive.Entity
+		categoryEntity.UserId = uuid.Nil
+
+		public.Categories = append(
+			public.Categories,
+			&aggregate.RecipeCategory{
+				Derive: &aggregate.Category{
+					AltNames: publicAltNames(recipeCategory.Derive.AltNames),
+					Entity:   &categoryEntity,
+					Pictures: publicPictures(recipeCategory.Derive.Pictures),
+				},
+				Entity: &recipeCategoryEntity,
+			},
+		)
+	}
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if recipeIngredient.Entity == nil || recipeIngredient.Entity.Status != kind.RecipeIngredientStatusPublished {
+			continue
+		}
+
+		recipeIngredientEntity := *recipeIngredient.Entity
+		recipeIngredientEntity.UserId = uuid.Nil
+		publicIngredient := &aggregate.RecipeIngredient{
+			AltNames: publicAltNames(recipeIngredient.AltNames),
+			Entity:   &recipeIngredientEntity,
+			Measures: []*aggregate.RecipeMeasure{},
+			Pictures: publicPictures(recipeIngredient.Pictures),
+		}
+
+		if recipeIngredient.Derive != nil && recipeIngredient.Derive.Status == kind.IngredientStatusPublished {
+			ingredientEntity := *recipeIngredient.Derive
+			ingredientEntity.UserId = uuid.Nil
+			publicIngredient.Derive = &ingredientEntity
+		}
+
+		for _, recipeMeasure := range recipeIngredient.Measures {
+			if recipeMeasure.Entity == nil || recipeMeasure.Entity.Status != kind.RecipeMeasureStatusPublished {
+				continue
+			}
+
+			recipeMeasureEntity := *recipeMeasure.Entity
+			recipeMeasureEntity.UserId = uuid.Nil
+			publicMeasure := &aggregate.RecipeMeasure{
+				AltNames: publicAltNames(recipeMeasure.AltNames),
+				Entity:   &recipeMeasureEntity,
+			}
+
+			if recipeMeasure.Unit != nil && recipeMeasure.Unit.Status == kind.UnitStatusPublished {
+				publicMeasure.Unit = recipeMeasure.Unit
+			}
+
+			publicIngredient.Measures = append(publicIngredient.Measures, publicMeasure)
+		}
+
+		public.Ingredients = append(public.Ingredients, publicIngredient)
+	}
+
+	for _, recipeProcess := range recipe.Processes {
+		if recipeProcess.Entity == nil || recipeProcess.Entity.Status != kind.RecipeProcessStatusPublished {
+			continue
+		}
+
+		recipeProcessEntity := *recipeProcess.Entity
+		recipeProcessEntity.UserId = uuid.Nil
+
+		public.Processes = append(
+			public.Processes,
+			&aggregate.RecipeProcess{
+				AltNames: publicAltNames(recipeProcess.AltNames),
+				Entity:   &recipeProcessEntity,
+				Pictures: publicPictures(recipeProcess.Pictures),
+			},
+		)
+	}
+
+	return public
+}
+
+func publicAltNames(altNames []*entity.AltName) []*entity.AltName {
+	public := []*entity.AltName{}
+
+	for _, altName := range altNames {
+		if altName.Status != kind.AltNameStatusPublished {
+			continue
+		}
+
+		altNameEntity := *altName
+		altNameEntity.UserId = uuid.Nil
+		public = append(public, &altNameEntity)
+	}
+
+	return public
+}
+
+func publicPictures(pictures []*aggregate.Picture) []*aggregate.Picture {
+	public := []*aggregate.Picture{}
+
+	for _, picture := range pictures {
+		if picture.Entity == nil || picture.Entity.Status != kind.PictureStatusPublished {
+			continue
+		}
+
+		pictureEntity := *picture.Entity
+		pictureEntity.UserId = uuid.Nil
+		public = append(public, &aggregate.Picture{AltNames: publicAltNames(picture.AltNames), Entity: &pictureEntity})
+	}
+
+	return public
+}
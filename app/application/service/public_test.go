@@ -0,0 +1,89 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testPublicRecipe(status kind.RecipeStatus) *aggregate.Recipe {
+	userId := uuid.New()
+
+	return &aggregate.Recipe{
+		AltNames: []*entity.AltName{
+			{Id: uuid.New(), UserId: userId, Name: "Crepes", Status: kind.AltNameStatusPublished},
+			{Id: uuid.New(), UserId: userId, Name: "Secret", Status: kind.AltNameStatusUnPublished},
+		},
+		Categories: []*aggregate.RecipeCategory{
+			{
+				Derive: &aggregate.Category{Entity: &entity.Category{Id: uuid.New(), UserId: userId, Name: "Breakfast", Status: kind.CategoryStatusPublished}},
+				Entity: &entity.RecipeCategory{Id: uuid.New(), UserId: userId, Status: kind.RecipeCategoryStatusPublished},
+			},
+			{
+				Derive: &aggregate.Category{Entity: &entity.Category{Id: uuid.New(), UserId: userId, Name: "Private", Status: kind.CategoryStatusUnPublished}},
+				Entity: &entity.RecipeCategory{Id: uuid.New(), UserId: userId, Status: kind.RecipeCategoryStatusPublished},
+			},
+		},
+		Entity: &entity.Recipe{Id: uuid.New(), UserId: userId, ForkedFromId: uuid.New(), Name: "Pancakes", Status: status},
+		Ingredients: []*aggregate.RecipeIngredient{
+			{
+				Derive: &entity.Ingredient{Id: uuid.New(), UserId: userId, Name: "Flour", Status: kind.IngredientStatusUnPublished},
+				Entity: &entity.RecipeIngredient{Id: uuid.New(), UserId: userId, Name: "Wheat flour", Status: kind.RecipeIngredientStatusPublished},
+				Measures: []*aggregate.RecipeMeasure{
+					{
+						Entity: &entity.RecipeMeasure{Id: uuid.New(), UserId: userId, Value: 200, Status: kind.RecipeMeasureStatusPublished},
+						Unit:   &entity.Unit{Id: uuid.New(), Name: "g", Status: kind.UnitStatusPublished},
+					},
+					{
+						Entity: &entity.RecipeMeasure{Id: uuid.New(), UserId: userId, Value: 1, Status: kind.RecipeMeasureStatusUnPublished},
+					},
+				},
+			},
+			{
+				Entity: &entity.RecipeIngredient{Id: uuid.New(), UserId: userId, Name: "Secret sauce", Status: kind.RecipeIngredientStatusUnPublished},
+			},
+		},
+		Processes: []*aggregate.RecipeProcess{
+			{
+				Entity: &entity.RecipeProcess{Id: uuid.New(), UserId: userId, Name: "Mix", Status: kind.RecipeProcessStatusPublished},
+				Pictures: []*aggregate.Picture{
+					{Entity: &entity.Picture{Id: uuid.New(), UserId: userId, URL: "https://example.com/1.png", Status: kind.PictureStatusPublished}},
+					{Entity: &entity.Picture{Id: uuid.New(), UserId: userId, URL: "https://example.com/2.png", Status: kind.PictureStatusUnPublished}},
+				},
+			},
+			{
+				Entity: &entity.RecipeProcess{Id: uuid.New(), UserId: userId, Name: "Draft", Status: kind.RecipeProcessStatusUnPublished},
+			},
+		},
+	}
+}
+
+func TestPublicRecipe(t *testing.T) {
+	recipe := testPublicRecipe(kind.RecipeStatusPublished)
+	actual := PublicRecipe(recipe)
+
+	assert.NotNil(t, actual)
+	assert.Equal(t, uuid.Nil, actual.Entity.UserId)
+	assert.Equal(t, uuid.Nil, actual.Entity.ForkedFromId)
+	assert.NotEqual(t, uuid.Nil, recipe.Entity.UserId)
+	assert.Len(t, actual.AltNames, 1)
+	assert.Equal(t, "Crepes", actual.AltNames[0].Name)
+	assert.Equal(t, uuid.Nil, actual.AltNames[0].UserId)
+	assert.Len(t, actual.Categories, 1)
+	assert.Equal(t, "Breakfast", actual.Categories[0].Derive.Entity.Name)
+	assert.Equal(t, uuid.Nil, actual.Categories[0].Derive.Entity.UserId)
+	assert.Len(t, actual.Ingredients, 1)
+	assert.Nil(t, actual.Ingredients[0].Derive)
+	assert.Equal(t, uuid.Nil, actual.Ingredients[0].Entity.UserId)
+	assert.Len(t, actual.Ingredients[0].Measures, 1)
+	assert.Equal(t, int64(200), actual.Ingredients[0].Measures[0].Entity.Value)
+	assert.Len(t, actual.Processes, 1)
+	assert.Len(t, actual.Processes[0].Pictures, 1)
+	assert.Equal(t, uuid.Nil, actual.Processes[0].Pictures[0].Entity.UserId)
+
+	assert.Nil(t, PublicRecipe(testPublicRecipe(kind.RecipeStatusUnPublished)))
+	assert.Nil(t, PublicRecipe(nil))
+}
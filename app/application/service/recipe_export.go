@@ -0,0 +1,303 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	recipeExportStepName       = regexp.MustCompile(`^Step \d+(?::\s*)?`)
+	recipeExportMarkdownEscape = strings.NewReplacer(
+		`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "#", `\#`, "<", `\<`, ">", `\>`,
+	)
+	recipeExportMarkdownURL = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29")
+)
+
+type recipeJSONLD struct {
+	Context            string                 `json:"@context"`
+	Type               string                 `json:"@type"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	URL                string                 `json:"url,omitempty"`
+	DateCreated        string                 `json:"dateCreated,omitempty"`
+	DateModified       string                 `json:"dateModified,omitempty"`
+	RecipeYield        string                 `json:"recipeYield,omitempty"`
+	Nutrition          *recipeJSONLDNutrition `json:"nutrition,omitempty"`
+	RecipeCategory     []string               `json:"recipeCategory,omitempty"`
+	RecipeIngredient   []string               `json:"recipeIngredient,omitempty"`
+	RecipeInstructions []*recipeJSONLDStep    `json:"recipeInstructions,omitempty"`
+	Image              []*recipeJSONLDImage   `json:"image,omitempty"`
+}
+
+type recipeJSONLDNutrition struct {
+	Type     string `json:"@type"`
+	Calories string `json:"calories"`
+}
+
+type recipeJSONLDStep struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name,omitempty"`
+	Text     string `json:"text"`
+}
+
+type recipeJSONLDImage struct {
+	Type           string `json:"@type"`
+	URL            string `json:"url"`
+	Width          int64  `json:"width,omitempty"`
+	Height         int64  `json:"height,omitempty"`
+	EncodingFormat string `json:"encodingFormat,omitempty"`
+}
+
+// RecipeJSONLD returns the recipe as a schema.org Recipe JSON-LD document, the link of the API is used to make
+// the URL of the recipe. Unpublished categories, processes and pictures are left out.
+func RecipeJSONLD(recipe *aggregate.Recipe, link string) ([]byte, error) {
+	document := &recipeJSONLD{
+		Context:      "https://schema.org",
+		Type:         "Recipe",
+		Name:         recipe.Entity.Name,
+		Description:  recipe.Entity.Description,
+		DateCreated:  recipeExportTime(recipe.Entity.DateInsert),
+		DateModified: recipeExportTime(recipe.Entity.DateUpdate),
+	}
+
+	if link != "" {
+		document.URL = fmt.Sprintf("%s/recipes/%s", strings.TrimRight(link, "/"), recipe.Entity.Id)
+	}
+
+	if recipe.Entity.Servings > 0 {
+		document.RecipeYield = fmt.Sprintf("%d", recipe.Entity.Servings)
+	}
+
+	if recipe.Entity.Calories > 0 {
+		document.Nutrition = &recipeJSONLDNutrition{Type: "NutritionInformation", Calories: fmt.Sprintf("%d calories", recipe.Entity.Calories)}
+	}
+
+	document.RecipeCategory = recipeExportCategories(recipe)
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if line := recipeExportIngredientLine(recipeIngredient); line != "" {
+			document.RecipeIngredient = append(document.RecipeIngredient, line)
+		}
+	}
+
+	for index, recipeProcess := range recipeExportProcesses(recipe) {
+		step := &recipeJSONLDStep{Type: "HowToStep", Position: index + 1, Name: recipeExportStepTitle(recipeProcess), Text: recipeProcess.Description}
+
+		if step.Text == "" {
+			step.Text, step.Name = recipeProcess.Name, ""
+		}
+
+		document.RecipeInstructions = append(document.RecipeInstructions, step)
+	}
+
+	for _, picture := range recipeExportPictures(recipe.Pictures) {
+		document.Image = append(
+			document.Image,
+			&recipeJSONLDImage{Type: "ImageObject", URL: picture.URL, Width: picture.Width, Height: picture.Height, EncodingFormat: picture.Type},
+		)
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// RecipeMarkdown returns the recipe as a readable Markdown document with the ingredients, the ordered steps and
+// the pictures. Unpublished categories, processes and pictures are left out.
+func RecipeMarkdown(recipe *aggregate.Recipe) string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("# %s\n", recipeExportMarkdownEscape.Replace(recipe.Entity.Name)))
+
+	if recipe.Entity.Description != "" {
+		builder.WriteString(fmt.Sprintf("\n%s\n", recipeExportMarkdownEscape.Replace(recipe.Entity.Description)))
+	}
+
+	var facts []string
+
+	if recipe.Entity.Servings > 0 {
+		facts = append(facts, fmt.Sprintf("- **Servings:** %d", recipe.Entity.Servings))
+	}
+
+	if recipe.Entity.Calories > 0 {
+		facts = append(facts, fmt.Sprintf("- **Calories:** %d", recipe.Entity.Calories))
+	}
+
+	if categories := recipeExportCategories(recipe); len(categories) > 0 {
+		facts = append(facts, fmt.Sprintf("- **Categories:** %s", recipeExportMarkdownEscape.Replace(strings.Join(categories, ", "))))
+	}
+
+	if len(facts) > 0 {
+		builder.WriteString(fmt.Sprintf("\n%s\n", strings.Join(facts, "\n")))
+	}
+
+	var ingredients []string
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if line := recipeExportIngredientLine(recipeIngredient); line != "" {
+			ingredients = append(ingredients, fmt.Sprintf("- %s", recipeExportMarkdownEscape.Replace(line)))
+		}
+	}
+
+	if len(ingredients) > 0 {
+		builder.WriteString(fmt.Sprintf("\n## Ingredients\n\n%s\n", strings.Join(ingredients, "\n")))
+	}
+
+	if recipeProcesses := recipeExportProcesses(recipe); len(recipeProcesses) > 0 {
+		builder.WriteString("\n## Steps\n\n")
+
+		for index, recipeProcess := range recipeProcesses {
+			var parts []string
+
+			if title := recipeExportStepTitle(recipeProcess); title != "" {
+				parts = append(parts, fmt.Sprintf("**%s**", recipeExportMarkdownEscape.Replace(title)))
+			}
+
+			if recipeProcess.Description != "" {
+				parts = append(parts, recipeExportMarkdownEscape.Replace(recipeProcess.Description))
+			} else if len(parts) == 0 {
+				parts = append(parts, recipeExportMarkdownEscape.Replace(recipeProcess.Name))
+			}
+
+			if recipeProcess.Notes != "" {
+				parts = append(parts, fmt.Sprintf("_%s_", recipeExportMarkdownEscape.Replace(recipeProcess.Notes)))
+			}
+
+			builder.WriteString(fmt.Sprintf("%d. %s\n", index+1, strings.Join(parts, " ")))
+		}
+	}
+
+	if pictures := recipeExportPictures(recipe.Pictures); len(pictures) > 0 {
+		builder.WriteString("\n## Pictures\n\n")
+
+		for _, picture := range pictures {
+			builder.WriteString(fmt.Sprintf("![%s](%s)\n", recipeExportMarkdownEscape.Replace(picture.Name), recipeExportMarkdownURL.Replace(picture.URL)))
+		}
+	}
+
+	if recipe.Entity.Notes != "" {
+		builder.WriteString(fmt.Sprintf("\n## Notes\n\n%s\n", recipeExportMarkdownEscape.Replace(recipe.Entity.Notes)))
+	}
+
+	return builder.String()
+}
+
+func recipeExportTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+func recipeExportCategories(recipe *aggregate.Recipe) []string {
+	var categories []string
+
+	for _, recipeCategory := range recipe.Categories {
+		if recipeCategory == nil || recipeCategory.Derive == nil || recipeCategory.Derive.Entity == nil {
+			continue
+		}
+
+		if recipeCategory.Entity != nil && recipeCategory.Entity.Status == kind.RecipeCategoryStatusUnPublished {
+			continue
+		}
+
+		categories = append(categories, recipeCategory.Derive.Entity.Name)
+	}
+
+	return categories
+}
+
+// recipeExportIngredientLine returns a line like "200 g flour", the measures of several units are joined.
+func recipeExportIngredientLine(recipeIngredient *aggregate.RecipeIngredient) string {
+	if recipeIngredient == nil || recipeIngredient.Entity == nil {
+		return ""
+	}
+
+	name := recipeIngredient.Entity.Name
+
+	if recipeIngredient.Derive != nil && recipeIngredient.Derive.Name != "" {
+		name = recipeIngredient.Derive.Name
+	}
+
+	var measures []string
+
+	for _, recipeMeasure := range recipeIngredient.Measures {
+		if recipeMeasure == nil || recipeMeasure.Entity == nil {
+			continue
+		}
+
+		if recipeMeasure.Unit != nil && recipeMeasure.Unit.Name != "" {
+			measures = append(measures, fmt.Sprintf("%d %s", recipeMeasure.Entity.Value, recipeMeasure.Unit.Name))
+		} else {
+			measures = append(measures, fmt.Sprintf("%d", recipeMeasure.Entity.Value))
+		}
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s %s", strings.Join(measures, " + "), name))
+}
+
+// recipeExportProcesses returns the published processes in the order they have been added, the processes added
+// at the same time are ordered by the numbers in their names, e.g. "Step 2" goes before "Step 10".
+func recipeExportProcesses(recipe *aggregate.Recipe) []*entity.RecipeProcess {
+	var recipeProcesses []*entity.RecipeProcess
+
+	for _, recipeProcess := range recipe.Processes {
+		if recipeProcess == nil || recipeProcess.Entity == nil || recipeProcess.Entity.Status == kind.RecipeProcessStatusUnPublished {
+			continue
+		}
+
+		recipeProcesses = append(recipeProcesses, recipeProcess.Entity)
+	}
+
+	sort.SliceStable(
+		recipeProcesses,
+		func(i, j int) bool {
+			if !recipeProcesses[i].DateInsert.Equal(recipeProcesses[j].DateInsert) {
+				return recipeProcesses[i].DateInsert.Before(recipeProcesses[j].DateInsert)
+			}
+
+			return recipeExportNameLess(recipeProcesses[i].Name, recipeProcesses[j].Name)
+		},
+	)
+
+	return recipeProcesses
+}
+
+// recipeExportStepTitle returns the name of the process without a "Step N" prefix, because the steps are numbered
+// by their order.
+func recipeExportStepTitle(recipeProcess *entity.RecipeProcess) string {
+	return strings.TrimSpace(recipeExportStepName.ReplaceAllString(recipeProcess.Name, ""))
+}
+
+func recipeExportNameLess(a string, b string) bool {
+	numberA, errorNumberA := strconv.ParseInt(recipeImportInteger.FindString(a), 10, 64)
+	numberB, errorNumberB := strconv.ParseInt(recipeImportInteger.FindString(b), 10, 64)
+
+	if errorNumberA == nil && errorNumberB == nil && numberA != numberB {
+		return numberA < numberB
+	}
+
+	return a < b
+}
+
+func recipeExportPictures(pictures []*aggregate.Picture) []*entity.Picture {
+	var published []*entity.Picture
+
+	for _, picture := range pictures {
+		if picture == nil || picture.Entity == nil || picture.Entity.URL == "" || picture.Entity.Status == kind.PictureStatusUnPublished {
+			continue
+		}
+
+		published = append(published, picture.Entity)
+	}
+
+	return published
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"bytes"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func getRecipeExportAggregate() *aggregate.Recipe {
+	dateInsert := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return &aggregate.Recipe{
+		Entity: &entity.Recipe{
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DateInsert:  dateInsert,
+			DateUpdate:  dateInsert,
+			Name:        "Pancakes #1",
+			Description: "Fluffy pancakes",
+			Notes:       "Best served warm",
+			Servings:    4,
+			Calories:    350,
+		},
+		Categories: []*aggregate.RecipeCategory{
+			{Entity: &entity.RecipeCategory{Status: kind.RecipeCategoryStatusPublished}, Derive: &aggregate.Category{Entity: &entity.Category{Name: "Breakfast"}}},
+			{Entity: &entity.RecipeCategory{Status: kind.RecipeCategoryStatusUnPublished}, Derive: &aggregate.Category{Entity: &entity.Category{Name: "Hidden"}}},
+		},
+		Ingredients: []*aggregate.RecipeIngredient{
+			{
+				Entity:   &entity.RecipeIngredient{Name: "eggs"},
+				Derive:   &entity.Ingredient{Name: "Egg"},
+				Measures: []*aggregate.RecipeMeasure{{Entity: &entity.RecipeMeasure{Value: 2}, Unit: &entity.Unit{Name: "pc"}}},
+			},
+			{
+				Entity:   &entity.RecipeIngredient{Name: "Milk"},
+				Measures: []*aggregate.RecipeMeasure{{Entity: &entity.RecipeMeasure{Value: 240}, Unit: &entity.Unit{Name: "ml"}}},
+			},
+			{
+				Entity: &entity.RecipeIngredient{Name: "Salt"},
+			},
+		},
+		Processes: []*aggregate.RecipeProcess{
+			{Entity: &entity.RecipeProcess{DateInsert: dateInsert, Name: "Step 10", Description: "Serve.", Status: kind.RecipeProcessStatusPublished}},
+			{Entity: &entity.RecipeProcess{DateInsert: dateInsert, Name: "Step 2: Cook", Description: "Fry the pancakes.", Status: kind.RecipeProcessStatusPublished}},
+			{Entity: &entity.RecipeProcess{DateInsert: dateInsert, Name: "Step 3", Description: "Hidden.", Status: kind.RecipeProcessStatusUnPublished}},
+			{Entity: &entity.RecipeProcess{DateInsert: dateInsert.Add(-time.Hour), Name: "Step 1", Description: "Mix *everything*.", Status: kind.RecipeProcessStatusPublished}},
+		},
+		Pictures: []*aggregate.Picture{
+			{Entity: &entity.Picture{Name: "Pancakes 1", URL: "https://example.com/pancakes (1).jpg", Width: 800, Height: 600, Status: kind.PictureStatusPublished}},
+		},
+	}
+}
+
+func TestRecipeMarkdown(t *testing.T) {
+	expected := "# Pancakes \\#1\n" +
+		"\nFluffy pancakes\n" +
+		"\n- **Servings:** 4\n- **Calories:** 350\n- **Categories:** Breakfast\n" +
+		"\n## Ingredients\n\n- 2 pc Egg\n- 240 ml Milk\n- Salt\n" +
+		"\n## Steps\n\n1. Mix \\*everything\\*.\n2. **Cook** Fry the pancakes.\n3. Serve.\n" +
+		"\n## Pictures\n\n![Pancakes 1](https://example.com/pancakes%20%281%29.jpg)\n" +
+		"\n## Notes\n\nBest served warm\n"
+
+	assert.Equal(t, expected, RecipeMarkdown(getRecipeExportAggregate()))
+}
+
+func TestRecipeJSONLD(t *testing.T) {
+	document, errorDocument := RecipeJSONLD(getRecipeExportAggregate(), "https://example.com/api/v1/")
+
+	assert.Nil(t, errorDocument)
+	assert.Contains(t, string(document), `"url": "https://example.com/api/v1/recipes/00000000-0000-0000-0000-000000000001"`)
+	assert.Contains(t, string(document), `"dateCreated": "2000-01-01T00:00:00Z"`)
+
+	recipeImport, errorRecipeImport := RecipeImportParse(bytes.NewReader(document), nil)
+
+	assert.Nil(t, errorRecipeImport)
+	assert.Equal(
+		t,
+		&aggregate.RecipeImport{
+			Categories: []string{"Breakfast"},
+			Entity: &entity.Recipe{
+				Name:        "Pancakes #1",
+				Description: "Fluffy pancakes",
+				Servings:    4,
+				Calories:    350,
+				Status:      kind.RecipeStatusUnPublished,
+			},
+			Ingredients: []*aggregate.RecipeImportIngredient{
+				{Line: "2 pc Egg", Name: "Egg", Unit: "pc", Value: 2},
+				{Line: "240 ml Milk", Name: "Milk", Unit: "ml", Value: 240},
+				{Line: "Salt", Name: "Salt"},
+			},
+			Processes: []*entity.RecipeProcess{
+				{Name: "Step 1", Description: "Mix *everything*.", Status: kind.RecipeProcessStatusPublished},
+				{Name: "Step 2: Cook", Description: "Fry the pancakes.", Status: kind.RecipeProcessStatusPublished},
+				{Name: "Step 3", Description: "Serve.", Status: kind.RecipeProcessStatusPublished},
+			},
+			Pictures: []*entity.Picture{
+				{Name: "Pancakes #1 1", URL: "https://example.com/pancakes (1).jpg", Width: 800, Height: 600, Status: kind.PictureStatusPublished},
+			},
+		},
+		recipeImport,
+	)
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"html"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	errorRecipeImportInvalid  = errors.New("recipe cannot be found in provided data")
+	recipeImportScript        = regexp.MustCompile(`(?is)<script[^>]*application/ld\+json[^>]*>(.*?)</script>`)
+	recipeImportTag           = regexp.MustCompile(`<[^>]*>`)
+	recipeImportBreak         = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>`)
+	recipeImportParentheses   = regexp.MustCompile(`\([^)]*\)`)
+	recipeImportSpace         = regexp.MustCompile(`\s+`)
+	recipeImportInteger       = regexp.MustCompile(`\d+`)
+	recipeImportMixedFraction = regexp.MustCompile(`^(\d+)\s+(\d+)\s*/\s*(\d+)`)
+	recipeImportFraction      = regexp.MustCompile(`^(\d+)\s*/\s*(\d+)`)
+	recipeImportUnicode       = regexp.MustCompile(`^(\d*)\s*([½⅓⅔¼¾⅕⅖⅗⅘⅙⅚⅛⅜⅝⅞])`)
+	recipeImportDecimal       = regexp.MustCompile(`^\d+(?:[.,]\d+)?`)
+	recipeImportRange         = regexp.MustCompile(`^\s*(?:-|–|to)\s*\d+(?:[.,/]\d+)?[½⅓⅔¼¾⅕⅖⅗⅘⅙⅚⅛⅜⅝⅞]?`)
+	recipeImportFractions     = map[string]float64{
+		"½": 1. / 2, "⅓": 1. / 3, "⅔": 2. / 3, "¼": 1. / 4, "¾": 3. / 4, "⅕": 1. / 5, "⅖": 2. / 5, "⅗": 3. / 5,
+		"⅘": 4. / 5, "⅙": 1. / 6, "⅚": 5. / 6, "⅛": 1. / 8, "⅜": 3. / 8, "⅝": 5. / 8, "⅞": 7. / 8,
+	}
+	recipeImportUnits = []string{
+		"bunch", "bunches", "can", "cans", "clove", "cloves", "dash", "dashes", "handful", "handfuls", "pinch", "pinches",
+		"slice", "slices", "sprig", "sprigs", "stick", "sticks",
+	}
+	recipeImportUnitBases = map[string]string{
+		unitDimensionMass:   "g",
+		unitDimensionVolume: "ml",
+		unitDimensionCount:  "pc",
+	}
+)
+
+type recipeImportStep struct {
+	Name string
+	Text string
+}
+
+// RecipeImportParse builds the recipe to import from a schema.org Recipe JSON-LD document or from an HTML page
+// with the document embedded. The lines of the ingredients are parsed with the known unit names. The recipe is
+// unpublished, so it is not public until the user publishes it.
+func RecipeImportParse(data io.Reader, unitNames []string) (*aggregate.RecipeImport, error) {
+	content, errorContent := io.ReadAll(data)
+
+	if errorContent != nil {
+		return nil, errorContent
+	}
+
+	documents := []string{string(content)}
+
+	if trimmed := strings.TrimSpace(string(content)); !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		documents = nil
+
+		for _, match := range recipeImportScript.FindAllStringSubmatch(string(content), -1) {
+			documents = append(documents, match[1])
+		}
+	}
+
+	for _, document := range documents {
+		var value any
+
+		if json.Unmarshal([]byte(strings.TrimSpace(document)), &value) != nil {
+			continue
+		}
+
+		if node := recipeImportNode(value); node != nil {
+			return recipeImportBuild(node, unitNames)
+		}
+	}
+
+	return nil, errorRecipeImportInvalid
+}
+
+// RecipeIngredientLineParse splits a line of an ingredient like "1 1/2 cups flour, sifted" into the value, the unit
+// and the name of the ingredient. Fractional values are converted to grams, milliliters or pieces when the unit is
+// convertible, otherwise they are rounded, because measures keep whole values.
+func RecipeIngredientLineParse(line string, unitNames []string) *aggregate.RecipeImportIngredient {
+	text := recipeImportText(line)
+	text = strings.TrimSpace(recipeImportSpace.ReplaceAllString(recipeImportParentheses.ReplaceAllString(text, " "), " "))
+	text = strings.ReplaceAll(text, " ,", ",")
+	recipeImportIngredient := &aggregate.RecipeImportIngredient{Line: text}
+
+	quantity, rest, found := recipeImportQuantity(text)
+	words := strings.Fields(rest)
+
+	if !found && len(words) > 1 && (strings.EqualFold(words[0], "a") || strings.EqualFold(words[0], "an")) && recipeImportUnit(words[1:], unitNames) != "" {
+		quantity, found, words = 1, true, words[1:]
+	}
+
+	unit := recipeImportUnit(words, unitNames)
+
+	if unit != "" {
+		words = words[len(strings.Fields(unit)):]
+
+		if len(words) > 0 && strings.EqualFold(words[0], "of") {
+			words = words[1:]
+		}
+	}
+
+	name, _, _ := strings.Cut(strings.Join(words, " "), ",")
+	recipeImportIngredient.Name = strings.Trim(name, " .;:-")
+
+	if recipeImportIngredient.Name == "" {
+		recipeImportIngredient.Name = text
+	}
+
+	switch {
+	case found && quantity > 0:
+		if unit == "" {
+			unit = recipeImportUnitBases[unitDimensionCount]
+		}
+
+		if conversion, ok := unitConversions[strings.ToLower(unit)]; ok && quantity != math.Trunc(quantity) && conversion.Dimension != unitDimensionCount {
+			quantity, unit = quantity*conversion.Factor, recipeImportUnitBases[conversion.Dimension]
+		}
+
+		recipeImportIngredient.Unit = unit
+		recipeImportIngredient.Value = MathMaxInt(int64(math.Round(quantity)), 1)
+	case unit != "":
+		recipeImportIngredient.Unit = unit
+		recipeImportIngredient.Value = 1
+	}
+
+	return recipeImportIngredient
+}
+
+// RecipeImportUnit returns the unit which has the name or an alternative name, otherwise the unit which is converted
+// with the same factor, e.g. "grams" is matched with "g".
+func RecipeImportUnit(name string, units []*entity.Unit, altNames []*entity.AltName) *entity.Unit {
+	key := strings.ToLower(strings.TrimSpace(name))
+
+	for _, unit := range units {
+		if strings.ToLower(strings.TrimSpace(unit.Name)) == key || recipeImportAltName(unit.Id.String(), key, altNames, false) {
+			return unit
+		}
+	}
+
+	conversion, okConversion := unitConversions[key]
+
+	if !okConversion {
+		return nil
+	}
+
+	for _, unit := range units {
+		if unitConversion, ok := unitConversions[strings.ToLower(strings.TrimSpace(unit.Name))]; ok && unitConversion == conversion {
+			return unit
+		}
+	}
+
+	return nil
+}
+
+// RecipeImportIngredient returns the ingredient which has the name or an alternative name, plural forms are matched
+// with singular ones.
+func RecipeImportIngredient(name string, ingredients []*entity.Ingredient, altNames []*entity.AltName) *entity.Ingredient {
+	key := recipeImportSingular(strings.ToLower(strings.TrimSpace(name)))
+
+	for _, ingredient := range ingredients {
+		if recipeImportSingular(strings.ToLower(strings.TrimSpace(ingredient.Name))) == key || recipeImportAltName(ingredient.Id.String(), key, altNames, true) {
+			return ingredient
+		}
+	}
+
+	return nil
+}
+
+func recipeImportAltName(entityId string, key string, altNames []*entity.AltName, singular bool) bool {
+	for _, altName := range altNames {
+		if altName.EntityId.String() != entityId {
+			continue
+		}
+
+		altNameKey := strings.ToLower(strings.TrimSpace(altName.Name))
+
+		if singular {
+			altNameKey = recipeImportSingular(altNameKey)
+		}
+
+		if altNameKey == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func recipeImportSingular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "oes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	default:
+		return name
+	}
+}
+
+func recipeImportNode(value any) map[string]any {
+	switch typedValue := value.(type) {
+	case []any:
+		for _, item := range typedValue {
+			if node := recipeImportNode(item); node != nil {
+				return node
+			}
+		}
+	case map[string]any:
+		if recipeImportIsRecipe(typedValue["@type"]) {
+			return typedValue
+		}
+
+		if graph, ok := typedValue["@graph"]; ok {
+			return recipeImportNode(graph)
+		}
+	}
+
+	return nil
+}
+
+func recipeImportIsRecipe(value any) bool {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue == "Recipe" || strings.HasSuffix(typedValue, "/Recipe") || strings.HasSuffix(typedValue, ":Recipe")
+	case []any:
+		for _, item := range typedValue {
+			if recipeImportIsRecipe(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func recipeImportBuild(node map[string]any, unitNames []string) (*aggregate.RecipeImport, error) {
+	recipeImport := &aggregate.RecipeImport{
+		Entity: &entity.Recipe{
+			Name:        recipeImportString(node["name"]),
+			Description: recipeImportString(node["description"]),
+			Servings:    recipeImportNumber(node["recipeYield"]),
+			Status:      kind.RecipeStatusUnPublished,
+		},
+	}
+
+	if recipeImport.Entity.Name == "" {
+		return nil, errors.Wrap(errorRecipeImportInvalid, "the recipe has no name")
+	}
+
+	if nutrition, ok := node["nutrition"].(map[string]any); ok {
+		recipeImport.Entity.Calories = recipeImportNumber(nutrition["calories"])
+	}
+
+	categories := map[string]bool{}
+
+	for _, value := range recipeImportStrings(node["recipeCategory"]) {
+		for _, category := range strings.Split(value, ",") {
+			category = strings.TrimSpace(category)
+
+			if category != "" && !categories[strings.ToLower(category)] {
+				categories[strings.ToLower(category)] = true
+				recipeImport.Categories = append(recipeImport.Categories, category)
+			}
+		}
+	}
+
+	ingredientLines := node["recipeIngredient"]
+
+	if ingredientLines == nil {
+		ingredientLines = node["ingredients"]
+	}
+
+	for _, line := range recipeImportStrings(ingredientLines) {
+		if strings.TrimSpace(line) != "" {
+			recipeImport.Ingredients = append(recipeImport.Ingredients, RecipeIngredientLineParse(line, unitNames))
+		}
+	}
+
+	for index, step := range recipeImportSteps(node["recipeInstructions"]) {
+		name := fmt.Sprintf("Step %d", index+1)
+
+		if step.Name != "" && step.Name != step.Text && !strings.HasPrefix(step.Text, step.Name) {
+			name = fmt.Sprintf("%s: %s", name, step.Name)
+		}
+
+		recipeImport.Processes = append(
+			recipeImport.Processes,
+			&entity.RecipeProcess{Name: name, Description: step.Text, Status: kind.RecipeProcessStatusPublished},
+		)
+	}
+
+	for index, picture := range recipeImportPictures(node["image"]) {
+		picture.Name = fmt.Sprintf("%s %d", recipeImport.Entity.Name, index+1)
+		recipeImport.Pictures = append(recipeImport.Pictures, picture)
+	}
+
+	return recipeImport, nil
+}
+
+func recipeImportSteps(value any) []*recipeImportStep {
+	var steps []*recipeImportStep
+
+	switch typedValue := value.(type) {
+	case string:
+		for _, line := range strings.Split(recipeImportBreak.ReplaceAllString(html.UnescapeString(typedValue), "\n"), "\n") {
+			if text := recipeImportText(line); text != "" {
+				steps = append(steps, &recipeImportStep{Text: text})
+			}
+		}
+	case []any:
+		for _, item := range typedValue {
+			steps = append(steps, recipeImportSteps(item)...)
+		}
+	case map[string]any:
+		if items, ok := typedValue["itemListElement"]; ok {
+			return recipeImportSteps(items)
+		}
+
+		step := &recipeImportStep{Name: recipeImportString(typedValue["name"]), Text: recipeImportString(typedValue["text"])}
+
+		if step.Text == "" {
+			step.Text, step.Name = step.Name, ""
+		}
+
+		if step.Text != "" {
+			steps = append(steps, step)
+		}
+	}
+
+	return steps
+}
+
+func recipeImportPictures(value any) []*entity.Picture {
+	var pictures []*entity.Picture
+
+	switch typedValue := value.(type) {
+	case string:
+		if url := strings.TrimSpace(typedValue); url != "" {
+			pictures = append(pictures, &entity.Picture{URL: url, Status: kind.PictureStatusPublished})
+		}
+	case []any:
+		for _, item := range typedValue {
+			pictures = append(pictures, recipeImportPictures(item)...)
+		}
+	case map[string]any:
+		url := recipeImportString(typedValue["url"])
+
+		if url == "" {
+			url = recipeImportString(typedValue["contentUrl"])
+		}
+
+		if url != "" {
+			pictures = append(
+				pictures,
+				&entity.Picture{
+					URL:    url,
+					Width:  recipeImportNumber(typedValue["width"]),
+					Height: recipeImportNumber(typedValue["height"]),
+					Type:   recipeImportString(typedValue["encodingFormat"]),
+					Status: kind.PictureStatusPublished,
+				},
+			)
+		}
+	}
+
+	return pictures
+}
+
+func recipeImportStrings(value any) []string {
+	switch typedValue := value.(type) {
+	case []any:
+		var values []string
+
+		for _, item := range typedValue {
+			values = append(values, recipeImportStrings(item)...)
+		}
+
+		return values
+	case nil:
+		return nil
+	default:
+		if text := recipeImportString(typedValue); text != "" {
+			return []string{text}
+		}
+
+		return nil
+	}
+}
+
+func recipeImportString(value any) string {
+	switch typedValue := value.(type) {
+	case string:
+		return recipeImportText(typedValue)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case []any:
+		if len(typedValue) > 0 {
+			return recipeImportString(typedValue[0])
+		}
+	case map[string]any:
+		for _, key := range []string{"@value", "text", "name", "value"} {
+			if text := recipeImportString(typedValue[key]); text != "" {
+				return text
+			}
+		}
+	}
+
+	return ""
+}
+
+func recipeImportNumber(value any) int64 {
+	switch typedValue := value.(type) {
+	case float64:
+		return int64(math.Round(typedValue))
+	case []any:
+		for _, item := range typedValue {
+			if number := recipeImportNumber(item); number != 0 {
+				return number
+			}
+		}
+	default:
+		if number, errorNumber := strconv.ParseInt(recipeImportInteger.FindString(recipeImportString(typedValue)), 10, 64); errorNumber == nil {
+			return number
+		}
+	}
+
+	return 0
+}
+
+func recipeImportText(value string) string {
+	value = html.UnescapeString(recipeImportTag.ReplaceAllString(html.UnescapeString(value), " "))
+
+	return strings.TrimSpace(recipeImportSpace.ReplaceAllString(value, " "))
+}
+
+func recipeImportQuantity(text string) (float64, string, bool) {
+	var quantity float64
+
+	if match := recipeImportMixedFraction.FindStringSubmatch(text); match != nil && match[3] != "0" {
+		whole, _ := strconv.ParseFloat(match[1], 64)
+		numerator, _ := strconv.ParseFloat(match[2], 64)
+		denominator, _ := strconv.ParseFloat(match[3], 64)
+		quantity, text = whole+numerator/denominator, text[len(match[0]):]
+	} else if match = recipeImportFraction.FindStringSubmatch(text); match != nil && match[2] != "0" {
+		numerator, _ := strconv.ParseFloat(match[1], 64)
+		denominator, _ := strconv.ParseFloat(match[2], 64)
+		quantity, text = numerator/denominator, text[len(match[0]):]
+	} else if match = recipeImportUnicode.FindStringSubmatch(text); match != nil {
+		whole, _ := strconv.ParseFloat(match[1], 64)
+		quantity, text = whole+recipeImportFractions[match[2]], text[len(match[0]):]
+	} else if match := recipeImportDecimal.FindString(text); match != "" {
+		quantity, _ = strconv.ParseFloat(strings.Replace(match, ",", ".", 1), 64)
+		text = text[len(match):]
+	} else {
+		return 0, text, false
+	}
+
+	if match := recipeImportRange.FindString(text); match != "" {
+		text = text[len(match):]
+	}
+
+	return quantity, strings.TrimSpace(text), true
+}
+
+func recipeImportUnit(words []string, unitNames []string) string {
+	for count := MathMinInt(int64(len(words)), 2); count > 0; count-- {
+		key := strings.ToLower(strings.TrimRight(strings.Join(words[:count], " "), "."))
+
+		if _, ok := unitConversions[key]; ok {
+			return key
+		}
+
+		for _, unitName := range append(recipeImportUnits, unitNames...) {
+			if strings.ToLower(strings.TrimSpace(unitName)) == key {
+				return key
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestRecipeIngredientLineParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		expected *aggregate.RecipeImportIngredient
+	}{
+		{name: "Test case with a value and a unit", line: "200g sugar", expected: &aggregate.RecipeImportIngredient{Line: "200g sugar", Name: "sugar", Unit: "g", Value: 200}},
+		{name: "Test case with a mixed fraction and a note", line: "1 1/2 Cups flour (sifted), plus more", expected: &aggregate.RecipeImportIngredient{Line: "1 1/2 Cups flour, plus more", Name: "flour", Unit: "ml", Value: 360}},
+		{name: "Test case with a unicode fraction of a known unit", line: "½ bunch of parsley", expected: &aggregate.RecipeImportIngredient{Line: "½ bunch of parsley", Name: "parsley", Unit: "bunch", Value: 1}},
+		{name: "Test case with a range and without a unit", line: "2-3 eggs", expected: &aggregate.RecipeImportIngredient{Line: "2-3 eggs", Name: "eggs", Unit: "pc", Value: 2}},
+		{name: "Test case with an article", line: "a pinch of salt", expected: &aggregate.RecipeImportIngredient{Line: "a pinch of salt", Name: "salt", Unit: "pinch", Value: 1}},
+		{name: "Test case with a decimal comma and a custom unit", line: "1,5 glass milk", expected: &aggregate.RecipeImportIngredient{Line: "1,5 glass milk", Name: "milk", Unit: "glass", Value: 2}},
+		{name: "Test case without a value", line: "Pepper, to taste", expected: &aggregate.RecipeImportIngredient{Line: "Pepper, to taste", Name: "Pepper"}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, RecipeIngredientLineParse(testCase.line, []string{"Glass"}))
+			},
+		)
+	}
+}
+
+func TestRecipeImportUnit(t *testing.T) {
+	gram := &entity.Unit{Id: uuid.New(), Name: "g"}
+	spoon := &entity.Unit{Id: uuid.New(), Name: "spoon"}
+	units := []*entity.Unit{gram, spoon}
+	altNames := []*entity.AltName{{EntityId: spoon.Id, Name: "Spoonful"}}
+
+	assert.Equal(t, gram, RecipeImportUnit("G", units, altNames))
+	assert.Equal(t, gram, RecipeImportUnit("grams", units, altNames))
+	assert.Equal(t, spoon, RecipeImportUnit("spoonful", units, altNames))
+	assert.Nil(t, RecipeImportUnit("ml", units, altNames))
+}
+
+func TestRecipeImportIngredient(t *testing.T) {
+	egg := &entity.Ingredient{Id: uuid.New(), Name: "Egg"}
+	tomato := &entity.Ingredient{Id: uuid.New(), Name: "Tomato"}
+	ingredients := []*entity.Ingredient{egg, tomato}
+	altNames := []*entity.AltName{{EntityId: egg.Id, Name: "Hen egg"}}
+
+	assert.Equal(t, egg, RecipeImportIngredient("eggs", ingredients, altNames))
+	assert.Equal(t, egg, RecipeImportIngredient("hen eggs", ingredients, altNames))
+	assert.Equal(t, tomato, RecipeImportIngredient("tomatoes", ingredients, altNames))
+	assert.Nil(t, RecipeImportIngredient("flour", ingredients, altNames))
+}
+
+func TestRecipeImportParse(t *testing.T) {
+	document := `{
+		"@context": "https://schema.org",
+		"@graph": [
+			{"@type": "WebPage", "name": "Page"},
+			{
+				"@type": ["Recipe", "NewsArticle"],
+				"name": "Pancakes &amp; syrup",
+				"description": "<p>Fluffy pancakes</p>",
+				"recipeYield": ["4", "4 servings"],
+				"nutrition": {"@type": "NutritionInformation", "calories": "350 kcal"},
+				"recipeCategory": ["Breakfast, Dessert", "breakfast"],
+				"recipeIngredient": ["2 eggs", "", "1 cup milk"],
+				"recipeInstructions": [
+					{"@type": "HowToSection", "name": "Batter", "itemListElement": [
+						{"@type": "HowToStep", "name": "Whisk", "text": "Mix everything."}
+					]},
+					{"@type": "HowToStep", "text": "Fry the pancakes."},
+					"Serve."
+				],
+				"image": [
+					"https://example.com/1.jpg",
+					{"@type": "ImageObject", "url": "https://example.com/2.jpg", "width": 800, "height": "600"}
+				]
+			}
+		]
+	}`
+	expected := &aggregate.RecipeImport{
+		Categories: []string{"Breakfast", "Dessert"},
+		Entity: &entity.Recipe{
+			Name:        "Pancakes & syrup",
+			Description: "Fluffy pancakes",
+			Servings:    4,
+			Calories:    350,
+			Status:      kind.RecipeStatusUnPublished,
+		},
+		Ingredients: []*aggregate.RecipeImportIngredient{
+			{Line: "2 eggs", Name: "eggs", Unit: "pc", Value: 2},
+			{Line: "1 cup milk", Name: "milk", Unit: "cup", Value: 1},
+		},
+		Processes: []*entity.RecipeProcess{
+			{Name: "Step 1: Whisk", Description: "Mix everything.", Status: kind.RecipeProcessStatusPublished},
+			{Name: "Step 2", Description: "Fry the pancakes.", Status: kind.RecipeProcessStatusPublished},
+			{Name: "Step 3", Description: "Serve.", Status: kind.RecipeProcessStatusPublished},
+		},
+		Pictures: []*entity.Picture{
+			{Name: "Pancakes & syrup 1", URL: "https://example.com/1.jpg", Status: kind.PictureStatusPublished},
+			{Name: "Pancakes & syrup 2", URL: "https://example.com/2.jpg", Width: 800, Height: 600, Status: kind.PictureStatusPublished},
+		},
+	}
+
+	recipeImport, errorRecipeImport := RecipeImportParse(strings.NewReader(document), nil)
+
+	assert.Nil(t, errorRecipeImport)
+	assert.Equal(t, expected, recipeImport)
+
+	page := `<html><head>
+		<script type="application/ld+json">{"@type": "Organization", "name": "Site"}</script>
+		<script type="application/ld+json">
+			{"@context": "https://schema.org", "@type": "Recipe", "name": "Soup", "recipeInstructions": "Boil.<br/>Serve."}
+		</script>
+	</head></html>`
+
+	recipeImport, errorRecipeImport = RecipeImportParse(strings.NewReader(page), nil)
+
+	assert.Nil(t, errorRecipeImport)
+	assert.Equal(t, "Soup", recipeImport.Entity.Name)
+	assert.Equal(t, []*entity.RecipeProcess{
+		{Name: "Step 1", Description: "Boil.", Status: kind.RecipeProcessStatusPublished},
+		{Name: "Step 2", Description: "Serve.", Status: kind.RecipeProcessStatusPublished},
+	}, recipeImport.Processes)
+
+	_, errorRecipeImport = RecipeImportParse(strings.NewReader("<html></html>"), nil)
+
+	assert.Equal(t, errorRecipeImportInvalid, errorRecipeImport)
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"strconv"
+)
+
+// RecipeSnapshot encodes the recipe aggregate to be stored in a recipe version.
+func RecipeSnapshot(recipe *aggregate.Recipe) ([]byte, error) {
+	return json.Marshal(recipe)
+}
+
+// RecipeFromSnapshot decodes the recipe aggregate stored in a recipe version.
+func RecipeFromSnapshot(snapshot []byte) (*aggregate.Recipe, error) {
+	recipe := &aggregate.Recipe{}
+
+	if errorUnmarshal := json.Unmarshal(snapshot, recipe); errorUnmarshal != nil {
+		return nil, errorUnmarshal
+	}
+
+	return recipe, nil
+}
+
+// RecipeDiff compares two versions of a recipe. The fields of the recipe, the categories, the ingredients with
+// their measures and the processes are compared, the ingredients are matched by the ingredient they derive from.
+func RecipeDiff(from *aggregate.RecipeVersion, to *aggregate.RecipeVersion) *aggregate.RecipeDiff {
+	recipeDiff := &aggregate.RecipeDiff{
+		From:        from.Entity,
+		To:          to.Entity,
+		Fields:      []*aggregate.RecipeFieldChange{},
+		Categories:  []*aggregate.RecipeItemChange{},
+		Ingredients: []*aggregate.RecipeIngredientChange{},
+		Processes:   []*aggregate.RecipeItemChange{},
+	}
+
+	if from.Recipe == nil || to.Recipe == nil {
+		return recipeDiff
+	}
+
+	if from.Recipe.Entity != nil && to.Recipe.Entity != nil {
+		recipeDiff.Fields = recipeFieldChanges(
+			[]string{"name", "description", "notes", "servings", "calories", "status"},
+			[]string{
+				from.Recipe.Entity.Name,
+				from.Recipe.Entity.Description,
+				from.Recipe.Entity.Notes,
+				strconv.FormatInt(from.Recipe.Entity.Servings, 10),
+				strconv.FormatInt(from.Recipe.Entity.Calories, 10),
+				from.Recipe.Entity.Status.String(),
+			},
+			[]string{
+				to.Recipe.Entity.Name,
+				to.Recipe.Entity.Description,
+				to.Recipe.Entity.Notes,
+				strconv.FormatInt(to.Recipe.Entity.Servings, 10),
+				strconv.FormatInt(to.Recipe.Entity.Calories, 10),
+				to.Recipe.Entity.Status.String(),
+			},
+		)
+	}
+
+	recipeDiff.Categories = recipeCategoryChanges(from.Recipe.Categories, to.Recipe.Categories)
+	recipeDiff.Ingredients = recipeIngredientChanges(from.Recipe.Ingredients, to.Recipe.Ingredients)
+	recipeDiff.Processes = recipeProcessChanges(from.Recipe.Processes, to.Recipe.Processes)
+
+	return recipeDiff
+}
+
+func recipeFieldChanges(fields []string, from []string, to []string) []*aggregate.RecipeFieldChange {
+	fieldChanges := []*aggregate.RecipeFieldChange{}
+
+	for index, field := range fields {
+		if from[index] != to[index] {
+			fieldChanges = append(fieldChanges, &aggregate.RecipeFieldChange{Field: field, From: from[index], To: to[index]})
+		}
+	}
+
+	return fieldChanges
+}
+
+func recipeCategoryChanges(from []*aggregate.RecipeCategory, to []*aggregate.RecipeCategory) []*aggregate.RecipeItemChange {
+	itemChanges := []*aggregate.RecipeItemChange{}
+	toCategories := map[uuid.UUID]*aggregate.RecipeCategory{}
+	fromCategories := map[uuid.UUID]bool{}
+
+	for _, recipeCategory := range to {
+		if recipeCategory != nil && recipeCategory.Entity != nil {
+			toCategories[recipeCategory.Entity.DeriveId] = recipeCategory
+		}
+	}
+
+	for _, recipeCategory := range from {
+		if recipeCategory == nil || recipeCategory.Entity == nil {
+			continue
+		}
+
+		fromCategories[recipeCategory.Entity.DeriveId] = true
+		toCategory, ok := toCategories[recipeCategory.Entity.DeriveId]
+
+		if !ok {
+			itemChanges = append(itemChanges, recipeCategoryChange(kind.RecipeChangeRemoved, recipeCategory, nil))
+		} else if fields := recipeFieldChanges(
+			[]string{"status"},
+			[]string{recipeCategory.Entity.Status.String()},
+			[]string{toCategory.Entity.Status.String()},
+		); len(fields) > 0 {
+			itemChanges = append(itemChanges, recipeCategoryChange(kind.RecipeChangeChanged, toCategory, fields))
+		}
+	}
+
+	for _, recipeCategory := range to {
+		if recipeCategory != nil && recipeCategory.Entity != nil && !fromCategories[recipeCategory.Entity.DeriveId] {
+			itemChanges = append(itemChanges, recipeCategoryChange(kind.RecipeChangeAdded, recipeCategory, nil))
+		}
+	}
+
+	return itemChanges
+}
+
+func recipeCategoryChange(change kind.RecipeChange, recipeCategory *aggregate.RecipeCategory, fields []*aggregate.RecipeFieldChange) *aggregate.RecipeItemChange {
+	itemChange := &aggregate.RecipeItemChange{Change: change, Id: recipeCategory.Entity.DeriveId, Fields: fields}
+
+	if recipeCategory.Derive != nil && recipeCategory.Derive.Entity != nil {
+		itemChange.Name = recipeCategory.Derive.Entity.Name
+	}
+
+	return itemChange
+}
+
+func recipeIngredientChanges(from []*aggregate.RecipeIngredient, to []*aggregate.RecipeIngredient) []*aggregate.RecipeIngredientChange {
+	ingredientChanges := []*aggregate.RecipeIngredientChange{}
+	toIngredients := map[uuid.UUID]*aggregate.RecipeIngredient{}
+	fromIngredients := map[uuid.UUID]bool{}
+
+	for _, recipeIngredient := range to {
+		if recipeIngredient != nil && recipeIngredient.Entity != nil {
+			toIngredients[recipeIngredient.Entity.DeriveId] = recipeIngredient
+		}
+	}
+
+	for _, recipeIngredient := range from {
+		if recipeIngredient == nil || recipeIngredient.Entity == nil {
+			continue
+		}
+
+		fromIngredients[recipeIngredient.Entity.DeriveId] = true
+		toIngredient, ok := toIngredients[recipeIngredient.Entity.DeriveId]
+
+		if !ok {
+			ingredientChanges = append(
+				ingredientChanges,
+				&aggregate.RecipeIngredientChange{
+					Change:     kind.RecipeChangeRemoved,
+					Ingredient: recipeIngredient.Derive,
+					Name:       recipeIngredient.Entity.Name,
+					From:       recipeMeasureChanges(recipeIngredient.Measures),
+				},
+			)
+
+			continue
+		}
+
+		fromMeasures := recipeMeasureChanges(recipeIngredient.Measures)
+		toMeasures := recipeMeasureChanges(toIngredient.Measures)
+
+		if recipeIngredient.Entity.Name != toIngredient.Entity.Name || !recipeMeasuresEqual(fromMeasures, toMeasures) {
+			ingredientChanges = append(
+				ingredientChanges,
+				&aggregate.RecipeIngredientChange{
+					Change:     kind.RecipeChangeChanged,
+					Ingredient: toIngredient.Derive,
+					Name:       toIngredient.Entity.Name,
+					From:       fromMeasures,
+					To:         toMeasures,
+				},
+			)
+		}
+	}
+
+	for _, recipeIngredient := range to {
+		if recipeIngredient != nil && recipeIngredient.Entity != nil && !fromIngredients[recipeIngredient.Entity.DeriveId] {
+			ingredientChanges = append(
+				ingredientChanges,
+				&aggregate.RecipeIngredientChange{
+					Change:     kind.RecipeChangeAdded,
+					Ingredient: recipeIngredient.Derive,
+					Name:       recipeIngredient.Entity.Name,
+					To:         recipeMeasureChanges(recipeIngredient.Measures),
+				},
+			)
+		}
+	}
+
+	return ingredientChanges
+}
+
+func recipeMeasureChanges(recipeMeasures []*aggregate.RecipeMeasure) []*aggregate.RecipeMeasureChange {
+	var measureChanges []*aggregate.RecipeMeasureChange
+
+	for _, recipeMeasure := range recipeMeasures {
+		if recipeMeasure != nil && recipeMeasure.Entity != nil {
+			measureChanges = append(measureChanges, &aggregate.RecipeMeasureChange{Unit: recipeMeasure.Unit, Value: recipeMeasure.Entity.Value})
+		}
+	}
+
+	return measureChanges
+}
+
+func recipeMeasuresEqual(from []*aggregate.RecipeMeasureChange, to []*aggregate.RecipeMeasureChange) bool {
+	if len(from) != len(to) {
+		return false
+	}
+
+	values := map[uuid.UUID]int64{}
+
+	for _, measure := range from {
+		values[recipeMeasureUnitId(measure)] += measure.Value
+	}
+
+	for _, measure := range to {
+		values[recipeMeasureUnitId(measure)] -= measure.Value
+	}
+
+	for _, value := range values {
+		if value != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func recipeMeasureUnitId(measure *aggregate.RecipeMeasureChange) uuid.UUID {
+	if measure.Unit == nil {
+		return uuid.Nil
+	}
+
+	return measure.Unit.Id
+}
+
+func recipeProcessChanges(from []*aggregate.RecipeProcess, to []*aggregate.RecipeProcess) []*aggregate.RecipeItemChange {
+	itemChanges := []*aggregate.RecipeItemChange{}
+	toProcesses := map[uuid.UUID]*aggregate.RecipeProcess{}
+	fromProcesses := map[uuid.UUID]bool{}
+
+	for _, recipeProcess := range to {
+		if recipeProcess != nil && recipeProcess.Entity != nil {
+			toProcesses[recipeProcess.Entity.Id] = recipeProcess
+		}
+	}
+
+	for _, recipeProcess := range from {
+		if recipeProcess == nil || recipeProcess.Entity == nil {
+			continue
+		}
+
+		fromProcesses[recipeProcess.Entity.Id] = true
+		toProcess, ok := toProcesses[recipeProcess.Entity.Id]
+
+		if !ok {
+			itemChanges = append(
+				itemChanges,
+				&aggregate.RecipeItemChange{Change: kind.RecipeChangeRemoved, Id: recipeProcess.Entity.Id, Name: recipeProcess.Entity.Name},
+			)
+		} else if fields := recipeFieldChanges(
+			[]string{"name", "description", "notes", "status"},
+			[]string{recipeProcess.Entity.Name, recipeProcess.Entity.Description, recipeProcess.Entity.Notes, recipeProcess.Entity.Status.String()},
+			[]string{toProcess.Entity.Name, toProcess.Entity.Description, toProcess.Entity.Notes, toProcess.Entity.Status.String()},
+		); len(fields) > 0 {
+			itemChanges = append(
+				itemChanges,
+				&aggregate.RecipeItemChange{Change: kind.RecipeChangeChanged, Id: toProcess.Entity.Id, Name: toProcess.Entity.Name, Fields: fields},
+			)
+		}
+	}
+
+	for _, recipeProcess := range to {
+		if recipeProcess != nil && recipeProcess.Entity != nil && !fromProcesses[recipeProcess.Entity.Id] {
+			itemChanges = append(
+				itemChanges,
+				&aggregate.RecipeItemChange{Change: kind.RecipeChangeAdded, Id: recipeProcess.Entity.Id, Name: recipeProcess.Entity.Name},
+			)
+		}
+	}
+
+	return itemChanges
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	testRecipeVersionCategoryId = uuid.New()
+	testRecipeVersionProcessId  = uuid.New()
+)
+
+func testRecipeVersionRecipe(name string, servings int64, flour int64, withButter bool, processName string) *aggregate.Recipe {
+	recipe := &aggregate.Recipe{
+		Entity: &entity.Recipe{Name: name, Servings: servings, Status: kind.RecipeStatusPublished},
+		Categories: []*aggregate.RecipeCategory{
+			{
+				Derive: &aggregate.Category{Entity: &entity.Category{Id: testRecipeVersionCategoryId, Name: "Bread"}},
+				Entity: &entity.RecipeCategory{DeriveId: testRecipeVersionCategoryId},
+			},
+		},
+		Ingredients: []*aggregate.RecipeIngredient{
+			{
+				Derive:   testPantryFlour,
+				Entity:   &entity.RecipeIngredient{DeriveId: testPantryFlour.Id, Name: "Flour"},
+				Measures: []*aggregate.RecipeMeasure{{Entity: &entity.RecipeMeasure{Value: flour}, Unit: testPantryGram}},
+			},
+		},
+		Processes: []*aggregate.RecipeProcess{
+			{Entity: &entity.RecipeProcess{Id: testRecipeVersionProcessId, Name: processName}},
+		},
+	}
+
+	if withButter {
+		recipe.Ingredients = append(
+			recipe.Ingredients,
+			&aggregate.RecipeIngredient{
+				Derive:   testSubstituteButter,
+				Entity:   &entity.RecipeIngredient{DeriveId: testSubstituteButter.Id, Name: "Butter"},
+				Measures: []*aggregate.RecipeMeasure{{Entity: &entity.RecipeMeasure{Value: 50}, Unit: testPantryGram}},
+			},
+		)
+	}
+
+	return recipe
+}
+
+func TestRecipeSnapshot(t *testing.T) {
+	recipe := testRecipeVersionRecipe("Bread", 4, 500, true, "Knead")
+	snapshot, errorSnapshot := RecipeSnapshot(recipe)
+
+	assert.Nil(t, errorSnapshot)
+
+	actual, errorActual := RecipeFromSnapshot(snapshot)
+
+	assert.Nil(t, errorActual)
+	assert.Equal(t, recipe, actual)
+
+	actual, errorActual = RecipeFromSnapshot([]byte("{"))
+
+	assert.Nil(t, actual)
+	assert.NotNil(t, errorActual)
+}
+
+func TestRecipeDiff(t *testing.T) {
+	from := &aggregate.RecipeVersion{
+		Entity: &entity.RecipeVersion{Version: 1},
+		Recipe: testRecipeVersionRecipe("Bread", 4, 500, true, "Knead"),
+	}
+	to := &aggregate.RecipeVersion{
+		Entity: &entity.RecipeVersion{Version: 2},
+		Recipe: testRecipeVersionRecipe("Rye Bread", 4, 600, false, "Knead well"),
+	}
+	to.Recipe.Categories = nil
+
+	actual := RecipeDiff(from, to)
+
+	assert.Equal(t, from.Entity, actual.From)
+	assert.Equal(t, to.Entity, actual.To)
+	assert.Equal(t, []*aggregate.RecipeFieldChange{{Field: "name", From: "Bread", To: "Rye Bread"}}, actual.Fields)
+	assert.Equal(
+		t,
+		[]*aggregate.RecipeItemChange{{Change: kind.RecipeChangeRemoved, Id: testRecipeVersionCategoryId, Name: "Bread"}},
+		actual.Categories,
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.RecipeIngredientChange{
+			{
+				Change:     kind.RecipeChangeChanged,
+				Ingredient: testPantryFlour,
+				Name:       "Flour",
+				From:       []*aggregate.RecipeMeasureChange{{Unit: testPantryGram, Value: 500}},
+				To:         []*aggregate.RecipeMeasureChange{{Unit: testPantryGram, Value: 600}},
+			},
+			{
+				Change:     kind.RecipeChangeRemoved,
+				Ingredient: testSubstituteButter,
+				Name:       "Butter",
+				From:       []*aggregate.RecipeMeasureChange{{Unit: testPantryGram, Value: 50}},
+			},
+		},
+		actual.Ingredients,
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.RecipeItemChange{
+			{
+				Change: kind.RecipeChangeChanged,
+				Id:     testRecipeVersionProcessId,
+				Name:   "Knead well",
+				Fields: []*aggregate.RecipeFieldChange{{Field: "name", From: "Knead", To: "Knead well"}},
+			},
+		},
+		actual.Processes,
+	)
+
+	actual = RecipeDiff(to, from)
+
+	assert.Equal(t, kind.RecipeChangeAdded, actual.Categories[0].Change)
+	assert.Equal(t, kind.RecipeChangeAdded, actual.Ingredients[1].Change)
+	assert.Equal(t, "Butter", actual.Ingredients[1].Name)
+
+	actual = RecipeDiff(from, from)
+
+	assert.Empty(t, actual.Fields)
+	assert.Empty(t, actual.Categories)
+	assert.Empty(t, actual.Ingredients)
+	assert.Empty(t, actual.Processes)
+}
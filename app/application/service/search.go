@@ -0,0 +1,422 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"html"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	searchBM25K1          = 1.2
+	searchBM25B           = 0.75
+	searchFragmentLength  = 160
+	searchFragmentContext = 5
+	searchFragments       = 3
+	searchHighlightStart  = "<mark>"
+	searchHighlightEnd    = "</mark>"
+)
+
+var (
+	searchWord      = regexp.MustCompile(`[\p{L}\p{N}]+`)
+	searchStopWords = map[string]bool{
+		"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true, "for": true,
+		"from": true, "in": true, "into": true, "is": true, "it": true, "of": true, "on": true, "or": true, "the": true,
+		"to": true, "with": true,
+	}
+	// searchFieldWeights are the weights of the text indexes of the database, so a search ranks alike with
+	// the index of the application.
+	searchFieldWeights = map[kind.SearchField]float64{
+		kind.SearchFieldName:        10,
+		kind.SearchFieldDescription: 5,
+		kind.SearchFieldNotes:       2,
+		kind.SearchFieldProcesses:   3,
+		kind.SearchFieldIngredients: 4,
+		kind.SearchFieldAltNames:    3,
+	}
+	searchFields = []kind.SearchField{
+		kind.SearchFieldName,
+		kind.SearchFieldDescription,
+		kind.SearchFieldNotes,
+		kind.SearchFieldIngredients,
+		kind.SearchFieldProcesses,
+		kind.SearchFieldAltNames,
+	}
+)
+
+// SearchDocument is the text of a recipe which is searched, the texts are grouped by the fields they come from.
+type SearchDocument struct {
+	Id     uuid.UUID
+	Fields map[kind.SearchField][]string
+}
+
+type SearchHit struct {
+	Id    uuid.UUID
+	Score float64
+}
+
+// SearchIndex is an inverted index of the stemmed words of the documents which ranks the documents by BM25 with
+// the weights of the fields.
+type SearchIndex struct {
+	postings  map[string]map[int]map[kind.SearchField]int64
+	documents []uuid.UUID
+	lengths   []map[kind.SearchField]int64
+	totals    map[kind.SearchField]int64
+}
+
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{postings: map[string]map[int]map[kind.SearchField]int64{}, totals: map[kind.SearchField]int64{}}
+}
+
+// Add indexes the words of the document.
+func (si *SearchIndex) Add(document *SearchDocument) {
+	position := len(si.documents)
+	lengths := map[kind.SearchField]int64{}
+
+	si.documents = append(si.documents, document.Id)
+	si.lengths = append(si.lengths, lengths)
+
+	for field, texts := range document.Fields {
+		for _, text := range texts {
+			for _, term := range searchTokens(text) {
+				if _, ok := si.postings[term]; !ok {
+					si.postings[term] = map[int]map[kind.SearchField]int64{}
+				}
+
+				if _, ok := si.postings[term][position]; !ok {
+					si.postings[term][position] = map[kind.SearchField]int64{}
+				}
+
+				si.postings[term][position][field]++
+				lengths[field]++
+				si.totals[field]++
+			}
+		}
+	}
+}
+
+// Search returns the documents which have any of the terms in the order of their relevance, the limit is not applied
+// when it is not positive.
+func (si *SearchIndex) Search(terms []string, limit int) []*SearchHit {
+	scores := map[int]float64{}
+	count := float64(len(si.documents))
+
+	for _, term := range terms {
+		postings := si.postings[term]
+
+		if len(postings) == 0 {
+			continue
+		}
+
+		idf := math.Log(1 + (count-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+
+		for position, frequencies := range postings {
+			for field, frequency := range frequencies {
+				average := float64(si.totals[field]) / count
+				norm := 1 - searchBM25B + searchBM25B*float64(si.lengths[position][field])/average
+				tf := float64(frequency) * (searchBM25K1 + 1) / (float64(frequency) + searchBM25K1*norm)
+
+				scores[position] += idf * tf * searchFieldWeights[field]
+			}
+		}
+	}
+
+	ranks := make(map[uuid.UUID]float64, len(scores))
+
+	for position, score := range scores {
+		ranks[si.documents[position]] += score
+	}
+
+	return SearchRank(ranks, limit)
+}
+
+// SearchRank returns the hits in the order of their scores, the limit is not applied when it is not positive.
+func SearchRank(scores map[uuid.UUID]float64, limit int) []*SearchHit {
+	hits := make([]*SearchHit, 0, len(scores))
+
+	for id, score := range scores {
+		hits = append(hits, &SearchHit{Id: id, Score: score})
+	}
+
+	sort.Slice(
+		hits,
+		func(i, j int) bool {
+			if hits[i].Score != hits[j].Score {
+				return hits[i].Score > hits[j].Score
+			}
+
+			return hits[i].Id.String() < hits[j].Id.String()
+		},
+	)
+
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits
+}
+
+// SearchTerms returns the stemmed words of the query without the stop words and the repeated words.
+func SearchTerms(query string) []string {
+	var terms []string
+
+	seen := map[string]bool{}
+
+	for _, term := range searchTokens(query) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
+// SearchStem returns the stem of an English word, e.g. "chopped", "chopping" and "chop" have the same stem. The stem
+// is not a word itself sometimes, e.g. "bake" and "baking" have the stem "bak".
+func SearchStem(word string) string {
+	word = strings.ToLower(word)
+
+	if utf8.RuneCountInString(word) <= 3 {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		word = word[:len(word)-3] + "y"
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "es") && searchStemSibilant(word[:len(word)-2]):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		word = word[:len(word)-1]
+	}
+
+	if strings.HasSuffix(word, "ly") && len(word) > 4 {
+		word = word[:len(word)-2]
+	}
+
+	if strings.HasSuffix(word, "ied") && len(word) > 4 {
+		word = word[:len(word)-3] + "y"
+	} else {
+		for _, suffix := range []string{"ing", "ed"} {
+			stem := strings.TrimSuffix(word, suffix)
+
+			if stem != word && len(stem) >= 3 && strings.ContainsAny(stem, "aeiouy") {
+				word = searchStemUndouble(stem)
+
+				break
+			}
+		}
+	}
+
+	if strings.HasSuffix(word, "e") && len(word) > 3 {
+		word = word[:len(word)-1]
+	}
+
+	return word
+}
+
+// SearchRecipeDocument returns the texts of the recipe, its processes, its ingredients and all of their alternative
+// names. The alternative names of the ingredients of the user are taken from the names given.
+func SearchRecipeDocument(recipe *aggregate.Recipe, altNames []*entity.AltName) *SearchDocument {
+	document := &SearchDocument{Id: recipe.Entity.Id, Fields: map[kind.SearchField][]string{}}
+
+	searchDocumentAdd(document, kind.SearchFieldName, recipe.Entity.Name)
+	searchDocumentAdd(document, kind.SearchFieldDescription, recipe.Entity.Description)
+	searchDocumentAdd(document, kind.SearchFieldNotes, recipe.Entity.Notes)
+	searchDocumentAltNames(document, recipe.AltNames)
+
+	for _, recipeProcess := range recipe.Processes {
+		if recipeProcess == nil || recipeProcess.Entity == nil {
+			continue
+		}
+
+		searchDocumentAdd(document, kind.SearchFieldProcesses, recipeProcess.Entity.Name)
+		searchDocumentAdd(document, kind.SearchFieldProcesses, recipeProcess.Entity.Description)
+		searchDocumentAdd(document, kind.SearchFieldProcesses, recipeProcess.Entity.Notes)
+		searchDocumentAltNames(document, recipeProcess.AltNames)
+	}
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if recipeIngredient == nil || recipeIngredient.Entity == nil {
+			continue
+		}
+
+		searchDocumentAdd(document, kind.SearchFieldIngredients, recipeIngredient.Entity.Name)
+		searchDocumentAltNames(document, recipeIngredient.AltNames)
+
+		if recipeIngredient.Derive == nil {
+			continue
+		}
+
+		if !strings.EqualFold(recipeIngredient.Derive.Name, recipeIngredient.Entity.Name) {
+			searchDocumentAdd(document, kind.SearchFieldIngredients, recipeIngredient.Derive.Name)
+		}
+
+		for _, altName := range altNames {
+			if recipeIngredient.Derive.Id != uuid.Nil && altName.EntityId == recipeIngredient.Derive.Id {
+				searchDocumentAdd(document, kind.SearchFieldAltNames, altName.Name)
+			}
+		}
+	}
+
+	return document
+}
+
+// SearchHighlights returns the fragments of the texts of the document with the words of the terms marked by <mark>
+// tags, the rest of the fragments is escaped as HTML.
+func SearchHighlights(document *SearchDocument, terms []string) []*aggregate.SearchHighlight {
+	var highlights []*aggregate.SearchHighlight
+
+	termSet := map[string]bool{}
+
+	for _, term := range terms {
+		termSet[term] = true
+	}
+
+	for _, field := range searchFields {
+		count := 0
+
+		for _, text := range document.Fields[field] {
+			if count == searchFragments {
+				break
+			}
+
+			if fragment, ok := searchHighlight(text, termSet); ok {
+				highlights = append(highlights, &aggregate.SearchHighlight{Field: field, Fragment: fragment})
+				count++
+			}
+		}
+	}
+
+	return highlights
+}
+
+func searchTokens(text string) []string {
+	var tokens []string
+
+	for _, word := range searchWord.FindAllString(strings.ToLower(text), -1) {
+		if !searchStopWords[word] {
+			tokens = append(tokens, SearchStem(word))
+		}
+	}
+
+	return tokens
+}
+
+// searchHighlight cuts the fragment around the first marked word when the text is longer than a fragment.
+func searchHighlight(text string, terms map[string]bool) (string, bool) {
+	locations := searchWord.FindAllStringIndex(text, -1)
+	first := -1
+
+	for index, location := range locations {
+		if terms[SearchStem(text[location[0]:location[1]])] {
+			first = index
+
+			break
+		}
+	}
+
+	if first == -1 {
+		return "", false
+	}
+
+	from, to := 0, len(text)
+
+	if utf8.RuneCountInString(text) > searchFragmentLength {
+		start := first - searchFragmentContext
+
+		if start < 0 {
+			start = 0
+		}
+
+		if start > 0 {
+			from = locations[start][0]
+		}
+
+		last := first
+
+		for last+1 < len(locations) && utf8.RuneCountInString(text[from:locations[last+1][1]]) <= searchFragmentLength {
+			last++
+		}
+
+		if last+1 < len(locations) {
+			to = locations[last][1]
+		}
+	}
+
+	var builder strings.Builder
+
+	if from > 0 {
+		builder.WriteString("…")
+	}
+
+	position := from
+
+	for _, location := range locations {
+		if location[0] < from || location[1] > to {
+			continue
+		}
+
+		word := text[location[0]:location[1]]
+
+		if !terms[SearchStem(word)] {
+			continue
+		}
+
+		builder.WriteString(html.EscapeString(text[position:location[0]]))
+		builder.WriteString(searchHighlightStart)
+		builder.WriteString(html.EscapeString(word))
+		builder.WriteString(searchHighlightEnd)
+		position = location[1]
+	}
+
+	builder.WriteString(html.EscapeString(text[position:to]))
+
+	if to < len(text) {
+		builder.WriteString("…")
+	}
+
+	return builder.String(), true
+}
+
+func searchDocumentAdd(document *SearchDocument, field kind.SearchField, text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		document.Fields[field] = append(document.Fields[field], text)
+	}
+}
+
+func searchDocumentAltNames(document *SearchDocument, altNames []*entity.AltName) {
+	for _, altName := range altNames {
+		if altName != nil {
+			searchDocumentAdd(document, kind.SearchFieldAltNames, altName.Name)
+		}
+	}
+}
+
+func searchStemSibilant(stem string) bool {
+	for _, suffix := range []string{"sh", "ch", "x", "s", "z", "o"} {
+		if strings.HasSuffix(stem, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func searchStemUndouble(stem string) string {
+	length := len(stem)
+
+	if length >= 2 && stem[length-1] == stem[length-2] && !strings.ContainsRune("aeiouylsz", rune(stem[length-1])) {
+		return stem[:length-1]
+	}
+
+	return stem
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestSearchStem(t *testing.T) {
+	tests := []struct {
+		words    []string
+		expected string
+	}{
+		{words: []string{"chop", "chopped", "chopping", "chops"}, expected: "chop"},
+		{words: []string{"bake", "baked", "baking", "bakes"}, expected: "bak"},
+		{words: []string{"tomato", "tomatoes"}, expected: "tomato"},
+		{words: []string{"berry", "berries"}, expected: "berry"},
+		{words: []string{"fry", "fried", "fries"}, expected: "fry"},
+		{words: []string{"slice", "sliced", "slices"}, expected: "slic"},
+		{words: []string{"Dish", "dishes"}, expected: "dish"},
+		{words: []string{"hummus"}, expected: "hummus"},
+		{words: []string{"string"}, expected: "string"},
+		{words: []string{"pudding", "puddings"}, expected: "pud"},
+		{words: []string{"egg", "eggs"}, expected: "egg"},
+	}
+
+	for _, testCase := range tests {
+		for _, word := range testCase.words {
+			assert.Equal(t, testCase.expected, SearchStem(word), word)
+		}
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"chop", "tomato", "onion"}, SearchTerms("Chopped tomatoes, the onions and CHOP!"))
+	assert.Nil(t, SearchTerms("the and of"))
+}
+
+func TestSearchIndex(t *testing.T) {
+	soup := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	salad := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	bread := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	searchIndex := NewSearchIndex()
+
+	searchIndex.Add(&SearchDocument{Id: soup, Fields: map[kind.SearchField][]string{
+		kind.SearchFieldName:      {"Tomato soup"},
+		kind.SearchFieldProcesses: {"Chop the onions and fry them."},
+	}})
+	searchIndex.Add(&SearchDocument{Id: salad, Fields: map[kind.SearchField][]string{
+		kind.SearchFieldName:  {"Green salad"},
+		kind.SearchFieldNotes: {"Add tomatoes if you like."},
+	}})
+	searchIndex.Add(&SearchDocument{Id: bread, Fields: map[kind.SearchField][]string{
+		kind.SearchFieldName: {"Bread"},
+	}})
+
+	hits := searchIndex.Search(SearchTerms("tomatoes"), 0)
+
+	assert.Equal(t, 2, len(hits))
+	assert.Equal(t, soup, hits[0].Id)
+	assert.Equal(t, salad, hits[1].Id)
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+
+	hits = searchIndex.Search(SearchTerms("chopped onion"), 0)
+
+	assert.Equal(t, 1, len(hits))
+	assert.Equal(t, soup, hits[0].Id)
+
+	assert.Equal(t, 1, len(searchIndex.Search(SearchTerms("tomato"), 1)))
+	assert.Equal(t, 0, len(searchIndex.Search(SearchTerms("pasta"), 0)))
+}
+
+func TestSearchHighlights(t *testing.T) {
+	longText := strings.Repeat("Stir the pot slowly. ", 10) + "Add the chopped <tomatoes> & salt. " + strings.Repeat("Simmer for a while. ", 10)
+	document := &SearchDocument{Fields: map[kind.SearchField][]string{
+		kind.SearchFieldName:      {"Tomato soup"},
+		kind.SearchFieldNotes:     {"No match here"},
+		kind.SearchFieldProcesses: {longText},
+	}}
+
+	highlights := SearchHighlights(document, SearchTerms("tomatoes"))
+
+	assert.Equal(t, 2, len(highlights))
+	assert.Equal(t, &aggregate.SearchHighlight{Field: kind.SearchFieldName, Fragment: "<mark>Tomato</mark> soup"}, highlights[0])
+	assert.Equal(t, kind.SearchFieldProcesses, highlights[1].Field)
+	assert.True(t, strings.HasPrefix(highlights[1].Fragment, "…"))
+	assert.True(t, strings.HasSuffix(highlights[1].Fragment, "…"))
+	assert.Contains(t, highlights[1].Fragment, "Add the chopped &lt;<mark>tomatoes</mark>&gt; &amp; salt.")
+	assert.LessOrEqual(t, len([]rune(highlights[1].Fragment)), searchFragmentLength+60)
+}
+
+func TestSearchRecipeDocument(t *testing.T) {
+	ingredientId := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	recipe := &aggregate.Recipe{
+		AltNames: []*entity.AltName{{Name: "Gazpacho"}},
+		Entity:   &entity.Recipe{Id: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "Tomato soup", Notes: " "},
+		Ingredients: []*aggregate.RecipeIngredient{
+			{Entity: &entity.RecipeIngredient{Name: "tomatoes"}, Derive: &entity.Ingredient{Id: ingredientId, Name: "Tomato"}},
+			{Entity: &entity.RecipeIngredient{Name: "Salt"}, Derive: &entity.Ingredient{Name: "salt"}},
+		},
+		Processes: []*aggregate.RecipeProcess{
+			{Entity: &entity.RecipeProcess{Name: "Step 1", Description: "Blend."}, AltNames: []*entity.AltName{{Name: "Mixing"}}},
+		},
+	}
+
+	document := SearchRecipeDocument(recipe, []*entity.AltName{{EntityId: ingredientId, Name: "Pomodoro"}, {Name: "Other"}})
+
+	assert.Equal(t, recipe.Entity.Id, document.Id)
+	assert.Equal(
+		t,
+		map[kind.SearchField][]string{
+			kind.SearchFieldName:        {"Tomato soup"},
+			kind.SearchFieldProcesses:   {"Step 1", "Blend."},
+			kind.SearchFieldIngredients: {"tomatoes", "Tomato", "Salt"},
+			kind.SearchFieldAltNames:    {"Gazpacho", "Mixing", "Pomodoro"},
+		},
+		document.Fields,
+	)
+}
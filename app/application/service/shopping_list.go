@@ -0,0 +1,152 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+// PlannerCalculationMerge sums the calculations of several planners up by ingredient and unit.
+func PlannerCalculationMerge(plannerCalculationsList ...[]*aggregate.PlannerCalculation) []*aggregate.PlannerCalculation {
+	var plannerCalculationsMerged []*aggregate.PlannerCalculation
+
+	mapPlannerCalculations := map[string]*aggregate.PlannerCalculation{}
+
+	for _, plannerCalculations := range plannerCalculationsList {
+		for _, plannerCalculation := range plannerCalculations {
+			if plannerCalculation == nil || plannerCalculation.Ingredient == nil || plannerCalculation.Unit == nil {
+				continue
+			}
+
+			mapKey := plannerCalculation.Ingredient.Id.String() + plannerCalculation.Unit.Id.String()
+			_, ok := mapPlannerCalculations[mapKey]
+
+			if !ok {
+				mapPlannerCalculations[mapKey] = &aggregate.PlannerCalculation{
+					Ingredient: plannerCalculation.Ingredient,
+					Unit:       plannerCalculation.Unit,
+					Amount:     0,
+				}
+				plannerCalculationsMerged = append(plannerCalculationsMerged, mapPlannerCalculations[mapKey])
+			}
+
+			mapPlannerCalculations[mapKey].Amount += plannerCalculation.Amount
+		}
+	}
+
+	return plannerCalculationsMerged
+}
+
+// ShoppingListItemsMerge merges freshly calculated amounts into the existing items of a shopping list.
+// Matching items keep their id, status and category, new calculations become unchecked items.
+// Generated items that are not calculated anymore are deleted unless they have been checked,
+// manually added items are never touched.
+func ShoppingListItemsMerge(
+	shoppingListItems []*entity.ShoppingListItem,
+	plannerCalculations []*aggregate.PlannerCalculation,
+	now time.Time,
+) (upserted []*entity.ShoppingListItem, deleted []*entity.ShoppingListItem) {
+	mapShoppingListItems := map[string]*entity.ShoppingListItem{}
+	mapCalculated := map[string]bool{}
+
+	for _, shoppingListItem := range shoppingListItems {
+		if shoppingListItem == nil || shoppingListItem.Manual {
+			continue
+		}
+
+		mapShoppingListItems[shoppingListItem.IngredientId.String()+shoppingListItem.UnitId.String()] = shoppingListItem
+	}
+
+	for _, plannerCalculation := range plannerCalculations {
+		if plannerCalculation == nil || plannerCalculation.Ingredient == nil || plannerCalculation.Unit == nil {
+			continue
+		}
+
+		mapKey := plannerCalculation.Ingredient.Id.String() + plannerCalculation.Unit.Id.String()
+		mapCalculated[mapKey] = true
+		shoppingListItem, ok := mapShoppingListItems[mapKey]
+
+		if !ok {
+			upserted = append(
+				upserted,
+				&entity.ShoppingListItem{
+					IngredientId: plannerCalculation.Ingredient.Id,
+					UnitId:       plannerCalculation.Unit.Id,
+					CategoryId:   plannerCalculation.Ingredient.CategoryId,
+					DateInsert:   now,
+					DateUpdate:   now,
+					Name:         plannerCalculation.Ingredient.Name,
+					Amount:       plannerCalculation.Amount,
+					Manual:       false,
+					Status:       kind.ShoppingListItemStatusUnChecked,
+				},
+			)
+		} else if shoppingListItem.Amount != plannerCalculation.Amount {
+			shoppingListItem.Amount = plannerCalculation.Amount
+			shoppingListItem.DateUpdate = now
+			upserted = append(upserted, shoppingListItem)
+		}
+	}
+
+	for _, shoppingListItem := range shoppingListItems {
+		if shoppingListItem == nil || shoppingListItem.Manual || shoppingListItem.Status == kind.ShoppingListItemStatusChecked {
+			continue
+		}
+
+		if !mapCalculated[shoppingListItem.IngredientId.String()+shoppingListItem.UnitId.String()] {
+			deleted = append(deleted, shoppingListItem)
+		}
+	}
+
+	return upserted, deleted
+}
+
+// ShoppingListGroup groups the items of a shopping list by their category keeping the order of the items.
+// The items without a known category are put into the last group which has no category.
+func ShoppingListGroup(
+	shoppingListItems []*aggregate.ShoppingListItem,
+	categories map[uuid.UUID]*entity.Category,
+) []*aggregate.ShoppingListGroup {
+	var (
+		shoppingListGroups    []*aggregate.ShoppingListGroup
+		shoppingListUngrouped *aggregate.ShoppingListGroup
+	)
+
+	mapShoppingListGroups := map[uuid.UUID]*aggregate.ShoppingListGroup{}
+
+	for _, shoppingListItem := range shoppingListItems {
+		if shoppingListItem == nil || shoppingListItem.Entity == nil {
+			continue
+		}
+
+		category, ok := categories[shoppingListItem.Entity.CategoryId]
+
+		if !ok || category == nil {
+			if shoppingListUngrouped == nil {
+				shoppingListUngrouped = &aggregate.ShoppingListGroup{}
+			}
+
+			shoppingListUngrouped.Items = append(shoppingListUngrouped.Items, shoppingListItem)
+
+			continue
+		}
+
+		shoppingListGroup, ok := mapShoppingListGroups[category.Id]
+
+		if !ok {
+			shoppingListGroup = &aggregate.ShoppingListGroup{Category: category}
+			mapShoppingListGroups[category.Id] = shoppingListGroup
+			shoppingListGroups = append(shoppingListGroups, shoppingListGroup)
+		}
+
+		shoppingListGroup.Items = append(shoppingListGroup.Items, shoppingListItem)
+	}
+
+	if shoppingListUngrouped != nil {
+		shoppingListGroups = append(shoppingListGroups, shoppingListUngrouped)
+	}
+
+	return shoppingListGroups
+}
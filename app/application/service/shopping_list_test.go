@@ -0,0 +1,151 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	testShoppingListNow        = time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC)
+	testShoppingListGram       = &entity.Unit{Id: uuid.New(), Name: "g"}
+	testShoppingListPiece      = &entity.Unit{Id: uuid.New(), Name: "pcs"}
+	testShoppingListBakery     = &entity.Category{Id: uuid.New(), Name: "Bakery"}
+	testShoppingListDairy      = &entity.Category{Id: uuid.New(), Name: "Dairy"}
+	testShoppingListFlour      = &entity.Ingredient{Id: uuid.New(), CategoryId: testShoppingListBakery.Id, Name: "Flour"}
+	testShoppingListEgg        = &entity.Ingredient{Id: uuid.New(), CategoryId: testShoppingListDairy.Id, Name: "Egg"}
+	testShoppingListMilk       = &entity.Ingredient{Id: uuid.New(), CategoryId: testShoppingListDairy.Id, Name: "Milk"}
+	testShoppingListSalt       = &entity.Ingredient{Id: uuid.New(), Name: "Salt"}
+	testShoppingListCategories = map[uuid.UUID]*entity.Category{
+		testShoppingListBakery.Id: testShoppingListBakery,
+		testShoppingListDairy.Id:  testShoppingListDairy,
+	}
+)
+
+func testShoppingListItem(ingredient *entity.Ingredient, unit *entity.Unit, amount int64, manual bool, status kind.ShoppingListItemStatus) *entity.ShoppingListItem {
+	return &entity.ShoppingListItem{
+		Id:           uuid.New(),
+		IngredientId: ingredient.Id,
+		UnitId:       unit.Id,
+		CategoryId:   ingredient.CategoryId,
+		Name:         ingredient.Name,
+		Amount:       amount,
+		Manual:       manual,
+		Status:       status,
+	}
+}
+
+func TestPlannerCalculationMerge(t *testing.T) {
+	plannerCalculations := PlannerCalculationMerge(
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListGram, Amount: 500},
+			{Ingredient: testShoppingListEgg, Unit: testShoppingListPiece, Amount: 2},
+		},
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListGram, Amount: 250},
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListPiece, Amount: 1},
+			nil,
+		},
+	)
+
+	assert.Len(t, plannerCalculations, 3)
+	assert.Equal(t, testShoppingListFlour, plannerCalculations[0].Ingredient)
+	assert.Equal(t, int64(750), plannerCalculations[0].Amount)
+	assert.Equal(t, testShoppingListEgg, plannerCalculations[1].Ingredient)
+	assert.Equal(t, int64(2), plannerCalculations[1].Amount)
+	assert.Equal(t, testShoppingListPiece, plannerCalculations[2].Unit)
+	assert.Equal(t, int64(1), plannerCalculations[2].Amount)
+}
+
+func TestShoppingListItemsMerge(t *testing.T) {
+	checkedFlour := testShoppingListItem(testShoppingListFlour, testShoppingListGram, 500, false, kind.ShoppingListItemStatusChecked)
+	uncheckedEgg := testShoppingListItem(testShoppingListEgg, testShoppingListPiece, 2, false, kind.ShoppingListItemStatusUnChecked)
+	checkedSalt := testShoppingListItem(testShoppingListSalt, testShoppingListGram, 10, false, kind.ShoppingListItemStatusChecked)
+	manualMilk := testShoppingListItem(testShoppingListMilk, testShoppingListPiece, 1, true, kind.ShoppingListItemStatusUnChecked)
+
+	upserted, deleted := ShoppingListItemsMerge(
+		[]*entity.ShoppingListItem{checkedFlour, uncheckedEgg, checkedSalt, manualMilk},
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListGram, Amount: 750},
+			{Ingredient: testShoppingListMilk, Unit: testShoppingListPiece, Amount: 2},
+		},
+		testShoppingListNow,
+	)
+
+	assert.Len(t, upserted, 2)
+	assert.Equal(t, checkedFlour.Id, upserted[0].Id)
+	assert.Equal(t, int64(750), upserted[0].Amount)
+	assert.Equal(t, kind.ShoppingListItemStatusChecked, upserted[0].Status)
+	assert.Equal(t, testShoppingListNow, upserted[0].DateUpdate)
+	assert.Equal(t, uuid.Nil, upserted[1].Id)
+	assert.Equal(t, testShoppingListMilk.Id, upserted[1].IngredientId)
+	assert.Equal(t, testShoppingListDairy.Id, upserted[1].CategoryId)
+	assert.Equal(t, "Milk", upserted[1].Name)
+	assert.Equal(t, int64(2), upserted[1].Amount)
+	assert.False(t, upserted[1].Manual)
+	assert.Equal(t, kind.ShoppingListItemStatusUnChecked, upserted[1].Status)
+	assert.Equal(t, []*entity.ShoppingListItem{uncheckedEgg}, deleted)
+	assert.Equal(t, int64(1), manualMilk.Amount)
+}
+
+func TestShoppingListItemsMergeWithoutChanges(t *testing.T) {
+	uncheckedFlour := testShoppingListItem(testShoppingListFlour, testShoppingListGram, 500, false, kind.ShoppingListItemStatusUnChecked)
+
+	upserted, deleted := ShoppingListItemsMerge(
+		[]*entity.ShoppingListItem{uncheckedFlour},
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListGram, Amount: 500},
+		},
+		testShoppingListNow,
+	)
+
+	assert.Empty(t, upserted)
+	assert.Empty(t, deleted)
+}
+
+func TestShoppingListItemsMergeWithManualItems(t *testing.T) {
+	manualFlour := testShoppingListItem(testShoppingListFlour, testShoppingListGram, 100, true, kind.ShoppingListItemStatusUnChecked)
+	manualEgg := testShoppingListItem(testShoppingListEgg, testShoppingListPiece, 6, true, kind.ShoppingListItemStatusUnChecked)
+	generatedFlour := testShoppingListItem(testShoppingListFlour, testShoppingListGram, 500, false, kind.ShoppingListItemStatusUnChecked)
+
+	upserted, deleted := ShoppingListItemsMerge(
+		[]*entity.ShoppingListItem{manualFlour, manualEgg, generatedFlour},
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testShoppingListFlour, Unit: testShoppingListGram, Amount: 750},
+		},
+		testShoppingListNow,
+	)
+
+	assert.Equal(t, []*entity.ShoppingListItem{generatedFlour}, upserted)
+	assert.Equal(t, int64(750), generatedFlour.Amount)
+	assert.Empty(t, deleted)
+	assert.Equal(t, int64(100), manualFlour.Amount)
+	assert.Equal(t, int64(6), manualEgg.Amount)
+
+	upserted, deleted = ShoppingListItemsMerge([]*entity.ShoppingListItem{manualFlour, manualEgg}, nil, testShoppingListNow)
+
+	assert.Empty(t, upserted)
+	assert.Empty(t, deleted)
+}
+
+func TestShoppingListGroup(t *testing.T) {
+	flour := &aggregate.ShoppingListItem{Entity: testShoppingListItem(testShoppingListFlour, testShoppingListGram, 500, false, kind.ShoppingListItemStatusUnChecked)}
+	egg := &aggregate.ShoppingListItem{Entity: testShoppingListItem(testShoppingListEgg, testShoppingListPiece, 2, false, kind.ShoppingListItemStatusUnChecked)}
+	salt := &aggregate.ShoppingListItem{Entity: testShoppingListItem(testShoppingListSalt, testShoppingListGram, 10, false, kind.ShoppingListItemStatusUnChecked)}
+	milk := &aggregate.ShoppingListItem{Entity: testShoppingListItem(testShoppingListMilk, testShoppingListPiece, 1, true, kind.ShoppingListItemStatusChecked)}
+
+	shoppingListGroups := ShoppingListGroup([]*aggregate.ShoppingListItem{egg, salt, flour, milk, nil}, testShoppingListCategories)
+
+	assert.Len(t, shoppingListGroups, 3)
+	assert.Equal(t, testShoppingListDairy, shoppingListGroups[0].Category)
+	assert.Equal(t, []*aggregate.ShoppingListItem{egg, milk}, shoppingListGroups[0].Items)
+	assert.Equal(t, testShoppingListBakery, shoppingListGroups[1].Category)
+	assert.Equal(t, []*aggregate.ShoppingListItem{flour}, shoppingListGroups[1].Items)
+	assert.Nil(t, shoppingListGroups[2].Category)
+	assert.Equal(t, []*aggregate.ShoppingListItem{salt}, shoppingListGroups[2].Items)
+	assert.Nil(t, ShoppingListGroup(nil, testShoppingListCategories))
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"math"
+	"time"
+)
+
+// SubstituteMatch reports whether the substitute is active and suitable for all the contexts. A substitute
+// without contexts is suitable only when no contexts are required.
+func SubstituteMatch(substitute *entity.IngredientSubstitute, contexts []kind.SubstituteContext) bool {
+	if substitute == nil || substitute.Status != kind.IngredientSubstituteStatusActive {
+		return false
+	}
+
+	if len(contexts) == 0 {
+		return len(substitute.Contexts) == 0
+	}
+
+	for _, context := range contexts {
+		found := false
+
+		for _, substituteContext := range substitute.Contexts {
+			if substituteContext == context {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SubstituteCandidates returns the substitutes of the ingredient which are suitable for all the contexts.
+func SubstituteCandidates(
+	ingredientId uuid.UUID,
+	substitutes []*aggregate.IngredientSubstitute,
+	contexts []kind.SubstituteContext,
+) []*aggregate.IngredientSubstitute {
+	var candidates []*aggregate.IngredientSubstitute
+
+	for _, substitute := range substitutes {
+		if substitute == nil || substitute.Entity == nil || substitute.Substitute == nil || substitute.Entity.EntityId != ingredientId {
+			continue
+		}
+
+		if SubstituteMatch(substitute.Entity, contexts) {
+			candidates = append(candidates, substitute)
+		}
+	}
+
+	return candidates
+}
+
+// SubstituteFor returns the substitute of the ingredient which has been chosen by its id or, when there is none,
+// the first one suitable for the contexts of the query.
+func SubstituteFor(
+	ingredientId uuid.UUID,
+	substitutes []*aggregate.IngredientSubstitute,
+	substituteQuery *aggregate.SubstituteQuery,
+) (*aggregate.IngredientSubstitute, kind.SubstituteReason) {
+	if substituteQuery == nil {
+		return nil, ""
+	}
+
+	for _, substitute := range substitutes {
+		if substitute == nil || substitute.Entity == nil || substitute.Substitute == nil || substitute.Entity.EntityId != ingredientId {
+			continue
+		}
+
+		if substitute.Entity.Status != kind.IngredientSubstituteStatusActive {
+			continue
+		}
+
+		for _, substituteId := range substituteQuery.Substitutes {
+			if substitute.Entity.Id == substituteId {
+				return substitute, kind.SubstituteReasonManual
+			}
+		}
+	}
+
+	if len(substituteQuery.Contexts) > 0 {
+		if candidates := SubstituteCandidates(ingredientId, substitutes, substituteQuery.Contexts); len(candidates) > 0 {
+			return candidates[0], kind.SubstituteReasonContext
+		}
+	}
+
+	return nil, ""
+}
+
+// SubstituteAmount returns the amount of a substitute for the amount of an ingredient, a ratio which is not
+// positive keeps the amount.
+func SubstituteAmount(amount int64, ratio float64) int64 {
+	if ratio <= 0 {
+		return amount
+	}
+
+	return int64(math.Round(float64(amount) * ratio))
+}
+
+// SubstituteCalculations replaces the ingredients of the planner calculations by their substitutes. The chosen
+// and the context substitutes replace the whole amount. With the pantry the amount which is missing in the pantry
+// is replaced by a substitute which is in the pantry in full.
+func SubstituteCalculations(
+	plannerCalculations []*aggregate.PlannerCalculation,
+	substitutes []*aggregate.IngredientSubstitute,
+	substituteQuery *aggregate.SubstituteQuery,
+	pantryItems []*aggregate.PantryItem,
+	now time.Time,
+) *aggregate.PlannerSubstitute {
+	var (
+		calculations  []*aggregate.PlannerCalculation
+		substitutions []*aggregate.Substitution
+	)
+
+	pantryItems = pantryAvailable(pantryItems, now)
+	remains := pantryRemains(pantryItems)
+
+	for _, plannerCalculation := range plannerCalculations {
+		if plannerCalculation == nil || plannerCalculation.Ingredient == nil || plannerCalculation.Unit == nil {
+			continue
+		}
+
+		substitute, reason := SubstituteFor(plannerCalculation.Ingredient.Id, substitutes, substituteQuery)
+
+		if substitute != nil {
+			substitution := &aggregate.Substitution{
+				Ingredient:       plannerCalculation.Ingredient,
+				Substitute:       substitute.Substitute,
+				Unit:             plannerCalculation.Unit,
+				Amount:           plannerCalculation.Amount,
+				SubstituteAmount: SubstituteAmount(plannerCalculation.Amount, substitute.Entity.Ratio),
+				Reason:           reason,
+			}
+			substitutions = append(substitutions, substitution)
+			calculations = append(
+				calculations,
+				&aggregate.PlannerCalculation{Ingredient: substitute.Substitute, Unit: plannerCalculation.Unit, Amount: substitution.SubstituteAmount},
+			)
+
+			continue
+		}
+
+		if substituteQuery == nil || !substituteQuery.UsePantry {
+			calculations = append(calculations, plannerCalculation)
+
+			continue
+		}
+
+		taken := pantryTake(pantryItems, remains, plannerCalculation.Ingredient.Id, plannerCalculation.Unit, plannerCalculation.Amount)
+		substitution := substitutePantry(
+			pantryItems,
+			remains,
+			plannerCalculation.Ingredient,
+			plannerCalculation.Unit,
+			plannerCalculation.Amount-taken,
+			SubstituteCandidates(plannerCalculation.Ingredient.Id, substitutes, substituteQuery.Contexts),
+		)
+
+		if substitution == nil {
+			calculations = append(calculations, plannerCalculation)
+
+			continue
+		}
+
+		if taken > 0 {
+			calculations = append(
+				calculations,
+				&aggregate.PlannerCalculation{Ingredient: plannerCalculation.Ingredient, Unit: plannerCalculation.Unit, Amount: taken},
+			)
+		}
+
+		substitutions = append(substitutions, substitution)
+		calculations = append(
+			calculations,
+			&aggregate.PlannerCalculation{Ingredient: substitution.Substitute, Unit: plannerCalculation.Unit, Amount: substitution.SubstituteAmount},
+		)
+	}
+
+	return &aggregate.PlannerSubstitute{Calculations: PlannerCalculationMerge(calculations), Substitutions: substitutions}
+}
+
+// SubstituteRecipe returns the substitutions of the ingredients of the recipe, one per measure. The chosen and
+// the context substitutes are used first, with the pantry an ingredient which is not in the pantry in full
+// is replaced by a substitute which is.
+func SubstituteRecipe(
+	recipe *aggregate.Recipe,
+	substitutes []*aggregate.IngredientSubstitute,
+	substituteQuery *aggregate.SubstituteQuery,
+	pantryItems []*aggregate.PantryItem,
+	now time.Time,
+) []*aggregate.Substitution {
+	var substitutions []*aggregate.Substitution
+
+	if recipe == nil {
+		return nil
+	}
+
+	pantryItems = pantryAvailable(pantryItems, now)
+	remains := pantryRemains(pantryItems)
+
+	for _, recipeIngredient := range recipe.Ingredients {
+		if recipeIngredient == nil || recipeIngredient.Derive == nil || recipeIngredient.Entity == nil {
+			continue
+		}
+
+		substitute, reason := SubstituteFor(recipeIngredient.Derive.Id, substitutes, substituteQuery)
+
+		if substitute == nil && substituteQuery != nil && substituteQuery.UsePantry {
+			substitute = substituteRecipePantry(
+				pantryItems,
+				remains,
+				recipeIngredient,
+				SubstituteCandidates(recipeIngredient.Derive.Id, substitutes, substituteQuery.Contexts),
+			)
+			reason = kind.SubstituteReasonPantry
+		}
+
+		if substitute == nil {
+			continue
+		}
+
+		if len(recipeIngredient.Measures) == 0 {
+			substitutions = append(
+				substitutions,
+				&aggregate.Substitution{
+					Ingredient:       recipeIngredient.Derive,
+					Substitute:       substitute.Substitute,
+					Reason:           reason,
+					RecipeIngredient: recipeIngredient.Entity,
+				},
+			)
+		}
+
+		for _, measure := range recipeIngredient.Measures {
+			if measure == nil || measure.Entity == nil {
+				continue
+			}
+
+			substitutions = append(
+				substitutions,
+				&aggregate.Substitution{
+					Ingredient:       recipeIngredient.Derive,
+					Substitute:       substitute.Substitute,
+					Unit:             measure.Unit,
+					Amount:           measure.Entity.Value,
+					SubstituteAmount: SubstituteAmount(measure.Entity.Value, substitute.Entity.Ratio),
+					Reason:           reason,
+					RecipeIngredient: recipeIngredient.Entity,
+				},
+			)
+		}
+	}
+
+	return substitutions
+}
+
+// substitutePantry takes the substitute of the missing amount of the ingredient from the pantry, the first
+// candidate which is in the pantry in full is taken.
+func substitutePantry(
+	pantryItems []*aggregate.PantryItem,
+	remains map[uuid.UUID]float64,
+	ingredient *entity.Ingredient,
+	unit *entity.Unit,
+	missing int64,
+	candidates []*aggregate.IngredientSubstitute,
+) *aggregate.Substitution {
+	if missing <= 0 {
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		required := SubstituteAmount(missing, candidate.Entity.Ratio)
+		trial := substituteRemainsCopy(remains)
+
+		if pantryTake(pantryItems, trial, candidate.Substitute.Id, unit, required) < required {
+			continue
+		}
+
+		for pantryItemId, remain := range trial {
+			remains[pantryItemId] = remain
+		}
+
+		return &aggregate.Substitution{
+			Ingredient:       ingredient,
+			Substitute:       candidate.Substitute,
+			Unit:             unit,
+			Amount:           missing,
+			SubstituteAmount: required,
+			Reason:           kind.SubstituteReasonPantry,
+		}
+	}
+
+	return nil
+}
+
+// substituteRecipePantry returns the candidate which is in the pantry for every measure of the recipe ingredient
+// when the ingredient itself is not.
+func substituteRecipePantry(
+	pantryItems []*aggregate.PantryItem,
+	remains map[uuid.UUID]float64,
+	recipeIngredient *aggregate.RecipeIngredient,
+	candidates []*aggregate.IngredientSubstitute,
+) *aggregate.IngredientSubstitute {
+	if substituteRecipeTake(pantryItems, remains, recipeIngredient.Derive.Id, recipeIngredient.Measures, 0) {
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		if substituteRecipeTake(pantryItems, remains, candidate.Substitute.Id, recipeIngredient.Measures, candidate.Entity.Ratio) {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
+// substituteRecipeTake takes every measure of the ingredient from the pantry, the remains are changed only when
+// all the measures have been taken.
+func substituteRecipeTake(
+	pantryItems []*aggregate.PantryItem,
+	remains map[uuid.UUID]float64,
+	ingredientId uuid.UUID,
+	measures []*aggregate.RecipeMeasure,
+	ratio float64,
+) bool {
+	trial := substituteRemainsCopy(remains)
+
+	for _, measure := range measures {
+		if measure == nil || measure.Entity == nil {
+			continue
+		}
+
+		required := SubstituteAmount(measure.Entity.Value, ratio)
+
+		if pantryTake(pantryItems, trial, ingredientId, measure.Unit, required) < required {
+			return false
+		}
+	}
+
+	for pantryItemId, remain := range trial {
+		remains[pantryItemId] = remain
+	}
+
+	return true
+}
+
+func substituteRemainsCopy(remains map[uuid.UUID]float64) map[uuid.UUID]float64 {
+	remainsCopy := make(map[uuid.UUID]float64, len(remains))
+
+	for pantryItemId, remain := range remains {
+		remainsCopy[pantryItemId] = remain
+	}
+
+	return remainsCopy
+}
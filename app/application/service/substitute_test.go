@@ -0,0 +1,183 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	testSubstituteButter    = &entity.Ingredient{Id: uuid.New(), Name: "Butter"}
+	testSubstituteMargarine = &entity.Ingredient{Id: uuid.New(), Name: "Margarine"}
+	testSubstituteOil       = &entity.Ingredient{Id: uuid.New(), Name: "Oil"}
+)
+
+func testSubstitute(ingredient *entity.Ingredient, substitute *entity.Ingredient, ratio float64, status kind.IngredientSubstituteStatus, contexts ...kind.SubstituteContext) *aggregate.IngredientSubstitute {
+	return &aggregate.IngredientSubstitute{
+		Entity: &entity.IngredientSubstitute{
+			Id:           uuid.New(),
+			EntityId:     ingredient.Id,
+			SubstituteId: substitute.Id,
+			Ratio:        ratio,
+			Contexts:     contexts,
+			Status:       status,
+		},
+		Substitute: substitute,
+	}
+}
+
+func TestSubstituteMatch(t *testing.T) {
+	general := testSubstitute(testSubstituteButter, testSubstituteMargarine, 1, kind.IngredientSubstituteStatusActive).Entity
+	vegan := testSubstitute(testSubstituteButter, testSubstituteOil, 0.75, kind.IngredientSubstituteStatusActive, kind.SubstituteContextBaking, kind.SubstituteContextVegan).Entity
+	inactive := testSubstitute(testSubstituteButter, testSubstituteOil, 1, kind.IngredientSubstituteStatusInActive).Entity
+
+	assert.True(t, SubstituteMatch(general, nil))
+	assert.False(t, SubstituteMatch(general, []kind.SubstituteContext{kind.SubstituteContextVegan}))
+	assert.False(t, SubstituteMatch(vegan, nil))
+	assert.True(t, SubstituteMatch(vegan, []kind.SubstituteContext{kind.SubstituteContextVegan}))
+	assert.True(t, SubstituteMatch(vegan, []kind.SubstituteContext{kind.SubstituteContextVegan, kind.SubstituteContextBaking}))
+	assert.False(t, SubstituteMatch(vegan, []kind.SubstituteContext{kind.SubstituteContextVegan, kind.SubstituteContextGlutenFree}))
+	assert.False(t, SubstituteMatch(inactive, nil))
+	assert.False(t, SubstituteMatch(nil, nil))
+}
+
+func TestSubstituteFor(t *testing.T) {
+	general := testSubstitute(testSubstituteButter, testSubstituteMargarine, 1, kind.IngredientSubstituteStatusActive)
+	vegan := testSubstitute(testSubstituteButter, testSubstituteOil, 0.75, kind.IngredientSubstituteStatusActive, kind.SubstituteContextVegan)
+	inactive := testSubstitute(testSubstituteButter, testSubstituteOil, 1, kind.IngredientSubstituteStatusInActive)
+	substitutes := []*aggregate.IngredientSubstitute{general, vegan, inactive}
+
+	substitute, reason := SubstituteFor(testSubstituteButter.Id, substitutes, &aggregate.SubstituteQuery{Substitutes: []uuid.UUID{general.Entity.Id}})
+	assert.Equal(t, general, substitute)
+	assert.Equal(t, kind.SubstituteReasonManual, reason)
+
+	substitute, reason = SubstituteFor(testSubstituteButter.Id, substitutes, &aggregate.SubstituteQuery{Contexts: []kind.SubstituteContext{kind.SubstituteContextVegan}})
+	assert.Equal(t, vegan, substitute)
+	assert.Equal(t, kind.SubstituteReasonContext, reason)
+
+	substitute, _ = SubstituteFor(testSubstituteButter.Id, substitutes, &aggregate.SubstituteQuery{Substitutes: []uuid.UUID{inactive.Entity.Id}})
+	assert.Nil(t, substitute)
+
+	substitute, _ = SubstituteFor(testSubstituteOil.Id, substitutes, &aggregate.SubstituteQuery{Contexts: []kind.SubstituteContext{kind.SubstituteContextVegan}})
+	assert.Nil(t, substitute)
+
+	substitute, _ = SubstituteFor(testSubstituteButter.Id, substitutes, nil)
+	assert.Nil(t, substitute)
+}
+
+func TestSubstituteAmount(t *testing.T) {
+	assert.Equal(t, int64(75), SubstituteAmount(100, 0.75))
+	assert.Equal(t, int64(2), SubstituteAmount(3, 0.5))
+	assert.Equal(t, int64(100), SubstituteAmount(100, 0))
+}
+
+func TestSubstituteCalculations(t *testing.T) {
+	substitutes := []*aggregate.IngredientSubstitute{
+		testSubstitute(testSubstituteButter, testSubstituteOil, 0.75, kind.IngredientSubstituteStatusActive, kind.SubstituteContextVegan),
+		testSubstitute(testSubstituteButter, testSubstituteMargarine, 1, kind.IngredientSubstituteStatusActive),
+	}
+	plannerCalculations := []*aggregate.PlannerCalculation{
+		{Ingredient: testSubstituteButter, Unit: testPantryGram, Amount: 200},
+		{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 500},
+	}
+
+	actual := SubstituteCalculations(plannerCalculations, substitutes, &aggregate.SubstituteQuery{Contexts: []kind.SubstituteContext{kind.SubstituteContextVegan}}, nil, testPantryNow)
+
+	assert.Equal(
+		t,
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testSubstituteOil, Unit: testPantryGram, Amount: 150},
+			{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 500},
+		},
+		actual.Calculations,
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.Substitution{
+			{Ingredient: testSubstituteButter, Substitute: testSubstituteOil, Unit: testPantryGram, Amount: 200, SubstituteAmount: 150, Reason: kind.SubstituteReasonContext},
+		},
+		actual.Substitutions,
+	)
+
+	pantryItems := []*aggregate.PantryItem{
+		testPantryItem(testSubstituteButter, testPantryGram, 50, testPantryNotExpired, kind.PantryItemStatusActive),
+		testPantryItem(testSubstituteMargarine, testPantryKilogram, 1, testPantryNotExpired, kind.PantryItemStatusActive),
+	}
+
+	actual = SubstituteCalculations(plannerCalculations, substitutes, &aggregate.SubstituteQuery{UsePantry: true}, pantryItems, testPantryNow)
+
+	assert.Equal(
+		t,
+		[]*aggregate.PlannerCalculation{
+			{Ingredient: testSubstituteButter, Unit: testPantryGram, Amount: 50},
+			{Ingredient: testSubstituteMargarine, Unit: testPantryGram, Amount: 150},
+			{Ingredient: testPantryFlour, Unit: testPantryGram, Amount: 500},
+		},
+		actual.Calculations,
+	)
+	assert.Equal(
+		t,
+		[]*aggregate.Substitution{
+			{Ingredient: testSubstituteButter, Substitute: testSubstituteMargarine, Unit: testPantryGram, Amount: 150, SubstituteAmount: 150, Reason: kind.SubstituteReasonPantry},
+		},
+		actual.Substitutions,
+	)
+
+	actual = SubstituteCalculations(plannerCalculations, substitutes, &aggregate.SubstituteQuery{UsePantry: true}, pantryItems[:1], testPantryNow)
+
+	assert.Equal(t, plannerCalculations, actual.Calculations)
+	assert.Nil(t, actual.Substitutions)
+}
+
+func TestSubstituteRecipe(t *testing.T) {
+	substitutes := []*aggregate.IngredientSubstitute{
+		testSubstitute(testSubstituteButter, testSubstituteOil, 0.75, kind.IngredientSubstituteStatusActive),
+	}
+	recipeButter := &entity.RecipeIngredient{Id: uuid.New(), DeriveId: testSubstituteButter.Id, Name: "Butter"}
+	recipe := &aggregate.Recipe{
+		Ingredients: []*aggregate.RecipeIngredient{
+			{
+				Derive: testSubstituteButter,
+				Entity: recipeButter,
+				Measures: []*aggregate.RecipeMeasure{
+					{Entity: &entity.RecipeMeasure{Value: 100}, Unit: testPantryGram},
+				},
+			},
+			{
+				Derive: testPantryFlour,
+				Entity: &entity.RecipeIngredient{Id: uuid.New(), DeriveId: testPantryFlour.Id, Name: "Flour"},
+				Measures: []*aggregate.RecipeMeasure{
+					{Entity: &entity.RecipeMeasure{Value: 500}, Unit: testPantryGram},
+				},
+			},
+		},
+	}
+
+	assert.Equal(
+		t,
+		[]*aggregate.Substitution{
+			{Ingredient: testSubstituteButter, Substitute: testSubstituteOil, Unit: testPantryGram, Amount: 100, SubstituteAmount: 75, Reason: kind.SubstituteReasonManual, RecipeIngredient: recipeButter},
+		},
+		SubstituteRecipe(recipe, substitutes, &aggregate.SubstituteQuery{Substitutes: []uuid.UUID{substitutes[0].Entity.Id}}, nil, testPantryNow),
+	)
+
+	pantryItems := []*aggregate.PantryItem{
+		testPantryItem(testSubstituteOil, testPantryGram, 100, testPantryNotExpired, kind.PantryItemStatusActive),
+	}
+
+	assert.Equal(
+		t,
+		[]*aggregate.Substitution{
+			{Ingredient: testSubstituteButter, Substitute: testSubstituteOil, Unit: testPantryGram, Amount: 100, SubstituteAmount: 75, Reason: kind.SubstituteReasonPantry, RecipeIngredient: recipeButter},
+		},
+		SubstituteRecipe(recipe, substitutes, &aggregate.SubstituteQuery{UsePantry: true}, pantryItems, testPantryNow),
+	)
+
+	pantryItems = append(pantryItems, testPantryItem(testSubstituteButter, testPantryGram, 100, testPantryNotExpired, kind.PantryItemStatusActive))
+
+	assert.Nil(t, SubstituteRecipe(recipe, substitutes, &aggregate.SubstituteQuery{UsePantry: true}, pantryItems, testPantryNow))
+	assert.Nil(t, SubstituteRecipe(recipe, substitutes, &aggregate.SubstituteQuery{}, nil, testPantryNow))
+}
@@ -0,0 +1,141 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"github.com/pkg/errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	Issuer             = "MealPlanner"
+	SecretSize         = 20
+	Digits             = 6
+	Period             = 30
+	Skew               = 1
+	RecoveryCodeAmount = 10
+	recoveryCodeSize   = 5
+)
+
+var (
+	encoding          = base32.StdEncoding.WithPadding(base32.NoPadding)
+	errorSecretDecode = errors.New("the secret is not properly encoded")
+)
+
+// GenerateSecret returns the random secret encoded by base32 as authenticator applications expect.
+func GenerateSecret() (string, error) {
+	secret := make([]byte, SecretSize)
+
+	if _, errorRead := rand.Read(secret); errorRead != nil {
+		return "", errors.Wrap(errorRead, "an error occurred while generating a secret")
+	}
+
+	return encoding.EncodeToString(secret), nil
+}
+
+// Uri returns the otpauth:// URI of the secret to enrol an authenticator application.
+func Uri(account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(Period))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + Issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// Counter returns the time step of the time as RFC 6238 defines.
+func Counter(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Code returns the code of the secret for the time step as RFC 4226 defines.
+func Code(secret string, counter int64) (string, error) {
+	key, errorDecode := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+
+	if errorDecode != nil {
+		return "", errorSecretDecode
+	}
+
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+
+	for i := 0; i < Digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo), nil
+}
+
+// Validate checks the code against the time steps around the time and returns the matched one. A time step which is
+// not after the last accepted one is skipped, so the same code can't be accepted twice.
+func Validate(secret string, code string, t time.Time, lastCounter int64) (int64, bool) {
+	counter := Counter(t)
+
+	for step := counter - Skew; step <= counter+Skew; step++ {
+		if step <= lastCounter {
+			continue
+		}
+
+		expected, errorCode := Code(secret, step)
+
+		if errorCode != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns the plain recovery codes to show to the user once, only hashes should be kept.
+func GenerateRecoveryCodes() ([]string, error) {
+	recoveryCodes := make([]string, RecoveryCodeAmount)
+
+	for i := range recoveryCodes {
+		recoveryCode := make([]byte, recoveryCodeSize*2)
+
+		if _, errorRead := rand.Read(recoveryCode); errorRead != nil {
+			return nil, errors.Wrap(errorRead, "an error occurred while generating recovery codes")
+		}
+
+		value := hex.EncodeToString(recoveryCode)
+		recoveryCodes[i] = value[:recoveryCodeSize*2] + "-" + value[recoveryCodeSize*2:]
+	}
+
+	return recoveryCodes, nil
+}
+
+// HashRecoveryCode returns the hash of the recovery code, the dashes and the case are ignored.
+func HashRecoveryCode(recoveryCode string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(recoveryCode), "-", ""))))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,174 @@
+package totp
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testSecret is the key of the test vectors of RFC 6238 for SHA1 encoded by base32.
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   string
+		time     time.Time
+		expected string
+		fault    bool
+	}{
+		{
+			name:     "Test case with time 59",
+			secret:   testSecret,
+			time:     time.Unix(59, 0),
+			expected: "287082",
+		},
+		{
+			name:     "Test case with time 1111111109",
+			secret:   testSecret,
+			time:     time.Unix(1111111109, 0),
+			expected: "081804",
+		},
+		{
+			name:     "Test case with time 1234567890",
+			secret:   testSecret,
+			time:     time.Unix(1234567890, 0),
+			expected: "005924",
+		},
+		{
+			name:     "Test case with time 20000000000",
+			secret:   testSecret,
+			time:     time.Unix(20000000000, 0),
+			expected: "353130",
+		},
+		{
+			name:     "Test case with lower case secret",
+			secret:   strings.ToLower(testSecret),
+			time:     time.Unix(59, 0),
+			expected: "287082",
+		},
+		{
+			name:   "Test case with broken secret",
+			secret: "1",
+			time:   time.Unix(59, 0),
+			fault:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorCode := Code(testCase.secret, Counter(testCase.time))
+
+				if testCase.fault {
+					assert.NotNil(t, errorCode)
+				} else {
+					assert.Nil(t, errorCode)
+					assert.Equal(t, testCase.expected, actual)
+				}
+			},
+		)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	counter := Counter(now)
+	codePrevious, _ := Code(testSecret, counter-1)
+	codeNext, _ := Code(testSecret, counter+1)
+	codeStale, _ := Code(testSecret, counter-2)
+
+	tests := []struct {
+		name        string
+		code        string
+		lastCounter int64
+		expected    int64
+		valid       bool
+	}{
+		{
+			name:     "Test case with current code",
+			code:     "005924",
+			expected: counter,
+			valid:    true,
+		},
+		{
+			name:     "Test case with previous code",
+			code:     codePrevious,
+			expected: counter - 1,
+			valid:    true,
+		},
+		{
+			name:     "Test case with next code",
+			code:     codeNext,
+			expected: counter + 1,
+			valid:    true,
+		},
+		{
+			name: "Test case with stale code",
+			code: codeStale,
+		},
+		{
+			name:        "Test case with already accepted code",
+			code:        "005924",
+			lastCounter: counter,
+		},
+		{
+			name: "Test case with wrong code",
+			code: "000000",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, valid := Validate(testSecret, testCase.code, now, testCase.lastCounter)
+
+				assert.Equal(t, testCase.valid, valid)
+				assert.Equal(t, testCase.expected, actual)
+			},
+		)
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, errorSecret := GenerateSecret()
+
+	assert.Nil(t, errorSecret)
+	assert.Len(t, secret, 32)
+
+	_, errorCode := Code(secret, 1)
+
+	assert.Nil(t, errorCode)
+}
+
+func TestUri(t *testing.T) {
+	uri, errorUri := url.Parse(Uri("username", testSecret))
+
+	assert.Nil(t, errorUri)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/"+Issuer+":username", uri.Path)
+	assert.Equal(t, testSecret, uri.Query().Get("secret"))
+	assert.Equal(t, Issuer, uri.Query().Get("issuer"))
+	assert.Equal(t, "6", uri.Query().Get("digits"))
+	assert.Equal(t, "30", uri.Query().Get("period"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	recoveryCodes, errorRecoveryCodes := GenerateRecoveryCodes()
+
+	assert.Nil(t, errorRecoveryCodes)
+	assert.Len(t, recoveryCodes, RecoveryCodeAmount)
+
+	for _, recoveryCode := range recoveryCodes {
+		assert.Len(t, recoveryCode, 21)
+		assert.Equal(t, HashRecoveryCode(recoveryCode), HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(recoveryCode, "-", ""))))
+	}
+
+	assert.NotEqual(t, recoveryCodes[0], recoveryCodes[1])
+	assert.NotEqual(t, HashRecoveryCode(recoveryCodes[0]), HashRecoveryCode(recoveryCodes[1]))
+}
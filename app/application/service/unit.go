@@ -0,0 +1,94 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"math"
+	"strings"
+)
+
+const (
+	unitDimensionMass   = "mass"
+	unitDimensionVolume = "volume"
+	unitDimensionCount  = "count"
+)
+
+var (
+	errorUnitConvert = errors.New("units cannot be converted between each other")
+	unitConversions  = map[string]unitConversion{
+		"mg":          {Dimension: unitDimensionMass, Factor: 0.001},
+		"milligram":   {Dimension: unitDimensionMass, Factor: 0.001},
+		"milligrams":  {Dimension: unitDimensionMass, Factor: 0.001},
+		"g":           {Dimension: unitDimensionMass, Factor: 1},
+		"gram":        {Dimension: unitDimensionMass, Factor: 1},
+		"grams":       {Dimension: unitDimensionMass, Factor: 1},
+		"kg":          {Dimension: unitDimensionMass, Factor: 1000},
+		"kilogram":    {Dimension: unitDimensionMass, Factor: 1000},
+		"kilograms":   {Dimension: unitDimensionMass, Factor: 1000},
+		"oz":          {Dimension: unitDimensionMass, Factor: 28.349523125},
+		"ounce":       {Dimension: unitDimensionMass, Factor: 28.349523125},
+		"ounces":      {Dimension: unitDimensionMass, Factor: 28.349523125},
+		"lb":          {Dimension: unitDimensionMass, Factor: 453.59237},
+		"pound":       {Dimension: unitDimensionMass, Factor: 453.59237},
+		"pounds":      {Dimension: unitDimensionMass, Factor: 453.59237},
+		"ml":          {Dimension: unitDimensionVolume, Factor: 1},
+		"milliliter":  {Dimension: unitDimensionVolume, Factor: 1},
+		"milliliters": {Dimension: unitDimensionVolume, Factor: 1},
+		"millilitre":  {Dimension: unitDimensionVolume, Factor: 1},
+		"millilitres": {Dimension: unitDimensionVolume, Factor: 1},
+		"l":           {Dimension: unitDimensionVolume, Factor: 1000},
+		"liter":       {Dimension: unitDimensionVolume, Factor: 1000},
+		"liters":      {Dimension: unitDimensionVolume, Factor: 1000},
+		"litre":       {Dimension: unitDimensionVolume, Factor: 1000},
+		"litres":      {Dimension: unitDimensionVolume, Factor: 1000},
+		"tsp":         {Dimension: unitDimensionVolume, Factor: 5},
+		"teaspoon":    {Dimension: unitDimensionVolume, Factor: 5},
+		"teaspoons":   {Dimension: unitDimensionVolume, Factor: 5},
+		"tbsp":        {Dimension: unitDimensionVolume, Factor: 15},
+		"tablespoon":  {Dimension: unitDimensionVolume, Factor: 15},
+		"tablespoons": {Dimension: unitDimensionVolume, Factor: 15},
+		"cup":         {Dimension: unitDimensionVolume, Factor: 240},
+		"cups":        {Dimension: unitDimensionVolume, Factor: 240},
+		"pc":          {Dimension: unitDimensionCount, Factor: 1},
+		"pcs":         {Dimension: unitDimensionCount, Factor: 1},
+		"piece":       {Dimension: unitDimensionCount, Factor: 1},
+		"pieces":      {Dimension: unitDimensionCount, Factor: 1},
+	}
+)
+
+type unitConversion struct {
+	Dimension string
+	Factor    float64
+}
+
+// UnitConvert converts the value from one unit to another one. The units are matched by their names
+// and have to be the same dimension (mass, volume or count), otherwise the error will be returned.
+func UnitConvert(value int64, from *entity.Unit, to *entity.Unit) (int64, error) {
+	factor, errorUnitFactor := unitFactor(from, to)
+
+	if errorUnitFactor != nil {
+		return 0, errorUnitFactor
+	}
+
+	return int64(math.Round(float64(value) * factor)), nil
+}
+
+// unitFactor returns the factor the value in one unit is multiplied by to get the value in another one.
+func unitFactor(from *entity.Unit, to *entity.Unit) (float64, error) {
+	if from == nil || to == nil {
+		return 0, errorUnitConvert
+	}
+
+	if from.Id == to.Id {
+		return 1, nil
+	}
+
+	fromConversion, okFromConversion := unitConversions[strings.ToLower(strings.TrimSpace(from.Name))]
+	toConversion, okToConversion := unitConversions[strings.ToLower(strings.TrimSpace(to.Name))]
+
+	if !okFromConversion || !okToConversion || fromConversion.Dimension != toConversion.Dimension {
+		return 0, errors.Wrapf(errorUnitConvert, "from=%s,to=%s", from.Name, to.Name)
+	}
+
+	return fromConversion.Factor / toConversion.Factor, nil
+}
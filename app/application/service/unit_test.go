@@ -0,0 +1,115 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUnitConvert(t *testing.T) {
+	unitGram := &entity.Unit{Id: uuid.New(), Name: "g"}
+	unitKilogram := &entity.Unit{Id: uuid.New(), Name: "Kg"}
+	unitMilliliter := &entity.Unit{Id: uuid.New(), Name: "ml"}
+	unitTablespoon := &entity.Unit{Id: uuid.New(), Name: "tablespoon"}
+	unitUnknown := &entity.Unit{Id: uuid.New(), Name: "handful"}
+
+	tests := []struct {
+		Name        string
+		Value       int64
+		From        *entity.Unit
+		To          *entity.Unit
+		Expected    int64
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:        "Test case with UnitConvert with the same unit",
+			Value:       150,
+			From:        unitUnknown,
+			To:          unitUnknown,
+			Expected:    150,
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name:        "Test case with UnitConvert from kilograms to grams",
+			Value:       2,
+			From:        unitKilogram,
+			To:          unitGram,
+			Expected:    2000,
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name:        "Test case with UnitConvert from grams to kilograms",
+			Value:       1500,
+			From:        unitGram,
+			To:          unitKilogram,
+			Expected:    2,
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name:        "Test case with UnitConvert from tablespoons to milliliters",
+			Value:       3,
+			From:        unitTablespoon,
+			To:          unitMilliliter,
+			Expected:    45,
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name:        "Test case with UnitConvert with different dimensions",
+			Value:       100,
+			From:        unitGram,
+			To:          unitMilliliter,
+			Expected:    0,
+			MustBePanic: false,
+			MustBeFault: true,
+		},
+		{
+			Name:        "Test case with UnitConvert with unknown unit",
+			Value:       1,
+			From:        unitUnknown,
+			To:          unitGram,
+			Expected:    0,
+			MustBePanic: false,
+			MustBeFault: true,
+		},
+		{
+			Name:        "Test case with UnitConvert with empty unit",
+			Value:       1,
+			From:        nil,
+			To:          unitGram,
+			Expected:    0,
+			MustBePanic: false,
+			MustBeFault: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actual, errorUnitConvert := UnitConvert(testCase.Value, testCase.From, testCase.To)
+
+				if testCase.MustBeFault {
+					assert.NotNil(t, errorUnitConvert)
+				} else {
+					assert.Nil(t, errorUnitConvert)
+				}
+
+				assert.Equal(t, testCase.Expected, actual)
+			},
+		)
+	}
+}
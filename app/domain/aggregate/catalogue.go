@@ -0,0 +1,21 @@
+package aggregate
+
+import "github.com/sergeygardner/meal-planner-api/domain/kind"
+
+type CatalogueImport struct {
+	Entity    kind.CatalogueEntity  `bson:"entity" json:"entity"`
+	Format    kind.CatalogueFormat  `bson:"format" json:"format"`
+	DryRun    bool                  `bson:"dry_run" json:"dry_run"`
+	Created   int64                 `bson:"created" json:"created"`
+	Updated   int64                 `bson:"updated" json:"updated"`
+	Unchanged int64                 `bson:"unchanged" json:"unchanged"`
+	Failed    int64                 `bson:"failed" json:"failed"`
+	Rows      []*CatalogueImportRow `bson:"rows" json:"rows"`
+}
+
+type CatalogueImportRow struct {
+	Line   int64                      `bson:"line" json:"line"`
+	Name   string                     `bson:"name" json:"name"`
+	Action kind.CatalogueImportAction `bson:"action" json:"action"`
+	Error  string                     `bson:"error" json:"error,omitempty"`
+}
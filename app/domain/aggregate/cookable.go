@@ -0,0 +1,28 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+)
+
+type CookableQuery struct {
+	Ingredients []uuid.UUID           `bson:"ingredients" json:"ingredients"`
+	UsePantry   bool                  `bson:"use_pantry" json:"use_pantry"`
+	Substitutes []*CookableSubstitute `bson:"substitutes" json:"substitutes"`
+	MaxMissing  int64                 `bson:"max_missing" json:"max_missing"`
+	Limit       int64                 `bson:"limit" json:"limit"`
+}
+
+type CookableSubstitute struct {
+	IngredientId uuid.UUID `bson:"ingredient_id" json:"ingredient_id"`
+	SubstituteId uuid.UUID `bson:"substitute_id" json:"substitute_id"`
+}
+
+type Cookable struct {
+	Entity      *entity.Recipe             `bson:"entity" json:"entity"`
+	Score       float64                    `bson:"score" json:"score"`
+	Coverage    float64                    `bson:"coverage" json:"coverage"`
+	Available   []uuid.UUID                `bson:"available" json:"available"`
+	Substituted []*CookableSubstitute      `bson:"substituted" json:"substituted"`
+	Missing     []*entity.RecipeIngredient `bson:"missing" json:"missing"`
+}
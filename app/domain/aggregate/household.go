@@ -0,0 +1,20 @@
+package aggregate
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+)
+
+type Household struct {
+	Entity  *entity.Household         `bson:"entity" json:"entity"`
+	Members []*entity.HouseholdMember `bson:"members" json:"members"`
+	Grants  []*entity.HouseholdGrant  `bson:"grants" json:"grants"`
+}
+
+type HouseholdInvitation struct {
+	Entity    *entity.HouseholdMember `bson:"entity" json:"entity"`
+	Household *entity.Household       `bson:"household" json:"household"`
+}
+
+type HouseholdInvite struct {
+	Username string `bson:"username" json:"username"`
+}
@@ -0,0 +1,28 @@
+package aggregate
+
+import "github.com/sergeygardner/meal-planner-api/domain/entity"
+
+type IngredientPrice struct {
+	Entity *entity.IngredientPrice `bson:"entity" json:"entity"`
+	Unit   *entity.Unit            `bson:"unit" json:"unit"`
+}
+
+type RecipeCost struct {
+	Recipe     *entity.Recipe       `bson:"recipe" json:"recipe"`
+	Servings   int64                `bson:"servings" json:"servings"`
+	Total      int64                `bson:"total" json:"total"`
+	PerServing int64                `bson:"per_serving" json:"per_serving"`
+	Currency   string               `bson:"currency" json:"currency"`
+	Unpriced   []*entity.Ingredient `bson:"unpriced" json:"unpriced"`
+}
+
+type PlannerCost struct {
+	Planner      *entity.Planner       `bson:"planner" json:"planner"`
+	Recipes      []*RecipeCost         `bson:"recipes" json:"recipes"`
+	Calculations []*PlannerCalculation `bson:"calculations" json:"calculations"`
+	Total        int64                 `bson:"total" json:"total"`
+	Budget       int64                 `bson:"budget" json:"budget"`
+	Currency     string                `bson:"currency" json:"currency"`
+	Unpriced     []*entity.Ingredient  `bson:"unpriced" json:"unpriced"`
+	Warnings     []string              `bson:"warnings" json:"warnings"`
+}
@@ -0,0 +1,45 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+type IngredientSubstitute struct {
+	Entity     *entity.IngredientSubstitute `bson:"entity" json:"entity"`
+	Substitute *entity.Ingredient           `bson:"substitute" json:"substitute"`
+}
+
+type RecipeIngredientSubstitutes struct {
+	RecipeIngredient *entity.RecipeIngredient `bson:"recipe_ingredient" json:"recipe_ingredient"`
+	Substitutes      []*IngredientSubstitute  `bson:"substitutes" json:"substitutes"`
+}
+
+// SubstituteQuery chooses the substitutes to use. The substitutes which are given by their ids are used first,
+// then the ones suitable for all the contexts, then the ones which are in the pantry when the ingredient is not.
+type SubstituteQuery struct {
+	Substitutes []uuid.UUID              `bson:"substitutes" json:"substitutes"`
+	Contexts    []kind.SubstituteContext `bson:"contexts" json:"contexts"`
+	UsePantry   bool                     `bson:"use_pantry" json:"use_pantry"`
+}
+
+type Substitution struct {
+	Ingredient       *entity.Ingredient       `bson:"ingredient" json:"ingredient"`
+	Substitute       *entity.Ingredient       `bson:"substitute" json:"substitute"`
+	Unit             *entity.Unit             `bson:"unit" json:"unit"`
+	Amount           int64                    `bson:"amount" json:"amount"`
+	SubstituteAmount int64                    `bson:"substitute_amount" json:"substitute_amount"`
+	Reason           kind.SubstituteReason    `bson:"reason" json:"reason"`
+	RecipeIngredient *entity.RecipeIngredient `bson:"recipe_ingredient" json:"recipe_ingredient,omitempty"`
+}
+
+type RecipeSubstitute struct {
+	Recipe        *Recipe         `bson:"recipe" json:"recipe"`
+	Substitutions []*Substitution `bson:"substitutions" json:"substitutions"`
+}
+
+type PlannerSubstitute struct {
+	Calculations  []*PlannerCalculation `bson:"calculations" json:"calculations"`
+	Substitutions []*Substitution       `bson:"substitutions" json:"substitutions"`
+}
@@ -0,0 +1,35 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+type FuzzyMatch struct {
+	Id       uuid.UUID        `bson:"id" json:"id"`
+	Name     string           `bson:"name" json:"name"`
+	Reason   kind.FuzzyReason `bson:"reason" json:"reason"`
+	Distance int64            `bson:"distance" json:"distance"`
+}
+
+type MergeSuggestion struct {
+	SurvivorId    uuid.UUID        `bson:"survivor_id" json:"survivor_id"`
+	SurvivorName  string           `bson:"survivor_name" json:"survivor_name"`
+	DuplicateId   uuid.UUID        `bson:"duplicate_id" json:"duplicate_id"`
+	DuplicateName string           `bson:"duplicate_name" json:"duplicate_name"`
+	Reason        kind.FuzzyReason `bson:"reason" json:"reason"`
+	Distance      int64            `bson:"distance" json:"distance"`
+}
+
+type Merge struct {
+	SurvivorId            uuid.UUID `bson:"survivor_id" json:"survivor_id"`
+	DuplicateId           uuid.UUID `bson:"duplicate_id" json:"duplicate_id"`
+	RecipeIngredients     int64     `bson:"recipe_ingredients" json:"recipe_ingredients"`
+	RecipeCategories      int64     `bson:"recipe_categories" json:"recipe_categories"`
+	Ingredients           int64     `bson:"ingredients" json:"ingredients"`
+	AltNames              int64     `bson:"alt_names" json:"alt_names"`
+	Pictures              int64     `bson:"pictures" json:"pictures"`
+	PantryItems           int64     `bson:"pantry_items" json:"pantry_items"`
+	IngredientPrices      int64     `bson:"ingredient_prices" json:"ingredient_prices"`
+	IngredientSubstitutes int64     `bson:"ingredient_substitutes" json:"ingredient_substitutes"`
+}
@@ -0,0 +1,9 @@
+package aggregate
+
+import "github.com/sergeygardner/meal-planner-api/domain/entity"
+
+type PantryItem struct {
+	Entity     *entity.PantryItem `bson:"entity" json:"entity"`
+	Ingredient *entity.Ingredient `bson:"ingredient" json:"ingredient"`
+	Unit       *entity.Unit       `bson:"unit" json:"unit"`
+}
@@ -1,6 +1,10 @@
 package aggregate
 
-import "github.com/sergeygardner/meal-planner-api/domain/entity"
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"time"
+)
 
 type Planner struct {
 	Entity    *entity.Planner    `bson:"entity" json:"entity"`
@@ -21,4 +25,59 @@ type PlannerCalculation struct {
 	Ingredient *entity.Ingredient
 	Unit       *entity.Unit
 	Amount     int64
+	Cost       int64
+	Currency   string
+}
+
+type PlannerGenerateSlot struct {
+	IntervalId  uuid.UUID   `bson:"interval_id" json:"interval_id"`
+	Categories  []uuid.UUID `bson:"categories" json:"categories"`
+	CaloriesMin int64       `bson:"calories_min" json:"calories_min"`
+	CaloriesMax int64       `bson:"calories_max" json:"calories_max"`
+}
+
+type PlannerGenerateConstraints struct {
+	Seed         int64                  `bson:"seed" json:"seed"`
+	Slots        []*PlannerGenerateSlot `bson:"slots" json:"slots"`
+	Tags         []uuid.UUID            `bson:"tags" json:"tags"`
+	MaxRepeats   int64                  `bson:"max_repeats" json:"max_repeats"`
+	CaloriesMin  int64                  `bson:"calories_min" json:"calories_min"`
+	CaloriesMax  int64                  `bson:"calories_max" json:"calories_max"`
+	Budget       int64                  `bson:"budget" json:"budget"`
+	Currency     string                 `bson:"currency" json:"currency"`
+	PreferPantry bool                   `bson:"prefer_pantry" json:"prefer_pantry"`
+	Replace      bool                   `bson:"replace" json:"replace"`
+}
+
+type PlannerGenerateAssignment struct {
+	Interval *entity.PlannerInterval `bson:"interval" json:"interval"`
+	Recipe   *entity.Recipe          `bson:"recipe" json:"recipe"`
+	Cost     int64                   `bson:"cost" json:"cost"`
+}
+
+type PlannerGenerate struct {
+	Planner     *Planner                     `bson:"planner" json:"planner"`
+	Assignments []*PlannerGenerateAssignment `bson:"assignments" json:"assignments"`
+	Seed        int64                        `bson:"seed" json:"seed"`
+	Total       int64                        `bson:"total" json:"total"`
+	Currency    string                       `bson:"currency" json:"currency"`
+	Warnings    []string                     `bson:"warnings" json:"warnings"`
+}
+
+type PlannerCalendarEvent struct {
+	Summary   string    `bson:"summary" json:"summary"`
+	StartTime time.Time `bson:"start_time" json:"start_time"`
+	EndTime   time.Time `bson:"end_time" json:"end_time"`
+}
+
+type PlannerCalendarImport struct {
+	Planner   *Planner `bson:"planner" json:"planner"`
+	Intervals int64    `bson:"intervals" json:"intervals"`
+	Recipes   int64    `bson:"recipes" json:"recipes"`
+	Warnings  []string `bson:"warnings" json:"warnings"`
+}
+
+type PlannerTemplate struct {
+	Entity    *entity.PlannerTemplate           `bson:"entity" json:"entity"`
+	Intervals []*entity.PlannerTemplateInterval `bson:"intervals" json:"intervals"`
 }
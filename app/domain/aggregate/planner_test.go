@@ -60,7 +60,7 @@ func TestPlanner(t *testing.T) {
 	}{
 		{
 			name: "Test case with active planner properties",
-			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000100\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"status\":\"active\"},\"intervals\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000003\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000100\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"PlannerInterval\",\"status\":\"active\"},\"recipes\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}]}]}\n",
+			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000100\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"budget\":0,\"currency\":\"\",\"calendar_token\":\"\",\"status\":\"active\"},\"intervals\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000003\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000100\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"PlannerInterval\",\"status\":\"active\"},\"recipes\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"recipe_version_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"servings\":0,\"calories\":0,\"status\":\"published\",\"forked_from_id\":\"00000000-0000-0000-0000-000000000000\",\"forked_from_version_id\":\"00000000-0000-0000-0000-000000000000\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"category_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}]}]}\n",
 			Entity: planner{
 				Id:         uuid.MustParse("00000000-0000-0000-0000-000000000100"),
 				UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
@@ -1035,7 +1035,7 @@ func TestPlannerInterval(t *testing.T) {
 	}{
 		{
 			name: "Test case with active planner interval properties",
-			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000003\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000100\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"PlannerInterval\",\"status\":\"active\"},\"recipes\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}]}\n",
+			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000003\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000100\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"PlannerInterval\",\"status\":\"active\"},\"recipes\":[{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"recipe_version_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"servings\":0,\"calories\":0,\"status\":\"published\",\"forked_from_id\":\"00000000-0000-0000-0000-000000000000\",\"forked_from_version_id\":\"00000000-0000-0000-0000-000000000000\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"category_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}]}\n",
 			Entity: plannerInterval{
 				Id:         uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 				UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
@@ -1966,7 +1966,7 @@ func TestPlannerRecipe(t *testing.T) {
 	}{
 		{
 			name: "Test case with active planner recipe properties",
-			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}\n",
+			json: "{\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"recipe_version_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"},\"recipe\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000005\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"categories\":[{\"derive\":{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000006\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000007\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Category\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000008\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000009\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000009\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000007\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000010\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"published\"}}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000004\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"servings\":0,\"calories\":0,\"status\":\"published\",\"forked_from_id\":\"00000000-0000-0000-0000-000000000000\",\"forked_from_version_id\":\"00000000-0000-0000-0000-000000000000\"},\"ingredients\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000011\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"derive\":{\"id\":\"00000000-0000-0000-0000-000000000012\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"category_id\":\"00000000-0000-0000-0000-000000000000\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"},\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000013\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"derive_id\":\"00000000-0000-0000-0000-000000000012\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeIngredient\",\"status\":\"published\"},\"measures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000014\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000015\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000015\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"unit_id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"value\":42,\"status\":\"published\"},\"unit\":{\"id\":\"00000000-0000-0000-0000-000000000016\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Unit\",\"status\":\"published\"}}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000017\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000018\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000018\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000013\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"processes\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000019\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000020\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"RecipeProcess\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"},\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000021\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000022\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000022\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000020\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}],\"pictures\":[{\"alt_names\":[{\"id\":\"00000000-0000-0000-0000-000000000023\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000024\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"AltName\",\"status\":\"published\"}],\"entity\":{\"id\":\"00000000-0000-0000-0000-000000000024\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Picture\",\"url\":\"https://google.com/doodle.png\",\"width\":512,\"height\":512,\"size\":1024,\"type\":\"image/png\",\"status\":\"published\"}}]}}\n",
 			Entity: plannerRecipe{
 				Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
@@ -0,0 +1,18 @@
+package aggregate
+
+// PublicRecipeQuery filters the public catalogue by the words of the query and by the names of a category and of an
+// ingredient, the empty values don't filter.
+type PublicRecipeQuery struct {
+	Query      string `json:"query"`
+	Category   string `json:"category"`
+	Ingredient string `json:"ingredient"`
+	Limit      int64  `json:"limit"`
+	Offset     int64  `json:"offset"`
+}
+
+type PublicRecipes struct {
+	Recipes []*Recipe `json:"recipes"`
+	Total   int64     `json:"total"`
+	Limit   int64     `json:"limit"`
+	Offset  int64     `json:"offset"`
+}
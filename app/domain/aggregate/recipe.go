@@ -31,3 +31,16 @@ type RecipeProcess struct {
 	Entity   *DomainEntity.RecipeProcess `json:"entity"`
 	Pictures []*Picture                  `json:"pictures"`
 }
+type RecipeImport struct {
+	Categories  []string                      `json:"categories"`
+	Entity      *DomainEntity.Recipe          `json:"entity"`
+	Ingredients []*RecipeImportIngredient     `json:"ingredients"`
+	Processes   []*DomainEntity.RecipeProcess `json:"processes"`
+	Pictures    []*DomainEntity.Picture       `json:"pictures"`
+}
+type RecipeImportIngredient struct {
+	Line  string `json:"line"`
+	Name  string `json:"name"`
+	Unit  string `json:"unit"`
+	Value int64  `json:"value"`
+}
@@ -0,0 +1,49 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+type RecipeVersion struct {
+	Entity *entity.RecipeVersion `bson:"entity" json:"entity"`
+	Recipe *Recipe               `bson:"recipe" json:"recipe"`
+}
+
+type RecipeFieldChange struct {
+	Field string `bson:"field" json:"field"`
+	From  string `bson:"from" json:"from"`
+	To    string `bson:"to" json:"to"`
+}
+
+type RecipeMeasureChange struct {
+	Unit  *entity.Unit `bson:"unit" json:"unit"`
+	Value int64        `bson:"value" json:"value"`
+}
+
+type RecipeIngredientChange struct {
+	Change     kind.RecipeChange      `bson:"change" json:"change"`
+	Ingredient *entity.Ingredient     `bson:"ingredient" json:"ingredient"`
+	Name       string                 `bson:"name" json:"name"`
+	From       []*RecipeMeasureChange `bson:"from" json:"from"`
+	To         []*RecipeMeasureChange `bson:"to" json:"to"`
+}
+
+// RecipeItemChange is a change of a category or of a process of the recipe, the id is the id of the category
+// or of the process.
+type RecipeItemChange struct {
+	Change kind.RecipeChange    `bson:"change" json:"change"`
+	Id     uuid.UUID            `bson:"id" json:"id"`
+	Name   string               `bson:"name" json:"name"`
+	Fields []*RecipeFieldChange `bson:"fields" json:"fields,omitempty"`
+}
+
+type RecipeDiff struct {
+	From        *entity.RecipeVersion     `bson:"from" json:"from"`
+	To          *entity.RecipeVersion     `bson:"to" json:"to"`
+	Fields      []*RecipeFieldChange      `bson:"fields" json:"fields"`
+	Categories  []*RecipeItemChange       `bson:"categories" json:"categories"`
+	Ingredients []*RecipeIngredientChange `bson:"ingredients" json:"ingredients"`
+	Processes   []*RecipeItemChange       `bson:"processes" json:"processes"`
+}
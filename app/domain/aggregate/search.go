@@ -0,0 +1,17 @@
+package aggregate
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+type SearchResult struct {
+	Entity     *DomainEntity.Recipe `json:"entity"`
+	Score      float64              `json:"score"`
+	Highlights []*SearchHighlight   `json:"highlights"`
+}
+
+type SearchHighlight struct {
+	Field    kind.SearchField `json:"field"`
+	Fragment string           `json:"fragment"`
+}
@@ -0,0 +1,19 @@
+package aggregate
+
+import "github.com/sergeygardner/meal-planner-api/domain/entity"
+
+type ShoppingList struct {
+	Entity *entity.ShoppingList `bson:"entity" json:"entity"`
+	Groups []*ShoppingListGroup `bson:"groups" json:"groups"`
+}
+
+type ShoppingListGroup struct {
+	Category *entity.Category    `bson:"category" json:"category"`
+	Items    []*ShoppingListItem `bson:"items" json:"items"`
+}
+
+type ShoppingListItem struct {
+	Entity     *entity.ShoppingListItem `bson:"entity" json:"entity"`
+	Ingredient *entity.Ingredient       `bson:"ingredient" json:"ingredient"`
+	Unit       *entity.Unit             `bson:"unit" json:"unit"`
+}
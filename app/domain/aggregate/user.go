@@ -0,0 +1,28 @@
+package aggregate
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+type User struct {
+	Entity        *entity.User               `bson:"entity" json:"entity"`
+	Confirmations []*entity.UserConfirmation `bson:"confirmations" json:"confirmations"`
+	Roles         []*entity.UserToRole       `bson:"roles" json:"roles"`
+}
+
+// UserQuery filters the users by the words of the query in their usernames and names and by their status, the empty
+// values don't filter.
+type UserQuery struct {
+	Query  string          `json:"query"`
+	Status kind.UserStatus `json:"status"`
+	Limit  int64           `json:"limit"`
+	Offset int64           `json:"offset"`
+}
+
+type Users struct {
+	Users  []*entity.User `json:"users"`
+	Total  int64          `json:"total"`
+	Limit  int64          `json:"limit"`
+	Offset int64          `json:"offset"`
+}
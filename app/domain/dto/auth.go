@@ -4,3 +4,27 @@ type AuthConfirmationDTO struct {
 	UserCredentialsDTO
 	Code string
 }
+
+type AuthPasswordResetDTO struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" bson:"username" json:"username"`
+}
+
+type AuthPasswordResetConfirmationDTO struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" bson:"username" json:"username"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" bson:"code" json:"code"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" bson:"password" json:"password"`
+}
+
+type AuthPasswordChangeDTO struct {
+	Password    string `protobuf:"bytes,1,opt,name=password,proto3" bson:"password" json:"password"`
+	PasswordNew string `protobuf:"bytes,2,opt,name=password_new,proto3" bson:"password_new" json:"password_new"`
+}
+
+type AuthTotpDTO struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" bson:"code" json:"code"`
+}
+
+type AuthTotpDisableDTO struct {
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" bson:"password" json:"password"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" bson:"code" json:"code"`
+}
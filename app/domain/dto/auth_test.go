@@ -45,3 +45,167 @@ func TestAuthConfirmationDTO(t *testing.T) {
 		)
 	}
 }
+
+func TestAuthPasswordResetDTO(t *testing.T) {
+	tests := []struct {
+		name     string
+		Username string
+	}{
+		{
+			name:     "Test case with AuthPasswordResetDTO properties",
+			Username: "Username",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authPasswordResetDTO := AuthPasswordResetDTO{Username: testCase.Username}
+				assert.Equal(t, testCase.Username, authPasswordResetDTO.Username)
+
+				reflectAuthPasswordResetDTO := reflect.ValueOf(authPasswordResetDTO)
+
+				for i := 0; i < reflectAuthPasswordResetDTO.NumField(); i++ {
+					assert.False(t, reflectAuthPasswordResetDTO.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
+
+func TestAuthPasswordResetConfirmationDTO(t *testing.T) {
+	tests := []struct {
+		name     string
+		Username string
+		Code     string
+		Password string
+	}{
+		{
+			name:     "Test case with AuthPasswordResetConfirmationDTO properties",
+			Username: "Username",
+			Code:     "123456",
+			Password: "Password",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authPasswordResetConfirmationDTO := AuthPasswordResetConfirmationDTO{
+					Username: testCase.Username,
+					Code:     testCase.Code,
+					Password: testCase.Password,
+				}
+				assert.Equal(t, testCase.Username, authPasswordResetConfirmationDTO.Username)
+				assert.Equal(t, testCase.Code, authPasswordResetConfirmationDTO.Code)
+				assert.Equal(t, testCase.Password, authPasswordResetConfirmationDTO.Password)
+
+				reflectAuthPasswordResetConfirmationDTO := reflect.ValueOf(authPasswordResetConfirmationDTO)
+
+				for i := 0; i < reflectAuthPasswordResetConfirmationDTO.NumField(); i++ {
+					assert.False(t, reflectAuthPasswordResetConfirmationDTO.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
+
+func TestAuthPasswordChangeDTO(t *testing.T) {
+	tests := []struct {
+		name        string
+		Password    string
+		PasswordNew string
+	}{
+		{
+			name:        "Test case with AuthPasswordChangeDTO properties",
+			Password:    "Password",
+			PasswordNew: "PasswordNew",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authPasswordChangeDTO := AuthPasswordChangeDTO{
+					Password:    testCase.Password,
+					PasswordNew: testCase.PasswordNew,
+				}
+				assert.Equal(t, testCase.Password, authPasswordChangeDTO.Password)
+				assert.Equal(t, testCase.PasswordNew, authPasswordChangeDTO.PasswordNew)
+
+				reflectAuthPasswordChangeDTO := reflect.ValueOf(authPasswordChangeDTO)
+
+				for i := 0; i < reflectAuthPasswordChangeDTO.NumField(); i++ {
+					assert.False(t, reflectAuthPasswordChangeDTO.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
+
+func TestAuthTotpDTO(t *testing.T) {
+	tests := []struct {
+		name string
+		Code string
+	}{
+		{
+			name: "Test case with AuthTotpDTO properties",
+			Code: "123456",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authTotpDTO := AuthTotpDTO{
+					Code: testCase.Code,
+				}
+				assert.Equal(t, testCase.Code, authTotpDTO.Code)
+
+				reflectAuthTotpDTO := reflect.ValueOf(authTotpDTO)
+
+				for i := 0; i < reflectAuthTotpDTO.NumField(); i++ {
+					assert.False(t, reflectAuthTotpDTO.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
+
+func TestAuthTotpDisableDTO(t *testing.T) {
+	tests := []struct {
+		name     string
+		Password string
+		Code     string
+	}{
+		{
+			name:     "Test case with AuthTotpDisableDTO properties",
+			Password: "Password",
+			Code:     "123456",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authTotpDisableDTO := AuthTotpDisableDTO{
+					Password: testCase.Password,
+					Code:     testCase.Code,
+				}
+				assert.Equal(t, testCase.Password, authTotpDisableDTO.Password)
+				assert.Equal(t, testCase.Code, authTotpDisableDTO.Code)
+
+				reflectAuthTotpDisableDTO := reflect.ValueOf(authTotpDisableDTO)
+
+				for i := 0; i < reflectAuthTotpDisableDTO.NumField(); i++ {
+					assert.False(t, reflectAuthTotpDisableDTO.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+type MergeDTO struct {
+	SurvivorId  uuid.UUID `protobuf:"bytes,1,opt,name=survivor_id,proto3" bson:"survivor_id" json:"survivor_id"`
+	DuplicateId uuid.UUID `protobuf:"bytes,2,opt,name=duplicate_id,proto3" bson:"duplicate_id" json:"duplicate_id"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMergeDTO(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		SurvivorId  uuid.UUID
+		DuplicateId uuid.UUID
+	}{
+		{
+			name:        "Test case with MergeDTO properties",
+			json:        "{\"survivor_id\":\"00000000-0000-0000-0000-000000000001\",\"duplicate_id\":\"00000000-0000-0000-0000-000000000002\"}",
+			SurvivorId:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DuplicateId: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				mergeDTO := MergeDTO{
+					SurvivorId:  testCase.SurvivorId,
+					DuplicateId: testCase.DuplicateId,
+				}
+
+				actualJSON, errorMarshal := json.Marshal(mergeDTO)
+
+				assert.Nil(t, errorMarshal)
+				assert.JSONEq(t, testCase.json, string(actualJSON))
+			},
+		)
+	}
+}
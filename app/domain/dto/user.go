@@ -25,4 +25,5 @@ type UserDTO struct {
 	Status     kind.UserStatus `protobuf:"bytes,9,opt,name=status,proto3" bson:"status" json:"status"`
 	Active     bool            `protobuf:"bytes,11,opt,name=active,proto3" bson:"active" json:"active"`
 	Roles      kind.UserRoles  `protobuf:"bytes,12,opt,name=roles,proto3" bson:"roles" json:"roles"`
+	DateLogout time.Time       `protobuf:"bytes,13,opt,name=date_logout,proto3" bson:"date_logout" json:"date_logout"`
 }
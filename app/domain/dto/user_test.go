@@ -135,10 +135,11 @@ func TestUserDTO(t *testing.T) {
 		Status     kind.UserStatus
 		Active     bool
 		Roles      kind.UserRoles
+		DateLogout time.Time
 	}{
 		{
 			name:       "Test case with status register and role admin and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"admin\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -150,10 +151,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role common and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -165,10 +167,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status need confirmation and role common and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -180,10 +183,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusNeedConfirmation,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role admin and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"admin\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -195,10 +199,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role common and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -210,10 +215,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role admin and active false and the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"admin\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -225,10 +231,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role common and active false and  the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -240,10 +247,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status need confirmation and role common and active false and  the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -255,10 +263,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusNeedConfirmation,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role admin and active false and  the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"admin\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -270,10 +279,11 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role common and active false and  the other UserDTO properties",
-			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Username:   "Username",
 			Password:   "Password",
 			Name:       "Name",
@@ -285,6 +295,7 @@ func TestUserDTO(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 	}
 
@@ -308,6 +319,7 @@ func TestUserDTO(t *testing.T) {
 					Status:     testCase.Status,
 					Active:     testCase.Active,
 					Roles:      testCase.Roles,
+					DateLogout: testCase.DateLogout,
 				}
 				assert.Equal(t, testCase.Username, userDTO.Username)
 				assert.Equal(t, testCase.Password, userDTO.Password)
@@ -320,6 +332,7 @@ func TestUserDTO(t *testing.T) {
 				assert.Equal(t, testCase.Status, userDTO.Status)
 				assert.Equal(t, testCase.Active, userDTO.Active)
 				assert.Equal(t, testCase.Roles, userDTO.Roles)
+				assert.Equal(t, testCase.DateLogout, userDTO.DateLogout)
 
 				reflectUserDTO := reflect.ValueOf(userDTO)
 
@@ -51,6 +51,7 @@ type Category struct {
 type Ingredient struct {
 	Id         uuid.UUID             `bson:"id" json:"id"`
 	UserId     uuid.UUID             `bson:"user_id" json:"user_id"`
+	CategoryId uuid.UUID             `bson:"category_id" json:"category_id"`
 	DateInsert time.Time             `bson:"date_insert" json:"date_insert"`
 	DateUpdate time.Time             `bson:"date_update" json:"date_update"`
 	Name       string                `bson:"name" json:"name"`
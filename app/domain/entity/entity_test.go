@@ -366,6 +366,7 @@ func TestIngredient(t *testing.T) {
 		json       string
 		Id         uuid.UUID
 		UserId     uuid.UUID
+		CategoryId uuid.UUID
 		DateInsert time.Time
 		DateUpdate time.Time
 		Name       string
@@ -373,9 +374,10 @@ func TestIngredient(t *testing.T) {
 	}{
 		{
 			name:       "Test case with published ingredient properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"category_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"published\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			CategoryId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
 			Name:       "Ingredient",
@@ -383,9 +385,10 @@ func TestIngredient(t *testing.T) {
 		},
 		{
 			name:       "Test case with unpublished ingredient properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"unpublished\"}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"category_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Ingredient\",\"status\":\"unpublished\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			CategoryId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
 			Name:       "Ingredient",
@@ -400,6 +403,7 @@ func TestIngredient(t *testing.T) {
 				ingredient := Ingredient{
 					Id:         testCase.Id,
 					UserId:     testCase.UserId,
+					CategoryId: testCase.CategoryId,
 					DateInsert: testCase.DateInsert,
 					DateUpdate: testCase.DateUpdate,
 					Name:       testCase.Name,
@@ -407,6 +411,7 @@ func TestIngredient(t *testing.T) {
 				}
 				assert.Equal(t, testCase.Id, ingredient.Id)
 				assert.Equal(t, testCase.UserId, ingredient.UserId)
+				assert.Equal(t, testCase.CategoryId, ingredient.CategoryId)
 				assert.Equal(t, testCase.DateInsert, ingredient.DateInsert)
 				assert.Equal(t, testCase.DateUpdate, ingredient.DateUpdate)
 				assert.Equal(t, testCase.Name, ingredient.Name)
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+// Household is a group of users sharing planners and recipes, the user is the owner of the household.
+type Household struct {
+	Id         uuid.UUID            `bson:"id" json:"id"`
+	UserId     uuid.UUID            `bson:"user_id" json:"user_id"`
+	DateInsert time.Time            `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time            `bson:"date_update" json:"date_update"`
+	Name       string               `bson:"name" json:"name"`
+	Status     kind.HouseholdStatus `bson:"status" json:"status"`
+}
+
+// HouseholdMember is the membership of the user in the household, the entity is the household and the inviter is the
+// user who has sent the invitation. A member is invited until the invitation is accepted.
+type HouseholdMember struct {
+	Id         uuid.UUID                  `bson:"id" json:"id"`
+	UserId     uuid.UUID                  `bson:"user_id" json:"user_id"`
+	EntityId   uuid.UUID                  `bson:"entity_id" json:"entity_id"`
+	InviterId  uuid.UUID                  `bson:"inviter_id" json:"inviter_id"`
+	DateInsert time.Time                  `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time                  `bson:"date_update" json:"date_update"`
+	Status     kind.HouseholdMemberStatus `bson:"status" json:"status"`
+}
+
+// HouseholdGrant shares the planner, the recipe, the shopping list, the pantry item or the planner template of the user
+// with the members of the household, the entity is the household and the subject is the shared one.
+type HouseholdGrant struct {
+	Id         uuid.UUID             `bson:"id" json:"id"`
+	UserId     uuid.UUID             `bson:"user_id" json:"user_id"`
+	EntityId   uuid.UUID             `bson:"entity_id" json:"entity_id"`
+	SubjectId  uuid.UUID             `bson:"subject_id" json:"subject_id"`
+	DateInsert time.Time             `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time             `bson:"date_update" json:"date_update"`
+	Subject    kind.HouseholdSubject `bson:"subject" json:"subject"`
+	Right      kind.UserRight        `bson:"right" json:"right"`
+}
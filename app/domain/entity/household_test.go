@@ -0,0 +1,243 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHousehold(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		DateInsert time.Time
+		DateUpdate time.Time
+		Name       string
+		Status     kind.HouseholdStatus
+	}{
+		{
+			name:       "Test case with active household properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Name\",\"status\":\"active\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:       "Name",
+			Status:     kind.HouseholdStatusActive,
+		},
+		{
+			name:       "Test case with inactive household properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Name\",\"status\":\"inactive\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:       "Name",
+			Status:     kind.HouseholdStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				household := Household{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					DateInsert: testCase.DateInsert,
+					DateUpdate: testCase.DateUpdate,
+					Name:       testCase.Name,
+					Status:     testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, household.Id)
+				assert.Equal(t, testCase.UserId, household.UserId)
+				assert.Equal(t, testCase.DateInsert, household.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, household.DateUpdate)
+				assert.Equal(t, testCase.Name, household.Name)
+				assert.Equal(t, testCase.Status, household.Status)
+
+				reflectHousehold := reflect.ValueOf(household)
+
+				for i := 0; i < reflectHousehold.NumField(); i++ {
+					assert.False(t, reflectHousehold.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(household)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
+
+func TestHouseholdMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		EntityId   uuid.UUID
+		InviterId  uuid.UUID
+		DateInsert time.Time
+		DateUpdate time.Time
+		Status     kind.HouseholdMemberStatus
+	}{
+		{
+			name:       "Test case with invited household member properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"inviter_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"invited\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			InviterId:  uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Status:     kind.HouseholdMemberStatusInvited,
+		},
+		{
+			name:       "Test case with active household member properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"inviter_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			InviterId:  uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Status:     kind.HouseholdMemberStatusActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				householdMember := HouseholdMember{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					EntityId:   testCase.EntityId,
+					InviterId:  testCase.InviterId,
+					DateInsert: testCase.DateInsert,
+					DateUpdate: testCase.DateUpdate,
+					Status:     testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, householdMember.Id)
+				assert.Equal(t, testCase.UserId, householdMember.UserId)
+				assert.Equal(t, testCase.EntityId, householdMember.EntityId)
+				assert.Equal(t, testCase.InviterId, householdMember.InviterId)
+				assert.Equal(t, testCase.DateInsert, householdMember.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, householdMember.DateUpdate)
+				assert.Equal(t, testCase.Status, householdMember.Status)
+
+				reflectHouseholdMember := reflect.ValueOf(householdMember)
+
+				for i := 0; i < reflectHouseholdMember.NumField(); i++ {
+					assert.False(t, reflectHouseholdMember.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(householdMember)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
+
+func TestHouseholdGrant(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		EntityId   uuid.UUID
+		SubjectId  uuid.UUID
+		DateInsert time.Time
+		DateUpdate time.Time
+		Subject    kind.HouseholdSubject
+		Right      kind.UserRight
+	}{
+		{
+			name:       "Test case with readable planner household grant properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"subject_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"subject\":\"planner\",\"right\":\"read\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubjectId:  uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Subject:    kind.HouseholdSubjectPlanner,
+			Right:      kind.UserRightRead,
+		},
+		{
+			name:       "Test case with writable recipe household grant properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"subject_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"subject\":\"recipe\",\"right\":\"write\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubjectId:  uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Subject:    kind.HouseholdSubjectRecipe,
+			Right:      kind.UserRightWrite,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				householdGrant := HouseholdGrant{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					EntityId:   testCase.EntityId,
+					SubjectId:  testCase.SubjectId,
+					DateInsert: testCase.DateInsert,
+					DateUpdate: testCase.DateUpdate,
+					Subject:    testCase.Subject,
+					Right:      testCase.Right,
+				}
+				assert.Equal(t, testCase.Id, householdGrant.Id)
+				assert.Equal(t, testCase.UserId, householdGrant.UserId)
+				assert.Equal(t, testCase.EntityId, householdGrant.EntityId)
+				assert.Equal(t, testCase.SubjectId, householdGrant.SubjectId)
+				assert.Equal(t, testCase.DateInsert, householdGrant.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, householdGrant.DateUpdate)
+				assert.Equal(t, testCase.Subject, householdGrant.Subject)
+				assert.Equal(t, testCase.Right, householdGrant.Right)
+
+				reflectHouseholdGrant := reflect.ValueOf(householdGrant)
+
+				for i := 0; i < reflectHouseholdGrant.NumField(); i++ {
+					assert.False(t, reflectHouseholdGrant.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(householdGrant)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+// IngredientPrice is the price of the quantity of an ingredient in the unit. The price is stored in minor units
+// of the currency (cents, pennies, etc.), every change of the price is a new record to keep the history.
+type IngredientPrice struct {
+	Id         uuid.UUID                  `bson:"id" json:"id"`
+	UserId     uuid.UUID                  `bson:"user_id" json:"user_id"`
+	EntityId   uuid.UUID                  `bson:"entity_id" json:"entity_id"`
+	UnitId     uuid.UUID                  `bson:"unit_id" json:"unit_id"`
+	DateInsert time.Time                  `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time                  `bson:"date_update" json:"date_update"`
+	PriceTime  time.Time                  `bson:"price_time" json:"price_time"`
+	Price      int64                      `bson:"price" json:"price"`
+	Quantity   int64                      `bson:"quantity" json:"quantity"`
+	Currency   string                     `bson:"currency" json:"currency"`
+	Store      string                     `bson:"store" json:"store"`
+	Status     kind.IngredientPriceStatus `bson:"status" json:"status"`
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIngredientPrice(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		EntityId   uuid.UUID
+		UnitId     uuid.UUID
+		DateInsert time.Time
+		DateUpdate time.Time
+		PriceTime  time.Time
+		Price      int64
+		Quantity   int64
+		Currency   string
+		Store      string
+		Status     kind.IngredientPriceStatus
+	}{
+		{
+			name:       "Test case with active ingredient price properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"unit_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"price_time\":\"2000-01-05T00:00:00Z\",\"price\":199,\"quantity\":1,\"currency\":\"EUR\",\"store\":\"Store\",\"status\":\"active\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UnitId:     uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			PriceTime:  time.Date(2000, time.January, 5, 0, 0, 0, 0, time.UTC),
+			Price:      199,
+			Quantity:   1,
+			Currency:   "EUR",
+			Store:      "Store",
+			Status:     kind.IngredientPriceStatusActive,
+		},
+		{
+			name:       "Test case with inactive ingredient price properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"unit_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"price_time\":\"2000-01-05T00:00:00Z\",\"price\":199,\"quantity\":1,\"currency\":\"EUR\",\"store\":\"Store\",\"status\":\"inactive\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UnitId:     uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			PriceTime:  time.Date(2000, time.January, 5, 0, 0, 0, 0, time.UTC),
+			Price:      199,
+			Quantity:   1,
+			Currency:   "EUR",
+			Store:      "Store",
+			Status:     kind.IngredientPriceStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				ingredientPrice := IngredientPrice{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					EntityId:   testCase.EntityId,
+					UnitId:     testCase.UnitId,
+					DateInsert: testCase.DateInsert,
+					DateUpdate: testCase.DateUpdate,
+					PriceTime:  testCase.PriceTime,
+					Price:      testCase.Price,
+					Quantity:   testCase.Quantity,
+					Currency:   testCase.Currency,
+					Store:      testCase.Store,
+					Status:     testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, ingredientPrice.Id)
+				assert.Equal(t, testCase.UserId, ingredientPrice.UserId)
+				assert.Equal(t, testCase.EntityId, ingredientPrice.EntityId)
+				assert.Equal(t, testCase.UnitId, ingredientPrice.UnitId)
+				assert.Equal(t, testCase.DateInsert, ingredientPrice.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, ingredientPrice.DateUpdate)
+				assert.Equal(t, testCase.PriceTime, ingredientPrice.PriceTime)
+				assert.Equal(t, testCase.Price, ingredientPrice.Price)
+				assert.Equal(t, testCase.Quantity, ingredientPrice.Quantity)
+				assert.Equal(t, testCase.Currency, ingredientPrice.Currency)
+				assert.Equal(t, testCase.Store, ingredientPrice.Store)
+				assert.Equal(t, testCase.Status, ingredientPrice.Status)
+
+				reflectIngredientPrice := reflect.ValueOf(ingredientPrice)
+
+				for i := 0; i < reflectIngredientPrice.NumField(); i++ {
+					assert.False(t, reflectIngredientPrice.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(ingredientPrice)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+// IngredientSubstitute allows to use the substitute instead of the ingredient, the ratio is the amount of the
+// substitute for one amount of the ingredient. A substitute is suitable everywhere when there are no contexts,
+// otherwise in the contexts only, e.g. baking or vegan.
+type IngredientSubstitute struct {
+	Id           uuid.UUID                       `bson:"id" json:"id"`
+	UserId       uuid.UUID                       `bson:"user_id" json:"user_id"`
+	EntityId     uuid.UUID                       `bson:"entity_id" json:"entity_id"`
+	SubstituteId uuid.UUID                       `bson:"substitute_id" json:"substitute_id"`
+	DateInsert   time.Time                       `bson:"date_insert" json:"date_insert"`
+	DateUpdate   time.Time                       `bson:"date_update" json:"date_update"`
+	Ratio        float64                         `bson:"ratio" json:"ratio"`
+	Contexts     []kind.SubstituteContext        `bson:"contexts" json:"contexts"`
+	Notes        string                          `bson:"notes" json:"notes"`
+	Status       kind.IngredientSubstituteStatus `bson:"status" json:"status"`
+}
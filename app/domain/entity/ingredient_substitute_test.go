@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIngredientSubstitute(t *testing.T) {
+	tests := []struct {
+		name         string
+		json         string
+		Id           uuid.UUID
+		UserId       uuid.UUID
+		EntityId     uuid.UUID
+		SubstituteId uuid.UUID
+		DateInsert   time.Time
+		DateUpdate   time.Time
+		Ratio        float64
+		Contexts     []kind.SubstituteContext
+		Notes        string
+		Status       kind.IngredientSubstituteStatus
+	}{
+		{
+			name:         "Test case with active ingredient substitute properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"substitute_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"ratio\":0.75,\"contexts\":[\"baking\",\"vegan\"],\"notes\":\"Notes\",\"status\":\"active\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubstituteId: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Ratio:        0.75,
+			Contexts:     []kind.SubstituteContext{kind.SubstituteContextBaking, kind.SubstituteContextVegan},
+			Notes:        "Notes",
+			Status:       kind.IngredientSubstituteStatusActive,
+		},
+		{
+			name:         "Test case with inactive ingredient substitute properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"substitute_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"ratio\":1,\"contexts\":[\"cooking\"],\"notes\":\"Notes\",\"status\":\"inactive\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubstituteId: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Ratio:        1,
+			Contexts:     []kind.SubstituteContext{kind.SubstituteContextCooking},
+			Notes:        "Notes",
+			Status:       kind.IngredientSubstituteStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				ingredientSubstitute := IngredientSubstitute{
+					Id:           testCase.Id,
+					UserId:       testCase.UserId,
+					EntityId:     testCase.EntityId,
+					SubstituteId: testCase.SubstituteId,
+					DateInsert:   testCase.DateInsert,
+					DateUpdate:   testCase.DateUpdate,
+					Ratio:        testCase.Ratio,
+					Contexts:     testCase.Contexts,
+					Notes:        testCase.Notes,
+					Status:       testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, ingredientSubstitute.Id)
+				assert.Equal(t, testCase.UserId, ingredientSubstitute.UserId)
+				assert.Equal(t, testCase.EntityId, ingredientSubstitute.EntityId)
+				assert.Equal(t, testCase.SubstituteId, ingredientSubstitute.SubstituteId)
+				assert.Equal(t, testCase.DateInsert, ingredientSubstitute.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, ingredientSubstitute.DateUpdate)
+				assert.Equal(t, testCase.Ratio, ingredientSubstitute.Ratio)
+				assert.Equal(t, testCase.Contexts, ingredientSubstitute.Contexts)
+				assert.Equal(t, testCase.Notes, ingredientSubstitute.Notes)
+				assert.Equal(t, testCase.Status, ingredientSubstitute.Status)
+
+				reflectIngredientSubstitute := reflect.ValueOf(ingredientSubstitute)
+
+				for i := 0; i < reflectIngredientSubstitute.NumField(); i++ {
+					assert.False(t, reflectIngredientSubstitute.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(ingredientSubstitute)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+type PantryItem struct {
+	Id           uuid.UUID             `bson:"id" json:"id"`
+	UserId       uuid.UUID             `bson:"user_id" json:"user_id"`
+	IngredientId uuid.UUID             `bson:"ingredient_id" json:"ingredient_id"`
+	UnitId       uuid.UUID             `bson:"unit_id" json:"unit_id"`
+	DateInsert   time.Time             `bson:"date_insert" json:"date_insert"`
+	DateUpdate   time.Time             `bson:"date_update" json:"date_update"`
+	ExpiryTime   time.Time             `bson:"expiry_time" json:"expiry_time"`
+	Quantity     int64                 `bson:"quantity" json:"quantity"`
+	Status       kind.PantryItemStatus `bson:"status" json:"status"`
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPantryItem(t *testing.T) {
+	tests := []struct {
+		name         string
+		json         string
+		Id           uuid.UUID
+		UserId       uuid.UUID
+		IngredientId uuid.UUID
+		UnitId       uuid.UUID
+		DateInsert   time.Time
+		DateUpdate   time.Time
+		ExpiryTime   time.Time
+		Quantity     int64
+		Status       kind.PantryItemStatus
+	}{
+		{
+			name:         "Test case with active pantry item properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"ingredient_id\":\"00000000-0000-0000-0000-000000000003\",\"unit_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"expiry_time\":\"2000-02-01T00:00:00Z\",\"quantity\":500,\"status\":\"active\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			ExpiryTime:   time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Quantity:     500,
+			Status:       kind.PantryItemStatusActive,
+		},
+		{
+			name:         "Test case with inactive pantry item properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"ingredient_id\":\"00000000-0000-0000-0000-000000000003\",\"unit_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"expiry_time\":\"2000-02-01T00:00:00Z\",\"quantity\":500,\"status\":\"inactive\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			ExpiryTime:   time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Quantity:     500,
+			Status:       kind.PantryItemStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				pantryItem := PantryItem{
+					Id:           testCase.Id,
+					UserId:       testCase.UserId,
+					IngredientId: testCase.IngredientId,
+					UnitId:       testCase.UnitId,
+					DateInsert:   testCase.DateInsert,
+					DateUpdate:   testCase.DateUpdate,
+					ExpiryTime:   testCase.ExpiryTime,
+					Quantity:     testCase.Quantity,
+					Status:       testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, pantryItem.Id)
+				assert.Equal(t, testCase.UserId, pantryItem.UserId)
+				assert.Equal(t, testCase.IngredientId, pantryItem.IngredientId)
+				assert.Equal(t, testCase.UnitId, pantryItem.UnitId)
+				assert.Equal(t, testCase.DateInsert, pantryItem.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, pantryItem.DateUpdate)
+				assert.Equal(t, testCase.ExpiryTime, pantryItem.ExpiryTime)
+				assert.Equal(t, testCase.Quantity, pantryItem.Quantity)
+				assert.Equal(t, testCase.Status, pantryItem.Status)
+
+				reflectPantryItem := reflect.ValueOf(pantryItem)
+
+				for i := 0; i < reflectPantryItem.NumField(); i++ {
+					assert.False(t, reflectPantryItem.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(pantryItem)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
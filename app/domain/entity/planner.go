@@ -7,14 +7,17 @@ import (
 )
 
 type Planner struct {
-	Id         uuid.UUID          `bson:"id" json:"id"`
-	UserId     uuid.UUID          `bson:"user_id" json:"user_id"`
-	DateInsert time.Time          `bson:"date_insert" json:"date_insert"`
-	DateUpdate time.Time          `bson:"date_update" json:"date_update"`
-	StartTime  time.Time          `bson:"start_time" json:"start_time"`
-	EndTime    time.Time          `bson:"end_time" json:"end_time"`
-	Name       string             `bson:"name" json:"name"`
-	Status     kind.PlannerStatus `bson:"status" json:"status"`
+	Id            uuid.UUID          `bson:"id" json:"id"`
+	UserId        uuid.UUID          `bson:"user_id" json:"user_id"`
+	DateInsert    time.Time          `bson:"date_insert" json:"date_insert"`
+	DateUpdate    time.Time          `bson:"date_update" json:"date_update"`
+	StartTime     time.Time          `bson:"start_time" json:"start_time"`
+	EndTime       time.Time          `bson:"end_time" json:"end_time"`
+	Name          string             `bson:"name" json:"name"`
+	Budget        int64              `bson:"budget" json:"budget"`
+	Currency      string             `bson:"currency" json:"currency"`
+	CalendarToken string             `bson:"calendar_token" json:"calendar_token"`
+	Status        kind.PlannerStatus `bson:"status" json:"status"`
 }
 
 type PlannerInterval struct {
@@ -30,11 +33,41 @@ type PlannerInterval struct {
 }
 
 type PlannerRecipe struct {
-	Id         uuid.UUID                `bson:"id" json:"id"`
-	UserId     uuid.UUID                `bson:"user_id" json:"user_id"`
-	EntityId   uuid.UUID                `bson:"entity_id" json:"entity_id"`
-	RecipeId   uuid.UUID                `bson:"recipe_id" json:"recipe_id"`
-	DateInsert time.Time                `bson:"date_insert" json:"date_insert"`
-	DateUpdate time.Time                `bson:"date_update" json:"date_update"`
-	Status     kind.PlannerRecipeStatus `bson:"status" json:"status"`
+	Id       uuid.UUID `bson:"id" json:"id"`
+	UserId   uuid.UUID `bson:"user_id" json:"user_id"`
+	EntityId uuid.UUID `bson:"entity_id" json:"entity_id"`
+	RecipeId uuid.UUID `bson:"recipe_id" json:"recipe_id"`
+	// RecipeVersionId pins the version of the recipe, the latest recipe is used when it is empty.
+	RecipeVersionId uuid.UUID                `bson:"recipe_version_id" json:"recipe_version_id"`
+	DateInsert      time.Time                `bson:"date_insert" json:"date_insert"`
+	DateUpdate      time.Time                `bson:"date_update" json:"date_update"`
+	Status          kind.PlannerRecipeStatus `bson:"status" json:"status"`
+}
+
+type PlannerTemplate struct {
+	Id         uuid.UUID                      `bson:"id" json:"id"`
+	UserId     uuid.UUID                      `bson:"user_id" json:"user_id"`
+	DateInsert time.Time                      `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time                      `bson:"date_update" json:"date_update"`
+	NextTime   time.Time                      `bson:"next_time" json:"next_time"`
+	Name       string                         `bson:"name" json:"name"`
+	Days       int64                          `bson:"days" json:"days"`
+	Recurrence kind.PlannerTemplateRecurrence `bson:"recurrence" json:"recurrence"`
+	// Recurrences counts the made recurrences, a recurrence is claimed by the count, so it is not made twice.
+	Recurrences int64                      `bson:"recurrences" json:"-"`
+	Status      kind.PlannerTemplateStatus `bson:"status" json:"status"`
+}
+
+// PlannerTemplateInterval is an interval of a planner template, the offsets are minutes from the start of a planner.
+type PlannerTemplateInterval struct {
+	Id          uuid.UUID                          `bson:"id" json:"id"`
+	UserId      uuid.UUID                          `bson:"user_id" json:"user_id"`
+	EntityId    uuid.UUID                          `bson:"entity_id" json:"entity_id"`
+	DateInsert  time.Time                          `bson:"date_insert" json:"date_insert"`
+	DateUpdate  time.Time                          `bson:"date_update" json:"date_update"`
+	Name        string                             `bson:"name" json:"name"`
+	StartOffset int64                              `bson:"start_offset" json:"start_offset"`
+	EndOffset   int64                              `bson:"end_offset" json:"end_offset"`
+	RecipeIds   []uuid.UUID                        `bson:"recipe_ids" json:"recipe_ids"`
+	Status      kind.PlannerTemplateIntervalStatus `bson:"status" json:"status"`
 }
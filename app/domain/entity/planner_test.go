@@ -13,40 +13,49 @@ import (
 
 func TestPlanner(t *testing.T) {
 	tests := []struct {
-		name       string
-		json       string
-		Id         uuid.UUID
-		UserId     uuid.UUID
-		DateInsert time.Time
-		DateUpdate time.Time
-		StartTime  time.Time
-		EndTime    time.Time
-		Name       string
-		Status     kind.PlannerStatus
+		name          string
+		json          string
+		Id            uuid.UUID
+		UserId        uuid.UUID
+		DateInsert    time.Time
+		DateUpdate    time.Time
+		StartTime     time.Time
+		EndTime       time.Time
+		Name          string
+		Budget        int64
+		Currency      string
+		CalendarToken string
+		Status        kind.PlannerStatus
 	}{
 		{
-			name:       "Test case with active planner properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"status\":\"active\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			StartTime:  time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
-			EndTime:    time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
-			Name:       "Planner",
-			Status:     kind.PlannerStatusActive,
+			name:          "Test case with active planner properties",
+			json:          "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"budget\":5000,\"currency\":\"EUR\",\"calendar_token\":\"token\",\"status\":\"active\"}\n",
+			Id:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:    time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			StartTime:     time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
+			Name:          "Planner",
+			Budget:        5000,
+			Currency:      "EUR",
+			CalendarToken: "token",
+			Status:        kind.PlannerStatusActive,
 		},
 		{
-			name:       "Test case with inactive planner properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"status\":\"inactive\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			StartTime:  time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
-			EndTime:    time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
-			Name:       "Planner",
-			Status:     kind.PlannerStatusInActive,
+			name:          "Test case with inactive planner properties",
+			json:          "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"Planner\",\"budget\":5000,\"currency\":\"EUR\",\"calendar_token\":\"token\",\"status\":\"inactive\"}\n",
+			Id:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:    time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			StartTime:     time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
+			EndTime:       time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
+			Name:          "Planner",
+			Budget:        5000,
+			Currency:      "EUR",
+			CalendarToken: "token",
+			Status:        kind.PlannerStatusInActive,
 		},
 	}
 
@@ -55,14 +64,17 @@ func TestPlanner(t *testing.T) {
 			testCase.name,
 			func(t *testing.T) {
 				planner := Planner{
-					Id:         testCase.Id,
-					UserId:     testCase.UserId,
-					DateInsert: testCase.DateInsert,
-					DateUpdate: testCase.DateUpdate,
-					StartTime:  testCase.StartTime,
-					EndTime:    testCase.EndTime,
-					Name:       testCase.Name,
-					Status:     testCase.Status,
+					Id:            testCase.Id,
+					UserId:        testCase.UserId,
+					DateInsert:    testCase.DateInsert,
+					DateUpdate:    testCase.DateUpdate,
+					StartTime:     testCase.StartTime,
+					EndTime:       testCase.EndTime,
+					Name:          testCase.Name,
+					Budget:        testCase.Budget,
+					Currency:      testCase.Currency,
+					CalendarToken: testCase.CalendarToken,
+					Status:        testCase.Status,
 				}
 				assert.Equal(t, testCase.Id, planner.Id)
 				assert.Equal(t, testCase.UserId, planner.UserId)
@@ -71,6 +83,9 @@ func TestPlanner(t *testing.T) {
 				assert.Equal(t, testCase.StartTime, planner.StartTime)
 				assert.Equal(t, testCase.EndTime, planner.EndTime)
 				assert.Equal(t, testCase.Name, planner.Name)
+				assert.Equal(t, testCase.Budget, planner.Budget)
+				assert.Equal(t, testCase.Currency, planner.Currency)
+				assert.Equal(t, testCase.CalendarToken, planner.CalendarToken)
 				assert.Equal(t, testCase.Status, planner.Status)
 
 				reflectPlanner := reflect.ValueOf(planner)
@@ -182,37 +197,40 @@ func TestPlannerInterval(t *testing.T) {
 
 func TestPlannerRecipe(t *testing.T) {
 	tests := []struct {
-		name       string
-		json       string
-		Id         uuid.UUID
-		UserId     uuid.UUID
-		EntityId   uuid.UUID
-		RecipeId   uuid.UUID
-		DateInsert time.Time
-		DateUpdate time.Time
-		Status     kind.PlannerRecipeStatus
+		name            string
+		json            string
+		Id              uuid.UUID
+		UserId          uuid.UUID
+		EntityId        uuid.UUID
+		RecipeId        uuid.UUID
+		RecipeVersionId uuid.UUID
+		DateInsert      time.Time
+		DateUpdate      time.Time
+		Status          kind.PlannerRecipeStatus
 	}{
 		{
-			name:       "Test case with active planner recipe properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-			RecipeId:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
-			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Status:     kind.PlannerRecipeStatusActive,
+			name:            "Test case with active planner recipe properties",
+			json:            "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"recipe_version_id\":\"00000000-0000-0000-0000-000000000005\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"active\"}\n",
+			Id:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			RecipeId:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			RecipeVersionId: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			DateInsert:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:      time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Status:          kind.PlannerRecipeStatusActive,
 		},
 		{
-			name:       "Test case with inactive planner recipe properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"inactive\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-			RecipeId:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
-			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Status:     kind.PlannerRecipeStatusInActive,
+			name:            "Test case with inactive planner recipe properties",
+			json:            "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"recipe_id\":\"00000000-0000-0000-0000-000000000004\",\"recipe_version_id\":\"00000000-0000-0000-0000-000000000005\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"status\":\"inactive\"}\n",
+			Id:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			RecipeId:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			RecipeVersionId: uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			DateInsert:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:      time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Status:          kind.PlannerRecipeStatusInActive,
 		},
 	}
 
@@ -221,17 +239,19 @@ func TestPlannerRecipe(t *testing.T) {
 			testCase.name,
 			func(t *testing.T) {
 				plannerRecipe := PlannerRecipe{
-					Id:         testCase.Id,
-					UserId:     testCase.UserId,
-					EntityId:   testCase.EntityId,
-					RecipeId:   testCase.RecipeId,
-					DateInsert: testCase.DateInsert,
-					DateUpdate: testCase.DateUpdate,
-					Status:     testCase.Status,
+					Id:              testCase.Id,
+					UserId:          testCase.UserId,
+					EntityId:        testCase.EntityId,
+					RecipeId:        testCase.RecipeId,
+					RecipeVersionId: testCase.RecipeVersionId,
+					DateInsert:      testCase.DateInsert,
+					DateUpdate:      testCase.DateUpdate,
+					Status:          testCase.Status,
 				}
 				assert.Equal(t, testCase.Id, plannerRecipe.Id)
 				assert.Equal(t, testCase.UserId, plannerRecipe.UserId)
 				assert.Equal(t, testCase.EntityId, plannerRecipe.EntityId)
+				assert.Equal(t, testCase.RecipeVersionId, plannerRecipe.RecipeVersionId)
 				assert.Equal(t, testCase.DateInsert, plannerRecipe.DateInsert)
 				assert.Equal(t, testCase.DateUpdate, plannerRecipe.DateUpdate)
 				assert.Equal(t, testCase.Status, plannerRecipe.Status)
@@ -255,3 +275,187 @@ func TestPlannerRecipe(t *testing.T) {
 		)
 	}
 }
+
+func TestPlannerTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		Id          uuid.UUID
+		UserId      uuid.UUID
+		DateInsert  time.Time
+		DateUpdate  time.Time
+		NextTime    time.Time
+		Name        string
+		Days        int64
+		Recurrence  kind.PlannerTemplateRecurrence
+		Recurrences int64
+		Status      kind.PlannerTemplateStatus
+	}{
+		{
+			name:        "Test case with weekly planner template properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"next_time\":\"2000-01-17T00:00:00Z\",\"name\":\"PlannerTemplate\",\"days\":7,\"recurrence\":\"weekly\",\"status\":\"active\"}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			NextTime:    time.Date(2000, time.January, 17, 0, 0, 0, 0, time.UTC),
+			Name:        "PlannerTemplate",
+			Days:        7,
+			Recurrence:  kind.PlannerTemplateRecurrenceWeekly,
+			Recurrences: 3,
+			Status:      kind.PlannerTemplateStatusActive,
+		},
+		{
+			name:        "Test case with fortnightly planner template properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"next_time\":\"2000-01-24T00:00:00Z\",\"name\":\"PlannerTemplate\",\"days\":14,\"recurrence\":\"fortnightly\",\"status\":\"inactive\"}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			NextTime:    time.Date(2000, time.January, 24, 0, 0, 0, 0, time.UTC),
+			Name:        "PlannerTemplate",
+			Days:        14,
+			Recurrence:  kind.PlannerTemplateRecurrenceFortnightly,
+			Recurrences: 5,
+			Status:      kind.PlannerTemplateStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				plannerTemplate := PlannerTemplate{
+					Id:          testCase.Id,
+					UserId:      testCase.UserId,
+					DateInsert:  testCase.DateInsert,
+					DateUpdate:  testCase.DateUpdate,
+					NextTime:    testCase.NextTime,
+					Name:        testCase.Name,
+					Days:        testCase.Days,
+					Recurrence:  testCase.Recurrence,
+					Recurrences: testCase.Recurrences,
+					Status:      testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, plannerTemplate.Id)
+				assert.Equal(t, testCase.UserId, plannerTemplate.UserId)
+				assert.Equal(t, testCase.DateInsert, plannerTemplate.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, plannerTemplate.DateUpdate)
+				assert.Equal(t, testCase.NextTime, plannerTemplate.NextTime)
+				assert.Equal(t, testCase.Name, plannerTemplate.Name)
+				assert.Equal(t, testCase.Days, plannerTemplate.Days)
+				assert.Equal(t, testCase.Recurrence, plannerTemplate.Recurrence)
+				assert.Equal(t, testCase.Recurrences, plannerTemplate.Recurrences)
+				assert.Equal(t, testCase.Status, plannerTemplate.Status)
+
+				reflectPlannerTemplate := reflect.ValueOf(plannerTemplate)
+
+				for i := 0; i < reflectPlannerTemplate.NumField(); i++ {
+					assert.False(t, reflectPlannerTemplate.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(plannerTemplate)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
+
+func TestPlannerTemplateInterval(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		Id          uuid.UUID
+		UserId      uuid.UUID
+		EntityId    uuid.UUID
+		DateInsert  time.Time
+		DateUpdate  time.Time
+		Name        string
+		StartOffset int64
+		EndOffset   int64
+		RecipeIds   []uuid.UUID
+		Status      kind.PlannerTemplateIntervalStatus
+	}{
+		{
+			name:        "Test case with active planner template interval properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Breakfast\",\"start_offset\":420,\"end_offset\":540,\"recipe_ids\":[\"00000000-0000-0000-0000-000000000004\"],\"status\":\"active\"}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Breakfast",
+			StartOffset: 420,
+			EndOffset:   540,
+			RecipeIds:   []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000004")},
+			Status:      kind.PlannerTemplateIntervalStatusActive,
+		},
+		{
+			name:        "Test case with inactive planner template interval properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Dinner\",\"start_offset\":1080,\"end_offset\":1200,\"recipe_ids\":[\"00000000-0000-0000-0000-000000000004\"],\"status\":\"inactive\"}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Dinner",
+			StartOffset: 1080,
+			EndOffset:   1200,
+			RecipeIds:   []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000004")},
+			Status:      kind.PlannerTemplateIntervalStatusInActive,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				plannerTemplateInterval := PlannerTemplateInterval{
+					Id:          testCase.Id,
+					UserId:      testCase.UserId,
+					EntityId:    testCase.EntityId,
+					DateInsert:  testCase.DateInsert,
+					DateUpdate:  testCase.DateUpdate,
+					Name:        testCase.Name,
+					StartOffset: testCase.StartOffset,
+					EndOffset:   testCase.EndOffset,
+					RecipeIds:   testCase.RecipeIds,
+					Status:      testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, plannerTemplateInterval.Id)
+				assert.Equal(t, testCase.UserId, plannerTemplateInterval.UserId)
+				assert.Equal(t, testCase.EntityId, plannerTemplateInterval.EntityId)
+				assert.Equal(t, testCase.DateInsert, plannerTemplateInterval.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, plannerTemplateInterval.DateUpdate)
+				assert.Equal(t, testCase.Name, plannerTemplateInterval.Name)
+				assert.Equal(t, testCase.StartOffset, plannerTemplateInterval.StartOffset)
+				assert.Equal(t, testCase.EndOffset, plannerTemplateInterval.EndOffset)
+				assert.Equal(t, testCase.RecipeIds, plannerTemplateInterval.RecipeIds)
+				assert.Equal(t, testCase.Status, plannerTemplateInterval.Status)
+
+				reflectPlannerTemplateInterval := reflect.ValueOf(plannerTemplateInterval)
+
+				for i := 0; i < reflectPlannerTemplateInterval.NumField(); i++ {
+					assert.False(t, reflectPlannerTemplateInterval.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(plannerTemplateInterval)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -6,15 +6,21 @@ import (
 	"time"
 )
 
+// Recipe is forked from the recipe of ForkedFromId when the id is not uuid.Nil, ForkedFromVersionId is the version
+// of that recipe at the moment of the fork.
 type Recipe struct {
-	Id          uuid.UUID         `bson:"id" json:"id"`
-	UserId      uuid.UUID         `bson:"user_id" json:"user_id"`
-	DateInsert  time.Time         `bson:"date_insert" json:"date_insert"`
-	DateUpdate  time.Time         `bson:"date_update" json:"date_update"`
-	Name        string            `bson:"name" json:"name"`
-	Description string            `bson:"description" json:"description"`
-	Notes       string            `bson:"notes" json:"notes"`
-	Status      kind.RecipeStatus `bson:"status" json:"status"`
+	Id                  uuid.UUID         `bson:"id" json:"id"`
+	UserId              uuid.UUID         `bson:"user_id" json:"user_id"`
+	DateInsert          time.Time         `bson:"date_insert" json:"date_insert"`
+	DateUpdate          time.Time         `bson:"date_update" json:"date_update"`
+	Name                string            `bson:"name" json:"name"`
+	Description         string            `bson:"description" json:"description"`
+	Notes               string            `bson:"notes" json:"notes"`
+	Servings            int64             `bson:"servings" json:"servings"`
+	Calories            int64             `bson:"calories" json:"calories"`
+	Status              kind.RecipeStatus `bson:"status" json:"status"`
+	ForkedFromId        uuid.UUID         `bson:"forked_from_id" json:"forked_from_id"`
+	ForkedFromVersionId uuid.UUID         `bson:"forked_from_version_id" json:"forked_from_version_id"`
 }
 
 type RecipeCategory struct {
@@ -60,3 +66,17 @@ type RecipeProcess struct {
 	Notes       string                   `bson:"notes" json:"notes"`
 	Status      kind.RecipeProcessStatus `bson:"status" json:"status"`
 }
+
+// RecipeVersion is an immutable snapshot of the recipe aggregate which is written after every change of the recipe
+// or of its categories, ingredients, measures and processes. The subject and the action describe the change.
+type RecipeVersion struct {
+	Id         uuid.UUID                 `bson:"id" json:"id"`
+	UserId     uuid.UUID                 `bson:"user_id" json:"user_id"`
+	EntityId   uuid.UUID                 `bson:"entity_id" json:"entity_id"`
+	SubjectId  uuid.UUID                 `bson:"subject_id" json:"subject_id"`
+	DateInsert time.Time                 `bson:"date_insert" json:"date_insert"`
+	Version    int64                     `bson:"version" json:"version"`
+	Subject    kind.RecipeVersionSubject `bson:"subject" json:"subject"`
+	Action     kind.RecipeVersionAction  `bson:"action" json:"action"`
+	Snapshot   []byte                    `bson:"snapshot" json:"-"`
+}
@@ -13,40 +13,52 @@ import (
 
 func TestRecipe(t *testing.T) {
 	tests := []struct {
-		name        string
-		json        string
-		Id          uuid.UUID
-		UserId      uuid.UUID
-		DateInsert  time.Time
-		DateUpdate  time.Time
-		Name        string
-		Description string
-		Notes       string
-		Status      kind.RecipeStatus
+		name                string
+		json                string
+		Id                  uuid.UUID
+		UserId              uuid.UUID
+		DateInsert          time.Time
+		DateUpdate          time.Time
+		Name                string
+		Description         string
+		Notes               string
+		Servings            int64
+		Calories            int64
+		Status              kind.RecipeStatus
+		ForkedFromId        uuid.UUID
+		ForkedFromVersionId uuid.UUID
 	}{
 		{
-			name:        "Test case with published recipe properties",
-			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"published\"}\n",
-			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:        "Recipe",
-			Description: "Description",
-			Notes:       "Notes",
-			Status:      kind.RecipeStatusPublished,
+			name:                "Test case with published recipe properties",
+			json:                "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"servings\":4,\"calories\":420,\"status\":\"published\",\"forked_from_id\":\"00000000-0000-0000-0000-000000000005\",\"forked_from_version_id\":\"00000000-0000-0000-0000-000000000006\"}\n",
+			Id:                  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:          time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:          time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:                "Recipe",
+			Description:         "Description",
+			Notes:               "Notes",
+			Servings:            4,
+			Calories:            420,
+			Status:              kind.RecipeStatusPublished,
+			ForkedFromId:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			ForkedFromVersionId: uuid.MustParse("00000000-0000-0000-0000-000000000006"),
 		},
 		{
-			name:        "Test case with unpublished recipe properties",
-			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"status\":\"unpublished\"}\n",
-			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			UserId:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-			DateInsert:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate:  time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:        "Recipe",
-			Description: "Description",
-			Notes:       "Notes",
-			Status:      kind.RecipeStatusUnPublished,
+			name:                "Test case with unpublished recipe properties",
+			json:                "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"Recipe\",\"description\":\"Description\",\"notes\":\"Notes\",\"servings\":4,\"calories\":420,\"status\":\"unpublished\",\"forked_from_id\":\"00000000-0000-0000-0000-000000000005\",\"forked_from_version_id\":\"00000000-0000-0000-0000-000000000006\"}\n",
+			Id:                  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			DateInsert:          time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:          time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:                "Recipe",
+			Description:         "Description",
+			Notes:               "Notes",
+			Servings:            4,
+			Calories:            420,
+			Status:              kind.RecipeStatusUnPublished,
+			ForkedFromId:        uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			ForkedFromVersionId: uuid.MustParse("00000000-0000-0000-0000-000000000006"),
 		},
 	}
 
@@ -55,14 +67,18 @@ func TestRecipe(t *testing.T) {
 			testCase.name,
 			func(t *testing.T) {
 				recipe := Recipe{
-					Id:          testCase.Id,
-					UserId:      testCase.UserId,
-					DateInsert:  testCase.DateInsert,
-					DateUpdate:  testCase.DateUpdate,
-					Name:        testCase.Name,
-					Description: testCase.Description,
-					Notes:       testCase.Notes,
-					Status:      testCase.Status,
+					Id:                  testCase.Id,
+					UserId:              testCase.UserId,
+					DateInsert:          testCase.DateInsert,
+					DateUpdate:          testCase.DateUpdate,
+					Name:                testCase.Name,
+					Description:         testCase.Description,
+					Notes:               testCase.Notes,
+					Servings:            testCase.Servings,
+					Calories:            testCase.Calories,
+					Status:              testCase.Status,
+					ForkedFromId:        testCase.ForkedFromId,
+					ForkedFromVersionId: testCase.ForkedFromVersionId,
 				}
 				assert.Equal(t, testCase.Id, recipe.Id)
 				assert.Equal(t, testCase.UserId, recipe.UserId)
@@ -71,7 +87,11 @@ func TestRecipe(t *testing.T) {
 				assert.Equal(t, testCase.Name, recipe.Name)
 				assert.Equal(t, testCase.Description, recipe.Description)
 				assert.Equal(t, testCase.Notes, recipe.Notes)
+				assert.Equal(t, testCase.Servings, recipe.Servings)
+				assert.Equal(t, testCase.Calories, recipe.Calories)
 				assert.Equal(t, testCase.Status, recipe.Status)
+				assert.Equal(t, testCase.ForkedFromId, recipe.ForkedFromId)
+				assert.Equal(t, testCase.ForkedFromVersionId, recipe.ForkedFromVersionId)
 
 				reflectRecipe := reflect.ValueOf(recipe)
 
@@ -420,3 +440,90 @@ func TestRecipeProcess(t *testing.T) {
 		)
 	}
 }
+
+func TestRecipeVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		EntityId   uuid.UUID
+		SubjectId  uuid.UUID
+		DateInsert time.Time
+		Version    int64
+		Subject    kind.RecipeVersionSubject
+		Action     kind.RecipeVersionAction
+		Snapshot   []byte
+	}{
+		{
+			name:       "Test case with recipe update version properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"subject_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"version\":2,\"subject\":\"recipe\",\"action\":\"update\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubjectId:  uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Version:    2,
+			Subject:    kind.RecipeVersionSubjectRecipe,
+			Action:     kind.RecipeVersionActionUpdate,
+			Snapshot:   []byte("{}"),
+		},
+		{
+			name:       "Test case with recipe measure delete version properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"subject_id\":\"00000000-0000-0000-0000-000000000004\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"version\":5,\"subject\":\"recipe_measure\",\"action\":\"delete\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			SubjectId:  uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Version:    5,
+			Subject:    kind.RecipeVersionSubjectMeasure,
+			Action:     kind.RecipeVersionActionDelete,
+			Snapshot:   []byte("{}"),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				recipeVersion := RecipeVersion{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					EntityId:   testCase.EntityId,
+					SubjectId:  testCase.SubjectId,
+					DateInsert: testCase.DateInsert,
+					Version:    testCase.Version,
+					Subject:    testCase.Subject,
+					Action:     testCase.Action,
+					Snapshot:   testCase.Snapshot,
+				}
+				assert.Equal(t, testCase.Id, recipeVersion.Id)
+				assert.Equal(t, testCase.UserId, recipeVersion.UserId)
+				assert.Equal(t, testCase.EntityId, recipeVersion.EntityId)
+				assert.Equal(t, testCase.SubjectId, recipeVersion.SubjectId)
+				assert.Equal(t, testCase.DateInsert, recipeVersion.DateInsert)
+				assert.Equal(t, testCase.Version, recipeVersion.Version)
+				assert.Equal(t, testCase.Subject, recipeVersion.Subject)
+				assert.Equal(t, testCase.Action, recipeVersion.Action)
+				assert.Equal(t, testCase.Snapshot, recipeVersion.Snapshot)
+
+				reflectRecipeVersion := reflect.ValueOf(recipeVersion)
+
+				for i := 0; i < reflectRecipeVersion.NumField(); i++ {
+					assert.False(t, reflectRecipeVersion.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(recipeVersion)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"time"
+)
+
+type ShoppingList struct {
+	Id         uuid.UUID               `bson:"id" json:"id"`
+	UserId     uuid.UUID               `bson:"user_id" json:"user_id"`
+	PlannerId  uuid.UUID               `bson:"planner_id" json:"planner_id"`
+	DateInsert time.Time               `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time               `bson:"date_update" json:"date_update"`
+	StartTime  time.Time               `bson:"start_time" json:"start_time"`
+	EndTime    time.Time               `bson:"end_time" json:"end_time"`
+	Name       string                  `bson:"name" json:"name"`
+	Status     kind.ShoppingListStatus `bson:"status" json:"status"`
+}
+
+type ShoppingListItem struct {
+	Id           uuid.UUID                   `bson:"id" json:"id"`
+	UserId       uuid.UUID                   `bson:"user_id" json:"user_id"`
+	EntityId     uuid.UUID                   `bson:"entity_id" json:"entity_id"`
+	IngredientId uuid.UUID                   `bson:"ingredient_id" json:"ingredient_id"`
+	UnitId       uuid.UUID                   `bson:"unit_id" json:"unit_id"`
+	CategoryId   uuid.UUID                   `bson:"category_id" json:"category_id"`
+	DateInsert   time.Time                   `bson:"date_insert" json:"date_insert"`
+	DateUpdate   time.Time                   `bson:"date_update" json:"date_update"`
+	Name         string                      `bson:"name" json:"name"`
+	Amount       int64                       `bson:"amount" json:"amount"`
+	Manual       bool                        `bson:"manual" json:"manual"`
+	Status       kind.ShoppingListItemStatus `bson:"status" json:"status"`
+}
@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShoppingList(t *testing.T) {
+	tests := []struct {
+		name       string
+		json       string
+		Id         uuid.UUID
+		UserId     uuid.UUID
+		PlannerId  uuid.UUID
+		DateInsert time.Time
+		DateUpdate time.Time
+		StartTime  time.Time
+		EndTime    time.Time
+		Name       string
+		Status     kind.ShoppingListStatus
+	}{
+		{
+			name:       "Test case with active shopping list properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"planner_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"ShoppingList\",\"status\":\"active\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			PlannerId:  uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			StartTime:  time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
+			EndTime:    time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
+			Name:       "ShoppingList",
+			Status:     kind.ShoppingListStatusActive,
+		},
+		{
+			name:       "Test case with completed shopping list properties",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"planner_id\":\"00000000-0000-0000-0000-000000000003\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"ShoppingList\",\"status\":\"completed\"}\n",
+			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			PlannerId:  uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			DateInsert: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			StartTime:  time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
+			EndTime:    time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
+			Name:       "ShoppingList",
+			Status:     kind.ShoppingListStatusCompleted,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				shoppingList := ShoppingList{
+					Id:         testCase.Id,
+					UserId:     testCase.UserId,
+					PlannerId:  testCase.PlannerId,
+					DateInsert: testCase.DateInsert,
+					DateUpdate: testCase.DateUpdate,
+					StartTime:  testCase.StartTime,
+					EndTime:    testCase.EndTime,
+					Name:       testCase.Name,
+					Status:     testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, shoppingList.Id)
+				assert.Equal(t, testCase.UserId, shoppingList.UserId)
+				assert.Equal(t, testCase.PlannerId, shoppingList.PlannerId)
+				assert.Equal(t, testCase.DateInsert, shoppingList.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, shoppingList.DateUpdate)
+				assert.Equal(t, testCase.StartTime, shoppingList.StartTime)
+				assert.Equal(t, testCase.EndTime, shoppingList.EndTime)
+				assert.Equal(t, testCase.Name, shoppingList.Name)
+				assert.Equal(t, testCase.Status, shoppingList.Status)
+
+				reflectShoppingList := reflect.ValueOf(shoppingList)
+
+				for i := 0; i < reflectShoppingList.NumField(); i++ {
+					assert.False(t, reflectShoppingList.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(shoppingList)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
+
+func TestShoppingListItem(t *testing.T) {
+	tests := []struct {
+		name         string
+		json         string
+		Id           uuid.UUID
+		UserId       uuid.UUID
+		EntityId     uuid.UUID
+		IngredientId uuid.UUID
+		UnitId       uuid.UUID
+		CategoryId   uuid.UUID
+		DateInsert   time.Time
+		DateUpdate   time.Time
+		Name         string
+		Amount       int64
+		Manual       bool
+		Status       kind.ShoppingListItemStatus
+	}{
+		{
+			name:         "Test case with checked shopping list item properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"ingredient_id\":\"00000000-0000-0000-0000-000000000004\",\"unit_id\":\"00000000-0000-0000-0000-000000000005\",\"category_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"ShoppingListItem\",\"amount\":500,\"manual\":true,\"status\":\"checked\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			CategoryId:   uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:         "ShoppingListItem",
+			Amount:       500,
+			Manual:       true,
+			Status:       kind.ShoppingListItemStatusChecked,
+		},
+		{
+			name:         "Test case with unchecked shopping list item properties",
+			json:         "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"entity_id\":\"00000000-0000-0000-0000-000000000003\",\"ingredient_id\":\"00000000-0000-0000-0000-000000000004\",\"unit_id\":\"00000000-0000-0000-0000-000000000005\",\"category_id\":\"00000000-0000-0000-0000-000000000006\",\"date_insert\":\"2000-01-01T00:00:00Z\",\"date_update\":\"2000-01-10T00:00:00Z\",\"name\":\"ShoppingListItem\",\"amount\":500,\"manual\":true,\"status\":\"unchecked\"}\n",
+			Id:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			EntityId:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			CategoryId:   uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			DateInsert:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:   time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:         "ShoppingListItem",
+			Amount:       500,
+			Manual:       true,
+			Status:       kind.ShoppingListItemStatusUnChecked,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				shoppingListItem := ShoppingListItem{
+					Id:           testCase.Id,
+					UserId:       testCase.UserId,
+					EntityId:     testCase.EntityId,
+					IngredientId: testCase.IngredientId,
+					UnitId:       testCase.UnitId,
+					CategoryId:   testCase.CategoryId,
+					DateInsert:   testCase.DateInsert,
+					DateUpdate:   testCase.DateUpdate,
+					Name:         testCase.Name,
+					Amount:       testCase.Amount,
+					Manual:       testCase.Manual,
+					Status:       testCase.Status,
+				}
+				assert.Equal(t, testCase.Id, shoppingListItem.Id)
+				assert.Equal(t, testCase.UserId, shoppingListItem.UserId)
+				assert.Equal(t, testCase.EntityId, shoppingListItem.EntityId)
+				assert.Equal(t, testCase.IngredientId, shoppingListItem.IngredientId)
+				assert.Equal(t, testCase.UnitId, shoppingListItem.UnitId)
+				assert.Equal(t, testCase.CategoryId, shoppingListItem.CategoryId)
+				assert.Equal(t, testCase.DateInsert, shoppingListItem.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, shoppingListItem.DateUpdate)
+				assert.Equal(t, testCase.Name, shoppingListItem.Name)
+				assert.Equal(t, testCase.Amount, shoppingListItem.Amount)
+				assert.Equal(t, testCase.Manual, shoppingListItem.Manual)
+				assert.Equal(t, testCase.Status, shoppingListItem.Status)
+
+				reflectShoppingListItem := reflect.ValueOf(shoppingListItem)
+
+				for i := 0; i < reflectShoppingListItem.NumField(); i++ {
+					assert.False(t, reflectShoppingListItem.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(shoppingListItem)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
@@ -10,6 +10,10 @@ import (
 const (
 	UserConfirmationValueMin = 100000
 	UserConfirmationValueMax = 999999
+	// UserConfirmationExpire is the lifetime of the confirmation and UserConfirmationAttemptsMax is the amount of
+	// the wrong codes after which the confirmation is deactivated.
+	UserConfirmationExpire      = 10 * time.Minute
+	UserConfirmationAttemptsMax = 5
 )
 
 type User struct {
@@ -18,12 +22,18 @@ type User struct {
 }
 
 type UserRole struct {
-	Id         uuid.UUID           `bson:"id" json:"id"`
-	DateInsert time.Time           `bson:"date_insert" json:"date_insert"`
-	DateUpdate time.Time           `bson:"date_update" json:"date_update"`
-	Name       string              `bson:"name" json:"name"`
-	Code       kind.UserRole       `bson:"code" json:"code"`
-	Status     kind.UserRoleStatus `bson:"status" json:"status"`
+	Id          uuid.UUID           `bson:"id" json:"id"`
+	DateInsert  time.Time           `bson:"date_insert" json:"date_insert"`
+	DateUpdate  time.Time           `bson:"date_update" json:"date_update"`
+	Name        string              `bson:"name" json:"name"`
+	Code        kind.UserRole       `bson:"code" json:"code"`
+	Status      kind.UserRoleStatus `bson:"status" json:"status"`
+	Permissions []UserPermission    `bson:"permissions" json:"permissions"`
+}
+
+type UserPermission struct {
+	Resource kind.UserResource `bson:"resource" json:"resource"`
+	Rights   []kind.UserRight  `bson:"rights" json:"rights"`
 }
 
 type UserToRole struct {
@@ -37,10 +47,38 @@ type UserToRole struct {
 }
 
 type UserConfirmation struct {
-	Id         uuid.UUID `bson:"id" json:"id"`
-	DateInsert time.Time `bson:"date_insert" json:"date_insert"`
-	DateUpdate time.Time `bson:"date_update" json:"date_update"`
-	UserId     uuid.UUID `bson:"user_id" json:"user_id"`
-	Value      string    `bson:"value" json:"value"`
-	Active     bool      `bson:"active" json:"active"`
+	Id         uuid.UUID                 `bson:"id" json:"id"`
+	DateInsert time.Time                 `bson:"date_insert" json:"date_insert"`
+	DateUpdate time.Time                 `bson:"date_update" json:"date_update"`
+	UserId     uuid.UUID                 `bson:"user_id" json:"user_id"`
+	Value      string                    `bson:"value" json:"value"`
+	Active     bool                      `bson:"active" json:"active"`
+	Type       kind.UserConfirmationType `bson:"type" json:"type"`
+	Attempts   int                       `bson:"attempts" json:"attempts"`
+	DateExpire time.Time                 `bson:"date_expire" json:"date_expire"`
+}
+
+// UserSession is a family of the refresh tokens issued since a login, RefreshTokenId is the identifier of the only
+// refresh token of the family which can be exchanged.
+type UserSession struct {
+	Id             uuid.UUID              `bson:"id" json:"id"`
+	UserId         uuid.UUID              `bson:"user_id" json:"user_id"`
+	RefreshTokenId uuid.UUID              `bson:"refresh_token_id" json:"refresh_token_id"`
+	Status         kind.UserSessionStatus `bson:"status" json:"status"`
+	DateInsert     time.Time              `bson:"date_insert" json:"date_insert"`
+	DateUpdate     time.Time              `bson:"date_update" json:"date_update"`
+	DateExpire     time.Time              `bson:"date_expire" json:"date_expire"`
+}
+
+// UserTotp is the time-based one-time password factor of the user, RecoveryCodes are kept hashed and Counter is the
+// time step of the last accepted code, so the code can't be accepted twice.
+type UserTotp struct {
+	Id            uuid.UUID           `bson:"id" json:"id"`
+	UserId        uuid.UUID           `bson:"user_id" json:"user_id"`
+	Secret        string              `bson:"secret" json:"-"`
+	RecoveryCodes []string            `bson:"recovery_codes" json:"-"`
+	Counter       int64               `bson:"counter" json:"counter"`
+	Status        kind.UserTotpStatus `bson:"status" json:"status"`
+	DateInsert    time.Time           `bson:"date_insert" json:"date_insert"`
+	DateUpdate    time.Time           `bson:"date_update" json:"date_update"`
 }
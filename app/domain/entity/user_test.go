@@ -28,10 +28,11 @@ func TestUser(t *testing.T) {
 		Status     kind.UserStatus
 		Active     bool
 		Roles      kind.UserRoles
+		DateLogout time.Time
 	}{
 		{
 			name:       "Test case with status register and role admin and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"admin\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -44,10 +45,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role common and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -60,10 +62,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status need confirmation and role common and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -76,10 +79,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusNeedConfirmation,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role admin and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"admin\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -92,10 +96,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role common and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":true,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -108,10 +113,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     true,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role admin and active false and the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"admin\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -124,10 +130,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status register and role common and active false and  the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"register\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -140,10 +147,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusRegister,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status need confirmation and role common and active false and  the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"need_confirmation\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -156,10 +164,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusNeedConfirmation,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role admin and active false and  the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"admin\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"admin\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -172,10 +181,11 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleAdmin},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with status disabled and role common and active false and  the other User properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"common\"]}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"username\":\"Username\",\"password\":\"Password\",\"name\":\"Name\",\"surname\":\"Surname\",\"middle_name\":\"MiddleName\",\"birthday\":\"2000-01-01T00:00:00Z\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"status\":\"disabled\",\"active\":false,\"roles\":[\"common\"],\"date_logout\":\"2020-01-20T00:00:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			Username:   "Username",
 			Password:   "Password",
@@ -188,6 +198,7 @@ func TestUser(t *testing.T) {
 			Status:     kind.UserStatusDisabled,
 			Active:     false,
 			Roles:      kind.UserRoles{kind.UserRoleCommon},
+			DateLogout: time.Date(2020, time.January, 20, 0, 0, 0, 0, time.UTC),
 		},
 	}
 
@@ -213,6 +224,7 @@ func TestUser(t *testing.T) {
 						Status:     testCase.Status,
 						Active:     testCase.Active,
 						Roles:      testCase.Roles,
+						DateLogout: testCase.DateLogout,
 					},
 				}
 				assert.Equal(t, testCase.Id, user.Id)
@@ -227,6 +239,7 @@ func TestUser(t *testing.T) {
 				assert.Equal(t, testCase.Status, user.Status)
 				assert.Equal(t, testCase.Active, user.Active)
 				assert.Equal(t, testCase.Roles, user.Roles)
+				assert.Equal(t, testCase.DateLogout, user.DateLogout)
 
 				reflectUser := reflect.ValueOf(user)
 
@@ -252,54 +265,59 @@ func TestUser(t *testing.T) {
 
 func TestUserRole(t *testing.T) {
 	tests := []struct {
-		name       string
-		json       string
-		Id         uuid.UUID
-		DateInsert time.Time
-		DateUpdate time.Time
-		Name       string
-		Code       kind.UserRole
-		Status     kind.UserRoleStatus
+		name        string
+		json        string
+		Id          uuid.UUID
+		DateInsert  time.Time
+		DateUpdate  time.Time
+		Name        string
+		Code        kind.UserRole
+		Status      kind.UserRoleStatus
+		Permissions []UserPermission
 	}{
 		{
-			name:       "Test case with status active and code admin and other UserRole properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Admin\",\"code\":\"admin\",\"status\":\"active\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:       "Admin",
-			Code:       kind.UserRoleAdmin,
-			Status:     kind.UserRoleStatusActive,
+			name:        "Test case with status active and code admin and other UserRole properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Admin\",\"code\":\"admin\",\"status\":\"active\",\"permissions\":[{\"resource\":\"user\",\"rights\":[\"write\",\"read\"]}]}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DateInsert:  time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Admin",
+			Code:        kind.UserRoleAdmin,
+			Status:      kind.UserRoleStatusActive,
+			Permissions: []UserPermission{{Resource: kind.UserResourceUser, Rights: []kind.UserRight{kind.UserRightWrite, kind.UserRightRead}}},
 		},
 		{
-			name:       "Test case with status inactive and code admin and other UserRole properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Admin\",\"code\":\"admin\",\"status\":\"inactive\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:       "Admin",
-			Code:       kind.UserRoleAdmin,
-			Status:     kind.UserRoleStatusInActive,
+			name:        "Test case with status inactive and code admin and other UserRole properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Admin\",\"code\":\"admin\",\"status\":\"inactive\",\"permissions\":[{\"resource\":\"user\",\"rights\":[\"write\",\"read\"]}]}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DateInsert:  time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Admin",
+			Code:        kind.UserRoleAdmin,
+			Status:      kind.UserRoleStatusInActive,
+			Permissions: []UserPermission{{Resource: kind.UserResourceUser, Rights: []kind.UserRight{kind.UserRightWrite, kind.UserRightRead}}},
 		},
 		{
-			name:       "Test case with status active and code common and other UserRole properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Common\",\"code\":\"common\",\"status\":\"active\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:       "Common",
-			Code:       kind.UserRoleCommon,
-			Status:     kind.UserRoleStatusActive,
+			name:        "Test case with status active and code common and other UserRole properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Common\",\"code\":\"common\",\"status\":\"active\",\"permissions\":[{\"resource\":\"user\",\"rights\":[\"write\",\"read\"]}]}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DateInsert:  time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Common",
+			Code:        kind.UserRoleCommon,
+			Status:      kind.UserRoleStatusActive,
+			Permissions: []UserPermission{{Resource: kind.UserResourceUser, Rights: []kind.UserRight{kind.UserRightWrite, kind.UserRightRead}}},
 		},
 		{
-			name:       "Test case with status inactive and code common and other UserRole properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Common\",\"code\":\"common\",\"status\":\"inactive\"}\n",
-			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
-			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
-			Name:       "Common",
-			Code:       kind.UserRoleCommon,
-			Status:     kind.UserRoleStatusInActive,
+			name:        "Test case with status inactive and code common and other UserRole properties",
+			json:        "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"name\":\"Common\",\"code\":\"common\",\"status\":\"inactive\",\"permissions\":[{\"resource\":\"user\",\"rights\":[\"write\",\"read\"]}]}\n",
+			Id:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			DateInsert:  time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:  time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			Name:        "Common",
+			Code:        kind.UserRoleCommon,
+			Status:      kind.UserRoleStatusInActive,
+			Permissions: []UserPermission{{Resource: kind.UserResourceUser, Rights: []kind.UserRight{kind.UserRightWrite, kind.UserRightRead}}},
 		},
 	}
 
@@ -308,12 +326,13 @@ func TestUserRole(t *testing.T) {
 			testCase.name,
 			func(t *testing.T) {
 				userRole := UserRole{
-					Id:         testCase.Id,
-					DateInsert: testCase.DateInsert,
-					DateUpdate: testCase.DateUpdate,
-					Name:       testCase.Name,
-					Code:       testCase.Code,
-					Status:     testCase.Status,
+					Id:          testCase.Id,
+					DateInsert:  testCase.DateInsert,
+					DateUpdate:  testCase.DateUpdate,
+					Name:        testCase.Name,
+					Code:        testCase.Code,
+					Status:      testCase.Status,
+					Permissions: testCase.Permissions,
 				}
 				assert.Equal(t, testCase.Id, userRole.Id)
 				assert.Equal(t, testCase.DateInsert, userRole.DateInsert)
@@ -321,6 +340,7 @@ func TestUserRole(t *testing.T) {
 				assert.Equal(t, testCase.Name, userRole.Name)
 				assert.Equal(t, testCase.Code, userRole.Code)
 				assert.Equal(t, testCase.Status, userRole.Status)
+				assert.Equal(t, testCase.Permissions, userRole.Permissions)
 
 				reflectUser := reflect.ValueOf(userRole)
 
@@ -475,26 +495,35 @@ func TestUserConfirmation(t *testing.T) {
 		UserId     uuid.UUID
 		Value      string
 		Active     bool
+		Type       kind.UserConfirmationType
+		Attempts   int
+		DateExpire time.Time
 	}{
 		{
 			name:       "Test case with active true and other UserConfirmation properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"value\":\"424242\",\"active\":true}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"value\":\"424242\",\"active\":true,\"type\":\"auth\",\"attempts\":1,\"date_expire\":\"2020-01-10T00:10:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
 			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
 			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 			Value:      "424242",
 			Active:     true,
+			Type:       kind.UserConfirmationTypeAuth,
+			Attempts:   1,
+			DateExpire: time.Date(2020, time.January, 10, 0, 10, 0, 0, time.UTC),
 		},
 		{
 			name:       "Test case with active false and other UserConfirmation properties",
-			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"value\":\"424242\",\"active\":false}\n",
+			json:       "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"value\":\"424242\",\"active\":false,\"type\":\"password_reset\",\"attempts\":5,\"date_expire\":\"2020-01-10T00:10:00Z\"}\n",
 			Id:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 			DateInsert: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
 			DateUpdate: time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
 			UserId:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 			Value:      "424242",
 			Active:     false,
+			Type:       kind.UserConfirmationTypePasswordReset,
+			Attempts:   5,
+			DateExpire: time.Date(2020, time.January, 10, 0, 10, 0, 0, time.UTC),
 		},
 	}
 
@@ -509,6 +538,9 @@ func TestUserConfirmation(t *testing.T) {
 					UserId:     testCase.UserId,
 					Value:      testCase.Value,
 					Active:     testCase.Active,
+					Type:       testCase.Type,
+					Attempts:   testCase.Attempts,
+					DateExpire: testCase.DateExpire,
 				}
 				assert.Equal(t, testCase.Id, userConfirmation.Id)
 				assert.Equal(t, testCase.DateInsert, userConfirmation.DateInsert)
@@ -516,6 +548,9 @@ func TestUserConfirmation(t *testing.T) {
 				assert.Equal(t, testCase.UserId, userConfirmation.UserId)
 				assert.Equal(t, testCase.Value, userConfirmation.Value)
 				assert.Equal(t, testCase.Active, userConfirmation.Active)
+				assert.Equal(t, testCase.Type, userConfirmation.Type)
+				assert.Equal(t, testCase.Attempts, userConfirmation.Attempts)
+				assert.Equal(t, testCase.DateExpire, userConfirmation.DateExpire)
 
 				reflectUser := reflect.ValueOf(userConfirmation)
 
@@ -538,3 +573,162 @@ func TestUserConfirmation(t *testing.T) {
 		)
 	}
 }
+
+func TestUserSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		json           string
+		Id             uuid.UUID
+		UserId         uuid.UUID
+		RefreshTokenId uuid.UUID
+		Status         kind.UserSessionStatus
+		DateInsert     time.Time
+		DateUpdate     time.Time
+		DateExpire     time.Time
+	}{
+		{
+			name:           "Test case with active status and other UserSession properties",
+			json:           "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"refresh_token_id\":\"00000000-0000-0000-0000-000000000003\",\"status\":\"active\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"date_expire\":\"2020-01-17T00:00:00Z\"}\n",
+			Id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			RefreshTokenId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Status:         kind.UserSessionStatusActive,
+			DateInsert:     time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:     time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			DateExpire:     time.Date(2020, time.January, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Test case with revoked status and other UserSession properties",
+			json:           "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"refresh_token_id\":\"00000000-0000-0000-0000-000000000003\",\"status\":\"revoked\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\",\"date_expire\":\"2020-01-17T00:00:00Z\"}\n",
+			Id:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:         uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			RefreshTokenId: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Status:         kind.UserSessionStatusRevoked,
+			DateInsert:     time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:     time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+			DateExpire:     time.Date(2020, time.January, 17, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				userSession := UserSession{
+					Id:             testCase.Id,
+					UserId:         testCase.UserId,
+					RefreshTokenId: testCase.RefreshTokenId,
+					Status:         testCase.Status,
+					DateInsert:     testCase.DateInsert,
+					DateUpdate:     testCase.DateUpdate,
+					DateExpire:     testCase.DateExpire,
+				}
+				assert.Equal(t, testCase.Id, userSession.Id)
+				assert.Equal(t, testCase.UserId, userSession.UserId)
+				assert.Equal(t, testCase.RefreshTokenId, userSession.RefreshTokenId)
+				assert.Equal(t, testCase.Status, userSession.Status)
+				assert.Equal(t, testCase.DateInsert, userSession.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, userSession.DateUpdate)
+				assert.Equal(t, testCase.DateExpire, userSession.DateExpire)
+
+				reflectUserSession := reflect.ValueOf(userSession)
+
+				for i := 0; i < reflectUserSession.NumField(); i++ {
+					assert.False(t, reflectUserSession.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(userSession)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
+
+func TestUserTotp(t *testing.T) {
+	tests := []struct {
+		name          string
+		json          string
+		Id            uuid.UUID
+		UserId        uuid.UUID
+		Secret        string
+		RecoveryCodes []string
+		Counter       int64
+		Status        kind.UserTotpStatus
+		DateInsert    time.Time
+		DateUpdate    time.Time
+	}{
+		{
+			name:          "Test case with pending status and other UserTotp properties",
+			json:          "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"counter\":1,\"status\":\"pending\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\"}\n",
+			Id:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Secret:        "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+			RecoveryCodes: []string{"recovery_code"},
+			Counter:       1,
+			Status:        kind.UserTotpStatusPending,
+			DateInsert:    time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:    time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Test case with enabled status and other UserTotp properties",
+			json:          "{\"id\":\"00000000-0000-0000-0000-000000000001\",\"user_id\":\"00000000-0000-0000-0000-000000000002\",\"counter\":1,\"status\":\"enabled\",\"date_insert\":\"2020-01-01T00:00:00Z\",\"date_update\":\"2020-01-10T00:00:00Z\"}\n",
+			Id:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			UserId:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Secret:        "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+			RecoveryCodes: []string{"recovery_code"},
+			Counter:       1,
+			Status:        kind.UserTotpStatusEnabled,
+			DateInsert:    time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			DateUpdate:    time.Date(2020, time.January, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				userTotp := UserTotp{
+					Id:            testCase.Id,
+					UserId:        testCase.UserId,
+					Secret:        testCase.Secret,
+					RecoveryCodes: testCase.RecoveryCodes,
+					Counter:       testCase.Counter,
+					Status:        testCase.Status,
+					DateInsert:    testCase.DateInsert,
+					DateUpdate:    testCase.DateUpdate,
+				}
+				assert.Equal(t, testCase.Id, userTotp.Id)
+				assert.Equal(t, testCase.UserId, userTotp.UserId)
+				assert.Equal(t, testCase.Secret, userTotp.Secret)
+				assert.Equal(t, testCase.RecoveryCodes, userTotp.RecoveryCodes)
+				assert.Equal(t, testCase.Counter, userTotp.Counter)
+				assert.Equal(t, testCase.Status, userTotp.Status)
+				assert.Equal(t, testCase.DateInsert, userTotp.DateInsert)
+				assert.Equal(t, testCase.DateUpdate, userTotp.DateUpdate)
+
+				reflectUserTotp := reflect.ValueOf(userTotp)
+
+				for i := 0; i < reflectUserTotp.NumField(); i++ {
+					assert.False(t, reflectUserTotp.Field(i).IsZero())
+				}
+
+				var actual strings.Builder
+				enc := json.NewEncoder(&actual)
+				enc.SetIndent(">", ".")
+				enc.SetIndent("", "")
+
+				errorEncode := enc.Encode(userTotp)
+
+				assert.Nil(t, errorEncode)
+				assert.Equal(t, testCase.json, actual.String())
+			},
+		)
+	}
+}
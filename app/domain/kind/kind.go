@@ -1,47 +1,135 @@
 package kind
 
 const (
-	UserActive                                               = true
-	UserInActive                                             = false
-	UserConfirmationActive                                   = true
-	UserConfirmationInActive                                 = false
-	UserStatusRegister                UserStatus             = "register"
-	UserStatusNeedConfirmation        UserStatus             = "need_confirmation"
-	UserStatusDisabled                UserStatus             = "disabled"
-	UserRoleAdmin                     UserRole               = "admin"
-	UserRoleCommon                    UserRole               = "common"
-	UserRoleStatusActive              UserRoleStatus         = "active"
-	UserRoleStatusInActive            UserRoleStatus         = "inactive"
-	UserToRoleStatusActive            UserToRoleStatus       = "active"
-	UserToRoleStatusInActive          UserToRoleStatus       = "inactive"
-	UserRightRead                     UserRight              = "read"
-	UserRightWrite                    UserRight              = "write"
-	RecipeStatusPublished             RecipeStatus           = "published"
-	RecipeStatusUnPublished           RecipeStatus           = "unpublished"
-	RecipeCategoryStatusPublished     RecipeCategoryStatus   = "published"
-	RecipeCategoryStatusUnPublished   RecipeCategoryStatus   = "unpublished"
-	RecipeProcessStatusPublished      RecipeProcessStatus    = "published"
-	RecipeProcessStatusUnPublished    RecipeProcessStatus    = "unpublished"
-	RecipeIngredientStatusPublished   RecipeIngredientStatus = "published"
-	RecipeIngredientStatusUnPublished RecipeIngredientStatus = "unpublished"
-	RecipeMeasureStatusPublished      RecipeMeasureStatus    = "published"
-	RecipeMeasureStatusUnPublished    RecipeMeasureStatus    = "unpublished"
-	AltNameStatusPublished            AltNameStatus          = "published"
-	AltNameStatusUnPublished          AltNameStatus          = "unpublished"
-	UnitStatusPublished               UnitStatus             = "published"
-	UnitStatusUnPublished             UnitStatus             = "unpublished"
-	CategoryStatusPublished           CategoryStatus         = "published"
-	CategoryStatusUnPublished         CategoryStatus         = "unpublished"
-	IngredientStatusPublished         IngredientStatus       = "published"
-	IngredientStatusUnPublished       IngredientStatus       = "unpublished"
-	PictureStatusPublished            PictureStatus          = "published"
-	PictureStatusUnPublished          PictureStatus          = "unpublished"
-	PlannerStatusActive               PlannerStatus          = "active"
-	PlannerStatusInActive             PlannerStatus          = "inactive"
-	PlannerIntervalStatusActive       PlannerIntervalStatus  = "active"
-	PlannerIntervalStatusInActive     PlannerIntervalStatus  = "inactive"
-	PlannerRecipeStatusActive         PlannerRecipeStatus    = "active"
-	PlannerRecipeStatusInActive       PlannerRecipeStatus    = "inactive"
+	UserActive                                                          = true
+	UserInActive                                                        = false
+	UserConfirmationActive                                              = true
+	UserConfirmationInActive                                            = false
+	UserStatusRegister                    UserStatus                    = "register"
+	UserStatusNeedConfirmation            UserStatus                    = "need_confirmation"
+	UserStatusDisabled                    UserStatus                    = "disabled"
+	UserRoleAdmin                         UserRole                      = "admin"
+	UserRoleCommon                        UserRole                      = "common"
+	UserRoleStatusActive                  UserRoleStatus                = "active"
+	UserRoleStatusInActive                UserRoleStatus                = "inactive"
+	UserToRoleStatusActive                UserToRoleStatus              = "active"
+	UserToRoleStatusInActive              UserToRoleStatus              = "inactive"
+	UserRightRead                         UserRight                     = "read"
+	UserRightWrite                        UserRight                     = "write"
+	UserResourceUser                      UserResource                  = "user"
+	UserResourceRole                      UserResource                  = "role"
+	UserResourceMerge                     UserResource                  = "merge"
+	UserResourceRecipe                    UserResource                  = "recipe"
+	UserResourcePlanner                   UserResource                  = "planner"
+	UserResourcePantry                    UserResource                  = "pantry"
+	UserResourceShoppingList              UserResource                  = "shopping_list"
+	UserResourceHousehold                 UserResource                  = "household"
+	UserResourceAccount                   UserResource                  = "account"
+	RecipeStatusPublished                 RecipeStatus                  = "published"
+	RecipeStatusUnPublished               RecipeStatus                  = "unpublished"
+	RecipeCategoryStatusPublished         RecipeCategoryStatus          = "published"
+	RecipeCategoryStatusUnPublished       RecipeCategoryStatus          = "unpublished"
+	RecipeProcessStatusPublished          RecipeProcessStatus           = "published"
+	RecipeProcessStatusUnPublished        RecipeProcessStatus           = "unpublished"
+	RecipeIngredientStatusPublished       RecipeIngredientStatus        = "published"
+	RecipeIngredientStatusUnPublished     RecipeIngredientStatus        = "unpublished"
+	RecipeMeasureStatusPublished          RecipeMeasureStatus           = "published"
+	RecipeMeasureStatusUnPublished        RecipeMeasureStatus           = "unpublished"
+	AltNameStatusPublished                AltNameStatus                 = "published"
+	AltNameStatusUnPublished              AltNameStatus                 = "unpublished"
+	UnitStatusPublished                   UnitStatus                    = "published"
+	UnitStatusUnPublished                 UnitStatus                    = "unpublished"
+	CategoryStatusPublished               CategoryStatus                = "published"
+	CategoryStatusUnPublished             CategoryStatus                = "unpublished"
+	IngredientStatusPublished             IngredientStatus              = "published"
+	IngredientStatusUnPublished           IngredientStatus              = "unpublished"
+	PictureStatusPublished                PictureStatus                 = "published"
+	PictureStatusUnPublished              PictureStatus                 = "unpublished"
+	PlannerStatusActive                   PlannerStatus                 = "active"
+	PlannerStatusInActive                 PlannerStatus                 = "inactive"
+	PlannerIntervalStatusActive           PlannerIntervalStatus         = "active"
+	PlannerIntervalStatusInActive         PlannerIntervalStatus         = "inactive"
+	PlannerRecipeStatusActive             PlannerRecipeStatus           = "active"
+	PlannerRecipeStatusInActive           PlannerRecipeStatus           = "inactive"
+	PlannerRecipeStatusCooked             PlannerRecipeStatus           = "cooked"
+	PantryItemStatusActive                PantryItemStatus              = "active"
+	PantryItemStatusInActive              PantryItemStatus              = "inactive"
+	ShoppingListStatusActive              ShoppingListStatus            = "active"
+	ShoppingListStatusCompleted           ShoppingListStatus            = "completed"
+	ShoppingListStatusArchived            ShoppingListStatus            = "archived"
+	ShoppingListItemStatusUnChecked       ShoppingListItemStatus        = "unchecked"
+	ShoppingListItemStatusChecked         ShoppingListItemStatus        = "checked"
+	IngredientPriceStatusActive           IngredientPriceStatus         = "active"
+	IngredientPriceStatusInActive         IngredientPriceStatus         = "inactive"
+	PlannerTemplateStatusActive           PlannerTemplateStatus         = "active"
+	PlannerTemplateStatusInActive         PlannerTemplateStatus         = "inactive"
+	PlannerTemplateIntervalStatusActive   PlannerTemplateIntervalStatus = "active"
+	PlannerTemplateIntervalStatusInActive PlannerTemplateIntervalStatus = "inactive"
+	PlannerTemplateRecurrenceNone         PlannerTemplateRecurrence     = "none"
+	PlannerTemplateRecurrenceWeekly       PlannerTemplateRecurrence     = "weekly"
+	PlannerTemplateRecurrenceFortnightly  PlannerTemplateRecurrence     = "fortnightly"
+	RecipeExportFormatJSONLD              RecipeExportFormat            = "jsonld"
+	RecipeExportFormatMarkdown            RecipeExportFormat            = "markdown"
+	CatalogueEntityIngredients            CatalogueEntity               = "ingredients"
+	CatalogueEntityUnits                  CatalogueEntity               = "units"
+	CatalogueEntityCategories             CatalogueEntity               = "categories"
+	CatalogueEntityRecipes                CatalogueEntity               = "recipes"
+	CatalogueFormatCSV                    CatalogueFormat               = "csv"
+	CatalogueFormatNDJSON                 CatalogueFormat               = "ndjson"
+	CatalogueImportActionCreate           CatalogueImportAction         = "create"
+	CatalogueImportActionUpdate           CatalogueImportAction         = "update"
+	CatalogueImportActionUnchanged        CatalogueImportAction         = "unchanged"
+	CatalogueImportActionError            CatalogueImportAction         = "error"
+	SearchFieldName                       SearchField                   = "name"
+	SearchFieldDescription                SearchField                   = "description"
+	SearchFieldNotes                      SearchField                   = "notes"
+	SearchFieldProcesses                  SearchField                   = "processes"
+	SearchFieldIngredients                SearchField                   = "ingredients"
+	SearchFieldAltNames                   SearchField                   = "alt_names"
+	FuzzyReasonName                       FuzzyReason                   = "name"
+	FuzzyReasonAltName                    FuzzyReason                   = "alt_name"
+	FuzzyReasonDistance                   FuzzyReason                   = "distance"
+	IngredientSubstituteStatusActive      IngredientSubstituteStatus    = "active"
+	IngredientSubstituteStatusInActive    IngredientSubstituteStatus    = "inactive"
+	SubstituteContextBaking               SubstituteContext             = "baking"
+	SubstituteContextCooking              SubstituteContext             = "cooking"
+	SubstituteContextVegan                SubstituteContext             = "vegan"
+	SubstituteContextVegetarian           SubstituteContext             = "vegetarian"
+	SubstituteContextGlutenFree           SubstituteContext             = "gluten_free"
+	SubstituteContextDairyFree            SubstituteContext             = "dairy_free"
+	SubstituteReasonManual                SubstituteReason              = "manual"
+	SubstituteReasonContext               SubstituteReason              = "context"
+	SubstituteReasonPantry                SubstituteReason              = "pantry"
+	RecipeVersionSubjectRecipe            RecipeVersionSubject          = "recipe"
+	RecipeVersionSubjectCategory          RecipeVersionSubject          = "recipe_category"
+	RecipeVersionSubjectIngredient        RecipeVersionSubject          = "recipe_ingredient"
+	RecipeVersionSubjectMeasure           RecipeVersionSubject          = "recipe_measure"
+	RecipeVersionSubjectProcess           RecipeVersionSubject          = "recipe_process"
+	RecipeVersionActionCreate             RecipeVersionAction           = "create"
+	RecipeVersionActionUpdate             RecipeVersionAction           = "update"
+	RecipeVersionActionDelete             RecipeVersionAction           = "delete"
+	RecipeVersionActionRevert             RecipeVersionAction           = "revert"
+	RecipeChangeAdded                     RecipeChange                  = "added"
+	RecipeChangeRemoved                   RecipeChange                  = "removed"
+	RecipeChangeChanged                   RecipeChange                  = "changed"
+	HouseholdStatusActive                 HouseholdStatus               = "active"
+	HouseholdStatusInActive               HouseholdStatus               = "inactive"
+	HouseholdMemberStatusInvited          HouseholdMemberStatus         = "invited"
+	HouseholdMemberStatusActive           HouseholdMemberStatus         = "active"
+	HouseholdMemberStatusRevoked          HouseholdMemberStatus         = "revoked"
+	HouseholdSubjectPlanner               HouseholdSubject              = "planner"
+	HouseholdSubjectRecipe                HouseholdSubject              = "recipe"
+	HouseholdSubjectShoppingList          HouseholdSubject              = "shopping_list"
+	HouseholdSubjectPantryItem            HouseholdSubject              = "pantry_item"
+	HouseholdSubjectPlannerTemplate       HouseholdSubject              = "planner_template"
+	UserSessionStatusActive               UserSessionStatus             = "active"
+	UserSessionStatusRevoked              UserSessionStatus             = "revoked"
+	TokenTypeAccess                       TokenType                     = "access"
+	TokenTypeRefresh                      TokenType                     = "refresh"
+	UserConfirmationTypeAuth              UserConfirmationType          = "auth"
+	UserConfirmationTypePasswordReset     UserConfirmationType          = "password_reset"
+	UserTotpStatusPending                 UserTotpStatus                = "pending"
+	UserTotpStatusEnabled                 UserTotpStatus                = "enabled"
 )
 
 type UserStatus string
@@ -112,6 +200,33 @@ func (ur UserRight) String() string {
 	return "unknown"
 }
 
+type UserResource string
+
+func (ur UserResource) String() string {
+	switch ur {
+	case UserResourceUser:
+		return "user"
+	case UserResourceRole:
+		return "role"
+	case UserResourceMerge:
+		return "merge"
+	case UserResourceRecipe:
+		return "recipe"
+	case UserResourcePlanner:
+		return "planner"
+	case UserResourcePantry:
+		return "pantry"
+	case UserResourceShoppingList:
+		return "shopping_list"
+	case UserResourceHousehold:
+		return "household"
+	case UserResourceAccount:
+		return "account"
+	default:
+		return ""
+	}
+}
+
 type RecipeStatus string
 
 func (rs RecipeStatus) String() string {
@@ -276,7 +391,400 @@ func (prs PlannerRecipeStatus) String() string {
 		return "active"
 	case PlannerRecipeStatusInActive:
 		return "inactive"
+	case PlannerRecipeStatusCooked:
+		return "cooked"
+	default:
+		return "inactive"
+	}
+}
+
+type PantryItemStatus string
+
+func (pis PantryItemStatus) String() string {
+	switch pis {
+	case PantryItemStatusActive:
+		return "active"
+	case PantryItemStatusInActive:
+		return "inactive"
+	default:
+		return "inactive"
+	}
+}
+
+type ShoppingListStatus string
+
+func (sls ShoppingListStatus) String() string {
+	switch sls {
+	case ShoppingListStatusActive:
+		return "active"
+	case ShoppingListStatusCompleted:
+		return "completed"
+	case ShoppingListStatusArchived:
+		return "archived"
+	default:
+		return "active"
+	}
+}
+
+type ShoppingListItemStatus string
+
+func (slis ShoppingListItemStatus) String() string {
+	switch slis {
+	case ShoppingListItemStatusUnChecked:
+		return "unchecked"
+	case ShoppingListItemStatusChecked:
+		return "checked"
+	default:
+		return "unchecked"
+	}
+}
+
+type IngredientPriceStatus string
+
+func (ips IngredientPriceStatus) String() string {
+	switch ips {
+	case IngredientPriceStatusActive:
+		return "active"
+	case IngredientPriceStatusInActive:
+		return "inactive"
+	default:
+		return "inactive"
+	}
+}
+
+type PlannerTemplateStatus string
+
+func (pts PlannerTemplateStatus) String() string {
+	switch pts {
+	case PlannerTemplateStatusActive:
+		return "active"
+	case PlannerTemplateStatusInActive:
+		return "inactive"
+	default:
+		return "inactive"
+	}
+}
+
+type PlannerTemplateIntervalStatus string
+
+func (ptis PlannerTemplateIntervalStatus) String() string {
+	switch ptis {
+	case PlannerTemplateIntervalStatusActive:
+		return "active"
+	case PlannerTemplateIntervalStatusInActive:
+		return "inactive"
 	default:
 		return "inactive"
 	}
 }
+
+type PlannerTemplateRecurrence string
+
+func (ptr PlannerTemplateRecurrence) String() string {
+	switch ptr {
+	case PlannerTemplateRecurrenceWeekly:
+		return "weekly"
+	case PlannerTemplateRecurrenceFortnightly:
+		return "fortnightly"
+	default:
+		return "none"
+	}
+}
+
+// Days returns the number of days between two planners made by the recurrence, zero means there is no recurrence.
+func (ptr PlannerTemplateRecurrence) Days() int {
+	switch ptr {
+	case PlannerTemplateRecurrenceWeekly:
+		return 7
+	case PlannerTemplateRecurrenceFortnightly:
+		return 14
+	default:
+		return 0
+	}
+}
+
+type RecipeExportFormat string
+
+func (ref RecipeExportFormat) String() string {
+	switch ref {
+	case RecipeExportFormatMarkdown:
+		return "markdown"
+	default:
+		return "jsonld"
+	}
+}
+
+type CatalogueEntity string
+
+func (ce CatalogueEntity) String() string {
+	switch ce {
+	case CatalogueEntityIngredients:
+		return "ingredients"
+	case CatalogueEntityUnits:
+		return "units"
+	case CatalogueEntityCategories:
+		return "categories"
+	case CatalogueEntityRecipes:
+		return "recipes"
+	default:
+		return ""
+	}
+}
+
+type CatalogueFormat string
+
+func (cf CatalogueFormat) String() string {
+	switch cf {
+	case CatalogueFormatNDJSON:
+		return "ndjson"
+	default:
+		return "csv"
+	}
+}
+
+// ContentType returns the media type of the files which are made in the format.
+func (cf CatalogueFormat) ContentType() string {
+	switch cf {
+	case CatalogueFormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "text/csv"
+	}
+}
+
+type CatalogueImportAction string
+
+type SearchField string
+
+func (sf SearchField) String() string {
+	switch sf {
+	case SearchFieldName:
+		return "name"
+	case SearchFieldDescription:
+		return "description"
+	case SearchFieldNotes:
+		return "notes"
+	case SearchFieldProcesses:
+		return "processes"
+	case SearchFieldIngredients:
+		return "ingredients"
+	case SearchFieldAltNames:
+		return "alt_names"
+	default:
+		return ""
+	}
+}
+
+type FuzzyReason string
+
+func (fr FuzzyReason) String() string {
+	switch fr {
+	case FuzzyReasonName:
+		return "name"
+	case FuzzyReasonAltName:
+		return "alt_name"
+	case FuzzyReasonDistance:
+		return "distance"
+	default:
+		return ""
+	}
+}
+
+type IngredientSubstituteStatus string
+
+func (iss IngredientSubstituteStatus) String() string {
+	switch iss {
+	case IngredientSubstituteStatusActive:
+		return "active"
+	case IngredientSubstituteStatusInActive:
+		return "inactive"
+	default:
+		return "inactive"
+	}
+}
+
+type SubstituteContext string
+
+func (sc SubstituteContext) String() string {
+	switch sc {
+	case SubstituteContextBaking:
+		return "baking"
+	case SubstituteContextCooking:
+		return "cooking"
+	case SubstituteContextVegan:
+		return "vegan"
+	case SubstituteContextVegetarian:
+		return "vegetarian"
+	case SubstituteContextGlutenFree:
+		return "gluten_free"
+	case SubstituteContextDairyFree:
+		return "dairy_free"
+	default:
+		return ""
+	}
+}
+
+type SubstituteReason string
+
+func (sr SubstituteReason) String() string {
+	switch sr {
+	case SubstituteReasonManual:
+		return "manual"
+	case SubstituteReasonContext:
+		return "context"
+	case SubstituteReasonPantry:
+		return "pantry"
+	default:
+		return ""
+	}
+}
+
+type RecipeVersionSubject string
+
+func (rvs RecipeVersionSubject) String() string {
+	switch rvs {
+	case RecipeVersionSubjectRecipe:
+		return "recipe"
+	case RecipeVersionSubjectCategory:
+		return "recipe_category"
+	case RecipeVersionSubjectIngredient:
+		return "recipe_ingredient"
+	case RecipeVersionSubjectMeasure:
+		return "recipe_measure"
+	case RecipeVersionSubjectProcess:
+		return "recipe_process"
+	default:
+		return ""
+	}
+}
+
+type RecipeVersionAction string
+
+func (rva RecipeVersionAction) String() string {
+	switch rva {
+	case RecipeVersionActionCreate:
+		return "create"
+	case RecipeVersionActionUpdate:
+		return "update"
+	case RecipeVersionActionDelete:
+		return "delete"
+	case RecipeVersionActionRevert:
+		return "revert"
+	default:
+		return ""
+	}
+}
+
+type RecipeChange string
+
+func (rc RecipeChange) String() string {
+	switch rc {
+	case RecipeChangeAdded:
+		return "added"
+	case RecipeChangeRemoved:
+		return "removed"
+	case RecipeChangeChanged:
+		return "changed"
+	default:
+		return ""
+	}
+}
+
+type HouseholdStatus string
+
+func (hs HouseholdStatus) String() string {
+	switch hs {
+	case HouseholdStatusActive:
+		return "active"
+	case HouseholdStatusInActive:
+		return "inactive"
+	default:
+		return ""
+	}
+}
+
+type HouseholdMemberStatus string
+
+func (hms HouseholdMemberStatus) String() string {
+	switch hms {
+	case HouseholdMemberStatusInvited:
+		return "invited"
+	case HouseholdMemberStatusActive:
+		return "active"
+	case HouseholdMemberStatusRevoked:
+		return "revoked"
+	default:
+		return ""
+	}
+}
+
+type HouseholdSubject string
+
+func (hs HouseholdSubject) String() string {
+	switch hs {
+	case HouseholdSubjectPlanner:
+		return "planner"
+	case HouseholdSubjectRecipe:
+		return "recipe"
+	case HouseholdSubjectShoppingList:
+		return "shopping_list"
+	case HouseholdSubjectPantryItem:
+		return "pantry_item"
+	case HouseholdSubjectPlannerTemplate:
+		return "planner_template"
+	default:
+		return ""
+	}
+}
+
+type UserSessionStatus string
+
+func (uss UserSessionStatus) String() string {
+	switch uss {
+	case UserSessionStatusActive:
+		return "active"
+	case UserSessionStatusRevoked:
+		return "revoked"
+	default:
+		return ""
+	}
+}
+
+type TokenType string
+
+func (tt TokenType) String() string {
+	switch tt {
+	case TokenTypeAccess:
+		return "access"
+	case TokenTypeRefresh:
+		return "refresh"
+	default:
+		return ""
+	}
+}
+
+type UserConfirmationType string
+
+func (uct UserConfirmationType) String() string {
+	switch uct {
+	case UserConfirmationTypeAuth:
+		return "auth"
+	case UserConfirmationTypePasswordReset:
+		return "password_reset"
+	default:
+		return ""
+	}
+}
+
+type UserTotpStatus string
+
+func (uts UserTotpStatus) String() string {
+	switch uts {
+	case UserTotpStatusPending:
+		return "pending"
+	case UserTotpStatusEnabled:
+		return "enabled"
+	default:
+		return ""
+	}
+}
@@ -187,6 +187,74 @@ func TestUserRight(t *testing.T) {
 	}
 }
 
+func TestUserResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource UserResource
+		expected string
+	}{
+		{
+			name:     "Test case with user resource is user",
+			resource: UserResourceUser,
+			expected: "user",
+		},
+		{
+			name:     "Test case with user resource is role",
+			resource: UserResourceRole,
+			expected: "role",
+		},
+		{
+			name:     "Test case with user resource is merge",
+			resource: UserResourceMerge,
+			expected: "merge",
+		},
+		{
+			name:     "Test case with user resource is recipe",
+			resource: UserResourceRecipe,
+			expected: "recipe",
+		},
+		{
+			name:     "Test case with user resource is planner",
+			resource: UserResourcePlanner,
+			expected: "planner",
+		},
+		{
+			name:     "Test case with user resource is pantry",
+			resource: UserResourcePantry,
+			expected: "pantry",
+		},
+		{
+			name:     "Test case with user resource is shopping list",
+			resource: UserResourceShoppingList,
+			expected: "shopping_list",
+		},
+		{
+			name:     "Test case with user resource is household",
+			resource: UserResourceHousehold,
+			expected: "household",
+		},
+		{
+			name:     "Test case with user resource is account",
+			resource: UserResourceAccount,
+			expected: "account",
+		},
+		{
+			name:     "Test case with user resource is empty",
+			resource: "",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.resource.String())
+			},
+		)
+	}
+}
+
 func TestRecipeStatus(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -632,6 +700,11 @@ func TestPlannerRecipeStatus(t *testing.T) {
 			status:   PlannerRecipeStatusInActive,
 			expected: "inactive",
 		},
+		{
+			name:     "Test case with planner recipe status is cooked",
+			status:   PlannerRecipeStatusCooked,
+			expected: "cooked",
+		},
 		{
 			name:     "Test case with planner recipe status is empty",
 			status:   "",
@@ -648,3 +721,959 @@ func TestPlannerRecipeStatus(t *testing.T) {
 		)
 	}
 }
+
+func TestPantryItemStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   PantryItemStatus
+		expected string
+	}{
+		{
+			name:     "Test case with pantry item status is active",
+			status:   PantryItemStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with pantry item status is inactive",
+			status:   PantryItemStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with pantry item status is empty",
+			status:   "",
+			expected: "inactive",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestShoppingListStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   ShoppingListStatus
+		expected string
+	}{
+		{
+			name:     "Test case with shopping list status is active",
+			status:   ShoppingListStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with shopping list status is completed",
+			status:   ShoppingListStatusCompleted,
+			expected: "completed",
+		},
+		{
+			name:     "Test case with shopping list status is archived",
+			status:   ShoppingListStatusArchived,
+			expected: "archived",
+		},
+		{
+			name:     "Test case with shopping list status is empty",
+			status:   "",
+			expected: "active",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestShoppingListItemStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   ShoppingListItemStatus
+		expected string
+	}{
+		{
+			name:     "Test case with shopping list item status is unchecked",
+			status:   ShoppingListItemStatusUnChecked,
+			expected: "unchecked",
+		},
+		{
+			name:     "Test case with shopping list item status is checked",
+			status:   ShoppingListItemStatusChecked,
+			expected: "checked",
+		},
+		{
+			name:     "Test case with shopping list item status is empty",
+			status:   "",
+			expected: "unchecked",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestIngredientPriceStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   IngredientPriceStatus
+		expected string
+	}{
+		{
+			name:     "Test case with ingredient price status is active",
+			status:   IngredientPriceStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with ingredient price status is inactive",
+			status:   IngredientPriceStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with ingredient price status is empty",
+			status:   "",
+			expected: "inactive",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestPlannerTemplateStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   PlannerTemplateStatus
+		expected string
+	}{
+		{
+			name:     "Test case with planner template status is active",
+			status:   PlannerTemplateStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with planner template status is inactive",
+			status:   PlannerTemplateStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with planner template status is empty",
+			status:   "",
+			expected: "inactive",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestPlannerTemplateIntervalStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   PlannerTemplateIntervalStatus
+		expected string
+	}{
+		{
+			name:     "Test case with planner template interval status is active",
+			status:   PlannerTemplateIntervalStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with planner template interval status is inactive",
+			status:   PlannerTemplateIntervalStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with planner template interval status is empty",
+			status:   "",
+			expected: "inactive",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestPlannerTemplateRecurrence(t *testing.T) {
+	tests := []struct {
+		name       string
+		recurrence PlannerTemplateRecurrence
+		expected   string
+		days       int
+	}{
+		{
+			name:       "Test case with planner template recurrence is weekly",
+			recurrence: PlannerTemplateRecurrenceWeekly,
+			expected:   "weekly",
+			days:       7,
+		},
+		{
+			name:       "Test case with planner template recurrence is fortnightly",
+			recurrence: PlannerTemplateRecurrenceFortnightly,
+			expected:   "fortnightly",
+			days:       14,
+		},
+		{
+			name:       "Test case with planner template recurrence is none",
+			recurrence: PlannerTemplateRecurrenceNone,
+			expected:   "none",
+			days:       0,
+		},
+		{
+			name:       "Test case with planner template recurrence is empty",
+			recurrence: "",
+			expected:   "none",
+			days:       0,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.recurrence.String())
+				assert.Equal(t, testCase.days, testCase.recurrence.Days())
+			},
+		)
+	}
+}
+
+func TestRecipeExportFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   RecipeExportFormat
+		expected string
+	}{
+		{
+			name:     "Test case with recipe export format is jsonld",
+			format:   RecipeExportFormatJSONLD,
+			expected: "jsonld",
+		},
+		{
+			name:     "Test case with recipe export format is markdown",
+			format:   RecipeExportFormatMarkdown,
+			expected: "markdown",
+		},
+		{
+			name:     "Test case with recipe export format is empty",
+			format:   "",
+			expected: "jsonld",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.format.String())
+			},
+		)
+	}
+}
+
+func TestCatalogueEntity(t *testing.T) {
+	tests := []struct {
+		name     string
+		entity   CatalogueEntity
+		expected string
+	}{
+		{
+			name:     "Test case with catalogue entity is ingredients",
+			entity:   CatalogueEntityIngredients,
+			expected: "ingredients",
+		},
+		{
+			name:     "Test case with catalogue entity is units",
+			entity:   CatalogueEntityUnits,
+			expected: "units",
+		},
+		{
+			name:     "Test case with catalogue entity is categories",
+			entity:   CatalogueEntityCategories,
+			expected: "categories",
+		},
+		{
+			name:     "Test case with catalogue entity is recipes",
+			entity:   CatalogueEntityRecipes,
+			expected: "recipes",
+		},
+		{
+			name:     "Test case with catalogue entity is unknown",
+			entity:   "planners",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.entity.String())
+			},
+		)
+	}
+}
+
+func TestCatalogueFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      CatalogueFormat
+		expected    string
+		contentType string
+	}{
+		{
+			name:        "Test case with catalogue format is csv",
+			format:      CatalogueFormatCSV,
+			expected:    "csv",
+			contentType: "text/csv",
+		},
+		{
+			name:        "Test case with catalogue format is ndjson",
+			format:      CatalogueFormatNDJSON,
+			expected:    "ndjson",
+			contentType: "application/x-ndjson",
+		},
+		{
+			name:        "Test case with catalogue format is empty",
+			format:      "",
+			expected:    "csv",
+			contentType: "text/csv",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.format.String())
+				assert.Equal(t, testCase.contentType, testCase.format.ContentType())
+			},
+		)
+	}
+}
+
+func TestSearchField(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    SearchField
+		expected string
+	}{
+		{
+			name:     "Test case with search field is name",
+			field:    SearchFieldName,
+			expected: "name",
+		},
+		{
+			name:     "Test case with search field is description",
+			field:    SearchFieldDescription,
+			expected: "description",
+		},
+		{
+			name:     "Test case with search field is notes",
+			field:    SearchFieldNotes,
+			expected: "notes",
+		},
+		{
+			name:     "Test case with search field is processes",
+			field:    SearchFieldProcesses,
+			expected: "processes",
+		},
+		{
+			name:     "Test case with search field is ingredients",
+			field:    SearchFieldIngredients,
+			expected: "ingredients",
+		},
+		{
+			name:     "Test case with search field is alt names",
+			field:    SearchFieldAltNames,
+			expected: "alt_names",
+		},
+		{
+			name:     "Test case with search field is unknown",
+			field:    "servings",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.field.String())
+			},
+		)
+	}
+}
+
+func TestFuzzyReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		reason   FuzzyReason
+		expected string
+	}{
+		{
+			name:     "Test case with fuzzy reason is name",
+			reason:   FuzzyReasonName,
+			expected: "name",
+		},
+		{
+			name:     "Test case with fuzzy reason is alt name",
+			reason:   FuzzyReasonAltName,
+			expected: "alt_name",
+		},
+		{
+			name:     "Test case with fuzzy reason is distance",
+			reason:   FuzzyReasonDistance,
+			expected: "distance",
+		},
+		{
+			name:     "Test case with fuzzy reason is unknown",
+			reason:   "sound",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.reason.String())
+			},
+		)
+	}
+}
+
+func TestIngredientSubstituteStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   IngredientSubstituteStatus
+		expected string
+	}{
+		{
+			name:     "Test case with ingredient substitute status is active",
+			status:   IngredientSubstituteStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with ingredient substitute status is inactive",
+			status:   IngredientSubstituteStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with ingredient substitute status is empty",
+			status:   "",
+			expected: "inactive",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestSubstituteContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		context  SubstituteContext
+		expected string
+	}{
+		{
+			name:     "Test case with substitute context is baking",
+			context:  SubstituteContextBaking,
+			expected: "baking",
+		},
+		{
+			name:     "Test case with substitute context is cooking",
+			context:  SubstituteContextCooking,
+			expected: "cooking",
+		},
+		{
+			name:     "Test case with substitute context is vegan",
+			context:  SubstituteContextVegan,
+			expected: "vegan",
+		},
+		{
+			name:     "Test case with substitute context is vegetarian",
+			context:  SubstituteContextVegetarian,
+			expected: "vegetarian",
+		},
+		{
+			name:     "Test case with substitute context is gluten free",
+			context:  SubstituteContextGlutenFree,
+			expected: "gluten_free",
+		},
+		{
+			name:     "Test case with substitute context is dairy free",
+			context:  SubstituteContextDairyFree,
+			expected: "dairy_free",
+		},
+		{
+			name:     "Test case with substitute context is unknown",
+			context:  "frying",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.context.String())
+			},
+		)
+	}
+}
+
+func TestSubstituteReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		reason   SubstituteReason
+		expected string
+	}{
+		{
+			name:     "Test case with substitute reason is manual",
+			reason:   SubstituteReasonManual,
+			expected: "manual",
+		},
+		{
+			name:     "Test case with substitute reason is context",
+			reason:   SubstituteReasonContext,
+			expected: "context",
+		},
+		{
+			name:     "Test case with substitute reason is pantry",
+			reason:   SubstituteReasonPantry,
+			expected: "pantry",
+		},
+		{
+			name:     "Test case with substitute reason is unknown",
+			reason:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.reason.String())
+			},
+		)
+	}
+}
+
+func TestRecipeVersionSubject(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  RecipeVersionSubject
+		expected string
+	}{
+		{
+			name:     "Test case with recipe version subject is recipe",
+			subject:  RecipeVersionSubjectRecipe,
+			expected: "recipe",
+		},
+		{
+			name:     "Test case with recipe version subject is recipe_category",
+			subject:  RecipeVersionSubjectCategory,
+			expected: "recipe_category",
+		},
+		{
+			name:     "Test case with recipe version subject is recipe_ingredient",
+			subject:  RecipeVersionSubjectIngredient,
+			expected: "recipe_ingredient",
+		},
+		{
+			name:     "Test case with recipe version subject is recipe_measure",
+			subject:  RecipeVersionSubjectMeasure,
+			expected: "recipe_measure",
+		},
+		{
+			name:     "Test case with recipe version subject is recipe_process",
+			subject:  RecipeVersionSubjectProcess,
+			expected: "recipe_process",
+		},
+		{
+			name:     "Test case with recipe version subject is unknown",
+			subject:  "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.subject.String())
+			},
+		)
+	}
+}
+
+func TestRecipeVersionAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   RecipeVersionAction
+		expected string
+	}{
+		{
+			name:     "Test case with recipe version action is create",
+			action:   RecipeVersionActionCreate,
+			expected: "create",
+		},
+		{
+			name:     "Test case with recipe version action is update",
+			action:   RecipeVersionActionUpdate,
+			expected: "update",
+		},
+		{
+			name:     "Test case with recipe version action is delete",
+			action:   RecipeVersionActionDelete,
+			expected: "delete",
+		},
+		{
+			name:     "Test case with recipe version action is revert",
+			action:   RecipeVersionActionRevert,
+			expected: "revert",
+		},
+		{
+			name:     "Test case with recipe version action is unknown",
+			action:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.action.String())
+			},
+		)
+	}
+}
+
+func TestRecipeChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   RecipeChange
+		expected string
+	}{
+		{
+			name:     "Test case with recipe change is added",
+			change:   RecipeChangeAdded,
+			expected: "added",
+		},
+		{
+			name:     "Test case with recipe change is removed",
+			change:   RecipeChangeRemoved,
+			expected: "removed",
+		},
+		{
+			name:     "Test case with recipe change is changed",
+			change:   RecipeChangeChanged,
+			expected: "changed",
+		},
+		{
+			name:     "Test case with recipe change is unknown",
+			change:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.change.String())
+			},
+		)
+	}
+}
+
+func TestHouseholdStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   HouseholdStatus
+		expected string
+	}{
+		{
+			name:     "Test case with household status is active",
+			status:   HouseholdStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with household status is inactive",
+			status:   HouseholdStatusInActive,
+			expected: "inactive",
+		},
+		{
+			name:     "Test case with household status is unknown",
+			status:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestHouseholdMemberStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   HouseholdMemberStatus
+		expected string
+	}{
+		{
+			name:     "Test case with household member status is invited",
+			status:   HouseholdMemberStatusInvited,
+			expected: "invited",
+		},
+		{
+			name:     "Test case with household member status is active",
+			status:   HouseholdMemberStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with household member status is revoked",
+			status:   HouseholdMemberStatusRevoked,
+			expected: "revoked",
+		},
+		{
+			name:     "Test case with household member status is unknown",
+			status:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestHouseholdSubject(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  HouseholdSubject
+		expected string
+	}{
+		{
+			name:     "Test case with household subject is planner",
+			subject:  HouseholdSubjectPlanner,
+			expected: "planner",
+		},
+		{
+			name:     "Test case with household subject is recipe",
+			subject:  HouseholdSubjectRecipe,
+			expected: "recipe",
+		},
+		{
+			name:     "Test case with household subject is shopping list",
+			subject:  HouseholdSubjectShoppingList,
+			expected: "shopping_list",
+		},
+		{
+			name:     "Test case with household subject is pantry item",
+			subject:  HouseholdSubjectPantryItem,
+			expected: "pantry_item",
+		},
+		{
+			name:     "Test case with household subject is planner template",
+			subject:  HouseholdSubjectPlannerTemplate,
+			expected: "planner_template",
+		},
+		{
+			name:     "Test case with household subject is unknown",
+			subject:  "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.subject.String())
+			},
+		)
+	}
+}
+
+func TestUserSessionStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   UserSessionStatus
+		expected string
+	}{
+		{
+			name:     "Test case with user session status is active",
+			status:   UserSessionStatusActive,
+			expected: "active",
+		},
+		{
+			name:     "Test case with user session status is revoked",
+			status:   UserSessionStatusRevoked,
+			expected: "revoked",
+		},
+		{
+			name:     "Test case with user session status is unknown",
+			status:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
+
+func TestTokenType(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenType TokenType
+		expected  string
+	}{
+		{
+			name:      "Test case with token type is access",
+			tokenType: TokenTypeAccess,
+			expected:  "access",
+		},
+		{
+			name:      "Test case with token type is refresh",
+			tokenType: TokenTypeRefresh,
+			expected:  "refresh",
+		},
+		{
+			name:      "Test case with token type is unknown",
+			tokenType: "random",
+			expected:  "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.tokenType.String())
+			},
+		)
+	}
+}
+
+func TestUserConfirmationType(t *testing.T) {
+	tests := []struct {
+		name             string
+		confirmationType UserConfirmationType
+		expected         string
+	}{
+		{
+			name:             "Test case with user confirmation type is auth",
+			confirmationType: UserConfirmationTypeAuth,
+			expected:         "auth",
+		},
+		{
+			name:             "Test case with user confirmation type is password_reset",
+			confirmationType: UserConfirmationTypePasswordReset,
+			expected:         "password_reset",
+		},
+		{
+			name:             "Test case with user confirmation type is unknown",
+			confirmationType: "random",
+			expected:         "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.confirmationType.String())
+			},
+		)
+	}
+}
+
+func TestUserTotpStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   UserTotpStatus
+		expected string
+	}{
+		{
+			name:     "Test case with user totp status is pending",
+			status:   UserTotpStatusPending,
+			expected: "pending",
+		},
+		{
+			name:     "Test case with user totp status is enabled",
+			status:   UserTotpStatusEnabled,
+			expected: "enabled",
+		},
+		{
+			name:     "Test case with user totp status is unknown",
+			status:   "random",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.expected, testCase.status.String())
+			},
+		)
+	}
+}
@@ -18,6 +18,8 @@ type Token struct {
 	UserId    uuid.UUID      `json:"user_id"`
 	Username  string         `json:"username"`
 	UserRoles kind.UserRoles `json:"user_roles"`
+	SessionId uuid.UUID      `json:"session_id"`
+	Type      kind.TokenType `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
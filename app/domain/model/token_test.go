@@ -16,6 +16,8 @@ func TestToken(t *testing.T) {
 		UserId         uuid.UUID
 		Username       string
 		UserRoles      kind.UserRoles
+		SessionId      uuid.UUID
+		Type           kind.TokenType
 		UserRoleWanted kind.UserRole
 		MustBePassed   bool
 	}{
@@ -24,6 +26,8 @@ func TestToken(t *testing.T) {
 			UserId:         uuid.New(),
 			Username:       "Username",
 			UserRoles:      kind.UserRoles{kind.UserRoleAdmin},
+			SessionId:      uuid.New(),
+			Type:           kind.TokenTypeAccess,
 			UserRoleWanted: kind.UserRoleAdmin,
 			MustBePassed:   true,
 		},
@@ -32,6 +36,8 @@ func TestToken(t *testing.T) {
 			UserId:         uuid.New(),
 			Username:       "Username1",
 			UserRoles:      kind.UserRoles{kind.UserRoleCommon},
+			SessionId:      uuid.New(),
+			Type:           kind.TokenTypeRefresh,
 			UserRoleWanted: kind.UserRoleAdmin,
 			MustBePassed:   false,
 		},
@@ -45,6 +51,8 @@ func TestToken(t *testing.T) {
 					UserId:    testCase.UserId,
 					Username:  testCase.Username,
 					UserRoles: testCase.UserRoles,
+					SessionId: testCase.SessionId,
+					Type:      testCase.Type,
 					RegisteredClaims: jwt.RegisteredClaims{
 						Issuer:    testCase.name,
 						Subject:   "testing",
@@ -58,6 +66,8 @@ func TestToken(t *testing.T) {
 				assert.Equal(t, testCase.UserId, token.UserId)
 				assert.Equal(t, testCase.Username, token.Username)
 				assert.Equal(t, testCase.UserRoles, token.UserRoles)
+				assert.Equal(t, testCase.SessionId, token.SessionId)
+				assert.Equal(t, testCase.Type, token.Type)
 
 				ensureRoleExists, errorEnsureRoleExists := token.EnsureRoleExists(testCase.UserRoleWanted)
 
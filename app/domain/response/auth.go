@@ -9,3 +9,12 @@ type AuthConfirmation struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	Status  int    `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
 }
+
+type AuthTotpEnrol struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+type AuthTotpRecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
@@ -73,3 +73,67 @@ func TestAuthConfirmation(t *testing.T) {
 		)
 	}
 }
+
+func TestAuthTotpEnrol(t *testing.T) {
+	tests := []struct {
+		name   string
+		Secret string
+		Uri    string
+	}{
+		{
+			name:   "Test case with AuthTotpEnrol properties",
+			Secret: "Secret",
+			Uri:    "otpauth://totp/Issuer:username?secret=Secret",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authTotpEnrol := AuthTotpEnrol{
+					Secret: testCase.Secret,
+					Uri:    testCase.Uri,
+				}
+				assert.Equal(t, testCase.Secret, authTotpEnrol.Secret)
+				assert.Equal(t, testCase.Uri, authTotpEnrol.Uri)
+
+				reflectAuthTotpEnrol := reflect.ValueOf(authTotpEnrol)
+
+				for i := 0; i < reflectAuthTotpEnrol.NumField(); i++ {
+					assert.False(t, reflectAuthTotpEnrol.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
+
+func TestAuthTotpRecoveryCodes(t *testing.T) {
+	tests := []struct {
+		name          string
+		RecoveryCodes []string
+	}{
+		{
+			name:          "Test case with AuthTotpRecoveryCodes properties",
+			RecoveryCodes: []string{"RecoveryCode"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				authTotpRecoveryCodes := AuthTotpRecoveryCodes{
+					RecoveryCodes: testCase.RecoveryCodes,
+				}
+				assert.Equal(t, testCase.RecoveryCodes, authTotpRecoveryCodes.RecoveryCodes)
+
+				reflectAuthTotpRecoveryCodes := reflect.ValueOf(authTotpRecoveryCodes)
+
+				for i := 0; i < reflectAuthTotpRecoveryCodes.NumField(); i++ {
+					assert.False(t, reflectAuthTotpRecoveryCodes.Field(i).IsZero())
+				}
+			},
+		)
+	}
+}
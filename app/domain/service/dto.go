@@ -20,6 +20,41 @@ func CreateDTOFromAuthConfirmation(data io.Reader) (dto.AuthConfirmationDTO, err
 	return *authConfirmationDTO, errorDTO
 }
 
+func CreateDTOFromAuthPasswordReset(data io.Reader) (dto.AuthPasswordResetDTO, error) {
+	authPasswordResetDTO := &dto.AuthPasswordResetDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&authPasswordResetDTO)
+
+	return *authPasswordResetDTO, errorDTO
+}
+
+func CreateDTOFromAuthPasswordResetConfirmation(data io.Reader) (dto.AuthPasswordResetConfirmationDTO, error) {
+	authPasswordResetConfirmationDTO := &dto.AuthPasswordResetConfirmationDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&authPasswordResetConfirmationDTO)
+
+	return *authPasswordResetConfirmationDTO, errorDTO
+}
+
+func CreateDTOFromAuthPasswordChange(data io.Reader) (dto.AuthPasswordChangeDTO, error) {
+	authPasswordChangeDTO := &dto.AuthPasswordChangeDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&authPasswordChangeDTO)
+
+	return *authPasswordChangeDTO, errorDTO
+}
+
+func CreateDTOFromAuthTotp(data io.Reader) (dto.AuthTotpDTO, error) {
+	authTotpDTO := &dto.AuthTotpDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&authTotpDTO)
+
+	return *authTotpDTO, errorDTO
+}
+
+func CreateDTOFromAuthTotpDisable(data io.Reader) (dto.AuthTotpDisableDTO, error) {
+	authTotpDisableDTO := &dto.AuthTotpDisableDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&authTotpDisableDTO)
+
+	return *authTotpDisableDTO, errorDTO
+}
+
 func CreateDTOFromUserRegister(data io.Reader) (dto.UserRegisterDTO, error) {
 	userRegisterDTO := &dto.UserRegisterDTO{}
 	errorDTO := json.NewDecoder(data).Decode(&userRegisterDTO)
@@ -33,3 +68,10 @@ func CreateDTOFromUserUpdate(data io.Reader) (dto.UserDTO, error) {
 
 	return *userDTO, errorDTO
 }
+
+func CreateDTOFromMerge(data io.Reader) (dto.MergeDTO, error) {
+	mergeDTO := &dto.MergeDTO{}
+	errorDTO := json.NewDecoder(data).Decode(&mergeDTO)
+
+	return *mergeDTO, errorDTO
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"bytes"
+	"github.com/google/uuid"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -73,6 +74,151 @@ func TestCreateDTOFromAuthConfirmation(t *testing.T) {
 	}
 }
 
+func TestCreateDTOFromAuthPasswordReset(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.AuthPasswordResetDTO
+	}{
+		{
+			name:     "Test case for CreateDTOFromAuthPasswordReset",
+			JSON:     "{\"username\":\"username\"}",
+			Expected: dto.AuthPasswordResetDTO{Username: "username"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				authPasswordReset, errorCreateDTOFromAuthPasswordReset := CreateDTOFromAuthPasswordReset(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, authPasswordReset)
+				assert.Nil(t, errorCreateDTOFromAuthPasswordReset)
+			},
+		)
+	}
+}
+
+func TestCreateDTOFromAuthPasswordResetConfirmation(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.AuthPasswordResetConfirmationDTO
+	}{
+		{
+			name:     "Test case for CreateDTOFromAuthPasswordResetConfirmation",
+			JSON:     "{\"username\":\"username\",\"code\":\"123456\",\"password\":\"password\"}",
+			Expected: dto.AuthPasswordResetConfirmationDTO{Username: "username", Code: "123456", Password: "password"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				authPasswordResetConfirmation, errorCreateDTOFromAuthPasswordResetConfirmation := CreateDTOFromAuthPasswordResetConfirmation(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, authPasswordResetConfirmation)
+				assert.Nil(t, errorCreateDTOFromAuthPasswordResetConfirmation)
+			},
+		)
+	}
+}
+
+func TestCreateDTOFromAuthPasswordChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.AuthPasswordChangeDTO
+	}{
+		{
+			name:     "Test case for CreateDTOFromAuthPasswordChange",
+			JSON:     "{\"password\":\"password\",\"password_new\":\"password new\"}",
+			Expected: dto.AuthPasswordChangeDTO{Password: "password", PasswordNew: "password new"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				authPasswordChange, errorCreateDTOFromAuthPasswordChange := CreateDTOFromAuthPasswordChange(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, authPasswordChange)
+				assert.Nil(t, errorCreateDTOFromAuthPasswordChange)
+			},
+		)
+	}
+}
+
+func TestCreateDTOFromAuthTotp(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.AuthTotpDTO
+	}{
+		{
+			name:     "Test case for CreateDTOFromAuthTotp",
+			JSON:     "{\"code\":\"123456\"}",
+			Expected: dto.AuthTotpDTO{Code: "123456"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				authTotp, errorCreateDTOFromAuthTotp := CreateDTOFromAuthTotp(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, authTotp)
+				assert.Nil(t, errorCreateDTOFromAuthTotp)
+			},
+		)
+	}
+}
+
+func TestCreateDTOFromAuthTotpDisable(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.AuthTotpDisableDTO
+	}{
+		{
+			name:     "Test case for CreateDTOFromAuthTotpDisable",
+			JSON:     "{\"password\":\"password\",\"code\":\"123456\"}",
+			Expected: dto.AuthTotpDisableDTO{Password: "password", Code: "123456"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				authTotpDisable, errorCreateDTOFromAuthTotpDisable := CreateDTOFromAuthTotpDisable(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, authTotpDisable)
+				assert.Nil(t, errorCreateDTOFromAuthTotpDisable)
+			},
+		)
+	}
+}
+
 func TestCreateDTOFromUserRegister(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -150,3 +296,35 @@ func TestCreateDTOFromUserUpdate(t *testing.T) {
 		)
 	}
 }
+
+func TestCreateDTOFromMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected dto.MergeDTO
+	}{
+		{
+			name: "Test case for CreateDTOFromMerge",
+			JSON: "{\"survivor_id\":\"00000000-0000-0000-0000-000000000001\",\"duplicate_id\":\"00000000-0000-0000-0000-000000000002\"}",
+			Expected: dto.MergeDTO{
+				SurvivorId:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				DuplicateId: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				mergeDTO, errorCreateDTOFromMerge := CreateDTOFromMerge(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, mergeDTO)
+				assert.Nil(t, errorCreateDTOFromMerge)
+			},
+		)
+	}
+}
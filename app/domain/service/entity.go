@@ -2,6 +2,7 @@ package service
 
 import (
 	"encoding/json"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	"io"
 )
@@ -96,3 +97,108 @@ func CreateEntityFromPlannerRecipeUpdate(data io.Reader) (entity.PlannerRecipe,
 
 	return *recipePlannerRecipe, errorEntity
 }
+
+func CreateEntityFromPlannerTemplateUpdate(data io.Reader) (entity.PlannerTemplate, error) {
+	plannerTemplate := &entity.PlannerTemplate{}
+	errorEntity := json.NewDecoder(data).Decode(&plannerTemplate)
+
+	return *plannerTemplate, errorEntity
+}
+
+func CreateEntityFromPlannerTemplateIntervalUpdate(data io.Reader) (entity.PlannerTemplateInterval, error) {
+	plannerTemplateInterval := &entity.PlannerTemplateInterval{}
+	errorEntity := json.NewDecoder(data).Decode(&plannerTemplateInterval)
+
+	return *plannerTemplateInterval, errorEntity
+}
+
+func CreateEntityFromPantryItemUpdate(data io.Reader) (entity.PantryItem, error) {
+	pantryItem := &entity.PantryItem{}
+	errorEntity := json.NewDecoder(data).Decode(&pantryItem)
+
+	return *pantryItem, errorEntity
+}
+
+func CreateEntityFromShoppingListUpdate(data io.Reader) (entity.ShoppingList, error) {
+	shoppingList := &entity.ShoppingList{}
+	errorEntity := json.NewDecoder(data).Decode(&shoppingList)
+
+	return *shoppingList, errorEntity
+}
+
+func CreateEntityFromShoppingListItemUpdate(data io.Reader) (entity.ShoppingListItem, error) {
+	shoppingListItem := &entity.ShoppingListItem{}
+	errorEntity := json.NewDecoder(data).Decode(&shoppingListItem)
+
+	return *shoppingListItem, errorEntity
+}
+
+func CreateEntityFromIngredientPriceUpdate(data io.Reader) (entity.IngredientPrice, error) {
+	ingredientPrice := &entity.IngredientPrice{}
+	errorEntity := json.NewDecoder(data).Decode(&ingredientPrice)
+
+	return *ingredientPrice, errorEntity
+}
+
+func CreateAggregateFromPlannerGenerateConstraints(data io.Reader) (aggregate.PlannerGenerateConstraints, error) {
+	plannerGenerateConstraints := &aggregate.PlannerGenerateConstraints{}
+	errorAggregate := json.NewDecoder(data).Decode(&plannerGenerateConstraints)
+
+	return *plannerGenerateConstraints, errorAggregate
+}
+
+func CreateAggregateFromCookableQuery(data io.Reader) (aggregate.CookableQuery, error) {
+	cookableQuery := &aggregate.CookableQuery{}
+	errorAggregate := json.NewDecoder(data).Decode(&cookableQuery)
+
+	return *cookableQuery, errorAggregate
+}
+
+func CreateEntityFromIngredientSubstituteUpdate(data io.Reader) (entity.IngredientSubstitute, error) {
+	ingredientSubstitute := &entity.IngredientSubstitute{}
+	errorEntity := json.NewDecoder(data).Decode(&ingredientSubstitute)
+
+	return *ingredientSubstitute, errorEntity
+}
+
+func CreateAggregateFromSubstituteQuery(data io.Reader) (aggregate.SubstituteQuery, error) {
+	substituteQuery := &aggregate.SubstituteQuery{}
+	errorAggregate := json.NewDecoder(data).Decode(&substituteQuery)
+
+	return *substituteQuery, errorAggregate
+}
+
+func CreateEntityFromHouseholdUpdate(data io.Reader) (entity.Household, error) {
+	household := &entity.Household{}
+	errorEntity := json.NewDecoder(data).Decode(&household)
+
+	return *household, errorEntity
+}
+
+func CreateAggregateFromHouseholdInvite(data io.Reader) (aggregate.HouseholdInvite, error) {
+	householdInvite := &aggregate.HouseholdInvite{}
+	errorAggregate := json.NewDecoder(data).Decode(&householdInvite)
+
+	return *householdInvite, errorAggregate
+}
+
+func CreateEntityFromHouseholdGrantUpdate(data io.Reader) (entity.HouseholdGrant, error) {
+	householdGrant := &entity.HouseholdGrant{}
+	errorEntity := json.NewDecoder(data).Decode(&householdGrant)
+
+	return *householdGrant, errorEntity
+}
+
+func CreateEntityFromUserRoleUpdate(data io.Reader) (entity.UserRole, error) {
+	userRole := &entity.UserRole{}
+	errorEntity := json.NewDecoder(data).Decode(&userRole)
+
+	return *userRole, errorEntity
+}
+
+func CreateEntityFromUserToRoleUpdate(data io.Reader) (entity.UserToRole, error) {
+	userToRole := &entity.UserToRole{}
+	errorEntity := json.NewDecoder(data).Decode(&userToRole)
+
+	return *userToRole, errorEntity
+}
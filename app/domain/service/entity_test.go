@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/stretchr/testify/assert"
@@ -560,3 +561,638 @@ func TestCreateEntityFromPlannerRecipeUpdate(t *testing.T) {
 		)
 	}
 }
+
+func TestCreateEntityFromPantryItemUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.PantryItem
+	}{
+		{
+			name: "Test case for CreateEntityFromPantryItemUpdate with status active",
+			JSON: "{\"ingredient_id\":\"00000000-0000-0000-0000-000000000001\",\"unit_id\":\"00000000-0000-0000-0000-000000000002\",\"expiry_time\":\"2000-02-01T00:00:00Z\",\"quantity\":500,\"status\":\"active\"}",
+			Expected: entity.PantryItem{
+				IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ExpiryTime:   time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+				Quantity:     500,
+				Status:       kind.PantryItemStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromPantryItemUpdate with status inactive",
+			JSON: "{\"ingredient_id\":\"00000000-0000-0000-0000-000000000001\",\"unit_id\":\"00000000-0000-0000-0000-000000000002\",\"quantity\":1,\"status\":\"inactive\"}",
+			Expected: entity.PantryItem{
+				IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Quantity:     1,
+				Status:       kind.PantryItemStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				pantryItemUpdate, errorCreateEntityFromPantryItemUpdate := CreateEntityFromPantryItemUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, pantryItemUpdate)
+				assert.Nil(t, errorCreateEntityFromPantryItemUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromShoppingListUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.ShoppingList
+	}{
+		{
+			name: "Test case for CreateEntityFromShoppingListUpdate with planner",
+			JSON: "{\"planner_id\":\"00000000-0000-0000-0000-000000000001\",\"name\":\"ShoppingList\",\"status\":\"active\"}",
+			Expected: entity.ShoppingList{
+				PlannerId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Name:      "ShoppingList",
+				Status:    kind.ShoppingListStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromShoppingListUpdate with date range",
+			JSON: "{\"start_time\":\"2000-01-11T00:00:00Z\",\"end_time\":\"2000-01-17T23:59:59Z\",\"name\":\"ShoppingList\",\"status\":\"completed\"}",
+			Expected: entity.ShoppingList{
+				StartTime: time.Date(2000, time.January, 11, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2000, time.January, 17, 23, 59, 59, 0, time.UTC),
+				Name:      "ShoppingList",
+				Status:    kind.ShoppingListStatusCompleted,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				shoppingListUpdate, errorCreateEntityFromShoppingListUpdate := CreateEntityFromShoppingListUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, shoppingListUpdate)
+				assert.Nil(t, errorCreateEntityFromShoppingListUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromShoppingListItemUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.ShoppingListItem
+	}{
+		{
+			name: "Test case for CreateEntityFromShoppingListItemUpdate with status checked",
+			JSON: "{\"ingredient_id\":\"00000000-0000-0000-0000-000000000001\",\"unit_id\":\"00000000-0000-0000-0000-000000000002\",\"amount\":500,\"status\":\"checked\"}",
+			Expected: entity.ShoppingListItem{
+				IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UnitId:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Amount:       500,
+				Status:       kind.ShoppingListItemStatusChecked,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromShoppingListItemUpdate with a name only",
+			JSON: "{\"name\":\"Napkins\",\"amount\":1,\"status\":\"unchecked\"}",
+			Expected: entity.ShoppingListItem{
+				Name:   "Napkins",
+				Amount: 1,
+				Status: kind.ShoppingListItemStatusUnChecked,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				shoppingListItemUpdate, errorCreateEntityFromShoppingListItemUpdate := CreateEntityFromShoppingListItemUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, shoppingListItemUpdate)
+				assert.Nil(t, errorCreateEntityFromShoppingListItemUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromIngredientPriceUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.IngredientPrice
+	}{
+		{
+			name: "Test case for CreateEntityFromIngredientPriceUpdate with status active",
+			JSON: "{\"unit_id\":\"00000000-0000-0000-0000-000000000001\",\"price_time\":\"2000-01-05T00:00:00Z\",\"price\":199,\"quantity\":1,\"currency\":\"EUR\",\"store\":\"Store\",\"status\":\"active\"}",
+			Expected: entity.IngredientPrice{
+				UnitId:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				PriceTime: time.Date(2000, time.January, 5, 0, 0, 0, 0, time.UTC),
+				Price:     199,
+				Quantity:  1,
+				Currency:  "EUR",
+				Store:     "Store",
+				Status:    kind.IngredientPriceStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromIngredientPriceUpdate with status inactive",
+			JSON: "{\"unit_id\":\"00000000-0000-0000-0000-000000000001\",\"price\":250,\"quantity\":500,\"currency\":\"USD\",\"status\":\"inactive\"}",
+			Expected: entity.IngredientPrice{
+				UnitId:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Price:    250,
+				Quantity: 500,
+				Currency: "USD",
+				Status:   kind.IngredientPriceStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				ingredientPriceUpdate, errorCreateEntityFromIngredientPriceUpdate := CreateEntityFromIngredientPriceUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, ingredientPriceUpdate)
+				assert.Nil(t, errorCreateEntityFromIngredientPriceUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateAggregateFromPlannerGenerateConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected aggregate.PlannerGenerateConstraints
+	}{
+		{
+			name: "Test case for CreateAggregateFromPlannerGenerateConstraints with slots",
+			JSON: "{\"seed\":42,\"slots\":[{\"interval_id\":\"00000000-0000-0000-0000-000000000001\",\"categories\":[\"00000000-0000-0000-0000-000000000002\"],\"calories_max\":600}],\"tags\":[\"00000000-0000-0000-0000-000000000003\"],\"max_repeats\":2,\"budget\":5000,\"currency\":\"EUR\",\"prefer_pantry\":true}",
+			Expected: aggregate.PlannerGenerateConstraints{
+				Seed: 42,
+				Slots: []*aggregate.PlannerGenerateSlot{
+					{
+						IntervalId:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Categories:  []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+						CaloriesMax: 600,
+					},
+				},
+				Tags:         []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+				MaxRepeats:   2,
+				Budget:       5000,
+				Currency:     "EUR",
+				PreferPantry: true,
+			},
+		},
+		{
+			name: "Test case for CreateAggregateFromPlannerGenerateConstraints with replace",
+			JSON: "{\"calories_min\":200,\"calories_max\":800,\"replace\":true}",
+			Expected: aggregate.PlannerGenerateConstraints{
+				CaloriesMin: 200,
+				CaloriesMax: 800,
+				Replace:     true,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				plannerGenerateConstraints, errorCreateAggregateFromPlannerGenerateConstraints := CreateAggregateFromPlannerGenerateConstraints(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, plannerGenerateConstraints)
+				assert.Nil(t, errorCreateAggregateFromPlannerGenerateConstraints)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromPlannerTemplateUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.PlannerTemplate
+	}{
+		{
+			name: "Test case for CreateEntityFromPlannerTemplateUpdate with weekly recurrence",
+			JSON: "{\"next_time\":\"2000-01-03T00:00:00Z\",\"name\":\"name\",\"days\":7,\"recurrence\":\"weekly\",\"status\":\"active\"}",
+			Expected: entity.PlannerTemplate{
+				NextTime:   time.Date(2000, time.January, 3, 0, 0, 0, 0, time.UTC),
+				Name:       "name",
+				Days:       7,
+				Recurrence: kind.PlannerTemplateRecurrenceWeekly,
+				Status:     kind.PlannerTemplateStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromPlannerTemplateUpdate without recurrence",
+			JSON: "{\"name\":\"name\",\"recurrence\":\"none\",\"status\":\"inactive\"}",
+			Expected: entity.PlannerTemplate{
+				Name:       "name",
+				Recurrence: kind.PlannerTemplateRecurrenceNone,
+				Status:     kind.PlannerTemplateStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				plannerTemplateUpdate, errorCreateDTOFromPlannerTemplateUpdate := CreateEntityFromPlannerTemplateUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, plannerTemplateUpdate)
+				assert.Nil(t, errorCreateDTOFromPlannerTemplateUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromPlannerTemplateIntervalUpdate(t *testing.T) {
+	recipeId := uuid.MustParse("a1f6f0a0-0000-4000-8000-000000000001")
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.PlannerTemplateInterval
+	}{
+		{
+			name: "Test case for CreateEntityFromPlannerTemplateIntervalUpdate with recipes",
+			JSON: "{\"name\":\"breakfast\",\"start_offset\":420,\"end_offset\":480,\"recipe_ids\":[\"a1f6f0a0-0000-4000-8000-000000000001\"],\"status\":\"active\"}",
+			Expected: entity.PlannerTemplateInterval{
+				Name:        "breakfast",
+				StartOffset: 420,
+				EndOffset:   480,
+				RecipeIds:   []uuid.UUID{recipeId},
+				Status:      kind.PlannerTemplateIntervalStatusActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				plannerTemplateIntervalUpdate, errorCreateDTOFromPlannerTemplateIntervalUpdate := CreateEntityFromPlannerTemplateIntervalUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, plannerTemplateIntervalUpdate)
+				assert.Nil(t, errorCreateDTOFromPlannerTemplateIntervalUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateAggregateFromCookableQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected aggregate.CookableQuery
+	}{
+		{
+			name: "Test case for CreateAggregateFromCookableQuery with ingredients and substitutes",
+			JSON: "{\"ingredients\":[\"00000000-0000-0000-0000-000000000001\"],\"substitutes\":[{\"ingredient_id\":\"00000000-0000-0000-0000-000000000002\",\"substitute_id\":\"00000000-0000-0000-0000-000000000001\"}],\"max_missing\":2,\"limit\":10}",
+			Expected: aggregate.CookableQuery{
+				Ingredients: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				Substitutes: []*aggregate.CookableSubstitute{
+					{
+						IngredientId: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						SubstituteId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					},
+				},
+				MaxMissing: 2,
+				Limit:      10,
+			},
+		},
+		{
+			name: "Test case for CreateAggregateFromCookableQuery with pantry",
+			JSON: "{\"use_pantry\":true}",
+			Expected: aggregate.CookableQuery{
+				UsePantry: true,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				cookableQuery, errorCreateAggregateFromCookableQuery := CreateAggregateFromCookableQuery(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, cookableQuery)
+				assert.Nil(t, errorCreateAggregateFromCookableQuery)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromIngredientSubstituteUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.IngredientSubstitute
+	}{
+		{
+			name: "Test case for CreateEntityFromIngredientSubstituteUpdate with status active",
+			JSON: "{\"substitute_id\":\"00000000-0000-0000-0000-000000000001\",\"ratio\":0.75,\"contexts\":[\"baking\",\"vegan\"],\"notes\":\"Notes\",\"status\":\"active\"}",
+			Expected: entity.IngredientSubstitute{
+				SubstituteId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Ratio:        0.75,
+				Contexts:     []kind.SubstituteContext{kind.SubstituteContextBaking, kind.SubstituteContextVegan},
+				Notes:        "Notes",
+				Status:       kind.IngredientSubstituteStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromIngredientSubstituteUpdate with status inactive",
+			JSON: "{\"ratio\":2,\"status\":\"inactive\"}",
+			Expected: entity.IngredientSubstitute{
+				Ratio:  2,
+				Status: kind.IngredientSubstituteStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				ingredientSubstituteUpdate, errorCreateEntityFromIngredientSubstituteUpdate := CreateEntityFromIngredientSubstituteUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, ingredientSubstituteUpdate)
+				assert.Nil(t, errorCreateEntityFromIngredientSubstituteUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateAggregateFromSubstituteQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected aggregate.SubstituteQuery
+	}{
+		{
+			name: "Test case for CreateAggregateFromSubstituteQuery with all the options",
+			JSON: "{\"substitutes\":[\"00000000-0000-0000-0000-000000000001\"],\"contexts\":[\"vegan\"],\"use_pantry\":true}",
+			Expected: aggregate.SubstituteQuery{
+				Substitutes: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+				Contexts:    []kind.SubstituteContext{kind.SubstituteContextVegan},
+				UsePantry:   true,
+			},
+		},
+		{
+			name:     "Test case for CreateAggregateFromSubstituteQuery with no options",
+			JSON:     "{}",
+			Expected: aggregate.SubstituteQuery{},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				substituteQuery, errorCreateAggregateFromSubstituteQuery := CreateAggregateFromSubstituteQuery(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, substituteQuery)
+				assert.Nil(t, errorCreateAggregateFromSubstituteQuery)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromHouseholdUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.Household
+	}{
+		{
+			name: "Test case for CreateEntityFromHouseholdUpdate with status active",
+			JSON: "{\"name\":\"Name\",\"status\":\"active\"}",
+			Expected: entity.Household{
+				Name:   "Name",
+				Status: kind.HouseholdStatusActive,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromHouseholdUpdate with status inactive",
+			JSON: "{\"status\":\"inactive\"}",
+			Expected: entity.Household{
+				Status: kind.HouseholdStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				household, errorCreateEntityFromHouseholdUpdate := CreateEntityFromHouseholdUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, household)
+				assert.Nil(t, errorCreateEntityFromHouseholdUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateAggregateFromHouseholdInvite(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected aggregate.HouseholdInvite
+	}{
+		{
+			name:     "Test case for CreateAggregateFromHouseholdInvite with username",
+			JSON:     "{\"username\":\"username\"}",
+			Expected: aggregate.HouseholdInvite{Username: "username"},
+		},
+		{
+			name:     "Test case for CreateAggregateFromHouseholdInvite with no username",
+			JSON:     "{}",
+			Expected: aggregate.HouseholdInvite{},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				householdInvite, errorCreateAggregateFromHouseholdInvite := CreateAggregateFromHouseholdInvite(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, householdInvite)
+				assert.Nil(t, errorCreateAggregateFromHouseholdInvite)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromHouseholdGrantUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.HouseholdGrant
+	}{
+		{
+			name: "Test case for CreateEntityFromHouseholdGrantUpdate with planner",
+			JSON: "{\"subject_id\":\"00000000-0000-0000-0000-000000000001\",\"subject\":\"planner\",\"right\":\"write\"}",
+			Expected: entity.HouseholdGrant{
+				SubjectId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Subject:   kind.HouseholdSubjectPlanner,
+				Right:     kind.UserRightWrite,
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromHouseholdGrantUpdate with recipe",
+			JSON: "{\"subject_id\":\"00000000-0000-0000-0000-000000000001\",\"subject\":\"recipe\",\"right\":\"read\"}",
+			Expected: entity.HouseholdGrant{
+				SubjectId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Subject:   kind.HouseholdSubjectRecipe,
+				Right:     kind.UserRightRead,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				householdGrant, errorCreateEntityFromHouseholdGrantUpdate := CreateEntityFromHouseholdGrantUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, householdGrant)
+				assert.Nil(t, errorCreateEntityFromHouseholdGrantUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromUserRoleUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.UserRole
+	}{
+		{
+			name: "Test case for CreateEntityFromUserRoleUpdate with permissions",
+			JSON: "{\"name\":\"Moderator\",\"code\":\"moderator\",\"status\":\"active\",\"permissions\":[{\"resource\":\"merge\",\"rights\":[\"write\"]}]}",
+			Expected: entity.UserRole{
+				Name:   "Moderator",
+				Code:   kind.UserRole("moderator"),
+				Status: kind.UserRoleStatusActive,
+				Permissions: []entity.UserPermission{
+					{Resource: kind.UserResourceMerge, Rights: []kind.UserRight{kind.UserRightWrite}},
+				},
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromUserRoleUpdate without permissions",
+			JSON: "{\"status\":\"inactive\"}",
+			Expected: entity.UserRole{
+				Status: kind.UserRoleStatusInActive,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				userRole, errorCreateEntityFromUserRoleUpdate := CreateEntityFromUserRoleUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, userRole)
+				assert.Nil(t, errorCreateEntityFromUserRoleUpdate)
+			},
+		)
+	}
+}
+
+func TestCreateEntityFromUserToRoleUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		JSON     string
+		Expected entity.UserToRole
+	}{
+		{
+			name: "Test case for CreateEntityFromUserToRoleUpdate with rights",
+			JSON: "{\"user_id\":\"00000000-0000-0000-0000-000000000001\",\"rights\":[\"read\"]}",
+			Expected: entity.UserToRole{
+				UserId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Rights: []kind.UserRight{kind.UserRightRead},
+			},
+		},
+		{
+			name: "Test case for CreateEntityFromUserToRoleUpdate without rights",
+			JSON: "{\"user_id\":\"00000000-0000-0000-0000-000000000001\"}",
+			Expected: entity.UserToRole{
+				UserId: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				buffer := new(bytes.Buffer)
+				buffer.WriteString(testCase.JSON)
+				oneByteReader := iotest.OneByteReader(buffer)
+				userToRole, errorCreateEntityFromUserToRoleUpdate := CreateEntityFromUserToRoleUpdate(oneByteReader)
+
+				assert.Equal(t, testCase.Expected, userToRole)
+				assert.Nil(t, errorCreateEntityFromUserToRoleUpdate)
+			},
+		)
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	"sync"
 	"time"
 )
 
 var (
 	errorCachedItemDoesNotExist = errors.New("an error occurred while getting a cache item cause one does not exist.")
 	errorCachedItemIsExpired    = errors.New("an error occurred while getting a cache item cause a TTL of one is expired.")
+	errorCachedItemIsNotCounter = errors.New("an error occurred while incrementing a cache item cause one is not a counter.")
 )
 
 type CacheManager struct {
@@ -20,6 +22,7 @@ type CacheManager struct {
 	TTL              int64
 	dsn              *cache.DSN
 	cachedNamespaces *cachedNamespaces
+	mutex            sync.Mutex
 	cache.ManagerInterface
 }
 
@@ -43,33 +46,24 @@ func (cm *CacheManager) GetType() cache.Type {
 }
 
 func (cm *CacheManager) Set(key []byte, data any, ttl *int64) {
-	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
-
-	if ttl == nil {
-		ttl = &cm.TTL
-	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
 
-	(*cachedNamespace)[string(key)] = &cachedItem{TTL: time.Now().UTC().Add(time.Duration(*ttl) * time.Minute), Value: data}
+	cm.set(key, data, ttl)
 }
 
 func (cm *CacheManager) Get(key []byte) (any, error) {
-	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
 
-	cachedNamespaceValue, cachedNamespaceValueOk := (*cachedNamespace)[string(key)]
-
-	if !cachedNamespaceValueOk {
-		return nil, errorCachedItemDoesNotExist
-	}
-
-	if cachedNamespaceValue.TTL.Before(time.Now().UTC()) {
-		return nil, errorCachedItemIsExpired
-	}
-
-	return cachedNamespaceValue.Value, nil
+	return cm.get(key)
 }
 
 func (cm *CacheManager) Delete(key []byte) error {
-	errorExisting := cm.Exists(key)
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	errorExisting := cm.exists(key)
 
 	if errorExisting != nil {
 		return errorExisting
@@ -83,21 +77,43 @@ func (cm *CacheManager) Delete(key []byte) error {
 }
 
 func (cm *CacheManager) Exists(key []byte) error {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	return cm.exists(key)
+}
+
+func (cm *CacheManager) GetSet(key []byte, data any, ttl *int64) (any, error) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
+	cm.set(key, data, ttl)
+
+	return cm.get(key)
+}
+
+func (cm *CacheManager) Increment(key []byte, delta int64, ttl *int64) (int64, error) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+
 	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
-	stringedKey := string(key)
-	_, cachedNamespaceValueOk := (*cachedNamespace)[stringedKey]
+	cachedNamespaceValue, cachedNamespaceValueOk := (*cachedNamespace)[string(key)]
 
-	if !cachedNamespaceValueOk {
-		return errorCachedItemDoesNotExist
+	if !cachedNamespaceValueOk || cachedNamespaceValue.TTL.Before(time.Now().UTC()) {
+		cm.set(key, int64(0), ttl)
+
+		cachedNamespaceValue = (*cachedNamespace)[string(key)]
 	}
 
-	return nil
-}
+	value, valueOk := cachedNamespaceValue.Value.(int64)
 
-func (cm *CacheManager) GetSet(key []byte, data any, ttl *int64) (any, error) {
-	cm.Set(key, data, ttl)
+	if !valueOk {
+		return 0, errorCachedItemIsNotCounter
+	}
+
+	cachedNamespaceValue.Value = value + delta
 
-	return cm.Get(key)
+	return value + delta, nil
 }
 
 func (cm *CacheManager) SetDriver() {
@@ -117,3 +133,42 @@ func (cm *CacheManager) setCachedNamespace(namespace string) *cachedItems {
 
 	return namespaceData
 }
+
+// set, get and exists expect the mutex to be locked by the caller.
+func (cm *CacheManager) set(key []byte, data any, ttl *int64) {
+	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
+
+	if ttl == nil {
+		ttl = &cm.TTL
+	}
+
+	(*cachedNamespace)[string(key)] = &cachedItem{TTL: time.Now().UTC().Add(time.Duration(*ttl) * time.Minute), Value: data}
+}
+
+func (cm *CacheManager) get(key []byte) (any, error) {
+	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
+
+	cachedNamespaceValue, cachedNamespaceValueOk := (*cachedNamespace)[string(key)]
+
+	if !cachedNamespaceValueOk {
+		return nil, errorCachedItemDoesNotExist
+	}
+
+	if cachedNamespaceValue.TTL.Before(time.Now().UTC()) {
+		return nil, errorCachedItemIsExpired
+	}
+
+	return cachedNamespaceValue.Value, nil
+}
+
+func (cm *CacheManager) exists(key []byte) error {
+	cachedNamespace := cm.setCachedNamespace(cm.Namespace)
+	stringedKey := string(key)
+	_, cachedNamespaceValueOk := (*cachedNamespace)[stringedKey]
+
+	if !cachedNamespaceValueOk {
+		return errorCachedItemDoesNotExist
+	}
+
+	return nil
+}
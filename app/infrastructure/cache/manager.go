@@ -30,6 +30,9 @@ type ManagerInterface interface {
 	Delete(key []byte) error
 	Exists(key []byte) error
 	GetSet(key []byte, data any, ttl *int64) (any, error)
+	// Increment adds the delta to the counter of the key atomically and returns the new value, the counter which does
+	// not exist starts from zero and expires in the ttl minutes.
+	Increment(key []byte, delta int64, ttl *int64) (int64, error)
 }
 
 type DSN struct {
@@ -42,6 +45,22 @@ type DSN struct {
 	Type      string
 }
 
+// Counts reports whether the manager keeps the counters of Increment. The null manager and a manager which fails
+// return no counters, so the limits which are based on them, e.g. the failed login attempts, are off.
+func Counts(manager ManagerInterface) bool {
+	if manager == nil {
+		return false
+	}
+
+	ttl := int64(1)
+	key := PrepareKey("counts_check")
+	value, errorValue := manager.Increment(key, 1, &ttl)
+
+	_ = manager.Delete(key)
+
+	return errorValue == nil && value > 0
+}
+
 func PrepareKey(keys ...any) []byte {
 	defer func() {
 		if recoverValue := recover(); recoverValue != nil {
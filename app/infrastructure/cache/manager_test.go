@@ -0,0 +1,54 @@
+package cache_test
+
+import (
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache/in_memory"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache/null"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCounts(t *testing.T) {
+	inMemoryManager := &in_memory.CacheManager{Namespace: "test", Type: cache.InMemoryType, TTL: 1}
+	inMemoryManager.SetDriver()
+
+	nullManager := &null.CacheManager{Namespace: "test", Type: cache.NullType, TTL: 1}
+	nullManager.SetDriver()
+
+	tests := []struct {
+		Name     string
+		Manager  cache.ManagerInterface
+		Expected bool
+	}{
+		{
+			Name:     "Test case with the in memory manager",
+			Manager:  inMemoryManager,
+			Expected: true,
+		},
+		{
+			Name:     "Test case with the null manager",
+			Manager:  nullManager,
+			Expected: false,
+		},
+		{
+			Name:     "Test case without a manager",
+			Manager:  nil,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, cache.Counts(testCase.Manager))
+			},
+		)
+	}
+
+	ttl := int64(1)
+	value, errorValue := inMemoryManager.Increment(cache.PrepareKey("counts_check"), 0, &ttl)
+
+	assert.Nil(t, errorValue)
+	assert.Equal(t, int64(0), value)
+}
@@ -42,4 +42,8 @@ func (cm *CacheManager) GetSet(_ []byte, data any, _ *int64) (any, error) {
 	return data, nil
 }
 
+func (cm *CacheManager) Increment(_ []byte, _ int64, _ *int64) (int64, error) {
+	return 0, nil
+}
+
 func (cm *CacheManager) SetDriver() {}
@@ -7,6 +7,16 @@ import (
 	"strconv"
 )
 
+// incrementScript increments the counter and sets the expiration of the counter which has just been created in one
+// step, so concurrent requests can't leave a counter without the expiration.
+var incrementScript = redis.NewScript(`
+local value = redis.call("INCRBY", KEYS[1], ARGV[1])
+if redis.call("TTL", KEYS[1]) == -1 then
+	redis.call("EXPIRE", KEYS[1], ARGV[2])
+end
+return value
+`)
+
 type CacheManager struct {
 	context   context.Context
 	cancel    context.CancelFunc
@@ -39,7 +49,7 @@ func (cm *CacheManager) Get(key []byte) (any, error) {
 }
 
 func (cm *CacheManager) Delete(key []byte) error {
-	return nil
+	return cm.driver.Del(cm.getContext(), string(key)).Err()
 }
 
 func (cm *CacheManager) Exists(key []byte) error {
@@ -50,6 +60,22 @@ func (cm *CacheManager) GetSet(key []byte, data any, ttl *int64) (any, error) {
 	return nil, nil
 }
 
+func (cm *CacheManager) Increment(key []byte, delta int64, ttl *int64) (int64, error) {
+	if ttl == nil {
+		ttl = &cm.TTL
+	}
+
+	return incrementScript.Run(cm.getContext(), cm.driver, []string{string(key)}, delta, *ttl*60).Int64()
+}
+
+func (cm *CacheManager) getContext() context.Context {
+	if cm.context == nil {
+		return context.Background()
+	}
+
+	return cm.context
+}
+
 func (cm *CacheManager) SetDriver() {
 	if cm.driver != nil {
 		return
@@ -0,0 +1,63 @@
+package file
+
+import (
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sender appends the messages to the file of DSN.Path or writes them to the standard output when there is no path,
+// it is meant for the development.
+type Sender struct {
+	DSN   *notification.DSN
+	mutex sync.Mutex
+}
+
+func (s *Sender) Send(message *notification.Message) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.DSN.Path == "" {
+		return write(os.Stdout, message)
+	}
+
+	file, errorOpen := os.OpenFile(s.DSN.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if errorOpen != nil {
+		return errorOpen
+	}
+
+	errorWrite := write(file, message)
+	errorClose := file.Close()
+
+	if errorWrite != nil {
+		return errorWrite
+	}
+
+	return errorClose
+}
+
+func (s *Sender) GetType() notification.Type {
+	if s.DSN.Path == "" {
+		return notification.StdoutType
+	}
+
+	return notification.FileType
+}
+
+func write(writer io.Writer, message *notification.Message) error {
+	_, errorWrite := fmt.Fprintf(
+		writer,
+		"Date: %s\nTo: %s\nSubject: %s\nLocale: %s\n\n%s\n",
+		time.Now().UTC().Format(time.RFC3339),
+		message.Recipient,
+		message.Subject,
+		message.Locale,
+		message.Body,
+	)
+
+	return errorWrite
+}
@@ -0,0 +1,31 @@
+package file
+
+import (
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSenderSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notification.log")
+	sender := &Sender{DSN: &notification.DSN{Path: path}}
+
+	assert.Equal(t, notification.FileType, sender.GetType())
+
+	for _, recipient := range []string{"first", "second"} {
+		assert.Nil(t, sender.Send(&notification.Message{Recipient: recipient, Subject: "Subject", Body: "Body", Locale: "en"}))
+	}
+
+	content, errorReadFile := os.ReadFile(path)
+
+	assert.Nil(t, errorReadFile)
+	assert.Equal(t, 2, strings.Count(string(content), "Subject: Subject\n"))
+	assert.Contains(t, string(content), "To: first\n")
+	assert.Contains(t, string(content), "To: second\n")
+
+	assert.Equal(t, notification.StdoutType, (&Sender{DSN: &notification.DSN{}}).GetType())
+	assert.NotNil(t, (&Sender{DSN: &notification.DSN{Path: filepath.Join(path, "directory")}}).Send(&notification.Message{}))
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+type Type struct {
+	slug string
+}
+
+func (t Type) String() string {
+	return t.slug
+}
+
+var (
+	SmtpType    = Type{"smtp"}
+	WebhookType = Type{"webhook"}
+	FileType    = Type{"file"}
+	StdoutType  = Type{"stdout"}
+	DefaultType = Type{"stdout"}
+)
+
+const (
+	DefaultAttempts = 3
+	DefaultBackoff  = 500 * time.Millisecond
+)
+
+type SenderInterface interface {
+	Send(message *Message) error
+	GetType() Type
+}
+
+// Message is the rendered notification which is delivered to the recipient by a sender.
+type Message struct {
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	Locale    string `json:"locale"`
+	Template  string `json:"template"`
+}
+
+type DSN struct {
+	Type     string
+	Host     string
+	Port     string
+	User     string
+	Password string
+	From     string
+	Url      string
+	Path     string
+	Locale   string
+	Attempts int
+	Backoff  time.Duration
+}
+
+// Notifier renders the messages in its locale and delivers them by the sender, a failed delivery is retried with the
+// backoff doubled after every attempt.
+type Notifier struct {
+	Sender   SenderInterface
+	Locale   string
+	Attempts int
+	Backoff  time.Duration
+	sleep    func(duration time.Duration)
+}
+
+func (n *Notifier) Notify(template string, recipient string, data any) error {
+	message, errorRender := Render(template, n.Locale, data)
+
+	if errorRender != nil {
+		return errorRender
+	}
+
+	message.Recipient = recipient
+
+	return n.send(message)
+}
+
+func (n *Notifier) send(message *Message) error {
+	var errorSend error
+
+	attempts := n.Attempts
+
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	sleep := n.sleep
+
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	backoff := n.Backoff
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if errorSend = n.Sender.Send(message); errorSend == nil {
+			return nil
+		}
+
+		if attempt < attempts {
+			sleep(backoff)
+			backoff *= 2
+		}
+	}
+
+	return errors.Wrapf(errorSend, "an error occurred while sending a notification by %s after %d attempts by provided data template=%s", n.Sender.GetType(), attempts, message.Template)
+}
@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type testSender struct {
+	failures int
+	messages []*Message
+}
+
+func (ts *testSender) Send(message *Message) error {
+	if ts.failures > 0 {
+		ts.failures--
+
+		return errors.New("the sender is unavailable")
+	}
+
+	ts.messages = append(ts.messages, message)
+
+	return nil
+}
+
+func (ts *testSender) GetType() Type {
+	return FileType
+}
+
+func TestRender(t *testing.T) {
+	data := map[string]string{"Name": "Name", "Code": "123456"}
+
+	tests := []struct {
+		name     string
+		template string
+		locale   string
+		expected *Message
+	}{
+		{
+			name:     "Test case with auth template in english",
+			template: "auth",
+			locale:   "en",
+			expected: &Message{Subject: "Your confirmation code", Body: "Hello, Name!\n\nYour confirmation code is 123456.\n\nIf you didn't try to sign in, change your password.\n", Locale: "en", Template: "auth"},
+		},
+		{
+			name:     "Test case with password reset template in regional russian",
+			template: "password_reset",
+			locale:   "ru_RU",
+			expected: &Message{Subject: "Ваш код для сброса пароля", Body: "Здравствуйте, Name!\n\nВаш код для сброса пароля: 123456.\n\nЕсли вы не запрашивали сброс пароля, проигнорируйте это сообщение.\n", Locale: "ru", Template: "password_reset"},
+		},
+		{
+			name:     "Test case with unknown locale and template",
+			template: "",
+			locale:   "xx",
+			expected: &Message{Subject: "Your confirmation code", Body: "Hello, Name!\n\nYour confirmation code is 123456.\n\nIf you didn't try to sign in, change your password.\n", Locale: "en", Template: "auth"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				actual, errorRender := Render(testCase.template, testCase.locale, data)
+
+				assert.Nil(t, errorRender)
+				assert.Equal(t, testCase.expected, actual)
+			},
+		)
+	}
+
+	_, errorRenderMissingKey := Render("auth", "en", map[string]string{})
+
+	assert.NotNil(t, errorRenderMissingKey)
+}
+
+func TestNotifierNotify(t *testing.T) {
+	data := map[string]string{"Name": "Name", "Code": "123456"}
+
+	tests := []struct {
+		name     string
+		failures int
+		attempts int
+		sleeps   []time.Duration
+		fault    bool
+	}{
+		{
+			name:     "Test case with delivery at the first attempt",
+			attempts: 3,
+			sleeps:   []time.Duration{},
+		},
+		{
+			name:     "Test case with delivery after retries with backoff",
+			failures: 2,
+			attempts: 3,
+			sleeps:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:     "Test case with exhausted attempts",
+			failures: 3,
+			attempts: 3,
+			sleeps:   []time.Duration{time.Second, 2 * time.Second},
+			fault:    true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				sender := &testSender{failures: testCase.failures}
+				sleeps := make([]time.Duration, 0)
+				notifier := &Notifier{
+					Sender:   sender,
+					Locale:   "en",
+					Attempts: testCase.attempts,
+					Backoff:  time.Second,
+					sleep: func(duration time.Duration) {
+						sleeps = append(sleeps, duration)
+					},
+				}
+
+				errorNotify := notifier.Notify("auth", "username@meal-planner.local", data)
+
+				assert.Equal(t, testCase.sleeps, sleeps)
+
+				if testCase.fault {
+					assert.NotNil(t, errorNotify)
+					assert.Empty(t, sender.messages)
+				} else {
+					assert.Nil(t, errorNotify)
+					assert.Len(t, sender.messages, 1)
+					assert.Equal(t, "username@meal-planner.local", sender.messages[0].Recipient)
+				}
+			},
+		)
+	}
+}
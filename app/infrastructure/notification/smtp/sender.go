@@ -0,0 +1,61 @@
+package smtp
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+type Sender struct {
+	DSN *notification.DSN
+}
+
+func (s *Sender) Send(message *notification.Message) error {
+	var auth smtp.Auth
+
+	if s.DSN.User != "" {
+		auth = smtp.PlainAuth("", s.DSN.User, s.DSN.Password, s.DSN.Host)
+	}
+
+	data, errorData := s.prepareData(message)
+
+	if errorData != nil {
+		return errorData
+	}
+
+	return smtp.SendMail(net.JoinHostPort(s.DSN.Host, s.DSN.Port), auth, s.DSN.From, []string{message.Recipient}, data)
+}
+
+func (s *Sender) GetType() notification.Type {
+	return notification.SmtpType
+}
+
+func (s *Sender) prepareData(message *notification.Message) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	_, _ = fmt.Fprintf(&buffer, "From: %s\r\n", s.DSN.From)
+	_, _ = fmt.Fprintf(&buffer, "To: %s\r\n", message.Recipient)
+	_, _ = fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	_, _ = fmt.Fprintf(&buffer, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	_, _ = fmt.Fprintf(&buffer, "Content-Language: %s\r\n", message.Locale)
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&buffer)
+
+	if _, errorWrite := writer.Write([]byte(message.Body)); errorWrite != nil {
+		return nil, errorWrite
+	}
+
+	if errorClose := writer.Close(); errorClose != nil {
+		return nil, errorClose
+	}
+
+	return buffer.Bytes(), nil
+}
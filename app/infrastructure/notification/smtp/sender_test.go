@@ -0,0 +1,175 @@
+package smtp
+
+import (
+	"bufio"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// testServer is a local stand-in of an SMTP server, it accepts the messages after rejecting the first failures ones.
+type testServer struct {
+	listener net.Listener
+	failures int
+	messages chan string
+}
+
+func newTestServer(t *testing.T, failures int) *testServer {
+	listener, errorListen := net.Listen("tcp", "127.0.0.1:0")
+
+	assert.Nil(t, errorListen)
+
+	server := &testServer{listener: listener, failures: failures, messages: make(chan string, 10)}
+
+	go server.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return server
+}
+
+func (ts *testServer) serve() {
+	for {
+		connection, errorAccept := ts.listener.Accept()
+
+		if errorAccept != nil {
+			return
+		}
+
+		ts.handle(connection)
+	}
+}
+
+func (ts *testServer) handle(connection net.Conn) {
+	defer func() {
+		_ = connection.Close()
+	}()
+
+	reader := bufio.NewReader(connection)
+	reply := func(line string) {
+		_, _ = connection.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, errorRead := reader.ReadString('\n')
+
+		if errorRead != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM"):
+			if ts.failures > 0 {
+				ts.failures--
+				reply("451 try again later")
+			} else {
+				reply("250 OK")
+			}
+		case strings.HasPrefix(command, "RCPT TO"):
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				dataLine, errorDataLine := reader.ReadString('\n')
+
+				if errorDataLine != nil || dataLine == ".\r\n" {
+					break
+				}
+
+				data.WriteString(dataLine)
+			}
+
+			ts.messages <- data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSenderSend(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		attempts int
+		fault    bool
+	}{
+		{
+			name:     "Test case with delivered message",
+			attempts: 1,
+		},
+		{
+			name:     "Test case with delivered message after a temporary failure",
+			failures: 1,
+			attempts: 2,
+		},
+		{
+			name:     "Test case with undelivered message",
+			failures: 2,
+			attempts: 2,
+			fault:    true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				server := newTestServer(t, testCase.failures)
+				host, port, _ := net.SplitHostPort(server.listener.Addr().String())
+				notifier := &notification.Notifier{
+					Sender:   &Sender{DSN: &notification.DSN{Host: host, Port: port, From: "noreply@meal-planner.local"}},
+					Locale:   "ru",
+					Attempts: testCase.attempts,
+				}
+
+				errorNotify := notifier.Notify("auth", "username@meal-planner.local", map[string]string{"Name": "Name", "Code": "123456"})
+
+				if testCase.fault {
+					assert.NotNil(t, errorNotify)
+					assert.Len(t, server.messages, 0)
+
+					return
+				}
+
+				assert.Nil(t, errorNotify)
+
+				message, errorReadMessage := mail.ReadMessage(strings.NewReader(<-server.messages))
+
+				assert.Nil(t, errorReadMessage)
+
+				subject, errorSubject := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+				body, errorBody := io.ReadAll(quotedprintable.NewReader(message.Body))
+
+				assert.Nil(t, errorSubject)
+				assert.Nil(t, errorBody)
+				assert.Equal(t, "noreply@meal-planner.local", message.Header.Get("From"))
+				assert.Equal(t, "username@meal-planner.local", message.Header.Get("To"))
+				assert.Equal(t, "ru", message.Header.Get("Content-Language"))
+				assert.Equal(t, "Ваш код подтверждения", subject)
+				assert.Contains(t, string(body), "Ваш код подтверждения: 123456.")
+			},
+		)
+	}
+}
@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"strings"
+	"text/template"
+)
+
+const (
+	DefaultLocale   = "en"
+	DefaultTemplate = "auth"
+)
+
+type Template struct {
+	Subject string
+	Body    string
+}
+
+// Templates are the messages by the name and the locale, the name of a confirmation is its type.
+var Templates = map[string]map[string]Template{
+	"auth": {
+		"en": {
+			Subject: "Your confirmation code",
+			Body:    "Hello, {{.Name}}!\n\nYour confirmation code is {{.Code}}.\n\nIf you didn't try to sign in, change your password.\n",
+		},
+		"ru": {
+			Subject: "Ваш код подтверждения",
+			Body:    "Здравствуйте, {{.Name}}!\n\nВаш код подтверждения: {{.Code}}.\n\nЕсли вы не пытались войти, смените пароль.\n",
+		},
+	},
+	"password_reset": {
+		"en": {
+			Subject: "Your password reset code",
+			Body:    "Hello, {{.Name}}!\n\nYour password reset code is {{.Code}}.\n\nIf you didn't request a password reset, ignore this message.\n",
+		},
+		"ru": {
+			Subject: "Ваш код для сброса пароля",
+			Body:    "Здравствуйте, {{.Name}}!\n\nВаш код для сброса пароля: {{.Code}}.\n\nЕсли вы не запрашивали сброс пароля, проигнорируйте это сообщение.\n",
+		},
+	},
+}
+
+var errorTemplateNotFound = errors.New("the template of the notification is not found")
+
+// Render renders the template in the locale, a regional locale falls back to its language and then to DefaultLocale,
+// an unknown template falls back to DefaultTemplate.
+func Render(name string, locale string, data any) (*Message, error) {
+	locales, localesOk := Templates[name]
+
+	if !localesOk {
+		name = DefaultTemplate
+		locales, localesOk = Templates[name]
+
+		if !localesOk {
+			return nil, errorTemplateNotFound
+		}
+	}
+
+	locale = resolveLocale(locales, locale)
+	messageTemplate, messageTemplateOk := locales[locale]
+
+	if !messageTemplateOk {
+		return nil, errorTemplateNotFound
+	}
+
+	subject, errorSubject := execute(messageTemplate.Subject, data)
+
+	if errorSubject != nil {
+		return nil, errors.Wrapf(errorSubject, "an error occurred while rendering a subject by provided data name=%s,locale=%s", name, locale)
+	}
+
+	body, errorBody := execute(messageTemplate.Body, data)
+
+	if errorBody != nil {
+		return nil, errors.Wrapf(errorBody, "an error occurred while rendering a body by provided data name=%s,locale=%s", name, locale)
+	}
+
+	return &Message{Subject: subject, Body: body, Locale: locale, Template: name}, nil
+}
+
+func resolveLocale(locales map[string]Template, locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+
+	if _, ok := locales[locale]; ok {
+		return locale
+	}
+
+	if language, _, found := strings.Cut(locale, "-"); found {
+		if _, ok := locales[language]; ok {
+			return language
+		}
+	}
+
+	return DefaultLocale
+}
+
+func execute(text string, data any) (string, error) {
+	parsed, errorParse := template.New("notification").Option("missingkey=error").Parse(text)
+
+	if errorParse != nil {
+		return "", errorParse
+	}
+
+	var buffer bytes.Buffer
+
+	if errorExecute := parsed.Execute(&buffer, data); errorExecute != nil {
+		return "", errorExecute
+	}
+
+	return buffer.String(), nil
+}
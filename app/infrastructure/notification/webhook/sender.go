@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"net/http"
+	"time"
+)
+
+const timeout = 10 * time.Second
+
+type Sender struct {
+	DSN    *notification.DSN
+	Client *http.Client
+}
+
+func (s *Sender) Send(message *notification.Message) error {
+	body, errorMarshal := json.Marshal(message)
+
+	if errorMarshal != nil {
+		return errorMarshal
+	}
+
+	client := s.Client
+
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+
+	response, errorPost := client.Post(s.DSN.Url, "application/json", bytes.NewReader(body))
+
+	if errorPost != nil {
+		return errorPost
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the webhook has responded with the status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *Sender) GetType() notification.Type {
+	return notification.WebhookType
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"encoding/json"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSenderSend(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		fault  bool
+	}{
+		{
+			name:   "Test case with accepted message",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "Test case with rejected message",
+			status: http.StatusServiceUnavailable,
+			fault:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				var actual notification.Message
+
+				server := httptest.NewServer(
+					http.HandlerFunc(
+						func(w http.ResponseWriter, r *http.Request) {
+							assert.Equal(t, http.MethodPost, r.Method)
+							assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+							assert.Nil(t, json.NewDecoder(r.Body).Decode(&actual))
+
+							w.WriteHeader(testCase.status)
+						},
+					),
+				)
+				defer server.Close()
+
+				message := &notification.Message{Recipient: "username", Subject: "Subject", Body: "Body", Locale: "en", Template: "auth"}
+				sender := &Sender{DSN: &notification.DSN{Url: server.URL}}
+				errorSend := sender.Send(message)
+
+				assert.Equal(t, *message, actual)
+				assert.Equal(t, notification.WebhookType, sender.GetType())
+
+				if testCase.fault {
+					assert.NotNil(t, errorSend)
+				} else {
+					assert.Nil(t, errorSend)
+				}
+			},
+		)
+	}
+}
@@ -2,7 +2,10 @@ package persistence
 
 import (
 	"fmt"
+	"github.com/pkg/errors"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 type Type struct {
@@ -18,6 +21,14 @@ var (
 	DefaultType = Type{"mongo"}
 )
 
+// ErrorTextSearchUnsupported is returned when the entity manager has no full-text indexes, the text has to be searched
+// by the application then.
+var ErrorTextSearchUnsupported = errors.New("full-text search is not supported by the entity manager")
+
+// ErrorDuplicate is returned when the inserted entity has the same values of the fields of a unique index as an existing
+// one.
+var ErrorDuplicate = errors.New("the entity is a duplicate of an existing one by a unique index")
+
 type DSN struct {
 	DSN      string
 	Host     string
@@ -28,44 +39,61 @@ type DSN struct {
 	Type     string
 }
 
+// Criteria are the conditions, the order and the page of the entities. The order is a map of the fields to
+// the directions, 1 is ascending and -1 is descending, the fields are applied in the alphabetical order.
 type Criteria struct {
 	Where  map[string]interface{}
 	Order  map[string]interface{}
 	Limit  int
 	Offset int
+	// Uncached reads the results from the database bypassing the cache, it is used for the data which is updated
+	// concurrently, e.g. sessions, counters and one-time codes.
+	Uncached bool
 }
 
 func (c Criteria) String() string {
-	var (
-		where  map[string]interface{}
-		order  map[string]interface{}
-		limit  int
-		offset int
+	return fmt.Sprintf(
+		"%s%s%d%d",
+		criteriaMapString(c.Where),
+		criteriaMapString(c.Order),
+		c.Offset,
+		c.Limit,
 	)
+}
 
-	if reflect.ValueOf(c.Where).IsZero() {
-		where = map[string]interface{}{}
-	}
+// criteriaMapString prints the map in the order of the keys with the values the pointers point to, so the same
+// criteria always give the same string.
+func criteriaMapString(values map[string]interface{}) string {
+	keys := make([]string, 0, len(values))
 
-	if reflect.ValueOf(c.Order).IsZero() {
-		order = map[string]interface{}{}
+	for key := range values {
+		keys = append(keys, key)
 	}
 
-	if reflect.ValueOf(c.Limit).IsZero() {
-		limit = 0
-	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+
+	builder.WriteString("map[")
+
+	for index, key := range keys {
+		value := values[key]
+		reflectValue := reflect.ValueOf(value)
 
-	if reflect.ValueOf(c.Offset).IsZero() {
-		offset = 0
+		if reflectValue.Kind() == reflect.Pointer && !reflectValue.IsNil() {
+			value = reflectValue.Elem().Interface()
+		}
+
+		if index > 0 {
+			builder.WriteString(" ")
+		}
+
+		builder.WriteString(fmt.Sprintf("%s:%v", key, value))
 	}
 
-	return fmt.Sprintf(
-		"%v%v%d%d",
-		where,
-		order,
-		offset,
-		limit,
-	)
+	builder.WriteString("]")
+
+	return builder.String()
 }
 
 type Wrapper struct {
@@ -84,4 +112,22 @@ type EntityManagerInterface interface {
 	UpdateOne(table string, criteria *Criteria, wrapper *Wrapper) (interface{}, error)
 	UpdateMany(table string, criteria *Criteria, wrapper *Wrapper) ([]interface{}, error)
 	DeleteOne(table string, criteria *Criteria) (bool, error)
+	// IncrementOne adds the delta to the field of the entity atomically and returns the entity as it is after that, the
+	// entity is read bypassing the cache.
+	IncrementOne(table string, criteria *Criteria, field string, delta int64) (interface{}, error)
+	// Count returns the amount of the entities satisfying the conditions of the criteria, the order, the limit and the
+	// offset are not applied. The entities are counted bypassing the cache.
+	Count(table string, criteria *Criteria) (int64, error)
+}
+
+// TextSearchInterface is implemented by the entity managers which can search the text by full-text indexes. The fields
+// of the index are weighted, the results go with their relevance scores in the order of the relevance.
+type TextSearchInterface interface {
+	FindText(table string, criteria *Criteria, text string, weights map[string]int32) ([]interface{}, []float64, error)
+}
+
+// UniqueIndexInterface is implemented by the entity managers which can keep the values of the fields unique, an insert
+// of a duplicate fails with ErrorDuplicate then.
+type UniqueIndexInterface interface {
+	EnsureUniqueIndex(table string, fields []string) error
 }
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCriteriaString(t *testing.T) {
+	id := uuid.New()
+	idOther := uuid.New()
+	status := "active"
+
+	tests := []struct {
+		Name     string
+		Criteria Criteria
+		Other    Criteria
+		Equal    bool
+	}{
+		{
+			Name:     "Test case with the same criteria",
+			Criteria: Criteria{Where: map[string]interface{}{"id": &id, "status": &status}, Limit: 1},
+			Other:    Criteria{Where: map[string]interface{}{"status": &status, "id": &id}, Limit: 1},
+			Equal:    true,
+		},
+		{
+			Name:     "Test case with the other id",
+			Criteria: Criteria{Where: map[string]interface{}{"id": &id}},
+			Other:    Criteria{Where: map[string]interface{}{"id": &idOther}},
+			Equal:    false,
+		},
+		{
+			Name:     "Test case with the other limit and offset",
+			Criteria: Criteria{Limit: 1, Offset: 2},
+			Other:    Criteria{Limit: 2, Offset: 1},
+			Equal:    false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Equal, testCase.Criteria.String() == testCase.Other.String())
+				assert.Contains(t, testCase.Criteria.String(), "map[")
+			},
+		)
+	}
+}
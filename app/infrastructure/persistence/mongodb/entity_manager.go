@@ -10,24 +10,39 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 )
 
 var (
-	errorDeleteOneEntity = errors.New("an error occurred while deleting one entity")
+	errorDeleteOneEntity  = errors.New("an error occurred while deleting one entity")
+	errorCriteriaUncached = errors.New("the criteria are not cached")
 )
 
 // EntityManager /**/
 type EntityManager struct {
-	client       *mongo.Client
-	context      context.Context
-	cancel       context.CancelFunc
-	Database     string
-	Type         persistence.Type
-	CacheManager cache.ManagerInterface
-	dsn          *persistence.DSN
+	client        *mongo.Client
+	context       context.Context
+	cancel        context.CancelFunc
+	Database      string
+	Type          persistence.Type
+	CacheManager  cache.ManagerInterface
+	dsn           *persistence.DSN
+	textIndexes   sync.Map
+	uniqueIndexes sync.Map
+	cachedTables  sync.Map
 	persistence.EntityManagerInterface
 }
 
+// cachedTable keeps the keys of the cached results of a table, they are evicted by every write to the table. The
+// generation is changed by every write, so a result read before the write is not cached after it.
+type cachedTable struct {
+	mutex      sync.Mutex
+	generation uint64
+	keys       map[string]struct{}
+}
+
 func (em *EntityManager) getConnection() *mongo.Client {
 	if em.client == nil {
 		em.setConnection()
@@ -82,7 +97,8 @@ func (em *EntityManager) GetType() persistence.Type {
 
 func (em *EntityManager) FindOne(table string, criteria *persistence.Criteria) (interface{}, error) {
 	keyCache := cache.PrepareKey(table, criteria)
-	bsonMResultCached, errorGet := em.CacheManager.Get(keyCache)
+	generation := em.getCachedGeneration(table)
+	bsonMResultCached, errorGet := em.getCached(keyCache, criteria)
 
 	if errorGet == nil {
 		bsonMResultRestored, statusRestored := bsonMResultCached.(bson.M)
@@ -102,7 +118,7 @@ func (em *EntityManager) FindOne(table string, criteria *persistence.Criteria) (
 		return nil, errors.Wrapf(errorFindOne, "an error occurred while getting a result from the database by provided data %p", criteria)
 	}
 
-	em.CacheManager.Set(keyCache, bsonMResult, nil)
+	em.setCached(table, generation, keyCache, criteria, bsonMResult)
 
 	return bsonMResult, nil
 }
@@ -111,7 +127,8 @@ func (em *EntityManager) FindAll(table string, criteria *persistence.Criteria) (
 	var entities []interface{}
 
 	keyCache := cache.PrepareKey(table, criteria)
-	bsonMResultCached, errorGet := em.CacheManager.Get(keyCache)
+	generation := em.getCachedGeneration(table)
+	bsonMResultCached, errorGet := em.getCached(keyCache, criteria)
 
 	if errorGet == nil {
 		bsonMResultRestored, statusRestored := bsonMResultCached.([]interface{})
@@ -131,7 +148,7 @@ func (em *EntityManager) FindAll(table string, criteria *persistence.Criteria) (
 		}
 	}
 
-	cursor, errorFind := em.getConnection().Database(em.Database).Collection(table).Find(em.context, em.convertCriteriaToBSONCriteria(criteria))
+	cursor, errorFind := em.getConnection().Database(em.Database).Collection(table).Find(em.context, em.convertCriteriaToBSONCriteria(criteria), em.convertCriteriaToFindOptions(criteria))
 
 	if errorFind != nil {
 		return nil, errors.Wrapf(errorFind, "an error occurred while getting results from the database by provided data %p", criteria)
@@ -157,15 +174,19 @@ func (em *EntityManager) FindAll(table string, criteria *persistence.Criteria) (
 		return nil, errors.Wrap(errorClose, "an error occurred while closing a cursor")
 	}
 
-	em.CacheManager.Set(keyCache, entities, nil)
+	em.setCached(table, generation, keyCache, criteria, entities)
 
 	return entities, nil
 }
 
 func (em *EntityManager) InsertOne(table string, entity interface{}) (interface{}, error) {
+	defer em.evictCached(table)
+
 	_, errorInsertOne := em.getConnection().Database(em.Database).Collection(table).InsertOne(em.context, entity)
 
-	if errorInsertOne != nil {
+	if mongo.IsDuplicateKeyError(errorInsertOne) {
+		return nil, errors.Wrapf(persistence.ErrorDuplicate, "an error occurred while inserting an entity to the database by provided data %s", entity)
+	} else if errorInsertOne != nil {
 		return nil, errors.Wrapf(errorInsertOne, "an error occurred while inserting an entity to the database by provided data %s", entity)
 	}
 
@@ -173,6 +194,8 @@ func (em *EntityManager) InsertOne(table string, entity interface{}) (interface{
 }
 
 func (em *EntityManager) InsertMany(table string, entities []interface{}) ([]interface{}, error) {
+	defer em.evictCached(table)
+
 	_, errorInsertMany := em.getConnection().Database(em.Database).Collection(table).InsertMany(em.context, entities)
 
 	if errorInsertMany != nil {
@@ -183,6 +206,8 @@ func (em *EntityManager) InsertMany(table string, entities []interface{}) ([]int
 }
 
 func (em *EntityManager) UpdateOne(table string, criteria *persistence.Criteria, wrapper *persistence.Wrapper) (interface{}, error) {
+	defer em.evictCached(table)
+
 	_, errorUpdateOne := em.getConnection().Database(em.Database).Collection(table).UpdateOne(em.context, em.convertCriteriaToBSONCriteria(criteria), em.convertWrapperToBSONWrapper(wrapper))
 
 	if errorUpdateOne != nil {
@@ -197,6 +222,8 @@ func (em *EntityManager) UpdateOne(table string, criteria *persistence.Criteria,
 }
 
 func (em *EntityManager) UpdateMany(table string, criteria *persistence.Criteria, wrapper *persistence.Wrapper) ([]interface{}, error) {
+	defer em.evictCached(table)
+
 	_, errorUpdateMany := em.getConnection().Database(em.Database).Collection(table).UpdateMany(em.context, em.convertCriteriaToBSONCriteria(criteria), em.convertWrapperToBSONWrapper(wrapper))
 
 	if errorUpdateMany != nil {
@@ -206,7 +233,37 @@ func (em *EntityManager) UpdateMany(table string, criteria *persistence.Criteria
 	return []interface{}{}, nil
 }
 
+func (em *EntityManager) IncrementOne(table string, criteria *persistence.Criteria, field string, delta int64) (interface{}, error) {
+	defer em.evictCached(table)
+
+	bsonMResult := bson.M{}
+	errorFindOneAndUpdate := em.getConnection().Database(em.Database).Collection(table).FindOneAndUpdate(
+		em.context,
+		em.convertCriteriaToBSONCriteria(criteria),
+		bson.M{"$inc": bson.M{field: delta}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&bsonMResult)
+
+	if errorFindOneAndUpdate != nil {
+		return nil, errors.Wrapf(errorFindOneAndUpdate, "an error occurred while incrementing an entity in the database by provided data criteria=%p field=%s", criteria, field)
+	}
+
+	return bsonMResult, nil
+}
+
+func (em *EntityManager) Count(table string, criteria *persistence.Criteria) (int64, error) {
+	count, errorCount := em.getConnection().Database(em.Database).Collection(table).CountDocuments(em.context, em.convertCriteriaToBSONCriteria(criteria))
+
+	if errorCount != nil {
+		return 0, errors.Wrapf(errorCount, "an error occurred while counting entities in the database by provided data %p", criteria)
+	}
+
+	return count, nil
+}
+
 func (em *EntityManager) DeleteOne(table string, criteria *persistence.Criteria) (bool, error) {
+	defer em.evictCached(table)
+
 	deleteResult, errorDeleteOne := em.getConnection().Database(em.Database).Collection(table).DeleteOne(em.context, em.convertCriteriaToBSONCriteria(criteria))
 
 	if errorDeleteOne != nil {
@@ -218,6 +275,196 @@ func (em *EntityManager) DeleteOne(table string, criteria *persistence.Criteria)
 	return true, nil
 }
 
+// FindText searches the text by the text index of the table, the index is created with the weights of the fields
+// on the first search. The words of the text are stemmed by the rules of English.
+func (em *EntityManager) FindText(table string, criteria *persistence.Criteria, text string, weights map[string]int32) ([]interface{}, []float64, error) {
+	var (
+		entities []interface{}
+		scores   []float64
+	)
+
+	errorTextIndex := em.ensureTextIndex(table, weights)
+
+	if errorTextIndex != nil {
+		return nil, nil, errorTextIndex
+	}
+
+	filter := em.convertCriteriaToBSONCriteria(criteria)
+	filter["$text"] = bson.M{"$search": text}
+	score := bson.M{"$meta": "textScore"}
+	findOptions := options.Find().SetProjection(bson.M{"score": score}).SetSort(bson.D{bson.E{Key: "score", Value: score}})
+
+	if criteria != nil && criteria.Limit > 0 {
+		findOptions.SetLimit(int64(criteria.Limit))
+	}
+
+	if criteria != nil && criteria.Offset > 0 {
+		findOptions.SetSkip(int64(criteria.Offset))
+	}
+
+	cursor, errorFind := em.getConnection().Database(em.Database).Collection(table).Find(em.context, filter, findOptions)
+
+	if errorFind != nil {
+		return nil, nil, errors.Wrapf(errorFind, "an error occurred while searching the text in the database by provided data %p", criteria)
+	}
+
+	for cursor.Next(context.TODO()) {
+		entity := bson.M{}
+		errorDecode := cursor.Decode(&entity)
+
+		if errorDecode != nil {
+			return nil, nil, errors.Wrapf(errorDecode, "an error occurred while decoding a result from the database by provided data %p", criteria)
+		}
+
+		entityScore, _ := entity["score"].(float64)
+		delete(entity, "score")
+
+		entities = append(entities, entity)
+		scores = append(scores, entityScore)
+	}
+
+	if errorCursor := cursor.Err(); errorCursor != nil {
+		return nil, nil, errors.Wrapf(errorCursor, "an error occurred while processing results from the database by provided data %p", criteria)
+	}
+
+	errorClose := cursor.Close(context.TODO())
+
+	if errorClose != nil {
+		return nil, nil, errors.Wrap(errorClose, "an error occurred while closing a cursor")
+	}
+
+	return entities, scores, nil
+}
+
+func (em *EntityManager) ensureTextIndex(table string, weights map[string]int32) error {
+	if _, ok := em.textIndexes.Load(table); ok {
+		return nil
+	}
+
+	fields := make([]string, 0, len(weights))
+
+	for field := range weights {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	keys := bson.D{}
+	bsonWeights := bson.D{}
+
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: "text"})
+		bsonWeights = append(bsonWeights, bson.E{Key: field, Value: weights[field]})
+	}
+
+	_, errorCreateOne := em.getConnection().Database(em.Database).Collection(table).Indexes().CreateOne(
+		em.context,
+		mongo.IndexModel{
+			Keys:    keys,
+			Options: options.Index().SetName(fmt.Sprintf("%s_text", table)).SetWeights(bsonWeights).SetDefaultLanguage("english"),
+		},
+	)
+
+	if errorCreateOne != nil {
+		return errors.Wrapf(errorCreateOne, "an error occurred while creating a text index of the table %s", table)
+	}
+
+	em.textIndexes.Store(table, true)
+
+	return nil
+}
+
+// EnsureUniqueIndex creates the unique index of the fields of the table once, the fields go in the given order.
+func (em *EntityManager) EnsureUniqueIndex(table string, fields []string) error {
+	name := fmt.Sprintf("%s_%s_unique", table, strings.Join(fields, "_"))
+
+	if _, ok := em.uniqueIndexes.Load(name); ok {
+		return nil
+	}
+
+	keys := bson.D{}
+
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: 1})
+	}
+
+	_, errorCreateOne := em.getConnection().Database(em.Database).Collection(table).Indexes().CreateOne(
+		em.context,
+		mongo.IndexModel{
+			Keys:    keys,
+			Options: options.Index().SetName(name).SetUnique(true),
+		},
+	)
+
+	if errorCreateOne != nil {
+		return errors.Wrapf(errorCreateOne, "an error occurred while creating a unique index of the table %s", table)
+	}
+
+	em.uniqueIndexes.Store(name, true)
+
+	return nil
+}
+
+// getCached returns the cached result of the criteria, the uncached criteria are always missed.
+func (em *EntityManager) getCached(keyCache []byte, criteria *persistence.Criteria) (any, error) {
+	if criteria.Uncached {
+		return nil, errorCriteriaUncached
+	}
+
+	return em.CacheManager.Get(keyCache)
+}
+
+// setCached caches the result of the criteria unless the table has been written since the generation was got.
+func (em *EntityManager) setCached(table string, generation uint64, keyCache []byte, criteria *persistence.Criteria, result any) {
+	if criteria.Uncached {
+		return
+	}
+
+	cachedTableItem := em.getCachedTable(table)
+
+	cachedTableItem.mutex.Lock()
+	defer cachedTableItem.mutex.Unlock()
+
+	if cachedTableItem.generation != generation {
+		return
+	}
+
+	cachedTableItem.keys[string(keyCache)] = struct{}{}
+
+	em.CacheManager.Set(keyCache, result, nil)
+}
+
+// evictCached deletes the cached results of the table, it is called by every write to the table.
+func (em *EntityManager) evictCached(table string) {
+	cachedTableItem := em.getCachedTable(table)
+
+	cachedTableItem.mutex.Lock()
+	defer cachedTableItem.mutex.Unlock()
+
+	cachedTableItem.generation++
+
+	for keyCache := range cachedTableItem.keys {
+		_ = em.CacheManager.Delete([]byte(keyCache))
+	}
+
+	cachedTableItem.keys = map[string]struct{}{}
+}
+
+func (em *EntityManager) getCachedGeneration(table string) uint64 {
+	cachedTableItem := em.getCachedTable(table)
+
+	cachedTableItem.mutex.Lock()
+	defer cachedTableItem.mutex.Unlock()
+
+	return cachedTableItem.generation
+}
+
+func (em *EntityManager) getCachedTable(table string) *cachedTable {
+	cachedTableItem, _ := em.cachedTables.LoadOrStore(table, &cachedTable{keys: map[string]struct{}{}})
+
+	return cachedTableItem.(*cachedTable)
+}
+
 func (em *EntityManager) convertCriteriaToBSONCriteria(criteria *persistence.Criteria) bson.M {
 	bsonCriteria := bson.M{}
 
@@ -225,7 +472,8 @@ func (em *EntityManager) convertCriteriaToBSONCriteria(criteria *persistence.Cri
 		for key, value := range criteria.Where {
 			reflectValue := reflect.ValueOf(value)
 
-			if reflectValue.Kind() == reflect.Slice {
+			// the values of the operators, e.g. $and and $or, are passed as they are
+			if reflectValue.Kind() == reflect.Slice && !strings.HasPrefix(key, "$") {
 				valueBSONA := bson.A{}
 
 				for i := 0; i < reflectValue.Len(); i++ {
@@ -242,6 +490,40 @@ func (em *EntityManager) convertCriteriaToBSONCriteria(criteria *persistence.Cri
 	return bsonCriteria
 }
 
+// convertCriteriaToFindOptions applies the order, the limit and the offset of the criteria, the fields of the order go
+// in the alphabetical order.
+func (em *EntityManager) convertCriteriaToFindOptions(criteria *persistence.Criteria) *options.FindOptions {
+	findOptions := options.Find()
+
+	if len(criteria.Order) > 0 {
+		fields := make([]string, 0, len(criteria.Order))
+
+		for field := range criteria.Order {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		sortBSON := bson.D{}
+
+		for _, field := range fields {
+			sortBSON = append(sortBSON, bson.E{Key: field, Value: criteria.Order[field]})
+		}
+
+		findOptions.SetSort(sortBSON)
+	}
+
+	if criteria.Limit > 0 {
+		findOptions.SetLimit(int64(criteria.Limit))
+	}
+
+	if criteria.Offset > 0 {
+		findOptions.SetSkip(int64(criteria.Offset))
+	}
+
+	return findOptions
+}
+
 func (em *EntityManager) convertWrapperToBSONWrapper(wrapper *persistence.Wrapper) bson.M {
 	bsonWrapper := bson.M{}
 
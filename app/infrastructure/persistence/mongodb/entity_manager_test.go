@@ -0,0 +1,110 @@
+package mongodb
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/cache/in_memory"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+)
+
+func testEntityManager() *EntityManager {
+	cacheManager := &in_memory.CacheManager{Namespace: "test", Type: cache.InMemoryType, TTL: 1}
+	cacheManager.SetDriver()
+
+	return &EntityManager{Database: "test", Type: persistence.MongoType, CacheManager: cacheManager}
+}
+
+func TestEntityManagerEvictCached(t *testing.T) {
+	id := uuid.New()
+	criteria := &persistence.Criteria{Where: map[string]interface{}{"id": &id}}
+
+	t.Run(
+		"Test case with a write to the table",
+		func(t *testing.T) {
+			em := testEntityManager()
+			keyCache := cache.PrepareKey("recipe", criteria)
+			keyCacheOther := cache.PrepareKey("planner", criteria)
+
+			em.setCached("recipe", em.getCachedGeneration("recipe"), keyCache, criteria, bson.M{"id": id})
+			em.setCached("planner", em.getCachedGeneration("planner"), keyCacheOther, criteria, bson.M{"id": id})
+
+			cached, errorCached := em.getCached(keyCache, criteria)
+
+			assert.Nil(t, errorCached)
+			assert.Equal(t, bson.M{"id": id}, cached)
+
+			em.evictCached("recipe")
+
+			_, errorCached = em.getCached(keyCache, criteria)
+
+			assert.NotNil(t, errorCached)
+
+			_, errorCached = em.getCached(keyCacheOther, criteria)
+
+			assert.Nil(t, errorCached)
+		},
+	)
+
+	t.Run(
+		"Test case with a result read before a write",
+		func(t *testing.T) {
+			em := testEntityManager()
+			keyCache := cache.PrepareKey("recipe", criteria)
+			generation := em.getCachedGeneration("recipe")
+
+			em.evictCached("recipe")
+			em.setCached("recipe", generation, keyCache, criteria, bson.M{"id": id})
+
+			_, errorCached := em.getCached(keyCache, criteria)
+
+			assert.NotNil(t, errorCached)
+		},
+	)
+
+	t.Run(
+		"Test case with the uncached criteria",
+		func(t *testing.T) {
+			em := testEntityManager()
+			criteriaUncached := &persistence.Criteria{Where: criteria.Where, Uncached: true}
+			keyCache := cache.PrepareKey("recipe", criteriaUncached)
+
+			em.setCached("recipe", em.getCachedGeneration("recipe"), keyCache, criteriaUncached, bson.M{"id": id})
+
+			_, errorCached := em.getCached(keyCache, &persistence.Criteria{Where: criteria.Where})
+
+			assert.NotNil(t, errorCached)
+		},
+	)
+}
+
+func TestEntityManagerConvertCriteria(t *testing.T) {
+	em := testEntityManager()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	words := bson.A{bson.M{"name": "a"}, bson.M{"name": "b"}}
+	criteria := &persistence.Criteria{
+		Where:  map[string]interface{}{"id": ids, "$and": words},
+		Order:  map[string]interface{}{"name": 1, "date_insert": -1},
+		Limit:  10,
+		Offset: 20,
+	}
+
+	bsonCriteria := em.convertCriteriaToBSONCriteria(criteria)
+
+	assert.Equal(t, bson.D{{Key: "$in", Value: bson.A{ids[0], ids[1]}}}, bsonCriteria["id"])
+	assert.Equal(t, words, bsonCriteria["$and"])
+
+	findOptions := em.convertCriteriaToFindOptions(criteria)
+
+	assert.Equal(t, bson.D{{Key: "date_insert", Value: -1}, {Key: "name", Value: 1}}, findOptions.Sort)
+	assert.Equal(t, int64(10), *findOptions.Limit)
+	assert.Equal(t, int64(20), *findOptions.Skip)
+
+	findOptions = em.convertCriteriaToFindOptions(&persistence.Criteria{})
+
+	assert.Nil(t, findOptions.Sort)
+	assert.Nil(t, findOptions.Limit)
+	assert.Nil(t, findOptions.Skip)
+}
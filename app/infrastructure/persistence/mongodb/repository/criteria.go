@@ -4,6 +4,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	PersistenceRepository "github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"regexp"
 )
 
 var CriteriaRepository = PersistenceRepository.CriteriaRepository{
@@ -59,6 +62,32 @@ var CriteriaRepository = PersistenceRepository.CriteriaRepository{
 
 		return criteria
 	},
+	GetCriteriaByDeriveIds: func(id []*uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["derive_id"] = id
+
+		return criteria
+	},
+	GetCriteriaByIngredientId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["ingredient_id"] = id
+
+		return criteria
+	},
 	GetCriteriaByUnitId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
 		if criteria == nil {
 			criteria = &persistence.Criteria{
@@ -72,6 +101,45 @@ var CriteriaRepository = PersistenceRepository.CriteriaRepository{
 
 		return criteria
 	},
+	GetCriteriaByCategoryId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["category_id"] = id
+
+		return criteria
+	},
+	GetCriteriaBySubstituteId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["substitute_id"] = id
+
+		return criteria
+	},
+	GetCriteriaBySubjectId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["subject_id"] = id
+
+		return criteria
+	},
 	GetCriteriaByRecipeId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
 		if criteria == nil {
 			criteria = &persistence.Criteria{
@@ -98,6 +166,19 @@ var CriteriaRepository = PersistenceRepository.CriteriaRepository{
 
 		return criteria
 	},
+	GetCriteriaByRoleId: func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["role_id"] = id
+
+		return criteria
+	},
 	GetCriteriaByName: func(name *string, criteria *persistence.Criteria) *persistence.Criteria {
 		if criteria == nil {
 			criteria = &persistence.Criteria{
@@ -109,6 +190,71 @@ var CriteriaRepository = PersistenceRepository.CriteriaRepository{
 
 		criteria.Where["name"] = name
 
+		return criteria
+	},
+	GetCriteriaByNameFold: func(name *string, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["name"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(*name) + "$", Options: "i"}
+
+		return criteria
+	},
+	GetCriteriaByText: func(text *string, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["$text"] = bson.M{"$search": *text}
+
+		return criteria
+	},
+	GetCriteriaByCode: func(code *string, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["code"] = code
+
+		return criteria
+	},
+	GetCriteriaByCalendarToken: func(token *string, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["calendar_token"] = token
+
+		return criteria
+	},
+	GetCriteriaByStatus: func(status *string, criteria *persistence.Criteria) *persistence.Criteria {
+		if criteria == nil {
+			criteria = &persistence.Criteria{
+				Where: map[string]interface{}{},
+			}
+		} else if criteria.Where == nil {
+			criteria.Where = map[string]interface{}{}
+		}
+
+		criteria.Where["status"] = status
+
 		return criteria
 	},
 }
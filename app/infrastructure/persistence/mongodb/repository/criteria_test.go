@@ -2,8 +2,11 @@ package repository
 
 import (
 	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"testing"
 )
 
@@ -257,6 +260,130 @@ func TestGetCriteriaByDeriveId(t *testing.T) {
 	}
 }
 
+func TestGetCriteriaByDeriveIds(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Id          []*uuid.UUID
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByDeriveIds with empty criteria",
+			Id:       []*uuid.UUID{&testId},
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"derive_id": []*uuid.UUID{&testId}},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByDeriveIds with not empty criteria",
+			Id:   []*uuid.UUID{&testId},
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"derive_id": []*uuid.UUID{&testId}},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByDeriveIds(testCase.Id, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByIngredientId(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Id          uuid.UUID
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByIngredientId with empty criteria",
+			Id:       testId,
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"ingredient_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByIngredientId with not empty criteria",
+			Id:   testId,
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"ingredient_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByIngredientId(&testCase.Id, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
 func TestGetCriteriaByUnitId(t *testing.T) {
 	tests := []struct {
 		Name        string
@@ -319,7 +446,7 @@ func TestGetCriteriaByUnitId(t *testing.T) {
 	}
 }
 
-func TestGetCriteriaByRecipeId(t *testing.T) {
+func TestGetCriteriaByCategoryId(t *testing.T) {
 	tests := []struct {
 		Name        string
 		Id          uuid.UUID
@@ -329,17 +456,17 @@ func TestGetCriteriaByRecipeId(t *testing.T) {
 		MustBeFault bool
 	}{
 		{
-			Name:     "Test case with GetCriteriaByRecipeId with empty criteria",
+			Name:     "Test case with GetCriteriaByCategoryId with empty criteria",
 			Id:       testId,
 			Criteria: nil,
 			Expected: &persistence.Criteria{
-				Where: map[string]interface{}{"recipe_id": &testId},
+				Where: map[string]interface{}{"category_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
 		},
 		{
-			Name: "Test case with GetCriteriaByRecipeId with not empty criteria",
+			Name: "Test case with GetCriteriaByCategoryId with not empty criteria",
 			Id:   testId,
 			Criteria: &persistence.Criteria{
 				Limit:  100,
@@ -350,7 +477,7 @@ func TestGetCriteriaByRecipeId(t *testing.T) {
 				Limit:  100,
 				Offset: 100,
 				Order:  map[string]interface{}{"sort": "ASC"},
-				Where:  map[string]interface{}{"recipe_id": &testId},
+				Where:  map[string]interface{}{"category_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
@@ -369,7 +496,7 @@ func TestGetCriteriaByRecipeId(t *testing.T) {
 					}
 				}()
 
-				actualCriteria := CriteriaRepository.GetCriteriaByRecipeId(&testCase.Id, testCase.Criteria)
+				actualCriteria := CriteriaRepository.GetCriteriaByCategoryId(&testCase.Id, testCase.Criteria)
 
 				if testCase.MustBeFault {
 					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
@@ -381,7 +508,7 @@ func TestGetCriteriaByRecipeId(t *testing.T) {
 	}
 }
 
-func TestGetCriteriaByUserId(t *testing.T) {
+func TestGetCriteriaBySubstituteId(t *testing.T) {
 	tests := []struct {
 		Name        string
 		Id          uuid.UUID
@@ -391,17 +518,17 @@ func TestGetCriteriaByUserId(t *testing.T) {
 		MustBeFault bool
 	}{
 		{
-			Name:     "Test case with GetCriteriaByUserId with empty criteria",
+			Name:     "Test case with GetCriteriaBySubstituteId with empty criteria",
 			Id:       testId,
 			Criteria: nil,
 			Expected: &persistence.Criteria{
-				Where: map[string]interface{}{"user_id": &testId},
+				Where: map[string]interface{}{"substitute_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
 		},
 		{
-			Name: "Test case with GetCriteriaByUserId with not empty criteria",
+			Name: "Test case with GetCriteriaBySubstituteId with not empty criteria",
 			Id:   testId,
 			Criteria: &persistence.Criteria{
 				Limit:  100,
@@ -412,7 +539,7 @@ func TestGetCriteriaByUserId(t *testing.T) {
 				Limit:  100,
 				Offset: 100,
 				Order:  map[string]interface{}{"sort": "ASC"},
-				Where:  map[string]interface{}{"user_id": &testId},
+				Where:  map[string]interface{}{"substitute_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
@@ -431,7 +558,7 @@ func TestGetCriteriaByUserId(t *testing.T) {
 					}
 				}()
 
-				actualCriteria := CriteriaRepository.GetCriteriaByUserId(&testCase.Id, testCase.Criteria)
+				actualCriteria := CriteriaRepository.GetCriteriaBySubstituteId(&testCase.Id, testCase.Criteria)
 
 				if testCase.MustBeFault {
 					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
@@ -443,10 +570,134 @@ func TestGetCriteriaByUserId(t *testing.T) {
 	}
 }
 
-func TestGetCriteriaByName(t *testing.T) {
-	name := "name"
+func TestGetCriteriaBySubjectId(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Id          uuid.UUID
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaBySubjectId with empty criteria",
+			Id:       testId,
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"subject_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaBySubjectId with not empty criteria",
+			Id:   testId,
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"subject_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaBySubjectId(&testCase.Id, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByRecipeId(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Id          uuid.UUID
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByRecipeId with empty criteria",
+			Id:       testId,
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"recipe_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByRecipeId with not empty criteria",
+			Id:   testId,
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"recipe_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByRecipeId(&testCase.Id, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByUserId(t *testing.T) {
 	tests := []struct {
 		Name        string
+		Id          uuid.UUID
 		Criteria    *persistence.Criteria
 		Expected    *persistence.Criteria
 		MustBePanic bool
@@ -454,15 +705,17 @@ func TestGetCriteriaByName(t *testing.T) {
 	}{
 		{
 			Name:     "Test case with GetCriteriaByUserId with empty criteria",
+			Id:       testId,
 			Criteria: nil,
 			Expected: &persistence.Criteria{
-				Where: map[string]interface{}{"name": &name},
+				Where: map[string]interface{}{"user_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
 		},
 		{
 			Name: "Test case with GetCriteriaByUserId with not empty criteria",
+			Id:   testId,
 			Criteria: &persistence.Criteria{
 				Limit:  100,
 				Offset: 100,
@@ -472,7 +725,7 @@ func TestGetCriteriaByName(t *testing.T) {
 				Limit:  100,
 				Offset: 100,
 				Order:  map[string]interface{}{"sort": "ASC"},
-				Where:  map[string]interface{}{"name": &name},
+				Where:  map[string]interface{}{"user_id": &testId},
 			},
 			MustBePanic: false,
 			MustBeFault: false,
@@ -491,7 +744,7 @@ func TestGetCriteriaByName(t *testing.T) {
 					}
 				}()
 
-				actualCriteria := CriteriaRepository.GetCriteriaByName(&name, testCase.Criteria)
+				actualCriteria := CriteriaRepository.GetCriteriaByUserId(&testCase.Id, testCase.Criteria)
 
 				if testCase.MustBeFault {
 					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
@@ -502,3 +755,425 @@ func TestGetCriteriaByName(t *testing.T) {
 		)
 	}
 }
+
+func TestGetCriteriaByRoleId(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Id          uuid.UUID
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByRoleId with empty criteria",
+			Id:       testId,
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"role_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByRoleId with not empty criteria",
+			Id:   testId,
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"role_id": &testId},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByRoleId(&testCase.Id, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByName(t *testing.T) {
+	name := "name"
+	tests := []struct {
+		Name        string
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByUserId with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"name": &name},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByUserId with not empty criteria",
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"name": &name},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByName(&name, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByCode(t *testing.T) {
+	code := "code"
+	tests := []struct {
+		Name        string
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByCode with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"code": &code},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByCode with not empty criteria",
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"code": &code},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByCode(&code, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByCalendarToken(t *testing.T) {
+	token := "token"
+	tests := []struct {
+		Name        string
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByCalendarToken with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"calendar_token": &token},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByCalendarToken with not empty criteria",
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"calendar_token": &token},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByCalendarToken(&token, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByStatus(t *testing.T) {
+	status := "published"
+	tests := []struct {
+		Name        string
+		Criteria    *persistence.Criteria
+		Expected    *persistence.Criteria
+		MustBePanic bool
+		MustBeFault bool
+	}{
+		{
+			Name:     "Test case with GetCriteriaByStatus with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"status": &status},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+		{
+			Name: "Test case with GetCriteriaByStatus with not empty criteria",
+			Criteria: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+			},
+			Expected: &persistence.Criteria{
+				Limit:  100,
+				Offset: 100,
+				Order:  map[string]interface{}{"sort": "ASC"},
+				Where:  map[string]interface{}{"status": &status},
+			},
+			MustBePanic: false,
+			MustBeFault: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				defer func() {
+					if testCase.MustBePanic {
+						assert.NotNil(t, recover())
+					} else {
+						assert.Nil(t, recover())
+					}
+				}()
+
+				actualCriteria := CriteriaRepository.GetCriteriaByStatus(&status, testCase.Criteria)
+
+				if testCase.MustBeFault {
+					assert.NotEqual(t, *testCase.Expected, *actualCriteria)
+				} else {
+					assert.Equal(t, *testCase.Expected, *actualCriteria)
+				}
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByNameFold(t *testing.T) {
+	name := "Egg (large)"
+	pattern := primitive.Regex{Pattern: `^Egg \(large\)$`, Options: "i"}
+	tests := []struct {
+		Name     string
+		Criteria *persistence.Criteria
+		Expected *persistence.Criteria
+	}{
+		{
+			Name:     "Test case with GetCriteriaByNameFold with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"name": pattern},
+			},
+		},
+		{
+			Name:     "Test case with GetCriteriaByNameFold with not empty criteria",
+			Criteria: &persistence.Criteria{Uncached: true},
+			Expected: &persistence.Criteria{
+				Where:    map[string]interface{}{"name": pattern},
+				Uncached: true,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, *testCase.Expected, *CriteriaRepository.GetCriteriaByNameFold(&name, testCase.Criteria))
+			},
+		)
+	}
+}
+
+func TestGetCriteriaByText(t *testing.T) {
+	text := "pancakes"
+	tests := []struct {
+		Name     string
+		Criteria *persistence.Criteria
+		Expected *persistence.Criteria
+	}{
+		{
+			Name:     "Test case with GetCriteriaByText with empty criteria",
+			Criteria: nil,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{"$text": bson.M{"$search": text}},
+			},
+		},
+		{
+			Name:     "Test case with GetCriteriaByText with not empty criteria",
+			Criteria: &persistence.Criteria{Limit: 20, Offset: 40},
+			Expected: &persistence.Criteria{
+				Where:  map[string]interface{}{"$text": bson.M{"$search": text}},
+				Limit:  20,
+				Offset: 40,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, *testCase.Expected, *CriteriaRepository.GetCriteriaByText(&text, testCase.Criteria))
+			},
+		)
+	}
+}
+
+func TestUserRepositoryGetCriteriaByQuery(t *testing.T) {
+	userRepository := &UserRepository{}
+	pattern := primitive.Regex{Pattern: `jane\.doe`, Options: "i"}
+
+	tests := []struct {
+		Name     string
+		Query    string
+		Status   kind.UserStatus
+		Expected *persistence.Criteria
+	}{
+		{
+			Name: "Test case with GetCriteriaByQuery with empty query",
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{},
+				Order: map[string]interface{}{userFieldUsername: 1},
+			},
+		},
+		{
+			Name:   "Test case with GetCriteriaByQuery with query and status",
+			Query:  " jane.doe ",
+			Status: kind.UserStatusDisabled,
+			Expected: &persistence.Criteria{
+				Where: map[string]interface{}{
+					userFieldStatus: kind.UserStatusDisabled,
+					"$and": bson.A{
+						bson.M{
+							"$or": bson.A{
+								bson.M{userFieldUsername: pattern},
+								bson.M{userFieldName: pattern},
+								bson.M{userFieldSurname: pattern},
+								bson.M{userFieldMiddleName: pattern},
+							},
+						},
+					},
+				},
+				Order: map[string]interface{}{userFieldUsername: 1},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.Name,
+			func(t *testing.T) {
+				assert.Equal(t, testCase.Expected, userRepository.GetCriteriaByQuery(testCase.Query, testCase.Status))
+			},
+		)
+	}
+}
@@ -0,0 +1,341 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type HouseholdRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.HouseholdRepositoryInterface
+}
+
+func (hr *HouseholdRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.Household, error) {
+	entity, errorFindOne := hr.EntityManager.FindOne(hr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.Household{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (hr *HouseholdRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.Household, error) {
+	var households []*DomainEntity.Household
+
+	entities, errorFindAll := hr.EntityManager.FindAll(hr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.Household{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		households = append(households, &result)
+	}
+
+	return households, nil
+}
+
+func (hr *HouseholdRepository) InsertOne(entity *DomainEntity.Household) (*DomainEntity.Household, error) {
+	_, errorInsertOne := hr.EntityManager.InsertOne(hr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hr *HouseholdRepository) InsertMany(entities []*DomainEntity.Household) ([]*DomainEntity.Household, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := hr.EntityManager.InsertMany(hr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (hr *HouseholdRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.Household) (*DomainEntity.Household, error) {
+	_, errorInsertOne := hr.EntityManager.UpdateOne(hr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hr *HouseholdRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.Household) ([]*DomainEntity.Household, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := hr.EntityManager.UpdateMany(hr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (hr *HouseholdRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return hr.EntityManager.DeleteOne(hr.Table, criteria)
+}
+
+func (hr *HouseholdRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
+
+type HouseholdMemberRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.HouseholdMemberRepositoryInterface
+}
+
+func (hmr *HouseholdMemberRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.HouseholdMember, error) {
+	entity, errorFindOne := hmr.EntityManager.FindOne(hmr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.HouseholdMember{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (hmr *HouseholdMemberRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.HouseholdMember, error) {
+	var householdMembers []*DomainEntity.HouseholdMember
+
+	entities, errorFindAll := hmr.EntityManager.FindAll(hmr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.HouseholdMember{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		householdMembers = append(householdMembers, &result)
+	}
+
+	return householdMembers, nil
+}
+
+func (hmr *HouseholdMemberRepository) InsertOne(entity *DomainEntity.HouseholdMember) (*DomainEntity.HouseholdMember, error) {
+	_, errorInsertOne := hmr.EntityManager.InsertOne(hmr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hmr *HouseholdMemberRepository) InsertMany(entities []*DomainEntity.HouseholdMember) ([]*DomainEntity.HouseholdMember, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := hmr.EntityManager.InsertMany(hmr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (hmr *HouseholdMemberRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.HouseholdMember) (*DomainEntity.HouseholdMember, error) {
+	_, errorInsertOne := hmr.EntityManager.UpdateOne(hmr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hmr *HouseholdMemberRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.HouseholdMember) ([]*DomainEntity.HouseholdMember, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := hmr.EntityManager.UpdateMany(hmr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (hmr *HouseholdMemberRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return hmr.EntityManager.DeleteOne(hmr.Table, criteria)
+}
+
+func (hmr *HouseholdMemberRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
+
+type HouseholdGrantRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.HouseholdGrantRepositoryInterface
+}
+
+func (hgr *HouseholdGrantRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.HouseholdGrant, error) {
+	entity, errorFindOne := hgr.EntityManager.FindOne(hgr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.HouseholdGrant{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (hgr *HouseholdGrantRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.HouseholdGrant, error) {
+	var householdGrants []*DomainEntity.HouseholdGrant
+
+	entities, errorFindAll := hgr.EntityManager.FindAll(hgr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.HouseholdGrant{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		householdGrants = append(householdGrants, &result)
+	}
+
+	return householdGrants, nil
+}
+
+func (hgr *HouseholdGrantRepository) InsertOne(entity *DomainEntity.HouseholdGrant) (*DomainEntity.HouseholdGrant, error) {
+	_, errorInsertOne := hgr.EntityManager.InsertOne(hgr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hgr *HouseholdGrantRepository) InsertMany(entities []*DomainEntity.HouseholdGrant) ([]*DomainEntity.HouseholdGrant, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := hgr.EntityManager.InsertMany(hgr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (hgr *HouseholdGrantRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.HouseholdGrant) (*DomainEntity.HouseholdGrant, error) {
+	_, errorInsertOne := hgr.EntityManager.UpdateOne(hgr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (hgr *HouseholdGrantRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.HouseholdGrant) ([]*DomainEntity.HouseholdGrant, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := hgr.EntityManager.UpdateMany(hgr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (hgr *HouseholdGrantRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return hgr.EntityManager.DeleteOne(hgr.Table, criteria)
+}
+
+func (hgr *HouseholdGrantRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
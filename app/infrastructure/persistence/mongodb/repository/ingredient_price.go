@@ -0,0 +1,119 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type IngredientPriceRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.IngredientPriceRepositoryInterface
+}
+
+func (ipr *IngredientPriceRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.IngredientPrice, error) {
+	entity, errorFindOne := ipr.EntityManager.FindOne(ipr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.IngredientPrice{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (ipr *IngredientPriceRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.IngredientPrice, error) {
+	var ingredientPrices []*DomainEntity.IngredientPrice
+
+	entities, errorFindAll := ipr.EntityManager.FindAll(ipr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.IngredientPrice{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		ingredientPrices = append(ingredientPrices, &result)
+	}
+
+	return ingredientPrices, nil
+}
+
+func (ipr *IngredientPriceRepository) InsertOne(entity *DomainEntity.IngredientPrice) (*DomainEntity.IngredientPrice, error) {
+	_, errorInsertOne := ipr.EntityManager.InsertOne(ipr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ipr *IngredientPriceRepository) InsertMany(entities []*DomainEntity.IngredientPrice) ([]*DomainEntity.IngredientPrice, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := ipr.EntityManager.InsertMany(ipr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (ipr *IngredientPriceRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.IngredientPrice) (*DomainEntity.IngredientPrice, error) {
+	_, errorInsertOne := ipr.EntityManager.UpdateOne(ipr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ipr *IngredientPriceRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.IngredientPrice) ([]*DomainEntity.IngredientPrice, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := ipr.EntityManager.UpdateMany(ipr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (ipr *IngredientPriceRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return ipr.EntityManager.DeleteOne(ipr.Table, criteria)
+}
+
+func (ipr *IngredientPriceRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type IngredientSubstituteRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.IngredientSubstituteRepositoryInterface
+}
+
+func (isr *IngredientSubstituteRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.IngredientSubstitute, error) {
+	entity, errorFindOne := isr.EntityManager.FindOne(isr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.IngredientSubstitute{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (isr *IngredientSubstituteRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.IngredientSubstitute, error) {
+	var ingredientSubstitutes []*DomainEntity.IngredientSubstitute
+
+	entities, errorFindAll := isr.EntityManager.FindAll(isr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.IngredientSubstitute{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		ingredientSubstitutes = append(ingredientSubstitutes, &result)
+	}
+
+	return ingredientSubstitutes, nil
+}
+
+func (isr *IngredientSubstituteRepository) InsertOne(entity *DomainEntity.IngredientSubstitute) (*DomainEntity.IngredientSubstitute, error) {
+	_, errorInsertOne := isr.EntityManager.InsertOne(isr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (isr *IngredientSubstituteRepository) InsertMany(entities []*DomainEntity.IngredientSubstitute) ([]*DomainEntity.IngredientSubstitute, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := isr.EntityManager.InsertMany(isr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (isr *IngredientSubstituteRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.IngredientSubstitute) (*DomainEntity.IngredientSubstitute, error) {
+	_, errorInsertOne := isr.EntityManager.UpdateOne(isr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (isr *IngredientSubstituteRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.IngredientSubstitute) ([]*DomainEntity.IngredientSubstitute, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := isr.EntityManager.UpdateMany(isr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (isr *IngredientSubstituteRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return isr.EntityManager.DeleteOne(isr.Table, criteria)
+}
+
+func (isr *IngredientSubstituteRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type PantryItemRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.PantryItemRepositoryInterface
+}
+
+func (pir *PantryItemRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.PantryItem, error) {
+	entity, errorFindOne := pir.EntityManager.FindOne(pir.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.PantryItem{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (pir *PantryItemRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.PantryItem, error) {
+	var pantryItems []*DomainEntity.PantryItem
+
+	entities, errorFindAll := pir.EntityManager.FindAll(pir.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.PantryItem{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		pantryItems = append(pantryItems, &result)
+	}
+
+	return pantryItems, nil
+}
+
+func (pir *PantryItemRepository) InsertOne(entity *DomainEntity.PantryItem) (*DomainEntity.PantryItem, error) {
+	_, errorInsertOne := pir.EntityManager.InsertOne(pir.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (pir *PantryItemRepository) InsertMany(entities []*DomainEntity.PantryItem) ([]*DomainEntity.PantryItem, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := pir.EntityManager.InsertMany(pir.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (pir *PantryItemRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.PantryItem) (*DomainEntity.PantryItem, error) {
+	_, errorInsertOne := pir.EntityManager.UpdateOne(pir.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (pir *PantryItemRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.PantryItem) ([]*DomainEntity.PantryItem, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := pir.EntityManager.UpdateMany(pir.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (pir *PantryItemRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return pir.EntityManager.DeleteOne(pir.Table, criteria)
+}
+
+func (pir *PantryItemRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type PlannerTemplateRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.PlannerTemplateRepositoryInterface
+}
+
+func (ptr *PlannerTemplateRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.PlannerTemplate, error) {
+	entity, errorFindOne := ptr.EntityManager.FindOne(ptr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.PlannerTemplate{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (ptr *PlannerTemplateRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.PlannerTemplate, error) {
+	var plannerTemplates []*DomainEntity.PlannerTemplate
+
+	entities, errorFindAll := ptr.EntityManager.FindAll(ptr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.PlannerTemplate{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		plannerTemplates = append(plannerTemplates, &result)
+	}
+
+	return plannerTemplates, nil
+}
+
+func (ptr *PlannerTemplateRepository) InsertOne(entity *DomainEntity.PlannerTemplate) (*DomainEntity.PlannerTemplate, error) {
+	_, errorInsertOne := ptr.EntityManager.InsertOne(ptr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ptr *PlannerTemplateRepository) InsertMany(entities []*DomainEntity.PlannerTemplate) ([]*DomainEntity.PlannerTemplate, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := ptr.EntityManager.InsertMany(ptr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (ptr *PlannerTemplateRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.PlannerTemplate) (*DomainEntity.PlannerTemplate, error) {
+	_, errorInsertOne := ptr.EntityManager.UpdateOne(ptr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ptr *PlannerTemplateRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.PlannerTemplate) ([]*DomainEntity.PlannerTemplate, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := ptr.EntityManager.UpdateMany(ptr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (ptr *PlannerTemplateRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return ptr.EntityManager.DeleteOne(ptr.Table, criteria)
+}
+
+func (ptr *PlannerTemplateRepository) ClaimRecurrence(id *uuid.UUID, recurrences int64) (*DomainEntity.PlannerTemplate, error) {
+	criteria := &persistence.Criteria{
+		Where: map[string]interface{}{
+			"id":          id,
+			"recurrences": recurrences,
+		},
+		Uncached: true,
+	}
+
+	entity, errorIncrementOne := ptr.EntityManager.IncrementOne(ptr.Table, criteria, "recurrences", 1)
+
+	if errors.Is(errorIncrementOne, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if errorIncrementOne != nil {
+		return nil, errorIncrementOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.PlannerTemplate{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (ptr *PlannerTemplateRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type PlannerTemplateIntervalRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.PlannerTemplateIntervalRepositoryInterface
+}
+
+func (ptir *PlannerTemplateIntervalRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.PlannerTemplateInterval, error) {
+	entity, errorFindOne := ptir.EntityManager.FindOne(ptir.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.PlannerTemplateInterval{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.PlannerTemplateInterval, error) {
+	var plannerTemplateIntervals []*DomainEntity.PlannerTemplateInterval
+
+	entities, errorFindAll := ptir.EntityManager.FindAll(ptir.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.PlannerTemplateInterval{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		plannerTemplateIntervals = append(plannerTemplateIntervals, &result)
+	}
+
+	return plannerTemplateIntervals, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) InsertOne(entity *DomainEntity.PlannerTemplateInterval) (*DomainEntity.PlannerTemplateInterval, error) {
+	_, errorInsertOne := ptir.EntityManager.InsertOne(ptir.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) InsertMany(entities []*DomainEntity.PlannerTemplateInterval) ([]*DomainEntity.PlannerTemplateInterval, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := ptir.EntityManager.InsertMany(ptir.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.PlannerTemplateInterval) (*DomainEntity.PlannerTemplateInterval, error) {
+	_, errorInsertOne := ptir.EntityManager.UpdateOne(ptir.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.PlannerTemplateInterval) ([]*DomainEntity.PlannerTemplateInterval, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := ptir.EntityManager.UpdateMany(ptir.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (ptir *PlannerTemplateIntervalRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return ptir.EntityManager.DeleteOne(ptir.Table, criteria)
+}
+
+func (ptir *PlannerTemplateIntervalRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
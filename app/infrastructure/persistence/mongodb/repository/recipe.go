@@ -58,6 +58,40 @@ func (ur *RecipeRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEn
 	return recipes, nil
 }
 
+func (ur *RecipeRepository) FindText(criteria *persistence.Criteria, text string) ([]*DomainEntity.Recipe, []float64, error) {
+	var recipes []*DomainEntity.Recipe
+
+	entities, scores, errorFindText := findText(ur.EntityManager, ur.Table, criteria, text, textIndexRecipe)
+
+	if errorFindText != nil {
+		return nil, nil, errorFindText
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.Recipe{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, nil, errorBSONBytesUnMarshal
+		}
+
+		recipes = append(recipes, &result)
+	}
+
+	return recipes, scores, nil
+}
+
+func (ur *RecipeRepository) Count(criteria *persistence.Criteria) (int64, error) {
+	return ur.EntityManager.Count(ur.Table, criteria)
+}
+
 func (ur *RecipeRepository) InsertOne(entity *DomainEntity.Recipe) (*DomainEntity.Recipe, error) {
 	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
 
@@ -280,6 +314,36 @@ func (ur *RecipeIngredientRepository) FindAll(criteria *persistence.Criteria) ([
 	return recipeIngredients, nil
 }
 
+func (ur *RecipeIngredientRepository) FindText(criteria *persistence.Criteria, text string) ([]*DomainEntity.RecipeIngredient, []float64, error) {
+	var recipeIngredients []*DomainEntity.RecipeIngredient
+
+	entities, scores, errorFindText := findText(ur.EntityManager, ur.Table, criteria, text, textIndexRecipeIngredient)
+
+	if errorFindText != nil {
+		return nil, nil, errorFindText
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.RecipeIngredient{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, nil, errorBSONBytesUnMarshal
+		}
+
+		recipeIngredients = append(recipeIngredients, &result)
+	}
+
+	return recipeIngredients, scores, nil
+}
+
 func (ur *RecipeIngredientRepository) InsertOne(entity *DomainEntity.RecipeIngredient) (*DomainEntity.RecipeIngredient, error) {
 	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
 
@@ -391,6 +455,36 @@ func (ur *RecipeProcessRepository) FindAll(criteria *persistence.Criteria) ([]*D
 	return recipeProcesses, nil
 }
 
+func (ur *RecipeProcessRepository) FindText(criteria *persistence.Criteria, text string) ([]*DomainEntity.RecipeProcess, []float64, error) {
+	var recipeProcesses []*DomainEntity.RecipeProcess
+
+	entities, scores, errorFindText := findText(ur.EntityManager, ur.Table, criteria, text, textIndexRecipeProcess)
+
+	if errorFindText != nil {
+		return nil, nil, errorFindText
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.RecipeProcess{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, nil, errorBSONBytesUnMarshal
+		}
+
+		recipeProcesses = append(recipeProcesses, &result)
+	}
+
+	return recipeProcesses, scores, nil
+}
+
 func (ur *RecipeProcessRepository) InsertOne(entity *DomainEntity.RecipeProcess) (*DomainEntity.RecipeProcess, error) {
 	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
 
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type RecipeVersionRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.RecipeVersionRepositoryInterface
+}
+
+func (rvr *RecipeVersionRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.RecipeVersion, error) {
+	entity, errorFindOne := rvr.EntityManager.FindOne(rvr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.RecipeVersion{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (rvr *RecipeVersionRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.RecipeVersion, error) {
+	var recipeVersions []*DomainEntity.RecipeVersion
+
+	entities, errorFindAll := rvr.EntityManager.FindAll(rvr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.RecipeVersion{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		recipeVersions = append(recipeVersions, &result)
+	}
+
+	return recipeVersions, nil
+}
+
+// InsertOne inserts the version, the number of the version is unique for the recipe, so the version with the number
+// which has already been taken fails with persistence.ErrorDuplicate.
+func (rvr *RecipeVersionRepository) InsertOne(entity *DomainEntity.RecipeVersion) (*DomainEntity.RecipeVersion, error) {
+	if errorUniqueIndex := ensureUniqueIndex(rvr.EntityManager, rvr.Table, []string{"entity_id", "version"}); errorUniqueIndex != nil {
+		return nil, errorUniqueIndex
+	}
+
+	_, errorInsertOne := rvr.EntityManager.InsertOne(rvr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (rvr *RecipeVersionRepository) InsertMany(entities []*DomainEntity.RecipeVersion) ([]*DomainEntity.RecipeVersion, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := rvr.EntityManager.InsertMany(rvr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (rvr *RecipeVersionRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.RecipeVersion) (*DomainEntity.RecipeVersion, error) {
+	_, errorInsertOne := rvr.EntityManager.UpdateOne(rvr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (rvr *RecipeVersionRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.RecipeVersion) ([]*DomainEntity.RecipeVersion, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := rvr.EntityManager.UpdateMany(rvr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (rvr *RecipeVersionRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return rvr.EntityManager.DeleteOne(rvr.Table, criteria)
+}
+
+func (rvr *RecipeVersionRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
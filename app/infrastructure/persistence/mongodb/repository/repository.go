@@ -58,6 +58,36 @@ func (ur *AltNameRepository) FindAll(criteria *persistence.Criteria) ([]*DomainE
 	return recipeAltNames, nil
 }
 
+func (ur *AltNameRepository) FindText(criteria *persistence.Criteria, text string) ([]*DomainEntity.AltName, []float64, error) {
+	var altNames []*DomainEntity.AltName
+
+	entities, scores, errorFindText := findText(ur.EntityManager, ur.Table, criteria, text, textIndexAltName)
+
+	if errorFindText != nil {
+		return nil, nil, errorFindText
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.AltName{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, nil, errorBSONBytesUnMarshal
+		}
+
+		altNames = append(altNames, &result)
+	}
+
+	return altNames, scores, nil
+}
+
 func (ur *AltNameRepository) InsertOne(entity *DomainEntity.AltName) (*DomainEntity.AltName, error) {
 	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
 
@@ -502,6 +532,36 @@ func (ur *IngredientRepository) FindAll(criteria *persistence.Criteria) ([]*Doma
 	return recipeMeasures, nil
 }
 
+func (ur *IngredientRepository) FindText(criteria *persistence.Criteria, text string) ([]*DomainEntity.Ingredient, []float64, error) {
+	var ingredients []*DomainEntity.Ingredient
+
+	entities, scores, errorFindText := findText(ur.EntityManager, ur.Table, criteria, text, textIndexIngredient)
+
+	if errorFindText != nil {
+		return nil, nil, errorFindText
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.Ingredient{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, nil, errorBSONBytesUnMarshal
+		}
+
+		ingredients = append(ingredients, &result)
+	}
+
+	return ingredients, scores, nil
+}
+
 func (ur *IngredientRepository) InsertOne(entity *DomainEntity.Ingredient) (*DomainEntity.Ingredient, error) {
 	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
 
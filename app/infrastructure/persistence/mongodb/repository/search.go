@@ -0,0 +1,38 @@
+package repository
+
+import "github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+
+// The weights of the fields of the text indexes, a match in the name of a recipe outweighs a match in its notes.
+var (
+	textIndexRecipe           = map[string]int32{"name": 10, "description": 5, "notes": 2}
+	textIndexRecipeIngredient = map[string]int32{"name": 4}
+	textIndexRecipeProcess    = map[string]int32{"name": 3, "description": 3, "notes": 1}
+	textIndexIngredient       = map[string]int32{"name": 4}
+	textIndexAltName          = map[string]int32{"name": 3}
+)
+
+func findText(
+	entityManager persistence.EntityManagerInterface,
+	table string,
+	criteria *persistence.Criteria,
+	text string,
+	weights map[string]int32,
+) ([]interface{}, []float64, error) {
+	textSearch, ok := entityManager.(persistence.TextSearchInterface)
+
+	if !ok {
+		return nil, nil, persistence.ErrorTextSearchUnsupported
+	}
+
+	return textSearch.FindText(table, criteria, text, weights)
+}
+
+func ensureUniqueIndex(entityManager persistence.EntityManagerInterface, table string, fields []string) error {
+	uniqueIndex, ok := entityManager.(persistence.UniqueIndexInterface)
+
+	if !ok {
+		return nil
+	}
+
+	return uniqueIndex.EnsureUniqueIndex(table, fields)
+}
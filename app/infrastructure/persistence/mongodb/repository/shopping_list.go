@@ -0,0 +1,230 @@
+package repository
+
+import (
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type ShoppingListRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.ShoppingListRepositoryInterface
+}
+
+func (slr *ShoppingListRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.ShoppingList, error) {
+	entity, errorFindOne := slr.EntityManager.FindOne(slr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.ShoppingList{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (slr *ShoppingListRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.ShoppingList, error) {
+	var shoppingLists []*DomainEntity.ShoppingList
+
+	entities, errorFindAll := slr.EntityManager.FindAll(slr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.ShoppingList{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		shoppingLists = append(shoppingLists, &result)
+	}
+
+	return shoppingLists, nil
+}
+
+func (slr *ShoppingListRepository) InsertOne(entity *DomainEntity.ShoppingList) (*DomainEntity.ShoppingList, error) {
+	_, errorInsertOne := slr.EntityManager.InsertOne(slr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (slr *ShoppingListRepository) InsertMany(entities []*DomainEntity.ShoppingList) ([]*DomainEntity.ShoppingList, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := slr.EntityManager.InsertMany(slr.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (slr *ShoppingListRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.ShoppingList) (*DomainEntity.ShoppingList, error) {
+	_, errorInsertOne := slr.EntityManager.UpdateOne(slr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (slr *ShoppingListRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.ShoppingList) ([]*DomainEntity.ShoppingList, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := slr.EntityManager.UpdateMany(slr.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (slr *ShoppingListRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return slr.EntityManager.DeleteOne(slr.Table, criteria)
+}
+
+func (slr *ShoppingListRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
+
+type ShoppingListItemRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.ShoppingListItemRepositoryInterface
+}
+
+func (slir *ShoppingListItemRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.ShoppingListItem, error) {
+	entity, errorFindOne := slir.EntityManager.FindOne(slir.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.ShoppingListItem{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (slir *ShoppingListItemRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.ShoppingListItem, error) {
+	var shoppingListItems []*DomainEntity.ShoppingListItem
+
+	entities, errorFindAll := slir.EntityManager.FindAll(slir.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.ShoppingListItem{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		shoppingListItems = append(shoppingListItems, &result)
+	}
+
+	return shoppingListItems, nil
+}
+
+func (slir *ShoppingListItemRepository) InsertOne(entity *DomainEntity.ShoppingListItem) (*DomainEntity.ShoppingListItem, error) {
+	_, errorInsertOne := slir.EntityManager.InsertOne(slir.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (slir *ShoppingListItemRepository) InsertMany(entities []*DomainEntity.ShoppingListItem) ([]*DomainEntity.ShoppingListItem, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorInsertMany := slir.EntityManager.InsertMany(slir.Table, entitiesAsInterfaces)
+
+	if errorInsertMany != nil {
+		return nil, errorInsertMany
+	}
+
+	return entities, nil
+}
+
+func (slir *ShoppingListItemRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.ShoppingListItem) (*DomainEntity.ShoppingListItem, error) {
+	_, errorInsertOne := slir.EntityManager.UpdateOne(slir.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (slir *ShoppingListItemRepository) UpdateMany(criteria *persistence.Criteria, entities []*DomainEntity.ShoppingListItem) ([]*DomainEntity.ShoppingListItem, error) {
+	entitiesAsInterfaces := make([]interface{}, len(entities))
+
+	for i, value := range entities {
+		entitiesAsInterfaces[i] = &value
+	}
+
+	_, errorUpdateMany := slir.EntityManager.UpdateMany(slir.Table, criteria, &persistence.Wrapper{Mod: entitiesAsInterfaces})
+
+	if errorUpdateMany != nil {
+		return nil, errorUpdateMany
+	}
+
+	return entities, nil
+}
+
+func (slir *ShoppingListItemRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return slir.EntityManager.DeleteOne(slir.Table, criteria)
+}
+
+func (slir *ShoppingListItemRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
@@ -8,6 +8,17 @@ import (
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence/repository"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"regexp"
+	"strings"
+)
+
+const (
+	userFieldUsername   = "userdto.userregisterdto.usercredentialsdto.username"
+	userFieldName       = "userdto.userregisterdto.name"
+	userFieldSurname    = "userdto.userregisterdto.surname"
+	userFieldMiddleName = "userdto.userregisterdto.middle_name"
+	userFieldStatus     = "userdto.status"
 )
 
 var (
@@ -38,6 +49,18 @@ type UserConfirmationRepository struct {
 	repository.UserConfirmationRepositoryInterface
 }
 
+type UserSessionRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.UserSessionRepositoryInterface
+}
+
+type UserTotpRepository struct {
+	EntityManager persistence.EntityManagerInterface
+	Table         string
+	repository.UserTotpRepositoryInterface
+}
+
 func (ur *UserRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.User, error) {
 	entity, errorFindOne := ur.EntityManager.FindOne(ur.Table, criteria)
 
@@ -76,11 +99,22 @@ func (ur *UserRepository) FindAll(criteria *persistence.Criteria) ([]DomainEntit
 		return nil, errorFindAll
 	}
 
-	for _, v := range entities {
-		field, ok := v.(DomainEntity.User)
-		if ok {
-			users = append(users, field)
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.User{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
 		}
+
+		users = append(users, result)
 	}
 
 	return users, nil
@@ -142,22 +176,68 @@ func (ur *UserRepository) DeleteOne(criteria *persistence.Criteria) (bool, error
 	return ur.EntityManager.DeleteOne(ur.Table, criteria)
 }
 
+func (ur *UserRepository) Count(criteria *persistence.Criteria) (int64, error) {
+	return ur.EntityManager.Count(ur.Table, criteria)
+}
+
+// GetCriteriaByUserId reads the user bypassing the cache, the password, the status and the time of logout of the user
+// are checked by every authentication, so they have to be up-to-date.
 func (ur *UserRepository) GetCriteriaByUserId(id *uuid.UUID) *persistence.Criteria {
 	return &persistence.Criteria{
 		Where: map[string]interface{}{
 			"id": id,
 		},
+		Uncached: true,
 	}
 }
 
+// GetCriteriaByUsername reads the user bypassing the cache as GetCriteriaByUserId does.
 func (ur *UserRepository) GetCriteriaByUsername(username string) *persistence.Criteria {
 	return &persistence.Criteria{
 		Where: map[string]interface{}{
-			"userdto.userregisterdto.usercredentialsdto.username": username,
+			userFieldUsername: username,
 		},
+		Uncached: true,
 	}
 }
 
+// GetCriteriaByQuery filters the users by the status when it is not empty and by the words of the query, every word
+// has to be a part of the username, the name, the surname or the middle name regardless of the case. The users go in
+// the order of their usernames.
+func (ur *UserRepository) GetCriteriaByQuery(query string, status DomainKind.UserStatus) *persistence.Criteria {
+	criteria := &persistence.Criteria{
+		Where: map[string]interface{}{},
+		Order: map[string]interface{}{userFieldUsername: 1},
+	}
+
+	if status != "" {
+		criteria.Where[userFieldStatus] = status
+	}
+
+	words := bson.A{}
+
+	for _, word := range strings.Fields(query) {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(word), Options: "i"}
+		words = append(
+			words,
+			bson.M{
+				"$or": bson.A{
+					bson.M{userFieldUsername: pattern},
+					bson.M{userFieldName: pattern},
+					bson.M{userFieldSurname: pattern},
+					bson.M{userFieldMiddleName: pattern},
+				},
+			},
+		)
+	}
+
+	if len(words) > 0 {
+		criteria.Where["$and"] = words
+	}
+
+	return criteria
+}
+
 func (ur *UserRoleRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserRole, error) {
 	entity, errorFindOne := ur.EntityManager.FindOne(ur.Table, criteria)
 
@@ -165,19 +245,30 @@ func (ur *UserRoleRepository) FindOne(criteria *persistence.Criteria) (*DomainEn
 		return nil, errorFindOne
 	}
 
-	result, ok := entity.(DomainEntity.UserRole)
+	entityBsonM, statusEntityBsonM := entity.(bson.M)
+
+	if !statusEntityBsonM {
+		return nil, errorUserFindOneConvertToBSON
+	}
+
+	result := DomainEntity.UserRole{}
+	bsonBytes, errorEntityBsonMMarshaled := bson.Marshal(entityBsonM)
 
-	if !ok {
-		//errorConvert := fmt.Errorf("error is occurred while converting interface '%s' to struct 'entity.UserRole'", entity)
-		//
-		//return nil, errorConvert
+	if errorEntityBsonMMarshaled != nil {
+		return nil, errorEntityBsonMMarshaled
+	}
+
+	errorEntityBsonMUnMarshaled := bson.Unmarshal(bsonBytes, &result)
+
+	if errorEntityBsonMUnMarshaled != nil {
+		return nil, errorEntityBsonMUnMarshaled
 	}
 
 	return &result, nil
 }
 
-func (ur *UserRoleRepository) FindAll(criteria *persistence.Criteria) ([]DomainEntity.UserRole, error) {
-	var users []DomainEntity.UserRole
+func (ur *UserRoleRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.UserRole, error) {
+	var results []*DomainEntity.UserRole
 
 	entities, errorFindAll := ur.EntityManager.FindAll(ur.Table, criteria)
 
@@ -185,52 +276,140 @@ func (ur *UserRoleRepository) FindAll(criteria *persistence.Criteria) ([]DomainE
 		return nil, errorFindAll
 	}
 
-	for _, v := range entities {
-		field, ok := v.(DomainEntity.UserRole)
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.UserRole{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
 
-		if ok {
-			users = append(users, field)
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
 		}
+
+		results = append(results, &result)
 	}
 
-	return users, nil
+	return results, nil
 }
 
-func (ur *UserToRoleRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserToRole, error) {
-	entity, errorFindOne := ur.EntityManager.FindOne(ur.Table, criteria)
+func (ur *UserRoleRepository) InsertOne(entity *DomainEntity.UserRole) (*DomainEntity.UserRole, error) {
+	_, errorInsertOne := ur.EntityManager.InsertOne(ur.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (ur *UserRoleRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.UserRole) (*DomainEntity.UserRole, error) {
+	_, errorUpdateOne := ur.EntityManager.UpdateOne(ur.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	return entity, nil
+}
+
+func (ur *UserRoleRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return ur.EntityManager.DeleteOne(ur.Table, criteria)
+}
+
+func (ur *UserRoleRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
+
+func (utr *UserToRoleRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserToRole, error) {
+	entity, errorFindOne := utr.EntityManager.FindOne(utr.Table, criteria)
 
 	if errorFindOne != nil {
 		return nil, errorFindOne
 	}
 
-	result, ok := entity.(DomainEntity.UserToRole)
+	entityBsonM, statusEntityBsonM := entity.(bson.M)
+
+	if !statusEntityBsonM {
+		return nil, errorUserFindOneConvertToBSON
+	}
+
+	result := DomainEntity.UserToRole{}
+	bsonBytes, errorEntityBsonMMarshaled := bson.Marshal(entityBsonM)
 
-	if !ok {
-		//errorConvert := fmt.Errorf("error is occurred while converting interface '%s' to struct 'entity.UserTpGroup'", entity)
-		//
-		//return nil, errorConvert
+	if errorEntityBsonMMarshaled != nil {
+		return nil, errorEntityBsonMMarshaled
+	}
+
+	errorEntityBsonMUnMarshaled := bson.Unmarshal(bsonBytes, &result)
+
+	if errorEntityBsonMUnMarshaled != nil {
+		return nil, errorEntityBsonMUnMarshaled
 	}
 
 	return &result, nil
 }
 
-func (ur *UserToRoleRepository) FindAll(criteria *persistence.Criteria) ([]DomainEntity.UserToRole, error) {
-	var users []DomainEntity.UserToRole
+func (utr *UserToRoleRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.UserToRole, error) {
+	var results []*DomainEntity.UserToRole
 
-	entities, errorFindAll := ur.EntityManager.FindAll(ur.Table, criteria)
+	entities, errorFindAll := utr.EntityManager.FindAll(utr.Table, criteria)
 
 	if errorFindAll != nil {
 		return nil, errorFindAll
 	}
 
-	for _, v := range entities {
-		field, ok := v.(DomainEntity.UserToRole)
-		if ok {
-			users = append(users, field)
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.UserToRole{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
 		}
+
+		results = append(results, &result)
 	}
 
-	return users, nil
+	return results, nil
+}
+
+func (utr *UserToRoleRepository) InsertOne(entity *DomainEntity.UserToRole) (*DomainEntity.UserToRole, error) {
+	_, errorInsertOne := utr.EntityManager.InsertOne(utr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (utr *UserToRoleRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.UserToRole) (*DomainEntity.UserToRole, error) {
+	_, errorUpdateOne := utr.EntityManager.UpdateOne(utr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	return entity, nil
+}
+
+func (utr *UserToRoleRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return utr.EntityManager.DeleteOne(utr.Table, criteria)
+}
+
+func (utr *UserToRoleRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
 }
 
 func (ur *UserConfirmationRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserConfirmation, error) {
@@ -322,12 +501,29 @@ func (ur *UserConfirmationRepository) UpdateMany(criteria *persistence.Criteria,
 	return entities, nil
 }
 
-func (ur *UserConfirmationRepository) GetCriteriaByUserIdAndActive(user *DomainEntity.User) *persistence.Criteria {
+func (ur *UserConfirmationRepository) IncrementAttempts(criteria *persistence.Criteria) (*DomainEntity.UserConfirmation, error) {
+	entity, errorIncrementOne := ur.EntityManager.IncrementOne(ur.Table, criteria, "attempts", 1)
+
+	if errorIncrementOne != nil {
+		return nil, errorIncrementOne
+	}
+	//todo
+	entityBsonM, _ := entity.(bson.M)
+	result := DomainEntity.UserConfirmation{}
+	bsonBytes, _ := bson.Marshal(entityBsonM)
+	_ = bson.Unmarshal(bsonBytes, &result)
+
+	return &result, nil
+}
+
+func (ur *UserConfirmationRepository) GetCriteriaByUserIdAndActive(user *DomainEntity.User, confirmationType DomainKind.UserConfirmationType) *persistence.Criteria {
 	return &persistence.Criteria{
 		Where: map[string]interface{}{
 			"user_id": user.Id,
 			"active":  DomainKind.UserConfirmationActive,
+			"type":    confirmationType,
 		},
+		Uncached: true,
 	}
 }
 
@@ -338,3 +534,155 @@ func (ur *UserConfirmationRepository) GetCriteriaById(id *uuid.UUID) *persistenc
 		},
 	}
 }
+
+func (ur *UserConfirmationRepository) GetCriteriaByUserId(id *uuid.UUID) *persistence.Criteria {
+	return &persistence.Criteria{
+		Where: map[string]interface{}{
+			"user_id": id,
+		},
+	}
+}
+
+func (usr *UserSessionRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserSession, error) {
+	entity, errorFindOne := usr.EntityManager.FindOne(usr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+
+	entityBsonM, statusEntityBsonM := entity.(bson.M)
+
+	if !statusEntityBsonM {
+		return nil, errorUserFindOneConvertToBSON
+	}
+
+	result := DomainEntity.UserSession{}
+	bsonBytes, errorEntityBsonMMarshaled := bson.Marshal(entityBsonM)
+
+	if errorEntityBsonMMarshaled != nil {
+		return nil, errorEntityBsonMMarshaled
+	}
+
+	errorEntityBsonMUnMarshaled := bson.Unmarshal(bsonBytes, &result)
+
+	if errorEntityBsonMUnMarshaled != nil {
+		return nil, errorEntityBsonMUnMarshaled
+	}
+
+	return &result, nil
+}
+
+func (usr *UserSessionRepository) FindAll(criteria *persistence.Criteria) ([]*DomainEntity.UserSession, error) {
+	var results []*DomainEntity.UserSession
+
+	entities, errorFindAll := usr.EntityManager.FindAll(usr.Table, criteria)
+
+	if errorFindAll != nil {
+		return nil, errorFindAll
+	}
+
+	for _, entity := range entities {
+		entityBsonM, _ := entity.(bson.M)
+		result := DomainEntity.UserSession{}
+		bsonBytes, errorBSONBytesMarshal := bson.Marshal(entityBsonM)
+
+		if errorBSONBytesMarshal != nil {
+			return nil, errorBSONBytesMarshal
+		}
+
+		errorBSONBytesUnMarshal := bson.Unmarshal(bsonBytes, &result)
+
+		if errorBSONBytesUnMarshal != nil {
+			return nil, errorBSONBytesUnMarshal
+		}
+
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+func (usr *UserSessionRepository) InsertOne(entity *DomainEntity.UserSession) (*DomainEntity.UserSession, error) {
+	_, errorInsertOne := usr.EntityManager.InsertOne(usr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (usr *UserSessionRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.UserSession) (*DomainEntity.UserSession, error) {
+	_, errorUpdateOne := usr.EntityManager.UpdateOne(usr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	return entity, nil
+}
+
+func (usr *UserSessionRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return usr.EntityManager.DeleteOne(usr.Table, criteria)
+}
+
+func (usr *UserSessionRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
+
+func (utr *UserTotpRepository) FindOne(criteria *persistence.Criteria) (*DomainEntity.UserTotp, error) {
+	entity, errorFindOne := utr.EntityManager.FindOne(utr.Table, criteria)
+
+	if errorFindOne != nil {
+		return nil, errorFindOne
+	}
+
+	entityBsonM, statusEntityBsonM := entity.(bson.M)
+
+	if !statusEntityBsonM {
+		return nil, errorUserFindOneConvertToBSON
+	}
+
+	result := DomainEntity.UserTotp{}
+	bsonBytes, errorEntityBsonMMarshaled := bson.Marshal(entityBsonM)
+
+	if errorEntityBsonMMarshaled != nil {
+		return nil, errorEntityBsonMMarshaled
+	}
+
+	errorEntityBsonMUnMarshaled := bson.Unmarshal(bsonBytes, &result)
+
+	if errorEntityBsonMUnMarshaled != nil {
+		return nil, errorEntityBsonMUnMarshaled
+	}
+
+	return &result, nil
+}
+
+func (utr *UserTotpRepository) InsertOne(entity *DomainEntity.UserTotp) (*DomainEntity.UserTotp, error) {
+	_, errorInsertOne := utr.EntityManager.InsertOne(utr.Table, entity)
+
+	if errorInsertOne != nil {
+		return nil, errorInsertOne
+	}
+
+	return entity, nil
+}
+
+func (utr *UserTotpRepository) UpdateOne(criteria *persistence.Criteria, entity *DomainEntity.UserTotp) (*DomainEntity.UserTotp, error) {
+	_, errorUpdateOne := utr.EntityManager.UpdateOne(utr.Table, criteria, &persistence.Wrapper{Set: *entity})
+
+	if errorUpdateOne != nil {
+		return nil, errorUpdateOne
+	}
+
+	return entity, nil
+}
+
+func (utr *UserTotpRepository) DeleteOne(criteria *persistence.Criteria) (bool, error) {
+	return utr.EntityManager.DeleteOne(utr.Table, criteria)
+}
+
+func (utr *UserTotpRepository) GetCriteria() *repository.CriteriaRepository {
+	return &CriteriaRepository
+}
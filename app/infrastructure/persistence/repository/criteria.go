@@ -6,12 +6,23 @@ import (
 )
 
 type CriteriaRepository struct {
-	GetCriteriaById       func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByIds      func(id []*uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByEntityId func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByDeriveId func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByUnitId   func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByRecipeId func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByUserId   func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
-	GetCriteriaByName     func(name *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaById            func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByIds           func(id []*uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByEntityId      func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByDeriveId      func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByDeriveIds     func(id []*uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByIngredientId  func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByUnitId        func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByCategoryId    func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaBySubstituteId  func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaBySubjectId     func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByRecipeId      func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByUserId        func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByRoleId        func(id *uuid.UUID, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByName          func(name *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByNameFold      func(name *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByText          func(text *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByCode          func(code *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByCalendarToken func(token *string, criteria *persistence.Criteria) *persistence.Criteria
+	GetCriteriaByStatus        func(status *string, criteria *persistence.Criteria) *persistence.Criteria
 }
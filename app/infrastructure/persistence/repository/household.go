@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type HouseholdRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.Household, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.Household, error)
+	InsertOne(household *entity.Household) (*entity.Household, error)
+	InsertMany(households []*entity.Household) ([]*entity.Household, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.Household) (*entity.Household, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.Household) ([]*entity.Household, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
+
+type HouseholdMemberRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.HouseholdMember, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.HouseholdMember, error)
+	InsertOne(householdMember *entity.HouseholdMember) (*entity.HouseholdMember, error)
+	InsertMany(householdMembers []*entity.HouseholdMember) ([]*entity.HouseholdMember, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.HouseholdMember) (*entity.HouseholdMember, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.HouseholdMember) ([]*entity.HouseholdMember, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
+
+type HouseholdGrantRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.HouseholdGrant, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.HouseholdGrant, error)
+	InsertOne(householdGrant *entity.HouseholdGrant) (*entity.HouseholdGrant, error)
+	InsertMany(householdGrants []*entity.HouseholdGrant) ([]*entity.HouseholdGrant, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.HouseholdGrant) (*entity.HouseholdGrant, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.HouseholdGrant) ([]*entity.HouseholdGrant, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type IngredientPriceRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.IngredientPrice, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.IngredientPrice, error)
+	InsertOne(ingredientPrice *entity.IngredientPrice) (*entity.IngredientPrice, error)
+	InsertMany(ingredientPrices []*entity.IngredientPrice) ([]*entity.IngredientPrice, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.IngredientPrice) (*entity.IngredientPrice, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.IngredientPrice) ([]*entity.IngredientPrice, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
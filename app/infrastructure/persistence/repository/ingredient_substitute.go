@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type IngredientSubstituteRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.IngredientSubstitute, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.IngredientSubstitute, error)
+	InsertOne(ingredientSubstitute *entity.IngredientSubstitute) (*entity.IngredientSubstitute, error)
+	InsertMany(ingredientSubstitutes []*entity.IngredientSubstitute) ([]*entity.IngredientSubstitute, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.IngredientSubstitute) (*entity.IngredientSubstitute, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.IngredientSubstitute) ([]*entity.IngredientSubstitute, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type PantryItemRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.PantryItem, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.PantryItem, error)
+	InsertOne(pantryItem *entity.PantryItem) (*entity.PantryItem, error)
+	InsertMany(pantryItems []*entity.PantryItem) ([]*entity.PantryItem, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.PantryItem) (*entity.PantryItem, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.PantryItem) ([]*entity.PantryItem, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
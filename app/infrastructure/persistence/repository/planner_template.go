@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type PlannerTemplateRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.PlannerTemplate, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.PlannerTemplate, error)
+	InsertOne(plannerTemplate *entity.PlannerTemplate) (*entity.PlannerTemplate, error)
+	InsertMany(plannerTemplates []*entity.PlannerTemplate) ([]*entity.PlannerTemplate, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.PlannerTemplate) (*entity.PlannerTemplate, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.PlannerTemplate) ([]*entity.PlannerTemplate, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	// ClaimRecurrence counts the recurrence of the template atomically while the count is still the recurrences and
+	// returns the template with the count, so only one of the concurrent recurrences gets the template. The template is
+	// nil when it has been claimed by another recurrence already.
+	ClaimRecurrence(id *uuid.UUID, recurrences int64) (*entity.PlannerTemplate, error)
+	GetCriteria() *CriteriaRepository
+}
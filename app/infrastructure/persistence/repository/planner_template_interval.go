@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type PlannerTemplateIntervalRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.PlannerTemplateInterval, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.PlannerTemplateInterval, error)
+	InsertOne(plannerTemplateInterval *entity.PlannerTemplateInterval) (*entity.PlannerTemplateInterval, error)
+	InsertMany(plannerTemplateIntervals []*entity.PlannerTemplateInterval) ([]*entity.PlannerTemplateInterval, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.PlannerTemplateInterval) (*entity.PlannerTemplateInterval, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.PlannerTemplateInterval) ([]*entity.PlannerTemplateInterval, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
@@ -8,6 +8,8 @@ import (
 type RecipeRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.Recipe, error)
 	FindAll(criteria *persistence.Criteria) ([]*entity.Recipe, error)
+	FindText(criteria *persistence.Criteria, text string) ([]*entity.Recipe, []float64, error)
+	Count(criteria *persistence.Criteria) (int64, error)
 	InsertOne(recipe *entity.Recipe) (*entity.Recipe, error)
 	InsertMany(recipes []entity.Recipe) ([]entity.Recipe, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.Recipe) (*entity.Recipe, error)
@@ -30,6 +32,7 @@ type RecipeCategoryRepositoryInterface interface {
 type RecipeIngredientRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.RecipeIngredient, error)
 	FindAll(criteria *persistence.Criteria) ([]*entity.RecipeIngredient, error)
+	FindText(criteria *persistence.Criteria, text string) ([]*entity.RecipeIngredient, []float64, error)
 	InsertOne(recipeIngredient *entity.RecipeIngredient) (*entity.RecipeIngredient, error)
 	InsertMany(recipeIngredients []entity.RecipeIngredient) ([]entity.RecipeIngredient, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.RecipeIngredient) (*entity.RecipeIngredient, error)
@@ -41,6 +44,7 @@ type RecipeIngredientRepositoryInterface interface {
 type RecipeProcessRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.RecipeProcess, error)
 	FindAll(criteria *persistence.Criteria) ([]*entity.RecipeProcess, error)
+	FindText(criteria *persistence.Criteria, text string) ([]*entity.RecipeProcess, []float64, error)
 	InsertOne(recipeProcess *entity.RecipeProcess) (*entity.RecipeProcess, error)
 	InsertMany(recipeProcesses []entity.RecipeProcess) ([]entity.RecipeProcess, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.RecipeProcess) (*entity.RecipeProcess, error)
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type RecipeVersionRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.RecipeVersion, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.RecipeVersion, error)
+	InsertOne(recipeVersion *entity.RecipeVersion) (*entity.RecipeVersion, error)
+	InsertMany(recipeVersions []*entity.RecipeVersion) ([]*entity.RecipeVersion, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.RecipeVersion) (*entity.RecipeVersion, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.RecipeVersion) ([]*entity.RecipeVersion, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
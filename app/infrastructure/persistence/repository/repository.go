@@ -8,6 +8,7 @@ import (
 type AltNameRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.AltName, error)
 	FindAll(criteria *persistence.Criteria) ([]*entity.AltName, error)
+	FindText(criteria *persistence.Criteria, text string) ([]*entity.AltName, []float64, error)
 	InsertOne(recipeAltName *entity.AltName) (*entity.AltName, error)
 	InsertMany(recipeAltNames []*entity.AltName) ([]*entity.AltName, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.AltName) (*entity.AltName, error)
@@ -51,6 +52,7 @@ type CategoryRepositoryInterface interface {
 type IngredientRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.Ingredient, error)
 	FindAll(criteria *persistence.Criteria) ([]*entity.Ingredient, error)
+	FindText(criteria *persistence.Criteria, text string) ([]*entity.Ingredient, []float64, error)
 	InsertOne(recipeMeasure *entity.Ingredient) (*entity.Ingredient, error)
 	InsertMany(recipeMeasures []*entity.Ingredient) ([]*entity.Ingredient, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.Ingredient) (*entity.Ingredient, error)
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
+)
+
+type ShoppingListRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.ShoppingList, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.ShoppingList, error)
+	InsertOne(shoppingList *entity.ShoppingList) (*entity.ShoppingList, error)
+	InsertMany(shoppingLists []*entity.ShoppingList) ([]*entity.ShoppingList, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.ShoppingList) (*entity.ShoppingList, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.ShoppingList) ([]*entity.ShoppingList, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
+
+type ShoppingListItemRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.ShoppingListItem, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.ShoppingListItem, error)
+	InsertOne(shoppingListItem *entity.ShoppingListItem) (*entity.ShoppingListItem, error)
+	InsertMany(shoppingListItems []*entity.ShoppingListItem) ([]*entity.ShoppingListItem, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.ShoppingListItem) (*entity.ShoppingListItem, error)
+	UpdateMany(criteria *persistence.Criteria, entities []*entity.ShoppingListItem) ([]*entity.ShoppingListItem, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"github.com/google/uuid"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 )
 
@@ -14,18 +15,28 @@ type UserRepositoryInterface interface {
 	UpdateOne(criteria *persistence.Criteria, entity *entity.User) (*entity.User, error)
 	UpdateMany(criteria *persistence.Criteria, entities []*entity.User) ([]*entity.User, error)
 	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	Count(criteria *persistence.Criteria) (int64, error)
 	GetCriteriaByUserId(id *uuid.UUID) *persistence.Criteria
 	GetCriteriaByUsername(username string) *persistence.Criteria
+	GetCriteriaByQuery(query string, status kind.UserStatus) *persistence.Criteria
 }
 
 type UserRoleRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.UserRole, error)
-	FindAll(criteria *persistence.Criteria) ([]entity.UserRole, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.UserRole, error)
+	InsertOne(entity *entity.UserRole) (*entity.UserRole, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.UserRole) (*entity.UserRole, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
 }
 
 type UserToRoleRepositoryInterface interface {
 	FindOne(criteria *persistence.Criteria) (*entity.UserToRole, error)
-	FindAll(criteria *persistence.Criteria) ([]entity.UserToRole, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.UserToRole, error)
+	InsertOne(entity *entity.UserToRole) (*entity.UserToRole, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.UserToRole) (*entity.UserToRole, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
 }
 
 type UserConfirmationRepositoryInterface interface {
@@ -35,6 +46,26 @@ type UserConfirmationRepositoryInterface interface {
 	InsertMany(users []entity.UserConfirmation) ([]entity.UserConfirmation, error)
 	UpdateOne(criteria *persistence.Criteria, entity *entity.UserConfirmation) (*entity.UserConfirmation, error)
 	UpdateMany(criteria *persistence.Criteria, entities []*entity.UserConfirmation) ([]*entity.UserConfirmation, error)
-	GetCriteriaByUserIdAndActive(user *entity.User) *persistence.Criteria
+	// IncrementAttempts counts an attempt of the confirmation atomically and returns the confirmation with the attempt.
+	IncrementAttempts(criteria *persistence.Criteria) (*entity.UserConfirmation, error)
+	GetCriteriaByUserIdAndActive(user *entity.User, confirmationType kind.UserConfirmationType) *persistence.Criteria
 	GetCriteriaById(id *uuid.UUID) *persistence.Criteria
+	GetCriteriaByUserId(id *uuid.UUID) *persistence.Criteria
+}
+
+type UserSessionRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.UserSession, error)
+	FindAll(criteria *persistence.Criteria) ([]*entity.UserSession, error)
+	InsertOne(entity *entity.UserSession) (*entity.UserSession, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.UserSession) (*entity.UserSession, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
+}
+
+type UserTotpRepositoryInterface interface {
+	FindOne(criteria *persistence.Criteria) (*entity.UserTotp, error)
+	InsertOne(entity *entity.UserTotp) (*entity.UserTotp, error)
+	UpdateOne(criteria *persistence.Criteria, entity *entity.UserTotp) (*entity.UserTotp, error)
+	DeleteOne(criteria *persistence.Criteria) (bool, error)
+	GetCriteria() *CriteriaRepository
 }
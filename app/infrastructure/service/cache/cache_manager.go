@@ -20,7 +20,7 @@ func SetCacheManager(DSN *cache.DSN, ttl int64) {
 	case cache.InMemoryType.String():
 		cacheManager = &in_memory.CacheManager{Namespace: DSN.Namespace, Type: cache.InMemoryType, TTL: ttl}
 	case cache.NullType.String():
-		cacheManager = &null.CacheManager{Namespace: DSN.Namespace, Type: cache.InMemoryType, TTL: ttl}
+		cacheManager = &null.CacheManager{Namespace: DSN.Namespace, Type: cache.NullType, TTL: ttl}
 	default:
 		cacheManager = &redis.CacheManager{Namespace: DSN.Namespace, Type: cache.DefaultType, TTL: ttl}
 	}
@@ -3,12 +3,15 @@ package service
 import (
 	"fmt"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/cache"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/persistence"
 	ServiceCache "github.com/sergeygardner/meal-planner-api/infrastructure/service/cache"
 	ServiceEntity "github.com/sergeygardner/meal-planner-api/infrastructure/service/entity"
+	ServiceNotification "github.com/sergeygardner/meal-planner-api/infrastructure/service/notification"
 	log "github.com/sirupsen/logrus"
 	"os"
 	"strconv"
+	"time"
 )
 
 var (
@@ -86,4 +89,70 @@ func PrepareCache() {
 	}
 
 	ServiceCache.SetCacheManager(dsn, cacheTTLConverted)
+
+	if !cache.Counts(ServiceCache.GetCacheManager()) {
+		log.Warnf("the cache of the type %s cannot count, so the failed login attempts are not limited", dsn.Type)
+	}
+}
+
+// PrepareNotification configures the delivery of the notifications, the messages are written to the standard output
+// when NOTIFICATION_TYPE is not set.
+func PrepareNotification() {
+	dsn := &notification.DSN{Attempts: notification.DefaultAttempts, Backoff: notification.DefaultBackoff}
+	notificationType, notificationTypeOk := os.LookupEnv("NOTIFICATION_TYPE")
+	notificationHost, notificationHostOk := os.LookupEnv("NOTIFICATION_HOST")
+	notificationPort, notificationPortOk := os.LookupEnv("NOTIFICATION_PORT")
+	notificationFrom, notificationFromOk := os.LookupEnv("NOTIFICATION_FROM")
+	notificationUrl, notificationUrlOk := os.LookupEnv("NOTIFICATION_URL")
+	notificationPath, notificationPathOk := os.LookupEnv("NOTIFICATION_PATH")
+	notificationAttempts, notificationAttemptsOk := os.LookupEnv("NOTIFICATION_ATTEMPTS")
+	notificationBackoff, notificationBackoffOk := os.LookupEnv("NOTIFICATION_BACKOFF")
+
+	dsn.User, _ = os.LookupEnv("NOTIFICATION_USER")
+	dsn.Password, _ = os.LookupEnv("NOTIFICATION_PASSWORD")
+	dsn.Locale, _ = os.LookupEnv("NOTIFICATION_LOCALE")
+
+	if notificationAttemptsOk {
+		attempts, errorParseAttempts := strconv.Atoi(notificationAttempts)
+
+		if errorParseAttempts != nil {
+			panic("an error occurred while parsing the NOTIFICATION_ATTEMPTS variable")
+		}
+
+		dsn.Attempts = attempts
+	}
+
+	if notificationBackoffOk {
+		backoff, errorParseBackoff := time.ParseDuration(notificationBackoff)
+
+		if errorParseBackoff != nil {
+			panic("an error occurred while parsing the NOTIFICATION_BACKOFF variable")
+		}
+
+		dsn.Backoff = backoff
+	}
+
+	switch {
+	case !notificationTypeOk || notificationType == notification.StdoutType.String():
+		dsn.Type = notification.StdoutType.String()
+	case notificationType == notification.SmtpType.String() && notificationHostOk && notificationPortOk && notificationFromOk:
+		dsn.Type = notificationType
+		dsn.Host = notificationHost
+		dsn.Port = notificationPort
+		dsn.From = notificationFrom
+	case notificationType == notification.WebhookType.String() && notificationUrlOk:
+		dsn.Type = notificationType
+		dsn.Url = notificationUrl
+	case notificationType == notification.FileType.String() && notificationPathOk:
+		dsn.Type = notificationType
+		dsn.Path = notificationPath
+	default:
+		errorTitle, _ := fmt.Printf("the notification environments are not found NOTIFICATION_TYPE (%s) with NOTIFICATION_HOST (%s), NOTIFICATION_PORT (%s) and NOTIFICATION_FROM (%s) or NOTIFICATION_URL (%s) or NOTIFICATION_PATH (%s)", notificationType, notificationHost, notificationPort, notificationFrom, notificationUrl, notificationPath)
+
+		log.Panic(errorTitle)
+
+		panic(errorTitle)
+	}
+
+	ServiceNotification.SetNotifier(dsn)
 }
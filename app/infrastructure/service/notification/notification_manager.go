@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification/file"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification/smtp"
+	"github.com/sergeygardner/meal-planner-api/infrastructure/notification/webhook"
+)
+
+var notifier *notification.Notifier
+
+func GetNotifier() *notification.Notifier {
+	return notifier
+}
+
+func SetNotifier(DSN *notification.DSN) {
+	var sender notification.SenderInterface
+
+	switch DSN.Type {
+	case notification.SmtpType.String():
+		sender = &smtp.Sender{DSN: DSN}
+	case notification.WebhookType.String():
+		sender = &webhook.Sender{DSN: DSN}
+	case notification.FileType.String():
+		sender = &file.Sender{DSN: DSN}
+	case notification.StdoutType.String():
+		sender = &file.Sender{DSN: &notification.DSN{Type: DSN.Type}}
+	default:
+		sender = &file.Sender{DSN: &notification.DSN{Type: notification.DefaultType.String()}}
+	}
+
+	notifier = &notification.Notifier{Sender: sender, Locale: DSN.Locale, Attempts: DSN.Attempts, Backoff: DSN.Backoff}
+}
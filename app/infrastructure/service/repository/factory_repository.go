@@ -16,6 +16,8 @@ type FactoryRepositoryInterface interface {
 	GetUserRoleRepository() repository.UserRoleRepositoryInterface
 	GetUserToRoleRepository() repository.UserToRoleRepositoryInterface
 	GetUserConfirmationRepository() repository.UserConfirmationRepositoryInterface
+	GetUserSessionRepository() repository.UserSessionRepositoryInterface
+	GetUserTotpRepository() repository.UserTotpRepositoryInterface
 	GetRecipeRepository() repository.RecipeRepositoryInterface
 	GetRecipeCategoryRepository() repository.RecipeCategoryRepositoryInterface
 	GetRecipeIngredientRepository() repository.RecipeIngredientRepositoryInterface
@@ -29,26 +31,50 @@ type FactoryRepositoryInterface interface {
 	GetPlannerRepository() repository.PlannerRepositoryInterface
 	GetPlannerIntervalRepository() repository.PlannerIntervalRepositoryInterface
 	GetPlannerRecipeRepository() repository.PlannerRecipeRepositoryInterface
+	GetPantryItemRepository() repository.PantryItemRepositoryInterface
+	GetShoppingListRepository() repository.ShoppingListRepositoryInterface
+	GetShoppingListItemRepository() repository.ShoppingListItemRepositoryInterface
+	GetIngredientPriceRepository() repository.IngredientPriceRepositoryInterface
+	GetPlannerTemplateRepository() repository.PlannerTemplateRepositoryInterface
+	GetPlannerTemplateIntervalRepository() repository.PlannerTemplateIntervalRepositoryInterface
+	GetIngredientSubstituteRepository() repository.IngredientSubstituteRepositoryInterface
+	GetRecipeVersionRepository() repository.RecipeVersionRepositoryInterface
+	GetHouseholdRepository() repository.HouseholdRepositoryInterface
+	GetHouseholdMemberRepository() repository.HouseholdMemberRepositoryInterface
+	GetHouseholdGrantRepository() repository.HouseholdGrantRepositoryInterface
 }
 
 type FactoryRepository struct {
-	userRepository             repository.UserRepositoryInterface
-	userGroupRepository        repository.UserRoleRepositoryInterface
-	userToGroupRepository      repository.UserToRoleRepositoryInterface
-	userConfirmationRepository repository.UserConfirmationRepositoryInterface
-	recipeRepository           repository.RecipeRepositoryInterface
-	recipeCategoryRepository   repository.RecipeCategoryRepositoryInterface
-	recipeIngredientRepository repository.RecipeIngredientRepositoryInterface
-	recipeProcessRepository    repository.RecipeProcessRepositoryInterface
-	recipeMeasureRepository    repository.RecipeMeasureRepositoryInterface
-	unitRepository             repository.UnitRepositoryInterface
-	categoryRepository         repository.CategoryRepositoryInterface
-	ingredientRepository       repository.IngredientRepositoryInterface
-	pictureRepository          repository.PictureRepositoryInterface
-	altNameRepository          repository.AltNameRepositoryInterface
-	plannerRepository          repository.PlannerRepositoryInterface
-	plannerIntervalRepository  repository.PlannerIntervalRepositoryInterface
-	plannerRecipeRepository    repository.PlannerRecipeRepositoryInterface
+	userRepository                    repository.UserRepositoryInterface
+	userGroupRepository               repository.UserRoleRepositoryInterface
+	userToGroupRepository             repository.UserToRoleRepositoryInterface
+	userConfirmationRepository        repository.UserConfirmationRepositoryInterface
+	userSessionRepository             repository.UserSessionRepositoryInterface
+	userTotpRepository                repository.UserTotpRepositoryInterface
+	recipeRepository                  repository.RecipeRepositoryInterface
+	recipeCategoryRepository          repository.RecipeCategoryRepositoryInterface
+	recipeIngredientRepository        repository.RecipeIngredientRepositoryInterface
+	recipeProcessRepository           repository.RecipeProcessRepositoryInterface
+	recipeMeasureRepository           repository.RecipeMeasureRepositoryInterface
+	unitRepository                    repository.UnitRepositoryInterface
+	categoryRepository                repository.CategoryRepositoryInterface
+	ingredientRepository              repository.IngredientRepositoryInterface
+	pictureRepository                 repository.PictureRepositoryInterface
+	altNameRepository                 repository.AltNameRepositoryInterface
+	plannerRepository                 repository.PlannerRepositoryInterface
+	plannerIntervalRepository         repository.PlannerIntervalRepositoryInterface
+	plannerRecipeRepository           repository.PlannerRecipeRepositoryInterface
+	pantryItemRepository              repository.PantryItemRepositoryInterface
+	shoppingListRepository            repository.ShoppingListRepositoryInterface
+	shoppingListItemRepository        repository.ShoppingListItemRepositoryInterface
+	ingredientPriceRepository         repository.IngredientPriceRepositoryInterface
+	plannerTemplateRepository         repository.PlannerTemplateRepositoryInterface
+	plannerTemplateIntervalRepository repository.PlannerTemplateIntervalRepositoryInterface
+	ingredientSubstituteRepository    repository.IngredientSubstituteRepositoryInterface
+	recipeVersionRepository           repository.RecipeVersionRepositoryInterface
+	householdRepository               repository.HouseholdRepositoryInterface
+	householdMemberRepository         repository.HouseholdMemberRepositoryInterface
+	householdGrantRepository          repository.HouseholdGrantRepositoryInterface
 	FactoryRepositoryInterface
 }
 
@@ -112,6 +138,36 @@ func (f *FactoryRepository) GetUserConfirmationRepository() repository.UserConfi
 	return f.userConfirmationRepository
 }
 
+func (f *FactoryRepository) GetUserSessionRepository() repository.UserSessionRepositoryInterface {
+	if f.userSessionRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.userSessionRepository = &MongoDBRepository.UserSessionRepository{Table: "user_session", EntityManager: entity.GetEntityManager()}
+		default:
+			f.userSessionRepository = &MongoDBRepository.UserSessionRepository{Table: "user_session", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.userSessionRepository
+}
+
+func (f *FactoryRepository) GetUserTotpRepository() repository.UserTotpRepositoryInterface {
+	if f.userTotpRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.userTotpRepository = &MongoDBRepository.UserTotpRepository{Table: "user_totp", EntityManager: entity.GetEntityManager()}
+		default:
+			f.userTotpRepository = &MongoDBRepository.UserTotpRepository{Table: "user_totp", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.userTotpRepository
+}
+
 func (f *FactoryRepository) GetRecipeRepository() repository.RecipeRepositoryInterface {
 	if f.recipeRepository == nil {
 		entityManager := entity.GetEntityManager()
@@ -307,6 +363,51 @@ func (f *FactoryRepository) GetPlannerRecipeRepository() repository.PlannerRecip
 	return f.plannerRecipeRepository
 }
 
+func (f *FactoryRepository) GetPantryItemRepository() repository.PantryItemRepositoryInterface {
+	if f.pantryItemRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.pantryItemRepository = &MongoDBRepository.PantryItemRepository{Table: "pantry_item", EntityManager: entity.GetEntityManager()}
+		default:
+			f.pantryItemRepository = &MongoDBRepository.PantryItemRepository{Table: "pantry_item", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.pantryItemRepository
+}
+
+func (f *FactoryRepository) GetShoppingListRepository() repository.ShoppingListRepositoryInterface {
+	if f.shoppingListRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.shoppingListRepository = &MongoDBRepository.ShoppingListRepository{Table: "shopping_list", EntityManager: entity.GetEntityManager()}
+		default:
+			f.shoppingListRepository = &MongoDBRepository.ShoppingListRepository{Table: "shopping_list", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.shoppingListRepository
+}
+
+func (f *FactoryRepository) GetShoppingListItemRepository() repository.ShoppingListItemRepositoryInterface {
+	if f.shoppingListItemRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.shoppingListItemRepository = &MongoDBRepository.ShoppingListItemRepository{Table: "shopping_list_item", EntityManager: entity.GetEntityManager()}
+		default:
+			f.shoppingListItemRepository = &MongoDBRepository.ShoppingListItemRepository{Table: "shopping_list_item", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.shoppingListItemRepository
+}
+
 func GetFactoryRepository() FactoryRepositoryInterface {
 	if factory == nil {
 		factory = &FactoryRepository{}
@@ -314,3 +415,123 @@ func GetFactoryRepository() FactoryRepositoryInterface {
 
 	return factory
 }
+
+func (f *FactoryRepository) GetIngredientPriceRepository() repository.IngredientPriceRepositoryInterface {
+	if f.ingredientPriceRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.ingredientPriceRepository = &MongoDBRepository.IngredientPriceRepository{Table: "ingredient_price", EntityManager: entity.GetEntityManager()}
+		default:
+			f.ingredientPriceRepository = &MongoDBRepository.IngredientPriceRepository{Table: "ingredient_price", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.ingredientPriceRepository
+}
+
+func (f *FactoryRepository) GetPlannerTemplateRepository() repository.PlannerTemplateRepositoryInterface {
+	if f.plannerTemplateRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.plannerTemplateRepository = &MongoDBRepository.PlannerTemplateRepository{Table: "planner_template", EntityManager: entity.GetEntityManager()}
+		default:
+			f.plannerTemplateRepository = &MongoDBRepository.PlannerTemplateRepository{Table: "planner_template", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.plannerTemplateRepository
+}
+
+func (f *FactoryRepository) GetPlannerTemplateIntervalRepository() repository.PlannerTemplateIntervalRepositoryInterface {
+	if f.plannerTemplateIntervalRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.plannerTemplateIntervalRepository = &MongoDBRepository.PlannerTemplateIntervalRepository{Table: "planner_template_interval", EntityManager: entity.GetEntityManager()}
+		default:
+			f.plannerTemplateIntervalRepository = &MongoDBRepository.PlannerTemplateIntervalRepository{Table: "planner_template_interval", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.plannerTemplateIntervalRepository
+}
+
+func (f *FactoryRepository) GetIngredientSubstituteRepository() repository.IngredientSubstituteRepositoryInterface {
+	if f.ingredientSubstituteRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.ingredientSubstituteRepository = &MongoDBRepository.IngredientSubstituteRepository{Table: "ingredient_substitute", EntityManager: entity.GetEntityManager()}
+		default:
+			f.ingredientSubstituteRepository = &MongoDBRepository.IngredientSubstituteRepository{Table: "ingredient_substitute", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.ingredientSubstituteRepository
+}
+
+func (f *FactoryRepository) GetRecipeVersionRepository() repository.RecipeVersionRepositoryInterface {
+	if f.recipeVersionRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.recipeVersionRepository = &MongoDBRepository.RecipeVersionRepository{Table: "recipe_version", EntityManager: entity.GetEntityManager()}
+		default:
+			f.recipeVersionRepository = &MongoDBRepository.RecipeVersionRepository{Table: "recipe_version", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.recipeVersionRepository
+}
+
+func (f *FactoryRepository) GetHouseholdRepository() repository.HouseholdRepositoryInterface {
+	if f.householdRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.householdRepository = &MongoDBRepository.HouseholdRepository{Table: "household", EntityManager: entity.GetEntityManager()}
+		default:
+			f.householdRepository = &MongoDBRepository.HouseholdRepository{Table: "household", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.householdRepository
+}
+
+func (f *FactoryRepository) GetHouseholdMemberRepository() repository.HouseholdMemberRepositoryInterface {
+	if f.householdMemberRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.householdMemberRepository = &MongoDBRepository.HouseholdMemberRepository{Table: "household_member", EntityManager: entity.GetEntityManager()}
+		default:
+			f.householdMemberRepository = &MongoDBRepository.HouseholdMemberRepository{Table: "household_member", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.householdMemberRepository
+}
+
+func (f *FactoryRepository) GetHouseholdGrantRepository() repository.HouseholdGrantRepositoryInterface {
+	if f.householdGrantRepository == nil {
+		entityManager := entity.GetEntityManager()
+
+		switch entityManager.GetType() {
+		case persistence.MongoType:
+			f.householdGrantRepository = &MongoDBRepository.HouseholdGrantRepository{Table: "household_grant", EntityManager: entity.GetEntityManager()}
+		default:
+			f.householdGrantRepository = &MongoDBRepository.HouseholdGrantRepository{Table: "household_grant", EntityManager: entity.GetEntityManager()}
+		}
+	}
+
+	return f.householdGrantRepository
+}
@@ -3,23 +3,37 @@ package handler
 import (
 	"context"
 	"github.com/go-chi/jwtauth/v5"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	ApplicationHandler "github.com/sergeygardner/meal-planner-api/application/handler"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/domain/model"
 	"github.com/sergeygardner/meal-planner-api/infrastructure/service/repository"
 	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
 	"reflect"
+	"strings"
 	"time"
 )
 
 var (
-	userCredentialsDTO    *dto.UserCredentialsDTO
-	authConfirmationDTO   *dto.AuthConfirmationDTO
-	userRegisterDTO       *dto.UserRegisterDTO
-	errorAuthConfirmation error
-	errorWeirdBehaviour   = errors.New("an error occurred while running command. Weird behaviour!")
-	errorAuthentication   = errors.New("an error occurred while running command. You are not authenticated!")
+	userCredentialsDTO             *dto.UserCredentialsDTO
+	authConfirmationDTO            *dto.AuthConfirmationDTO
+	userRegisterDTO                *dto.UserRegisterDTO
+	authPasswordResetDTO           *dto.AuthPasswordResetConfirmationDTO
+	authPasswordChangeDTO          *dto.AuthPasswordChangeDTO
+	authTotpDisableDTO             *dto.AuthTotpDisableDTO
+	errorAuthConfirmation          error
+	errorWeirdBehaviour            = errors.New("an error occurred while running command. Weird behaviour!")
+	errorAuthentication            = errors.New("an error occurred while running command. You are not authenticated!")
+	statusAuthLogoutSuccess        = "the session has been logged out successful"
+	statusAuthLogoutError          = errors.New("the session has not been logged out")
+	statusUserSessionRevokeSuccess = "the session has been revoked successful"
+	statusUserSessionRevokeError   = errors.New("the session has not been revoked")
+	statusAuthPasswordSuccess      = "the password has been changed successful"
+	statusAuthPasswordError        = errors.New("the password has not been changed")
+	statusAuthTotpDisableSuccess   = "the two-factor authentication has been disabled successful"
+	statusAuthTotpDisableError     = errors.New("the two-factor authentication has not been disabled")
 )
 
 //func AuthCheck(w http.ResponseWriter, _ *http.Request) {
@@ -57,7 +71,7 @@ func authCredentials(message string) (int, error) {
 	} else if userCredentialsDTO.Password == "" {
 		userCredentialsDTO.Password = message
 
-		responseAuthConfirmation, _, errorAuthCredentials := ApplicationHandler.AuthCredentials(*userCredentialsDTO)
+		responseAuthConfirmation, _, errorAuthCredentials := ApplicationHandler.AuthCredentials(*userCredentialsDTO, "")
 
 		if errorAuthCredentials != nil {
 			return StatusError, errorAuthCredentials
@@ -81,7 +95,7 @@ func authConfirmation(message string) (int, error) {
 	} else if authConfirmationDTO.Code == "" {
 		authConfirmationDTO.Code = message
 
-		authToken, errorAuthConfirmation = ApplicationHandler.AuthConfirmation(*authConfirmationDTO)
+		authToken, errorAuthConfirmation = ApplicationHandler.AuthConfirmation(*authConfirmationDTO, "")
 
 		if errorAuthConfirmation != nil {
 			return StatusError, errorAuthConfirmation
@@ -101,12 +115,12 @@ func authConfirmation(message string) (int, error) {
 }
 
 func authRefresh(_ string) (int, error) {
-	token, errorExtractClaimsFromContext := tokenFromContext()
+	token, errorExtractClaimsFromContext := refreshTokenFromContext()
 
 	if errorExtractClaimsFromContext != nil {
 		return StatusError, errorExtractClaimsFromContext
 	}
-	newAuthToken, errorAuthToken := ApplicationHandler.AuthTokenByUserId(&token.UserId)
+	newAuthToken, errorAuthToken := ApplicationHandler.AuthRefresh(token)
 
 	if errorAuthToken != nil {
 		return StatusError, errorAuthToken
@@ -117,6 +131,250 @@ func authRefresh(_ string) (int, error) {
 	}
 }
 
+func authLogout(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	authLogoutStatus, errorAuthLogoutStatus := ApplicationHandler.AuthLogout(token)
+
+	if errorAuthLogoutStatus != nil {
+		return StatusError, errorAuthLogoutStatus
+	} else if authLogoutStatus {
+		authToken = nil
+
+		showInfoMessage(statusAuthLogoutSuccess)
+
+		return StatusOk, nil
+	} else {
+		return StatusError, statusAuthLogoutError
+	}
+}
+
+func authSessionsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	userSessions, errorUserSessions := ApplicationHandler.UserSessionsInfo(&token.UserId)
+
+	if errorUserSessions != nil {
+		return StatusError, errorUserSessions
+	} else {
+		printTable("UserSession", userSessions, DomainEntity.UserSession{})
+
+		return StatusOk, nil
+	}
+}
+
+func authSessionRevoke(message string) (int, error) {
+	if message == "AuthSessionRevoke" {
+		showDialogMessage("input id for UserSession")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	sessionIdValue, errorSessionId := uuid.Parse(message)
+
+	if errorSessionId != nil {
+		return StatusError, errorSessionId
+	} else {
+		userSessionRevokeStatus, errorUserSessionRevokeStatus := ApplicationHandler.UserSessionRevoke(&sessionIdValue, &token.UserId)
+
+		if errorUserSessionRevokeStatus != nil {
+			return StatusError, errorUserSessionRevokeStatus
+		} else if userSessionRevokeStatus {
+			showInfoMessage(statusUserSessionRevokeSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusUserSessionRevokeError
+		}
+	}
+}
+
+func authPasswordReset(message string) (int, error) {
+	if message == "AuthPasswordReset" {
+		showDialogMessage("your username")
+
+		return StatusContinue, nil
+	}
+
+	responseAuthConfirmation, _, errorAuthPasswordReset := ApplicationHandler.AuthPasswordReset(dto.AuthPasswordResetDTO{Username: message})
+
+	if errorAuthPasswordReset != nil {
+		return StatusError, errorAuthPasswordReset
+	} else {
+		showInfoMessage(responseAuthConfirmation.Message, "")
+
+		return StatusOk, nil
+	}
+}
+
+func authPasswordResetConfirmation(message string) (int, error) {
+	if authPasswordResetDTO == nil {
+		authPasswordResetDTO = &dto.AuthPasswordResetConfirmationDTO{}
+		showDialogMessage("your username")
+	} else if authPasswordResetDTO.Username == "" {
+		authPasswordResetDTO.Username = message
+		showDialogMessage("your confirmation code")
+	} else if authPasswordResetDTO.Code == "" {
+		authPasswordResetDTO.Code = message
+		showDialogMessage("your new password")
+	} else if authPasswordResetDTO.Password == "" {
+		authPasswordResetDTO.Password = message
+
+		authPasswordStatus, errorAuthPasswordStatus := ApplicationHandler.AuthPasswordResetConfirmation(*authPasswordResetDTO, "")
+
+		authPasswordResetDTO = nil
+
+		if errorAuthPasswordStatus != nil {
+			return StatusError, errorAuthPasswordStatus
+		} else if authPasswordStatus {
+			showInfoMessage(statusAuthPasswordSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusAuthPasswordError
+		}
+	} else {
+		return StatusError, errorWeirdBehaviour
+	}
+
+	return StatusContinue, nil
+}
+
+func authPasswordChange(message string) (int, error) {
+	if authPasswordChangeDTO == nil {
+		authPasswordChangeDTO = &dto.AuthPasswordChangeDTO{}
+		showDialogMessage("your current password")
+	} else if authPasswordChangeDTO.Password == "" {
+		authPasswordChangeDTO.Password = message
+		showDialogMessage("your new password")
+	} else if authPasswordChangeDTO.PasswordNew == "" {
+		authPasswordChangeDTO.PasswordNew = message
+
+		authPasswordChangeDTOValue := *authPasswordChangeDTO
+		authPasswordChangeDTO = nil
+
+		token, errorExtractClaimsFromContext := tokenFromContext()
+
+		if errorExtractClaimsFromContext != nil {
+			return StatusError, errorExtractClaimsFromContext
+		}
+
+		authPasswordStatus, errorAuthPasswordStatus := ApplicationHandler.AuthPasswordChange(&token.UserId, authPasswordChangeDTOValue)
+
+		if errorAuthPasswordStatus != nil {
+			return StatusError, errorAuthPasswordStatus
+		} else if authPasswordStatus {
+			authToken = nil
+
+			showInfoMessage(statusAuthPasswordSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusAuthPasswordError
+		}
+	} else {
+		return StatusError, errorWeirdBehaviour
+	}
+
+	return StatusContinue, nil
+}
+
+func authTotpEnrol(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	authTotpEnrol, errorAuthTotpEnrol := ApplicationHandler.AuthTotpEnrol(&token.UserId)
+
+	if errorAuthTotpEnrol != nil {
+		return StatusError, errorAuthTotpEnrol
+	}
+
+	showInfoMessage("the secret of the authenticator application: %s", authTotpEnrol.Secret)
+	showInfoMessage("the URI of the authenticator application: %s", authTotpEnrol.Uri)
+	showInfoMessage("confirm it by the AuthTotpVerify command")
+
+	return StatusOk, nil
+}
+
+func authTotpVerify(message string) (int, error) {
+	if message == "AuthTotpVerify" {
+		showDialogMessage("the code of the authenticator application")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	authTotpRecoveryCodes, errorAuthTotpVerify := ApplicationHandler.AuthTotpVerify(&token.UserId, dto.AuthTotpDTO{Code: message})
+
+	if errorAuthTotpVerify != nil {
+		return StatusError, errorAuthTotpVerify
+	}
+
+	showInfoMessage("the two-factor authentication is enabled, keep the recovery codes, they are shown once:")
+	showInfoMessage(strings.Join(authTotpRecoveryCodes.RecoveryCodes, "\n"))
+
+	return StatusOk, nil
+}
+
+func authTotpDisable(message string) (int, error) {
+	if authTotpDisableDTO == nil {
+		authTotpDisableDTO = &dto.AuthTotpDisableDTO{}
+		showDialogMessage("your password")
+	} else if authTotpDisableDTO.Password == "" {
+		authTotpDisableDTO.Password = message
+		showDialogMessage("the code of the authenticator application or a recovery code")
+	} else if authTotpDisableDTO.Code == "" {
+		authTotpDisableDTO.Code = message
+
+		authTotpDisableDTOValue := *authTotpDisableDTO
+		authTotpDisableDTO = nil
+
+		token, errorExtractClaimsFromContext := tokenFromContext()
+
+		if errorExtractClaimsFromContext != nil {
+			return StatusError, errorExtractClaimsFromContext
+		}
+
+		authTotpDisableStatus, errorAuthTotpDisableStatus := ApplicationHandler.AuthTotpDisable(&token.UserId, authTotpDisableDTOValue)
+
+		if errorAuthTotpDisableStatus != nil {
+			return StatusError, errorAuthTotpDisableStatus
+		} else if authTotpDisableStatus {
+			showInfoMessage(statusAuthTotpDisableSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusAuthTotpDisableError
+		}
+	} else {
+		return StatusError, errorWeirdBehaviour
+	}
+
+	return StatusContinue, nil
+}
+
 func authRegister(message string) (int, error) {
 	if userRegisterDTO == nil {
 		userRegisterDTO = &dto.UserRegisterDTO{}
@@ -164,13 +422,35 @@ func tokenFromContext() (*model.Token, error) {
 		return nil, errorAuthentication
 	}
 
-	jwtToken, errorVerifyToken := jwtauth.VerifyToken(jwtAuth, authToken.RefreshToken)
+	contextWithJwt, errorVerifyToken := tokenContext(authToken.AccessToken)
 
 	if errorVerifyToken != nil {
 		return nil, errorVerifyToken
 	}
 
-	contextWithJwt := jwtauth.NewContext(context.TODO(), jwtToken, errorVerifyToken)
-
 	return UiService.ExtractClaimsFromContext(contextWithJwt)
 }
+
+func refreshTokenFromContext() (*model.Token, error) {
+	if authToken == nil {
+		return nil, errorAuthentication
+	}
+
+	contextWithJwt, errorVerifyToken := tokenContext(authToken.RefreshToken)
+
+	if errorVerifyToken != nil {
+		return nil, errorVerifyToken
+	}
+
+	return UiService.ExtractRefreshClaimsFromContext(contextWithJwt)
+}
+
+func tokenContext(tokenString string) (context.Context, error) {
+	jwtToken, errorVerifyToken := jwtauth.VerifyToken(jwtAuth, tokenString)
+
+	if errorVerifyToken != nil {
+		return nil, errorVerifyToken
+	}
+
+	return jwtauth.NewContext(context.TODO(), jwtToken, errorVerifyToken), nil
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"os"
+	"strings"
+)
+
+var (
+	catalogueExportStep   int
+	catalogueExportEntity kind.CatalogueEntity
+	catalogueExportFormat kind.CatalogueFormat
+	catalogueImportStep   int
+	catalogueImportEntity kind.CatalogueEntity
+	catalogueImportFormat kind.CatalogueFormat
+	catalogueImportDryRun bool
+)
+
+func catalogueExport(message string) (int, error) {
+	if message == "CatalogueExport" {
+		catalogueExportStep = 0
+		catalogueExportEntity = ""
+		catalogueExportFormat = ""
+		showCatalogueEntityDialogMessage()
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	catalogueExportStep++
+
+	switch catalogueExportStep {
+	case 1:
+		catalogueExportEntity = kind.CatalogueEntity(message)
+		showCatalogueFormatDialogMessage()
+
+		return StatusContinue, nil
+	case 2:
+		catalogueExportFormat = kind.CatalogueFormat(message)
+		showDialogMessage("input path to a file to write the catalogue to")
+
+		return StatusContinue, nil
+	}
+
+	file, errorFile := os.Create(message)
+
+	if errorFile != nil {
+		return StatusError, errorFile
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	errorCatalogueExport := handler.CatalogueExport(&token.UserId, catalogueExportEntity, catalogueExportFormat, file)
+
+	if errorCatalogueExport != nil {
+		return StatusError, errorCatalogueExport
+	}
+
+	showInfoMessage("the catalogue of %s has been exported to %s", catalogueExportEntity, message)
+
+	return StatusOk, nil
+}
+
+func catalogueImport(message string) (int, error) {
+	if message == "CatalogueImport" {
+		catalogueImportStep = 0
+		catalogueImportEntity = ""
+		catalogueImportFormat = ""
+		catalogueImportDryRun = false
+		showCatalogueEntityDialogMessage()
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	catalogueImportStep++
+
+	switch catalogueImportStep {
+	case 1:
+		catalogueImportEntity = kind.CatalogueEntity(message)
+		showCatalogueFormatDialogMessage()
+
+		return StatusContinue, nil
+	case 2:
+		catalogueImportFormat = kind.CatalogueFormat(message)
+		showDialogMessage("input \"yes\" to validate the catalogue without changing anything")
+
+		return StatusContinue, nil
+	case 3:
+		catalogueImportDryRun = strings.ToLower(message) == "yes"
+		showDialogMessage("input path to a file to read the catalogue from")
+
+		return StatusContinue, nil
+	}
+
+	file, errorFile := os.Open(message)
+
+	if errorFile != nil {
+		return StatusError, errorFile
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	catalogueImportResult, errorCatalogueImport := handler.CatalogueImport(
+		&token.UserId,
+		catalogueImportEntity,
+		catalogueImportFormat,
+		file,
+		catalogueImportDryRun,
+	)
+
+	if errorCatalogueImport != nil {
+		return StatusError, errorCatalogueImport
+	}
+
+	printTable("CatalogueImportRow", catalogueImportResult.Rows, DomainAggregate.CatalogueImportRow{})
+	showInfoMessage(
+		"the catalogue of %s has been imported: created=%d, updated=%d, unchanged=%d, failed=%d, dry_run=%t",
+		catalogueImportResult.Entity,
+		catalogueImportResult.Created,
+		catalogueImportResult.Updated,
+		catalogueImportResult.Unchanged,
+		catalogueImportResult.Failed,
+		catalogueImportResult.DryRun,
+	)
+
+	return StatusOk, nil
+}
+
+func showCatalogueEntityDialogMessage() {
+	showDialogMessage(
+		"input entity for Catalogue. choose from (%v,%v,%v,%v)",
+		kind.CatalogueEntityIngredients,
+		kind.CatalogueEntityUnits,
+		kind.CatalogueEntityCategories,
+		kind.CatalogueEntityRecipes,
+	)
+}
+
+func showCatalogueFormatDialogMessage() {
+	showDialogMessage("input format for Catalogue. choose from (%v,%v)", kind.CatalogueFormatCSV, kind.CatalogueFormatNDJSON)
+}
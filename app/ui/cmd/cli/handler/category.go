@@ -64,6 +64,7 @@ func categoryCreate(message string) (int, error) {
 			return StatusError, errorCategory
 		} else {
 			printTable("CategoryAggregate", []*DomainAggregate.Category{category}, DomainAggregate.Category{})
+			printSimilar(handler.CategorySimilar(&token.UserId, category.Entity.Name, &category.Entity.Id))
 
 			return StatusOk, nil
 		}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"strconv"
+	"strings"
+)
+
+var (
+	cookableQuery           *DomainAggregate.CookableQuery
+	cookableStep            int
+	errorCookableSubstitute = errors.New("a substitute has to be given as ingredient_id:substitute_id")
+)
+
+func recipesCookable(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if cookableQuery == nil {
+		cookableQuery = &DomainAggregate.CookableQuery{}
+		cookableStep = 0
+		showDialogMessage("input ids of available ingredients separated by comma or \"-\" to skip")
+
+		return StatusContinue, nil
+	}
+
+	cookableStep++
+
+	switch cookableStep {
+	case 1:
+		if message != "-" {
+			for _, ingredient := range strings.Split(message, ",") {
+				ingredientId, errorIngredientId := uuid.Parse(strings.TrimSpace(ingredient))
+
+				if errorIngredientId != nil {
+					cookableQuery = nil
+
+					return StatusError, errorIngredientId
+				}
+
+				cookableQuery.Ingredients = append(cookableQuery.Ingredients, ingredientId)
+			}
+		}
+
+		showDialogMessage("input \"yes\" to take ingredients from the pantry")
+	case 2:
+		cookableQuery.UsePantry = strings.ToLower(message) == "yes"
+		showDialogMessage("input substitutes as ingredient_id:substitute_id separated by comma or \"-\" to skip")
+	case 3:
+		if message != "-" {
+			for _, pair := range strings.Split(message, ",") {
+				ids := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+
+				if len(ids) != 2 {
+					cookableQuery = nil
+
+					return StatusError, errorCookableSubstitute
+				}
+
+				ingredientId, errorIngredientId := uuid.Parse(ids[0])
+
+				if errorIngredientId != nil {
+					cookableQuery = nil
+
+					return StatusError, errorIngredientId
+				}
+
+				substituteId, errorSubstituteId := uuid.Parse(ids[1])
+
+				if errorSubstituteId != nil {
+					cookableQuery = nil
+
+					return StatusError, errorSubstituteId
+				}
+
+				cookableQuery.Substitutes = append(
+					cookableQuery.Substitutes,
+					&DomainAggregate.CookableSubstitute{IngredientId: ingredientId, SubstituteId: substituteId},
+				)
+			}
+		}
+
+		showDialogMessage("input max number of missing ingredients or \"-\" to skip")
+	default:
+		if message != "-" {
+			maxMissing, errorMaxMissing := strconv.ParseInt(message, 10, 64)
+
+			if errorMaxMissing != nil {
+				cookableQuery = nil
+
+				return StatusError, errorMaxMissing
+			}
+
+			cookableQuery.MaxMissing = maxMissing
+		}
+
+		cookables, errorCookables := handler.RecipesCookable(&token.UserId, cookableQuery)
+
+		cookableQuery = nil
+
+		if errorCookables != nil {
+			return StatusError, errorCookables
+		} else {
+			printTable("Cookable", cookables, DomainAggregate.Cookable{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
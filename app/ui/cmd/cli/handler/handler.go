@@ -32,6 +32,42 @@ var (
 				Description: "the AuthRefresh command to refresh authentication.",
 				Function:    authRefresh,
 			},
+			"AuthLogout": {
+				Description: "the AuthLogout command to revoke the current session.",
+				Function:    authLogout,
+			},
+			"AuthSessionsInfo": {
+				Description: "the AuthSessionsInfo command to show the active sessions.",
+				Function:    authSessionsInfo,
+			},
+			"AuthSessionRevoke": {
+				Description: "the AuthSessionRevoke command to revoke a session for specific id.",
+				Function:    authSessionRevoke,
+			},
+			"AuthPasswordReset": {
+				Description: "the AuthPasswordReset command to request a password reset code.",
+				Function:    authPasswordReset,
+			},
+			"AuthPasswordResetConfirmation": {
+				Description: "the AuthPasswordResetConfirmation command to set a new password with a reset code.",
+				Function:    authPasswordResetConfirmation,
+			},
+			"AuthPasswordChange": {
+				Description: "the AuthPasswordChange command to change the password of the current user.",
+				Function:    authPasswordChange,
+			},
+			"AuthTotpEnrol": {
+				Description: "the AuthTotpEnrol command to enrol the two-factor authentication.",
+				Function:    authTotpEnrol,
+			},
+			"AuthTotpVerify": {
+				Description: "the AuthTotpVerify command to enable the two-factor authentication by the code of the authenticator application.",
+				Function:    authTotpVerify,
+			},
+			"AuthTotpDisable": {
+				Description: "the AuthTotpDisable command to disable the two-factor authentication.",
+				Function:    authTotpDisable,
+			},
 			"AuthRegister": {
 				Description: "the AuthRegister command to register a user.",
 				Function:    authRegister,
@@ -41,11 +77,11 @@ var (
 				Function:    getParentIds,
 			},
 			"SetParentId": {
-				Description: "the SetParentId command to set any amount of parent id. try one of these - (picture_id, category_id, ingredient_id, process_id, recipe_id).",
+				Description: "the SetParentId command to set any amount of parent id. try one of these - (picture_id, category_id, ingredient_id, process_id, recipe_id, shopping_list_id).",
 				Function:    setParentId,
 			},
 			"RemoveParentId": {
-				Description: "the RemoveParentId command to remove any amount of parent id. try one of these - (picture_id, category_id, ingredient_id, process_id, recipe_id).",
+				Description: "the RemoveParentId command to remove any amount of parent id. try one of these - (picture_id, category_id, ingredient_id, process_id, recipe_id, shopping_list_id).",
 				Function:    removeParentId,
 			},
 			"ClearParentIds": {
@@ -72,6 +108,14 @@ var (
 				Description: "the AltNameDelete command to delete an alt name for specific id and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds).",
 				Function:    altNameDelete,
 			},
+			"CatalogueExport": {
+				Description: "the CatalogueExport command to write ingredients, units, categories or recipes to a CSV or NDJSON file for specific user.",
+				Function:    catalogueExport,
+			},
+			"CatalogueImport": {
+				Description: "the CatalogueImport command to create or update ingredients, units, categories or recipes by their names from a CSV or NDJSON file and show a result of every row for specific user.",
+				Function:    catalogueImport,
+			},
 			"CategoriesInfo": {
 				Description: "the CategoriesInfo command to show all of categories for specific parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds).",
 				Function:    categoriesInfo,
@@ -88,6 +132,10 @@ var (
 				Description: "the CategoryUpdate command to update a category and show one for specific id and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds).",
 				Function:    categoryUpdate,
 			},
+			"CategoryDuplicates": {
+				Description: "the CategoryDuplicates command to show pairs of similar categories to merge for specific user.",
+				Function:    categoryDuplicates,
+			},
 			"CategoryDelete": {
 				Description: "the CategoryDelete command to delete a category for specific id and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds).",
 				Function:    categoryDelete,
@@ -100,6 +148,10 @@ var (
 				Description: "the IngredientCreate command to create an ingredient and show one for specific user.",
 				Function:    ingredientCreate,
 			},
+			"IngredientDuplicates": {
+				Description: "the IngredientDuplicates command to show pairs of similar ingredients to merge for specific user.",
+				Function:    ingredientDuplicates,
+			},
 			"IngredientInfo": {
 				Description: "the IngredientInfo command to show an ingredient for specific id and user.",
 				Function:    ingredientInfo,
@@ -132,6 +184,54 @@ var (
 				Description: "the PictureDelete command to delete a picture for specific id and user.",
 				Function:    pictureDelete,
 			},
+			"HouseholdsInfo": {
+				Description: "the HouseholdsInfo command to show all of households owned by or shared with specific user.",
+				Function:    householdsInfo,
+			},
+			"HouseholdCreate": {
+				Description: "the HouseholdCreate command to create a household and show one for specific user.",
+				Function:    householdCreate,
+			},
+			"HouseholdInfo": {
+				Description: "the HouseholdInfo command to show a household with its members and grants for specific id and user.",
+				Function:    householdInfo,
+			},
+			"HouseholdUpdate": {
+				Description: "the HouseholdUpdate command to update a household and show one for specific id and user.",
+				Function:    householdUpdate,
+			},
+			"HouseholdDelete": {
+				Description: "the HouseholdDelete command to delete a household with its members and grants for specific id and user.",
+				Function:    householdDelete,
+			},
+			"HouseholdInvite": {
+				Description: "the HouseholdInvite command to invite a user by username to a household for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    householdInvite,
+			},
+			"HouseholdMemberRevoke": {
+				Description: "the HouseholdMemberRevoke command to revoke a household member with their grants for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    householdMemberRevoke,
+			},
+			"HouseholdInvitationsInfo": {
+				Description: "the HouseholdInvitationsInfo command to show all of pending household invitations for specific user.",
+				Function:    householdInvitationsInfo,
+			},
+			"HouseholdInvitationAccept": {
+				Description: "the HouseholdInvitationAccept command to accept a household invitation and show the household for specific id and user.",
+				Function:    householdInvitationAccept,
+			},
+			"HouseholdInvitationDecline": {
+				Description: "the HouseholdInvitationDecline command to decline a household invitation for specific id and user.",
+				Function:    householdInvitationDecline,
+			},
+			"HouseholdGrantCreate": {
+				Description: "the HouseholdGrantCreate command to share a planner or a recipe with a household and show the grant for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    householdGrantCreate,
+			},
+			"HouseholdGrantDelete": {
+				Description: "the HouseholdGrantDelete command to delete a household grant for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    householdGrantDelete,
+			},
 			"PlannersInfo": {
 				Description: "the PlannersInfo command to show all of planners for specific user.",
 				Function:    plannersInfo,
@@ -152,6 +252,50 @@ var (
 				Description: "the PlannerDelete command to delete a planner for specific id and user.",
 				Function:    plannerDelete,
 			},
+			"PlannerShoppingList": {
+				Description: "the PlannerShoppingList command to show a shopping list of a planner without the pantry stock for specific id and user.",
+				Function:    plannerShoppingList,
+			},
+			"PlannerCost": {
+				Description: "the PlannerCost command to show an estimated cost of a planner by the latest prices of ingredients and warnings about its budget for specific id and user.",
+				Function:    plannerCost,
+			},
+			"PlannerGenerate": {
+				Description: "the PlannerGenerate command to fill empty intervals of a planner by recipes satisfying constraints (seed, categories, max repeats, budget, pantry) for specific id and user.",
+				Function:    plannerGenerate,
+			},
+			"PlannerCalendar": {
+				Description: "the PlannerCalendar command to show an RFC 5545 calendar of a planner with an event for every interval for specific id and user.",
+				Function:    plannerCalendar,
+			},
+			"PlannerCalendarToken": {
+				Description: "the PlannerCalendarToken command to make a new token of the calendar subscription of a planner for specific id and user, a previous token stops working.",
+				Function:    plannerCalendarToken,
+			},
+			"PlannerCalendarImport": {
+				Description: "the PlannerCalendarImport command to make intervals of a planner from the events of an RFC 5545 calendar file with recipes matched by the summaries of the events for specific id and user.",
+				Function:    plannerCalendarImport,
+			},
+			"PlannerTemplatesInfo": {
+				Description: "the PlannerTemplatesInfo command to show all of planner templates for specific user.",
+				Function:    plannerTemplatesInfo,
+			},
+			"PlannerTemplateCreate": {
+				Description: "the PlannerTemplateCreate command to create a planner template with a recurrence (none, weekly, fortnightly) and show one for specific user.",
+				Function:    plannerTemplateCreate,
+			},
+			"PlannerTemplateDelete": {
+				Description: "the PlannerTemplateDelete command to delete a planner template with its intervals for specific id and user.",
+				Function:    plannerTemplateDelete,
+			},
+			"PlannerTemplateInstantiate": {
+				Description: "the PlannerTemplateInstantiate command to create a planner with intervals and recipes of a planner template for specific id, start time and user.",
+				Function:    plannerTemplateInstantiate,
+			},
+			"PlannerTemplateIntervalCreate": {
+				Description: "the PlannerTemplateIntervalCreate command to create a planner template interval and show one for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    plannerTemplateIntervalCreate,
+			},
 			"PlannerIntervalsInfo": {
 				Description: "the PlannerIntervalsInfo command to show all of planner intervals for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
 				Function:    plannerIntervalsInfo,
@@ -192,6 +336,138 @@ var (
 				Description: "the PlannerRecipeDelete command to delete a planner recipe for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
 				Function:    plannerRecipeDelete,
 			},
+			"PlannerRecipeCook": {
+				Description: "the PlannerRecipeCook command to mark a planner recipe as cooked and take its measures from the pantry for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    plannerRecipeCook,
+			},
+			"IngredientPricesInfo": {
+				Description: "the IngredientPricesInfo command to show a history of prices of an ingredient for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientPricesInfo,
+			},
+			"IngredientPriceCreate": {
+				Description: "the IngredientPriceCreate command to create a price of an ingredient and show one for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientPriceCreate,
+			},
+			"IngredientPriceDelete": {
+				Description: "the IngredientPriceDelete command to delete a price of an ingredient for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientPriceDelete,
+			},
+			"IngredientSubstitutesInfo": {
+				Description: "the IngredientSubstitutesInfo command to show all of substitutes of an ingredient for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientSubstitutesInfo,
+			},
+			"IngredientSubstituteCreate": {
+				Description: "the IngredientSubstituteCreate command to create a substitute of an ingredient and show one for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientSubstituteCreate,
+			},
+			"IngredientSubstituteDelete": {
+				Description: "the IngredientSubstituteDelete command to delete a substitute of an ingredient for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    ingredientSubstituteDelete,
+			},
+			"PantryItemsInfo": {
+				Description: "the PantryItemsInfo command to show all of pantry items for specific user.",
+				Function:    pantryItemsInfo,
+			},
+			"PantryItemCreate": {
+				Description: "the PantryItemCreate command to create a pantry item and show one for specific user.",
+				Function:    pantryItemCreate,
+			},
+			"PantryItemInfo": {
+				Description: "the PantryItemInfo command to show a pantry item for specific id and user.",
+				Function:    pantryItemInfo,
+			},
+			"PantryItemUpdate": {
+				Description: "the PantryItemUpdate command to update a pantry item and show one for specific id and user.",
+				Function:    pantryItemUpdate,
+			},
+			"PantryItemDelete": {
+				Description: "the PantryItemDelete command to delete a pantry item for specific id and user.",
+				Function:    pantryItemDelete,
+			},
+			"ShoppingListsInfo": {
+				Description: "the ShoppingListsInfo command to show all of shopping lists for specific user.",
+				Function:    shoppingListsInfo,
+			},
+			"ShoppingListGenerate": {
+				Description: "the ShoppingListGenerate command to generate a shopping list from a planner or a date range and show one for specific user.",
+				Function:    shoppingListGenerate,
+			},
+			"ShoppingListInfo": {
+				Description: "the ShoppingListInfo command to show a shopping list for specific id and user.",
+				Function:    shoppingListInfo,
+			},
+			"ShoppingListRegenerate": {
+				Description: "the ShoppingListRegenerate command to regenerate a shopping list keeping checked and manual items and show one for specific id and user.",
+				Function:    shoppingListRegenerate,
+			},
+			"ShoppingListDelete": {
+				Description: "the ShoppingListDelete command to delete a shopping list for specific id and user.",
+				Function:    shoppingListDelete,
+			},
+			"ShoppingListItemsInfo": {
+				Description: "the ShoppingListItemsInfo command to show all of shopping list items for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    shoppingListItemsInfo,
+			},
+			"ShoppingListItemCreate": {
+				Description: "the ShoppingListItemCreate command to create a manual shopping list item and show one for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    shoppingListItemCreate,
+			},
+			"ShoppingListItemUpdate": {
+				Description: "the ShoppingListItemUpdate command to update a shopping list item and show one for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    shoppingListItemUpdate,
+			},
+			"ShoppingListItemDelete": {
+				Description: "the ShoppingListItemDelete command to delete a shopping list item for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    shoppingListItemDelete,
+			},
+			"RecipeCost": {
+				Description: "the RecipeCost command to show an estimated cost of a recipe and of its serving by the latest prices of ingredients for specific id and user.",
+				Function:    recipeCost,
+			},
+			"RecipeSubstitutesInfo": {
+				Description: "the RecipeSubstitutesInfo command to show substitutes of every ingredient of a recipe suitable for contexts for specific id and user.",
+				Function:    recipeSubstitutesInfo,
+			},
+			"RecipeSubstitute": {
+				Description: "the RecipeSubstitute command to create a variant of a recipe with ingredients replaced by their substitutes and show one for specific id and user.",
+				Function:    recipeSubstitute,
+			},
+			"PublicRecipesInfo": {
+				Description: "the PublicRecipesInfo command to show a page of published recipes of all users filtered by words, a category and an ingredient.",
+				Function:    publicRecipesInfo,
+			},
+			"PublicRecipeInfo": {
+				Description: "the PublicRecipeInfo command to show a published recipe of any user with its published children for specific id.",
+				Function:    publicRecipeInfo,
+			},
+			"RecipeFork": {
+				Description: "the RecipeFork command to fork an own or a published recipe of another user with all of its children and show one for specific id and user.",
+				Function:    recipeFork,
+			},
+			"RecipeForkUpstream": {
+				Description: "the RecipeForkUpstream command to show changes of the upstream recipe since the fork for specific id and user.",
+				Function:    recipeForkUpstream,
+			},
+			"RecipeVersionsInfo": {
+				Description: "the RecipeVersionsInfo command to show all of versions of a recipe for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    recipeVersionsInfo,
+			},
+			"RecipeVersionInfo": {
+				Description: "the RecipeVersionInfo command to show a version of a recipe with its snapshot for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    recipeVersionInfo,
+			},
+			"RecipeVersionDiff": {
+				Description: "the RecipeVersionDiff command to show changes between two versions of a recipe for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    recipeVersionDiff,
+			},
+			"RecipeVersionRevert": {
+				Description: "the RecipeVersionRevert command to revert a recipe to a version and show one for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
+				Function:    recipeVersionRevert,
+			},
+			"RecipesCookable": {
+				Description: "the RecipesCookable command to show recipes ranked by available ingredients, the pantry and allowed substitutes for specific user.",
+				Function:    recipesCookable,
+			},
 			"RecipesInfo": {
 				Description: "the RecipesInfo command to show all of recipes for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
 				Function:    recipesInfo,
@@ -200,6 +476,14 @@ var (
 				Description: "the RecipeCreate command to create a recipe and show one for specific user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
 				Function:    recipeCreate,
 			},
+			"RecipeExport": {
+				Description: "the RecipeExport command to show a recipe as a schema.org Recipe JSON-LD document or as a Markdown document for specific id and user.",
+				Function:    recipeExport,
+			},
+			"RecipeImport": {
+				Description: "the RecipeImport command to create a recipe with categories, ingredients, measures, processes and pictures from a schema.org Recipe JSON-LD file or an HTML file with it for specific user.",
+				Function:    recipeImport,
+			},
 			"RecipeInfo": {
 				Description: "the RecipeInfo command to show a recipe for specific id and user and parent_id. see commands (SetParentId, RemoveParentId, ClearParentIds)..",
 				Function:    recipeInfo,
@@ -312,8 +596,12 @@ var (
 				Description: "the UnitDelete command to delete a unit for specific id and user.",
 				Function:    unitDelete,
 			},
+			"UsersInfo": {
+				Description: "the UsersInfo command to show a page of users filtered by words of their usernames and names and by their status.",
+				Function:    usersInfo,
+			},
 			"UserInfo": {
-				Description: "the UserInfo command to show a user for specific id and user.",
+				Description: "the UserInfo command to show a user with its confirmations and roles for specific id.",
 				Function:    userInfo,
 			},
 			"UserUpdate": {
@@ -324,6 +612,26 @@ var (
 				Description: "the UserDelete command to delete a user for specific id and user.",
 				Function:    userDelete,
 			},
+			"UserDisable": {
+				Description: "the UserDisable command to disable a user and log it out for specific id.",
+				Function:    userDisable,
+			},
+			"UserEnable": {
+				Description: "the UserEnable command to enable a disabled user for specific id.",
+				Function:    userEnable,
+			},
+			"UserLogout": {
+				Description: "the UserLogout command to revoke all of tokens issued to a user for specific id.",
+				Function:    userLogout,
+			},
+			"UserRoleCreate": {
+				Description: "the UserRoleCreate command to bind a role to a user and show the binding for specific id.",
+				Function:    userRoleCreate,
+			},
+			"UserRoleDelete": {
+				Description: "the UserRoleDelete command to delete a role binding of a user for specific id.",
+				Function:    userRoleDelete,
+			},
 			"auth": {
 				Description: "the auth command to Help faster authentication for username=username.",
 				Function:    auth,
@@ -388,6 +696,9 @@ func (cl *commandList) HelpMessage() string {
 	return strings.Join(response, "\n")
 }
 
+// apiLink is the link of the API which the links in the output of the commands are made from.
+const apiLink = "/api/v1"
+
 const (
 	StatusOk = iota
 	StatusExit
@@ -0,0 +1,440 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"reflect"
+	"time"
+)
+
+var (
+	householdDTO                            *DomainEntity.Household
+	householdId                             *uuid.UUID
+	householdGrantDTO                       *DomainEntity.HouseholdGrant
+	statusHouseholdDeleteSuccess            = "the household has been deleted successful"
+	statusHouseholdDeleteError              = errors.New("the household has not been deleted")
+	statusHouseholdMemberRevokeSuccess      = "the household member has been revoked successful"
+	statusHouseholdMemberRevokeError        = errors.New("the household member has not been revoked")
+	statusHouseholdInvitationDeclineSuccess = "the household invitation has been declined successful"
+	statusHouseholdInvitationDeclineError   = errors.New("the household invitation has not been declined")
+	statusHouseholdGrantDeleteSuccess       = "the household grant has been deleted successful"
+	statusHouseholdGrantDeleteError         = errors.New("the household grant has not been deleted")
+)
+
+func householdsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	households, errorHouseholds := handler.HouseholdsInfo(&token.UserId)
+
+	if errorHouseholds != nil {
+		return StatusError, errorHouseholds
+	} else {
+		if households == nil {
+			households = []*DomainAggregate.Household{}
+		}
+
+		printTable("HouseholdAggregate", households, DomainAggregate.Household{})
+
+		return StatusOk, nil
+	}
+}
+
+func householdCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if householdDTO == nil {
+		householdDTO = &DomainEntity.Household{}
+		householdDTO.UserId = token.UserId
+		showDialogMessage("input name for Household")
+	} else if householdDTO.Name == "" {
+		householdDTO.Name = message
+
+		household, errorHousehold := handler.HouseholdCreate(&token.UserId, householdDTO)
+
+		householdDTO = nil
+
+		if errorHousehold != nil {
+			return StatusError, errorHousehold
+		} else {
+			printTable("HouseholdAggregate", []*DomainAggregate.Household{household}, DomainAggregate.Household{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func householdInfo(message string) (int, error) {
+	if message == "HouseholdInfo" {
+		showDialogMessage("input id for Household")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdIdValue, errorHouseholdId := uuid.Parse(message)
+
+	if errorHouseholdId != nil {
+		return StatusError, errorHouseholdId
+	} else {
+		household, errorHousehold := handler.HouseholdInfo(&householdIdValue, &token.UserId)
+
+		if errorHousehold != nil {
+			return StatusError, errorHousehold
+		} else {
+			printTable("HouseholdAggregate", []*DomainAggregate.Household{household}, DomainAggregate.Household{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func householdUpdate(message string) (int, error) {
+	var (
+		householdIdValue uuid.UUID
+		errorHouseholdId error
+	)
+
+	if message == "HouseholdUpdate" {
+		showDialogMessage("input id for Household")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if householdId == nil {
+		householdIdValue, errorHouseholdId = uuid.Parse(message)
+
+		householdId = &householdIdValue
+	} else {
+		errorHouseholdId = nil
+	}
+
+	if errorHouseholdId != nil {
+		householdId = nil
+
+		return StatusError, errorHouseholdId
+	} else if householdDTO == nil {
+		householdDTO = &DomainEntity.Household{}
+		householdDTO.Id = *householdId
+		householdDTO.UserId = token.UserId
+		householdDTO.DateUpdate = time.Now().UTC()
+		showDialogMessage("input name for Household")
+	} else if householdDTO.Name == "" {
+		householdDTO.Name = message
+		showDialogMessage("input status for Household. choose from (%v,%v)", kind.HouseholdStatusInActive, kind.HouseholdStatusActive)
+	} else if householdDTO.Status == "" {
+		householdDTO.Status = kind.HouseholdStatus(message)
+
+		household, errorHousehold := handler.HouseholdUpdate(householdId, &token.UserId, householdDTO)
+
+		householdId = nil
+		householdDTO = nil
+
+		if errorHousehold != nil {
+			return StatusError, errorHousehold
+		} else {
+			printTable("HouseholdAggregate", []*DomainAggregate.Household{household}, DomainAggregate.Household{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func householdDelete(message string) (int, error) {
+	if message == "HouseholdDelete" {
+		showDialogMessage("input id for Household")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdIdValue, errorHouseholdId := uuid.Parse(message)
+
+	if errorHouseholdId != nil {
+		return StatusError, errorHouseholdId
+	} else {
+		householdDeleteStatus, errorHouseholdDeleteStatus := handler.HouseholdDelete(&householdIdValue, &token.UserId)
+
+		if errorHouseholdDeleteStatus != nil {
+			return StatusError, errorHouseholdDeleteStatus
+		} else if householdDeleteStatus {
+			showInfoMessage(statusHouseholdDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusHouseholdDeleteError
+		}
+	}
+}
+
+func householdInvite(message string) (int, error) {
+	if message == "HouseholdInvite" {
+		showDialogMessage("input username of a user to invite to Household")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "household_member_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else {
+		householdMember, errorHouseholdMember := handler.HouseholdInvite(parentId, &token.UserId, message)
+
+		if errorHouseholdMember != nil {
+			return StatusError, errorHouseholdMember
+		} else {
+			printTable("HouseholdMemberEntity", []*DomainEntity.HouseholdMember{householdMember}, DomainEntity.HouseholdMember{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func householdMemberRevoke(message string) (int, error) {
+	if message == "HouseholdMemberRevoke" {
+		showDialogMessage("input id for HouseholdMember")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdMemberIdValue, errorHouseholdMemberId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "household_member_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorHouseholdMemberId != nil {
+		return StatusError, errorHouseholdMemberId
+	} else {
+		householdMemberRevokeStatus, errorHouseholdMemberRevokeStatus := handler.HouseholdMemberRevoke(&householdMemberIdValue, &token.UserId, parentId)
+
+		if errorHouseholdMemberRevokeStatus != nil {
+			return StatusError, errorHouseholdMemberRevokeStatus
+		} else if householdMemberRevokeStatus {
+			showInfoMessage(statusHouseholdMemberRevokeSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusHouseholdMemberRevokeError
+		}
+	}
+}
+
+func householdInvitationsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdInvitations, errorHouseholdInvitations := handler.HouseholdInvitationsInfo(&token.UserId)
+
+	if errorHouseholdInvitations != nil {
+		return StatusError, errorHouseholdInvitations
+	} else {
+		if householdInvitations == nil {
+			householdInvitations = []*DomainAggregate.HouseholdInvitation{}
+		}
+
+		printTable("HouseholdInvitationAggregate", householdInvitations, DomainAggregate.HouseholdInvitation{})
+
+		return StatusOk, nil
+	}
+}
+
+func householdInvitationAccept(message string) (int, error) {
+	if message == "HouseholdInvitationAccept" {
+		showDialogMessage("input id for HouseholdInvitation")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdMemberIdValue, errorHouseholdMemberId := uuid.Parse(message)
+
+	if errorHouseholdMemberId != nil {
+		return StatusError, errorHouseholdMemberId
+	} else {
+		household, errorHousehold := handler.HouseholdInvitationAccept(&householdMemberIdValue, &token.UserId)
+
+		if errorHousehold != nil {
+			return StatusError, errorHousehold
+		} else {
+			printTable("HouseholdAggregate", []*DomainAggregate.Household{household}, DomainAggregate.Household{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func householdInvitationDecline(message string) (int, error) {
+	if message == "HouseholdInvitationDecline" {
+		showDialogMessage("input id for HouseholdInvitation")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdMemberIdValue, errorHouseholdMemberId := uuid.Parse(message)
+
+	if errorHouseholdMemberId != nil {
+		return StatusError, errorHouseholdMemberId
+	} else {
+		householdInvitationDeclineStatus, errorHouseholdInvitationDeclineStatus := handler.HouseholdInvitationDecline(&householdMemberIdValue, &token.UserId)
+
+		if errorHouseholdInvitationDeclineStatus != nil {
+			return StatusError, errorHouseholdInvitationDeclineStatus
+		} else if householdInvitationDeclineStatus {
+			showInfoMessage(statusHouseholdInvitationDeclineSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusHouseholdInvitationDeclineError
+		}
+	}
+}
+
+func householdGrantCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "household_grant_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if householdGrantDTO == nil {
+		householdGrantDTO = &DomainEntity.HouseholdGrant{}
+		householdGrantDTO.UserId = token.UserId
+		householdGrantDTO.EntityId = *parentId
+		showDialogMessage(
+			"input subject for HouseholdGrant. choose from (%v,%v,%v,%v,%v)",
+			kind.HouseholdSubjectPlanner,
+			kind.HouseholdSubjectRecipe,
+			kind.HouseholdSubjectShoppingList,
+			kind.HouseholdSubjectPantryItem,
+			kind.HouseholdSubjectPlannerTemplate,
+		)
+	} else if householdGrantDTO.Subject == "" {
+		householdGrantDTO.Subject = kind.HouseholdSubject(message)
+		showDialogMessage("input subject id for HouseholdGrant")
+	} else if reflect.ValueOf(householdGrantDTO.SubjectId).IsZero() {
+		subjectId, errorSubjectId := uuid.Parse(message)
+
+		if errorSubjectId != nil {
+			householdGrantDTO = nil
+
+			return StatusError, errorSubjectId
+		}
+
+		householdGrantDTO.SubjectId = subjectId
+		showDialogMessage("input right for HouseholdGrant. choose from (%v,%v)", kind.UserRightRead, kind.UserRightWrite)
+	} else if householdGrantDTO.Right == "" {
+		householdGrantDTO.Right = kind.UserRight(message)
+
+		householdGrant, errorHouseholdGrant := handler.HouseholdGrantCreate(&token.UserId, parentId, householdGrantDTO)
+
+		householdGrantDTO = nil
+
+		if errorHouseholdGrant != nil {
+			return StatusError, errorHouseholdGrant
+		} else {
+			printTable("HouseholdGrantEntity", []*DomainEntity.HouseholdGrant{householdGrant}, DomainEntity.HouseholdGrant{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func householdGrantDelete(message string) (int, error) {
+	if message == "HouseholdGrantDelete" {
+		showDialogMessage("input id for HouseholdGrant")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	householdGrantIdValue, errorHouseholdGrantId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "household_grant_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorHouseholdGrantId != nil {
+		return StatusError, errorHouseholdGrantId
+	} else {
+		householdGrantDeleteStatus, errorHouseholdGrantDeleteStatus := handler.HouseholdGrantDelete(&householdGrantIdValue, &token.UserId, parentId)
+
+		if errorHouseholdGrantDeleteStatus != nil {
+			return StatusError, errorHouseholdGrantDeleteStatus
+		} else if householdGrantDeleteStatus {
+			showInfoMessage(statusHouseholdGrantDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusHouseholdGrantDeleteError
+		}
+	}
+}
@@ -63,6 +63,7 @@ func ingredientCreate(message string) (int, error) {
 			return StatusError, errorIngredient
 		} else {
 			printTable("Ingredient", []*DomainEntity.Ingredient{ingredient}, DomainEntity.Ingredient{})
+			printSimilar(handler.IngredientSimilar(&token.UserId, ingredient.Name, &ingredient.Id))
 
 			return StatusOk, nil
 		}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"strconv"
+)
+
+var (
+	ingredientPriceDTO                 *DomainEntity.IngredientPrice
+	ingredientPriceStep                int
+	statusIngredientPriceDeleteSuccess = "the ingredient price has been deleted successful"
+	statusIngredientPriceDeleteError   = errors.New("the ingredient price has not been deleted")
+)
+
+func ingredientPricesInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_price_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	ingredientPrices, errorIngredientPrices := handler.IngredientPricesInfo(&token.UserId, parentId, nil)
+
+	if errorIngredientPrices != nil {
+		return StatusError, errorIngredientPrices
+	} else {
+		if ingredientPrices == nil {
+			ingredientPrices = []*DomainAggregate.IngredientPrice{}
+		}
+
+		printTable("IngredientPriceAggregate", ingredientPrices, DomainAggregate.IngredientPrice{})
+
+		return StatusOk, nil
+	}
+}
+
+func ingredientPriceCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_price_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	if ingredientPriceDTO == nil {
+		ingredientPriceDTO = &DomainEntity.IngredientPrice{}
+		ingredientPriceStep = 0
+		showDialogMessage("input unit id for IngredientPrice")
+
+		return StatusContinue, nil
+	}
+
+	ingredientPriceStep++
+
+	switch ingredientPriceStep {
+	case 1:
+		unitIdValue, errorUnitId := uuid.Parse(message)
+
+		if errorUnitId != nil {
+			ingredientPriceDTO = nil
+
+			return StatusError, errorUnitId
+		}
+
+		ingredientPriceDTO.UnitId = unitIdValue
+		showDialogMessage("input quantity of the unit for IngredientPrice")
+	case 2:
+		quantity, errorQuantity := strconv.ParseInt(message, 10, 64)
+
+		if errorQuantity != nil {
+			ingredientPriceDTO = nil
+
+			return StatusError, errorQuantity
+		}
+
+		ingredientPriceDTO.Quantity = quantity
+		showDialogMessage("input price in minor units of the currency for IngredientPrice")
+	case 3:
+		price, errorPrice := strconv.ParseInt(message, 10, 64)
+
+		if errorPrice != nil {
+			ingredientPriceDTO = nil
+
+			return StatusError, errorPrice
+		}
+
+		ingredientPriceDTO.Price = price
+		showDialogMessage("input currency for IngredientPrice")
+	case 4:
+		ingredientPriceDTO.Currency = message
+		showDialogMessage("input store for IngredientPrice or \"-\" to skip")
+	default:
+		if message != "-" {
+			ingredientPriceDTO.Store = message
+		}
+
+		ingredientPrice, errorIngredientPrice := handler.IngredientPriceCreate(&token.UserId, parentId, ingredientPriceDTO)
+
+		ingredientPriceDTO = nil
+
+		if errorIngredientPrice != nil {
+			return StatusError, errorIngredientPrice
+		} else {
+			printTable("IngredientPriceAggregate", []*DomainAggregate.IngredientPrice{ingredientPrice}, DomainAggregate.IngredientPrice{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func ingredientPriceDelete(message string) (int, error) {
+	if message == "IngredientPriceDelete" {
+		showDialogMessage("input id for IngredientPrice")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	ingredientPriceIdValue, errorIngredientPriceId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_price_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorIngredientPriceId != nil {
+		return StatusError, errorIngredientPriceId
+	} else {
+		ingredientPriceDeleteStatus, errorIngredientPriceDeleteStatus := handler.IngredientPriceDelete(&ingredientPriceIdValue, &token.UserId, parentId)
+
+		if errorIngredientPriceDeleteStatus != nil {
+			return StatusError, errorIngredientPriceDeleteStatus
+		} else if ingredientPriceDeleteStatus {
+			showInfoMessage(statusIngredientPriceDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusIngredientPriceDeleteError
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"strconv"
+	"strings"
+)
+
+var (
+	ingredientSubstituteDTO                 *DomainEntity.IngredientSubstitute
+	ingredientSubstituteStep                int
+	recipeSubstituteId                      *uuid.UUID
+	recipeSubstituteQuery                   *DomainAggregate.SubstituteQuery
+	recipeSubstituteStep                    int
+	statusIngredientSubstituteDeleteSuccess = "the ingredient substitute has been deleted successful"
+	statusIngredientSubstituteDeleteError   = errors.New("the ingredient substitute has not been deleted")
+)
+
+func ingredientSubstitutesInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_substitute_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	ingredientSubstitutes, errorIngredientSubstitutes := handler.IngredientSubstitutesInfo(&token.UserId, parentId, nil)
+
+	if errorIngredientSubstitutes != nil {
+		return StatusError, errorIngredientSubstitutes
+	} else {
+		if ingredientSubstitutes == nil {
+			ingredientSubstitutes = []*DomainAggregate.IngredientSubstitute{}
+		}
+
+		printTable("IngredientSubstituteAggregate", ingredientSubstitutes, DomainAggregate.IngredientSubstitute{})
+
+		return StatusOk, nil
+	}
+}
+
+func ingredientSubstituteCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_substitute_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	if ingredientSubstituteDTO == nil {
+		ingredientSubstituteDTO = &DomainEntity.IngredientSubstitute{}
+		ingredientSubstituteStep = 0
+		showDialogMessage("input substitute ingredient id for IngredientSubstitute")
+
+		return StatusContinue, nil
+	}
+
+	ingredientSubstituteStep++
+
+	switch ingredientSubstituteStep {
+	case 1:
+		substituteIdValue, errorSubstituteId := uuid.Parse(message)
+
+		if errorSubstituteId != nil {
+			ingredientSubstituteDTO = nil
+
+			return StatusError, errorSubstituteId
+		}
+
+		ingredientSubstituteDTO.SubstituteId = substituteIdValue
+		showDialogMessage("input ratio of the substitute to the ingredient for IngredientSubstitute")
+	case 2:
+		ratio, errorRatio := strconv.ParseFloat(message, 64)
+
+		if errorRatio != nil {
+			ingredientSubstituteDTO = nil
+
+			return StatusError, errorRatio
+		}
+
+		ingredientSubstituteDTO.Ratio = ratio
+		showDialogMessage("input contexts separated by comma for IngredientSubstitute or \"-\" to skip")
+	case 3:
+		ingredientSubstituteDTO.Contexts = substituteContexts(message)
+		showDialogMessage("input notes for IngredientSubstitute or \"-\" to skip")
+	default:
+		if message != "-" {
+			ingredientSubstituteDTO.Notes = message
+		}
+
+		ingredientSubstitute, errorIngredientSubstitute := handler.IngredientSubstituteCreate(&token.UserId, parentId, ingredientSubstituteDTO)
+
+		ingredientSubstituteDTO = nil
+
+		if errorIngredientSubstitute != nil {
+			return StatusError, errorIngredientSubstitute
+		} else {
+			printTable("IngredientSubstituteAggregate", []*DomainAggregate.IngredientSubstitute{ingredientSubstitute}, DomainAggregate.IngredientSubstitute{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func ingredientSubstituteDelete(message string) (int, error) {
+	if message == "IngredientSubstituteDelete" {
+		showDialogMessage("input id for IngredientSubstitute")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	ingredientSubstituteIdValue, errorIngredientSubstituteId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "ingredient_substitute_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorIngredientSubstituteId != nil {
+		return StatusError, errorIngredientSubstituteId
+	} else {
+		ingredientSubstituteDeleteStatus, errorIngredientSubstituteDeleteStatus := handler.IngredientSubstituteDelete(&ingredientSubstituteIdValue, &token.UserId, parentId)
+
+		if errorIngredientSubstituteDeleteStatus != nil {
+			return StatusError, errorIngredientSubstituteDeleteStatus
+		} else if ingredientSubstituteDeleteStatus {
+			showInfoMessage(statusIngredientSubstituteDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusIngredientSubstituteDeleteError
+		}
+	}
+}
+
+func recipeSubstitutesInfo(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if message == "RecipeSubstitutesInfo" {
+		recipeSubstituteId = nil
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	if recipeSubstituteId == nil {
+		recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+		if errorRecipeId != nil {
+			return StatusError, errorRecipeId
+		}
+
+		recipeSubstituteId = &recipeIdValue
+		showDialogMessage("input contexts separated by comma or \"-\" to skip")
+
+		return StatusContinue, nil
+	}
+
+	recipeId := recipeSubstituteId
+	recipeSubstituteId = nil
+	recipeSubstitutes, errorRecipeSubstitutes := handler.RecipeSubstitutesInfo(recipeId, &token.UserId, substituteContexts(message))
+
+	if errorRecipeSubstitutes != nil {
+		return StatusError, errorRecipeSubstitutes
+	} else {
+		printTable("RecipeIngredientSubstitutesAggregate", recipeSubstitutes, DomainAggregate.RecipeIngredientSubstitutes{})
+
+		return StatusOk, nil
+	}
+}
+
+func recipeSubstitute(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if recipeSubstituteQuery == nil {
+		recipeSubstituteQuery = &DomainAggregate.SubstituteQuery{}
+		recipeSubstituteStep = 0
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	recipeSubstituteStep++
+
+	switch recipeSubstituteStep {
+	case 1:
+		recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+		if errorRecipeId != nil {
+			recipeSubstituteQuery = nil
+
+			return StatusError, errorRecipeId
+		}
+
+		recipeSubstituteId = &recipeIdValue
+		showDialogMessage("input ids of ingredient substitutes separated by comma or \"-\" to skip")
+	case 2:
+		if message != "-" {
+			for _, substitute := range strings.Split(message, ",") {
+				substituteId, errorSubstituteId := uuid.Parse(strings.TrimSpace(substitute))
+
+				if errorSubstituteId != nil {
+					recipeSubstituteQuery = nil
+
+					return StatusError, errorSubstituteId
+				}
+
+				recipeSubstituteQuery.Substitutes = append(recipeSubstituteQuery.Substitutes, substituteId)
+			}
+		}
+
+		showDialogMessage("input contexts separated by comma or \"-\" to skip")
+	case 3:
+		recipeSubstituteQuery.Contexts = substituteContexts(message)
+		showDialogMessage("input \"yes\" to substitute ingredients which are not in the pantry")
+	default:
+		recipeSubstituteQuery.UsePantry = strings.ToLower(message) == "yes"
+
+		recipeSubstituteValue, errorRecipeSubstitute := handler.RecipeSubstitute(recipeSubstituteId, &token.UserId, recipeSubstituteQuery)
+
+		recipeSubstituteId = nil
+		recipeSubstituteQuery = nil
+
+		if errorRecipeSubstitute != nil {
+			return StatusError, errorRecipeSubstitute
+		} else {
+			printTable("RecipeAggregate", []*DomainAggregate.Recipe{recipeSubstituteValue.Recipe}, DomainAggregate.Recipe{})
+			printTable("SubstitutionAggregate", recipeSubstituteValue.Substitutions, DomainAggregate.Substitution{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+// substituteContexts parses the comma separated contexts, "-" skips them.
+func substituteContexts(message string) []kind.SubstituteContext {
+	var contexts []kind.SubstituteContext
+
+	if message == "-" {
+		return nil
+	}
+
+	for _, context := range strings.Split(message, ",") {
+		if context = strings.TrimSpace(context); context != "" {
+			contexts = append(contexts, kind.SubstituteContext(context))
+		}
+	}
+
+	return contexts
+}
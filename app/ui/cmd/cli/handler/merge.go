@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+)
+
+func ingredientDuplicates(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	suggestions, errorSuggestions := handler.IngredientDuplicates(&token.UserId)
+
+	if errorSuggestions != nil {
+		return StatusError, errorSuggestions
+	} else {
+		printTable("MergeSuggestion", suggestions, DomainAggregate.MergeSuggestion{})
+
+		return StatusOk, nil
+	}
+}
+
+func categoryDuplicates(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	suggestions, errorSuggestions := handler.CategoryDuplicates(&token.UserId)
+
+	if errorSuggestions != nil {
+		return StatusError, errorSuggestions
+	} else {
+		printTable("MergeSuggestion", suggestions, DomainAggregate.MergeSuggestion{})
+
+		return StatusOk, nil
+	}
+}
+
+func printSimilar(matches []*DomainAggregate.FuzzyMatch, errorMatches error) {
+	if errorMatches == nil && len(matches) > 0 {
+		showInfoMessage("similar entities already exist, consider merging them")
+		printTable("FuzzyMatch", matches, DomainAggregate.FuzzyMatch{})
+	}
+}
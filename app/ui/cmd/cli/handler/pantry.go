@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	pantryItemDTO                 *DomainEntity.PantryItem
+	pantryItemId                  *uuid.UUID
+	statusPantryItemDeleteSuccess = "the pantry item has been deleted successful"
+	statusPantryItemDeleteError   = errors.New("the pantry item has not been deleted")
+	errorPantryItemQuantity       = errors.New("the quantity of the pantry item must be greater than zero")
+)
+
+func pantryItemsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	pantryItems, errorPantryItems := handler.PantryItemsInfo(&token.UserId, nil)
+
+	if errorPantryItems != nil {
+		return StatusError, errorPantryItems
+	} else {
+		if pantryItems == nil {
+			pantryItems = []*DomainAggregate.PantryItem{}
+		}
+
+		printTable("PantryItemAggregate", pantryItems, DomainAggregate.PantryItem{})
+
+		return StatusOk, nil
+	}
+}
+
+func pantryItemCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if pantryItemDTO == nil {
+		pantryItemDTO = &DomainEntity.PantryItem{}
+		pantryItemDTO.UserId = token.UserId
+		showDialogMessage("input ingredient id for PantryItem")
+	} else if reflect.ValueOf(pantryItemDTO.IngredientId).IsZero() {
+		ingredientId, errorIngredientId := uuid.Parse(message)
+
+		if errorIngredientId != nil {
+			return StatusError, errorIngredientId
+		}
+
+		pantryItemDTO.IngredientId = ingredientId
+		showDialogMessage("input unit id for PantryItem")
+	} else if reflect.ValueOf(pantryItemDTO.UnitId).IsZero() {
+		unitId, errorUnitId := uuid.Parse(message)
+
+		if errorUnitId != nil {
+			return StatusError, errorUnitId
+		}
+
+		pantryItemDTO.UnitId = unitId
+		showDialogMessage("input quantity for PantryItem")
+	} else if pantryItemDTO.Quantity == 0 {
+		quantity, errorQuantity := parsePantryItemQuantity(message)
+
+		if errorQuantity != nil {
+			return StatusError, errorQuantity
+		}
+
+		pantryItemDTO.Quantity = quantity
+		showDialogMessage("input expiry time for PantryItem")
+	} else if reflect.ValueOf(pantryItemDTO.ExpiryTime).IsZero() {
+		parsedDate, errorParsedDate := time.Parse(time.RFC3339, message)
+
+		if errorParsedDate != nil {
+			return StatusError, errorParsedDate
+		}
+
+		pantryItemDTO.ExpiryTime = parsedDate
+		showDialogMessage("input status for PantryItem. choose from (%v,%v)", kind.PantryItemStatusInActive, kind.PantryItemStatusActive)
+	} else if pantryItemDTO.Status == "" {
+		pantryItemDTO.Status = kind.PantryItemStatus(message)
+
+		pantryItem, errorPantryItem := handler.PantryItemCreate(&token.UserId, pantryItemDTO)
+
+		pantryItemDTO = nil
+
+		if errorPantryItem != nil {
+			return StatusError, errorPantryItem
+		} else {
+			printTable("PantryItemAggregate", []*DomainAggregate.PantryItem{pantryItem}, DomainAggregate.PantryItem{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func pantryItemInfo(message string) (int, error) {
+	var (
+		pantryItemIdValue uuid.UUID
+		errorPantryItemId error
+	)
+
+	if message == "PantryItemInfo" {
+		showDialogMessage("input id for PantryItem")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if pantryItemId == nil {
+		pantryItemIdValue, errorPantryItemId = uuid.Parse(message)
+
+		pantryItemId = &pantryItemIdValue
+	} else {
+		errorPantryItemId = nil
+	}
+
+	if errorPantryItemId != nil {
+		return StatusError, errorPantryItemId
+	} else {
+		pantryItem, errorPantryItem := handler.PantryItemInfo(pantryItemId, &token.UserId, nil)
+
+		pantryItemId = nil
+
+		if errorPantryItem != nil {
+			return StatusError, errorPantryItem
+		} else {
+			printTable("PantryItemAggregate", []*DomainAggregate.PantryItem{pantryItem}, DomainAggregate.PantryItem{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func pantryItemUpdate(message string) (int, error) {
+	var (
+		pantryItemIdValue uuid.UUID
+		errorPantryItemId error
+	)
+
+	if message == "PantryItemUpdate" {
+		showDialogMessage("input id for PantryItem")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if pantryItemId == nil {
+		pantryItemIdValue, errorPantryItemId = uuid.Parse(message)
+
+		pantryItemId = &pantryItemIdValue
+	} else {
+		errorPantryItemId = nil
+	}
+
+	if errorPantryItemId != nil {
+		return StatusError, errorPantryItemId
+	} else if pantryItemDTO == nil {
+		pantryItemDTO = &DomainEntity.PantryItem{}
+		pantryItemDTO.Id = *pantryItemId
+		pantryItemDTO.UserId = token.UserId
+		pantryItemDTO.DateUpdate = time.Now().UTC()
+		showDialogMessage("input quantity for PantryItem")
+	} else if pantryItemDTO.Quantity == 0 {
+		quantity, errorQuantity := parsePantryItemQuantity(message)
+
+		if errorQuantity != nil {
+			return StatusError, errorQuantity
+		}
+
+		pantryItemDTO.Quantity = quantity
+		showDialogMessage("input expiry time for PantryItem")
+	} else if reflect.ValueOf(pantryItemDTO.ExpiryTime).IsZero() {
+		parsedDate, errorParsedDate := time.Parse(time.RFC3339, message)
+
+		if errorParsedDate != nil {
+			return StatusError, errorParsedDate
+		}
+
+		pantryItemDTO.ExpiryTime = parsedDate
+		showDialogMessage("input status for PantryItem. choose from (%v,%v)", kind.PantryItemStatusInActive, kind.PantryItemStatusActive)
+	} else if pantryItemDTO.Status == "" {
+		pantryItemDTO.Status = kind.PantryItemStatus(message)
+
+		pantryItem, errorPantryItem := handler.PantryItemUpdate(pantryItemId, &token.UserId, pantryItemDTO)
+
+		pantryItemId = nil
+		pantryItemDTO = nil
+
+		if errorPantryItem != nil {
+			return StatusError, errorPantryItem
+		} else {
+			printTable("PantryItemAggregate", []*DomainAggregate.PantryItem{pantryItem}, DomainAggregate.PantryItem{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func pantryItemDelete(message string) (int, error) {
+	var (
+		pantryItemIdValue uuid.UUID
+		errorPantryItemId error
+	)
+
+	if message == "PantryItemDelete" {
+		showDialogMessage("input id for PantryItem")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if pantryItemId == nil {
+		pantryItemIdValue, errorPantryItemId = uuid.Parse(message)
+
+		pantryItemId = &pantryItemIdValue
+	} else {
+		errorPantryItemId = nil
+	}
+
+	if errorPantryItemId != nil {
+		return StatusError, errorPantryItemId
+	} else {
+		pantryItemDeleteStatus, errorPantryItemDeleteStatus := handler.PantryItemDelete(pantryItemId, &token.UserId)
+
+		pantryItemId = nil
+
+		if errorPantryItemDeleteStatus != nil {
+			return StatusError, errorPantryItemDeleteStatus
+		} else if pantryItemDeleteStatus {
+			showInfoMessage(statusPantryItemDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusPantryItemDeleteError
+		}
+	}
+}
+
+func parsePantryItemQuantity(message string) (int64, error) {
+	quantity, errorQuantity := strconv.ParseInt(message, 10, 64)
+
+	if errorQuantity != nil {
+		return 0, errorQuantity
+	} else if quantity <= 0 {
+		return 0, errorPantryItemQuantity
+	}
+
+	return quantity, nil
+}
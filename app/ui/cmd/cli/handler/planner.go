@@ -8,12 +8,17 @@ import (
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
 var (
 	plannerDTO                 *DomainEntity.Planner
 	plannerId                  *uuid.UUID
+	plannerGenerateId          uuid.UUID
+	plannerGenerateConstraints *DomainAggregate.PlannerGenerateConstraints
+	plannerGenerateStep        int
 	statusPlannerDeleteSuccess = "the recipe planner has been deleted successful"
 	statusPlannerDeleteError   = errors.New("the recipe planner has not been deleted")
 )
@@ -234,3 +239,205 @@ func plannerDelete(message string) (int, error) {
 		}
 	}
 }
+
+func plannerShoppingList(message string) (int, error) {
+	var (
+		plannerIdValue uuid.UUID
+		errorPlannerId error
+	)
+
+	if message == "PlannerShoppingList" {
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if plannerId == nil {
+		plannerIdValue, errorPlannerId = uuid.Parse(message)
+
+		plannerId = &plannerIdValue
+	} else {
+		errorPlannerId = nil
+	}
+
+	if errorPlannerId != nil {
+		return StatusError, errorPlannerId
+	} else {
+		plannerShoppingList, errorPlannerShoppingList := handler.PlannerShoppingList(plannerId, &token.UserId)
+
+		plannerId = nil
+
+		if errorPlannerShoppingList != nil {
+			return StatusError, errorPlannerShoppingList
+		} else {
+			if plannerShoppingList == nil {
+				plannerShoppingList = []*DomainAggregate.PlannerCalculation{}
+			}
+
+			printTable("PlannerShoppingList", plannerShoppingList, DomainAggregate.PlannerCalculation{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func plannerCost(message string) (int, error) {
+	if message == "PlannerCost" {
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+	if errorPlannerId != nil {
+		return StatusError, errorPlannerId
+	} else {
+		plannerCostValue, errorPlannerCost := handler.PlannerCost(&plannerIdValue, &token.UserId)
+
+		if errorPlannerCost != nil {
+			return StatusError, errorPlannerCost
+		} else {
+			warnings := plannerCostValue.Warnings
+			plannerCostValue.Warnings = nil
+
+			printTable("PlannerCost", []*DomainAggregate.PlannerCost{plannerCostValue}, DomainAggregate.PlannerCost{})
+
+			for _, warning := range warnings {
+				showErrorMessage(warning)
+			}
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func plannerGenerate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if plannerGenerateConstraints == nil {
+		plannerGenerateConstraints = &DomainAggregate.PlannerGenerateConstraints{}
+		plannerGenerateStep = 0
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	plannerGenerateStep++
+
+	switch plannerGenerateStep {
+	case 1:
+		plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+		if errorPlannerId != nil {
+			plannerGenerateConstraints = nil
+
+			return StatusError, errorPlannerId
+		}
+
+		plannerGenerateId = plannerIdValue
+		showDialogMessage("input seed for PlannerGenerate or \"-\" to make a random one")
+	case 2:
+		if message != "-" {
+			seed, errorSeed := strconv.ParseInt(message, 10, 64)
+
+			if errorSeed != nil {
+				plannerGenerateConstraints = nil
+
+				return StatusError, errorSeed
+			}
+
+			plannerGenerateConstraints.Seed = seed
+		}
+
+		showDialogMessage("input ids of categories required for every recipe separated by comma or \"-\" to skip")
+	case 3:
+		if message != "-" {
+			for _, tag := range strings.Split(message, ",") {
+				tagId, errorTagId := uuid.Parse(strings.TrimSpace(tag))
+
+				if errorTagId != nil {
+					plannerGenerateConstraints = nil
+
+					return StatusError, errorTagId
+				}
+
+				plannerGenerateConstraints.Tags = append(plannerGenerateConstraints.Tags, tagId)
+			}
+		}
+
+		showDialogMessage("input max repeats of a recipe or \"-\" to skip")
+	case 4:
+		if message != "-" {
+			maxRepeats, errorMaxRepeats := strconv.ParseInt(message, 10, 64)
+
+			if errorMaxRepeats != nil {
+				plannerGenerateConstraints = nil
+
+				return StatusError, errorMaxRepeats
+			}
+
+			plannerGenerateConstraints.MaxRepeats = maxRepeats
+		}
+
+		showDialogMessage("input budget in minor units of the currency or \"-\" to use the budget of the planner")
+	case 5:
+		if message != "-" {
+			budget, errorBudget := strconv.ParseInt(message, 10, 64)
+
+			if errorBudget != nil {
+				plannerGenerateConstraints = nil
+
+				return StatusError, errorBudget
+			}
+
+			plannerGenerateConstraints.Budget = budget
+		}
+
+		showDialogMessage("input \"yes\" to prefer recipes with ingredients from the pantry")
+	case 6:
+		plannerGenerateConstraints.PreferPantry = strings.ToLower(message) == "yes"
+		showDialogMessage("input \"yes\" to replace recipes which have not been cooked yet")
+	default:
+		plannerGenerateConstraints.Replace = strings.ToLower(message) == "yes"
+
+		plannerGenerateValue, errorPlannerGenerate := handler.PlannerGenerate(&plannerGenerateId, &token.UserId, plannerGenerateConstraints)
+
+		plannerGenerateConstraints = nil
+
+		if errorPlannerGenerate != nil {
+			return StatusError, errorPlannerGenerate
+		} else {
+			if plannerGenerateValue.Assignments == nil {
+				plannerGenerateValue.Assignments = []*DomainAggregate.PlannerGenerateAssignment{}
+			}
+
+			printTable("PlannerGenerateAssignment", plannerGenerateValue.Assignments, DomainAggregate.PlannerGenerateAssignment{})
+			showInfoMessage("seed %d, estimated cost %d %s", plannerGenerateValue.Seed, plannerGenerateValue.Total, plannerGenerateValue.Currency)
+
+			for _, warning := range plannerGenerateValue.Warnings {
+				showErrorMessage(warning)
+			}
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"os"
+)
+
+var (
+	plannerCalendarImportStep      int
+	plannerCalendarImportPlannerId *uuid.UUID
+)
+
+func plannerCalendar(message string) (int, error) {
+	if message == "PlannerCalendar" {
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+	if errorPlannerId != nil {
+		return StatusError, errorPlannerId
+	} else {
+		calendar, errorCalendar := handler.PlannerCalendar(&plannerIdValue, &token.UserId, apiLink)
+
+		if errorCalendar != nil {
+			return StatusError, errorCalendar
+		} else {
+			fmt.Print(calendar)
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func plannerCalendarToken(message string) (int, error) {
+	if message == "PlannerCalendarToken" {
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+	if errorPlannerId != nil {
+		return StatusError, errorPlannerId
+	} else {
+		planner, errorPlanner := handler.PlannerCalendarTokenCreate(&plannerIdValue, &token.UserId)
+
+		if errorPlanner != nil {
+			return StatusError, errorPlanner
+		} else {
+			printTable("PlannerAggregate", []*DomainAggregate.Planner{planner}, DomainAggregate.Planner{})
+			showInfoMessage("the calendar subscription is available by %s/calendar/%s.ics", apiLink, planner.Entity.CalendarToken)
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func plannerCalendarImport(message string) (int, error) {
+	if message == "PlannerCalendarImport" {
+		plannerCalendarImportStep = 0
+		plannerCalendarImportPlannerId = nil
+		showDialogMessage("input id for Planner")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerCalendarImportStep++
+
+	if plannerCalendarImportStep == 1 {
+		plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+		if errorPlannerId != nil {
+			return StatusError, errorPlannerId
+		}
+
+		plannerCalendarImportPlannerId = &plannerIdValue
+		showDialogMessage("input path to an RFC 5545 calendar file")
+
+		return StatusContinue, nil
+	}
+
+	file, errorFile := os.Open(message)
+
+	if errorFile != nil {
+		return StatusError, errorFile
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	plannerCalendarImport, errorPlannerCalendarImport := handler.PlannerCalendarImport(plannerCalendarImportPlannerId, &token.UserId, file)
+
+	if errorPlannerCalendarImport != nil {
+		return StatusError, errorPlannerCalendarImport
+	}
+
+	printTable("PlannerAggregate", []*DomainAggregate.Planner{plannerCalendarImport.Planner}, DomainAggregate.Planner{})
+	showInfoMessage("the calendar has been imported: intervals=%d, recipes=%d", plannerCalendarImport.Intervals, plannerCalendarImport.Recipes)
+
+	for _, warning := range plannerCalendarImport.Warnings {
+		showErrorMessage("%s", warning)
+	}
+
+	return StatusOk, nil
+}
@@ -277,3 +277,50 @@ func plannerRecipeDelete(message string) (int, error) {
 		}
 	}
 }
+
+func plannerRecipeCook(message string) (int, error) {
+	var (
+		plannerRecipeIdValue uuid.UUID
+		errorPlannerRecipeId error
+	)
+
+	if message == "PlannerRecipeCook" {
+		showDialogMessage("input id for PlannerRecipe")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if plannerRecipeId == nil {
+		plannerRecipeIdValue, errorPlannerRecipeId = uuid.Parse(message)
+
+		plannerRecipeId = &plannerRecipeIdValue
+	} else {
+		errorPlannerRecipeId = nil
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "recipe_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorPlannerRecipeId != nil {
+		return StatusError, errorPlannerRecipeId
+	} else {
+		plannerRecipe, errorPlannerRecipe := handler.PlannerRecipeCook(plannerRecipeId, &token.UserId, parentId)
+
+		plannerRecipeId = nil
+
+		if errorPlannerRecipe != nil {
+			return StatusError, errorPlannerRecipe
+		} else {
+			printTable("PlannerRecipeAggregate", []*DomainAggregate.PlannerRecipe{plannerRecipe}, DomainAggregate.PlannerRecipe{})
+
+			return StatusOk, nil
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	plannerTemplateDTO                 *DomainEntity.PlannerTemplate
+	plannerTemplateStep                int
+	plannerTemplateId                  *uuid.UUID
+	plannerTemplateIntervalDTO         *DomainEntity.PlannerTemplateInterval
+	plannerTemplateIntervalStep        int
+	statusPlannerTemplateDeleteSuccess = "the planner template has been deleted successful"
+	statusPlannerTemplateDeleteError   = errors.New("the planner template has not been deleted")
+)
+
+func plannerTemplatesInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerTemplates, errorPlannerTemplates := handler.PlannerTemplatesInfo(&token.UserId, nil)
+
+	if errorPlannerTemplates != nil {
+		return StatusError, errorPlannerTemplates
+	} else {
+		printPlannerTemplates(plannerTemplates)
+
+		return StatusOk, nil
+	}
+}
+
+func plannerTemplateCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if plannerTemplateDTO == nil {
+		plannerTemplateDTO = &DomainEntity.PlannerTemplate{}
+		plannerTemplateDTO.UserId = token.UserId
+		plannerTemplateStep = 0
+		showDialogMessage("input name for Planner Template")
+
+		return StatusContinue, nil
+	}
+
+	plannerTemplateStep++
+
+	switch plannerTemplateStep {
+	case 1:
+		plannerTemplateDTO.Name = message
+		showDialogMessage("input days of a planner for Planner Template or \"-\" to take them from the recurrence or the intervals")
+	case 2:
+		if message != "-" {
+			days, errorDays := strconv.ParseInt(message, 10, 64)
+
+			if errorDays != nil {
+				plannerTemplateDTO = nil
+
+				return StatusError, errorDays
+			}
+
+			plannerTemplateDTO.Days = days
+		}
+
+		showDialogMessage(
+			"input recurrence for Planner Template. choose from (%v,%v,%v)",
+			kind.PlannerTemplateRecurrenceNone,
+			kind.PlannerTemplateRecurrenceWeekly,
+			kind.PlannerTemplateRecurrenceFortnightly,
+		)
+	case 3:
+		plannerTemplateDTO.Recurrence = kind.PlannerTemplateRecurrence(message)
+		showDialogMessage("input next time of a recurring planner for Planner Template or \"-\" to skip")
+	case 4:
+		if message != "-" {
+			parsedDate, errorParsedDate := time.Parse(time.RFC3339, message)
+
+			if errorParsedDate != nil {
+				plannerTemplateDTO = nil
+
+				return StatusError, errorParsedDate
+			}
+
+			plannerTemplateDTO.NextTime = parsedDate
+		}
+
+		showDialogMessage("input status for Planner Template. choose from (%v,%v)", kind.PlannerTemplateStatusInActive, kind.PlannerTemplateStatusActive)
+	default:
+		plannerTemplateDTO.Status = kind.PlannerTemplateStatus(message)
+
+		plannerTemplate, errorPlannerTemplate := handler.PlannerTemplateCreate(&token.UserId, plannerTemplateDTO)
+
+		plannerTemplateDTO = nil
+
+		if errorPlannerTemplate != nil {
+			return StatusError, errorPlannerTemplate
+		} else {
+			printPlannerTemplates([]*DomainAggregate.PlannerTemplate{plannerTemplate})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func plannerTemplateDelete(message string) (int, error) {
+	if message == "PlannerTemplateDelete" {
+		showDialogMessage("input id for Planner Template")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	plannerTemplateIdValue, errorPlannerTemplateId := uuid.Parse(message)
+
+	if errorPlannerTemplateId != nil {
+		return StatusError, errorPlannerTemplateId
+	} else {
+		plannerTemplateDeleteStatus, errorPlannerTemplateDeleteStatus := handler.PlannerTemplateDelete(&plannerTemplateIdValue, &token.UserId)
+
+		if errorPlannerTemplateDeleteStatus != nil {
+			return StatusError, errorPlannerTemplateDeleteStatus
+		} else if plannerTemplateDeleteStatus {
+			showInfoMessage(statusPlannerTemplateDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusPlannerTemplateDeleteError
+		}
+	}
+}
+
+func plannerTemplateInstantiate(message string) (int, error) {
+	if message == "PlannerTemplateInstantiate" {
+		plannerTemplateId = nil
+		showDialogMessage("input id for Planner Template")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if plannerTemplateId == nil {
+		plannerTemplateIdValue, errorPlannerTemplateId := uuid.Parse(message)
+
+		if errorPlannerTemplateId != nil {
+			return StatusError, errorPlannerTemplateId
+		}
+
+		plannerTemplateId = &plannerTemplateIdValue
+		showDialogMessage("input start time for Planner")
+
+		return StatusContinue, nil
+	}
+
+	startTime, errorStartTime := time.Parse(time.RFC3339, message)
+
+	if errorStartTime != nil {
+		plannerTemplateId = nil
+
+		return StatusError, errorStartTime
+	}
+
+	planner, errorPlanner := handler.PlannerTemplateInstantiate(plannerTemplateId, &token.UserId, startTime)
+
+	plannerTemplateId = nil
+
+	if errorPlanner != nil {
+		return StatusError, errorPlanner
+	} else {
+		printTable("PlannerAggregate", []*DomainAggregate.Planner{planner}, DomainAggregate.Planner{})
+
+		return StatusOk, nil
+	}
+}
+
+func plannerTemplateIntervalCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "planner_template_interval_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	if plannerTemplateIntervalDTO == nil {
+		plannerTemplateIntervalDTO = &DomainEntity.PlannerTemplateInterval{}
+		plannerTemplateIntervalDTO.EntityId = *parentId
+		plannerTemplateIntervalDTO.UserId = token.UserId
+		plannerTemplateIntervalStep = 0
+		showDialogMessage("input name for Planner Template Interval")
+
+		return StatusContinue, nil
+	}
+
+	plannerTemplateIntervalStep++
+
+	switch plannerTemplateIntervalStep {
+	case 1:
+		plannerTemplateIntervalDTO.Name = message
+		showDialogMessage("input start offset in minutes from the start of a planner for Planner Template Interval")
+	case 2, 3:
+		offset, errorOffset := strconv.ParseInt(message, 10, 64)
+
+		if errorOffset != nil {
+			plannerTemplateIntervalDTO = nil
+
+			return StatusError, errorOffset
+		}
+
+		if plannerTemplateIntervalStep == 2 {
+			plannerTemplateIntervalDTO.StartOffset = offset
+			showDialogMessage("input end offset in minutes from the start of a planner for Planner Template Interval")
+		} else {
+			plannerTemplateIntervalDTO.EndOffset = offset
+			showDialogMessage("input ids of recipes separated by comma for Planner Template Interval or \"-\" to skip")
+		}
+	case 4:
+		if message != "-" {
+			for _, recipe := range strings.Split(message, ",") {
+				recipeIdValue, errorRecipeId := uuid.Parse(strings.TrimSpace(recipe))
+
+				if errorRecipeId != nil {
+					plannerTemplateIntervalDTO = nil
+
+					return StatusError, errorRecipeId
+				}
+
+				plannerTemplateIntervalDTO.RecipeIds = append(plannerTemplateIntervalDTO.RecipeIds, recipeIdValue)
+			}
+		}
+
+		showDialogMessage("input status for Planner Template Interval. choose from (%v,%v)", kind.PlannerTemplateIntervalStatusInActive, kind.PlannerTemplateIntervalStatusActive)
+	default:
+		plannerTemplateIntervalDTO.Status = kind.PlannerTemplateIntervalStatus(message)
+
+		plannerTemplateInterval, errorPlannerTemplateInterval := handler.PlannerTemplateIntervalCreate(&token.UserId, parentId, plannerTemplateIntervalDTO)
+
+		plannerTemplateIntervalDTO = nil
+
+		if errorPlannerTemplateInterval != nil {
+			return StatusError, errorPlannerTemplateInterval
+		} else {
+			printPlannerTemplateIntervals([]*DomainEntity.PlannerTemplateInterval{plannerTemplateInterval})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func printPlannerTemplates(plannerTemplates []*DomainAggregate.PlannerTemplate) {
+	entities := make([]*DomainEntity.PlannerTemplate, 0, len(plannerTemplates))
+
+	for _, plannerTemplate := range plannerTemplates {
+		entities = append(entities, plannerTemplate.Entity)
+	}
+
+	printTable("PlannerTemplate", entities, DomainEntity.PlannerTemplate{})
+
+	for _, plannerTemplate := range plannerTemplates {
+		printPlannerTemplateIntervals(plannerTemplate.Intervals)
+	}
+}
+
+func printPlannerTemplateIntervals(plannerTemplateIntervals []*DomainEntity.PlannerTemplateInterval) {
+	entities := make([]*DomainEntity.PlannerTemplateInterval, 0, len(plannerTemplateIntervals))
+
+	for _, plannerTemplateInterval := range plannerTemplateIntervals {
+		entity := *plannerTemplateInterval
+
+		if len(entity.RecipeIds) > 0 {
+			showInfoMessage("the interval %s has recipes %v", entity.Name, entity.RecipeIds)
+		}
+
+		// the table cannot show slices of values
+		entity.RecipeIds = nil
+		entities = append(entities, &entity)
+	}
+
+	if len(entities) > 0 {
+		printTable("PlannerTemplateInterval", entities, DomainEntity.PlannerTemplateInterval{})
+	}
+}
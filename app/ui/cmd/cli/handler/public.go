@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"strconv"
+)
+
+var (
+	publicRecipeQuery *DomainAggregate.PublicRecipeQuery
+	publicRecipeStep  int
+)
+
+func publicRecipesInfo(message string) (int, error) {
+	if publicRecipeQuery == nil {
+		publicRecipeQuery = &DomainAggregate.PublicRecipeQuery{}
+		publicRecipeStep = 0
+		showDialogMessage("input words to search by or \"-\" to skip")
+
+		return StatusContinue, nil
+	}
+
+	publicRecipeStep++
+
+	switch publicRecipeStep {
+	case 1:
+		if message != "-" {
+			publicRecipeQuery.Query = message
+		}
+
+		showDialogMessage("input name of a category or \"-\" to skip")
+	case 2:
+		if message != "-" {
+			publicRecipeQuery.Category = message
+		}
+
+		showDialogMessage("input name of an ingredient or \"-\" to skip")
+	case 3:
+		if message != "-" {
+			publicRecipeQuery.Ingredient = message
+		}
+
+		showDialogMessage("input limit or \"-\" to skip")
+	case 4:
+		if message != "-" {
+			limit, errorLimit := strconv.ParseInt(message, 10, 64)
+
+			if errorLimit != nil {
+				publicRecipeQuery = nil
+
+				return StatusError, errorLimit
+			}
+
+			publicRecipeQuery.Limit = limit
+		}
+
+		showDialogMessage("input offset or \"-\" to skip")
+	default:
+		if message != "-" {
+			offset, errorOffset := strconv.ParseInt(message, 10, 64)
+
+			if errorOffset != nil {
+				publicRecipeQuery = nil
+
+				return StatusError, errorOffset
+			}
+
+			publicRecipeQuery.Offset = offset
+		}
+
+		publicRecipes, errorPublicRecipes := handler.PublicRecipesInfo(publicRecipeQuery)
+
+		publicRecipeQuery = nil
+
+		if errorPublicRecipes != nil {
+			return StatusError, errorPublicRecipes
+		} else {
+			printTable("RecipeAggregate", publicRecipes.Recipes, DomainAggregate.Recipe{})
+			showInfoMessage("shown %d of %d recipes", len(publicRecipes.Recipes), publicRecipes.Total)
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func publicRecipeInfo(message string) (int, error) {
+	if message == "PublicRecipeInfo" {
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+	if errorRecipeId != nil {
+		return StatusError, errorRecipeId
+	}
+
+	recipe, errorRecipe := handler.PublicRecipeInfo(&recipeIdValue)
+
+	if errorRecipe != nil {
+		return StatusError, errorRecipe
+	} else {
+		printTable("RecipeAggregate", []*DomainAggregate.Recipe{recipe}, DomainAggregate.Recipe{})
+
+		return StatusOk, nil
+	}
+}
@@ -252,3 +252,33 @@ func recipeDelete(message string) (int, error) {
 		}
 	}
 }
+
+func recipeCost(message string) (int, error) {
+	if message == "RecipeCost" {
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+	if errorRecipeId != nil {
+		return StatusError, errorRecipeId
+	} else {
+		recipeCostValue, errorRecipeCost := handler.RecipeCost(&recipeIdValue, &token.UserId, "")
+
+		if errorRecipeCost != nil {
+			return StatusError, errorRecipeCost
+		} else {
+			printTable("RecipeCost", []*DomainAggregate.RecipeCost{recipeCostValue}, DomainAggregate.RecipeCost{})
+
+			return StatusOk, nil
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+)
+
+var (
+	recipeExportStep     int
+	recipeExportRecipeId *uuid.UUID
+)
+
+func recipeExport(message string) (int, error) {
+	if message == "RecipeExport" {
+		recipeExportStep = 0
+		recipeExportRecipeId = nil
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeExportStep++
+
+	if recipeExportStep == 1 {
+		recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+		if errorRecipeId != nil {
+			return StatusError, errorRecipeId
+		}
+
+		recipeExportRecipeId = &recipeIdValue
+		showDialogMessage("input format for Recipe. choose from (%v,%v)", kind.RecipeExportFormatJSONLD, kind.RecipeExportFormatMarkdown)
+
+		return StatusContinue, nil
+	}
+
+	document, errorDocument := handler.RecipeExport(recipeExportRecipeId, &token.UserId, kind.RecipeExportFormat(message), apiLink)
+
+	if errorDocument != nil {
+		return StatusError, errorDocument
+	}
+
+	fmt.Println(document)
+
+	return StatusOk, nil
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+)
+
+var (
+	recipeForkId *uuid.UUID
+)
+
+func recipeFork(message string) (int, error) {
+	if message == "RecipeFork" {
+		recipeForkId = nil
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if recipeForkId == nil {
+		recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+		if errorRecipeId != nil {
+			return StatusError, errorRecipeId
+		}
+
+		recipeForkId = &recipeIdValue
+		showDialogMessage("input name for the fork or \"-\" to keep the name of the recipe")
+
+		return StatusContinue, nil
+	}
+
+	id := recipeForkId
+	recipeForkId = nil
+	name := message
+
+	if name == "-" {
+		name = ""
+	}
+
+	recipe, errorRecipe := handler.RecipeFork(id, &token.UserId, name)
+
+	if errorRecipe != nil {
+		return StatusError, errorRecipe
+	} else {
+		printTable("RecipeAggregate", []*DomainAggregate.Recipe{recipe}, DomainAggregate.Recipe{})
+
+		return StatusOk, nil
+	}
+}
+
+func recipeForkUpstream(message string) (int, error) {
+	if message == "RecipeForkUpstream" {
+		showDialogMessage("input id for Recipe")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeIdValue, errorRecipeId := uuid.Parse(message)
+
+	if errorRecipeId != nil {
+		return StatusError, errorRecipeId
+	}
+
+	recipeDiff, errorRecipeDiff := handler.RecipeForkUpstreamDiff(&recipeIdValue, &token.UserId)
+
+	if errorRecipeDiff != nil {
+		return StatusError, errorRecipeDiff
+	} else {
+		printTable("RecipeDiffAggregate", []*DomainAggregate.RecipeDiff{recipeDiff}, DomainAggregate.RecipeDiff{})
+
+		return StatusOk, nil
+	}
+}
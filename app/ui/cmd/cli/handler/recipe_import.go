@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"os"
+)
+
+func recipeImport(message string) (int, error) {
+	if message == "RecipeImport" {
+		showDialogMessage("input path to a schema.org Recipe JSON-LD file or an HTML file with it")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	file, errorFile := os.Open(message)
+
+	if errorFile != nil {
+		return StatusError, errorFile
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	recipe, errorRecipe := handler.RecipeImport(&token.UserId, file)
+
+	if errorRecipe != nil {
+		return StatusError, errorRecipe
+	}
+
+	printTable("RecipeAggregate", []*DomainAggregate.Recipe{recipe}, DomainAggregate.Recipe{})
+
+	return StatusOk, nil
+}
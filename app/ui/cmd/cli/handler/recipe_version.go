@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+)
+
+var (
+	recipeVersionFromId *uuid.UUID
+)
+
+func recipeVersionsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "recipe_version_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	recipeVersions, errorRecipeVersions := handler.RecipeVersionsInfo(&token.UserId, parentId)
+
+	if errorRecipeVersions != nil {
+		return StatusError, errorRecipeVersions
+	} else {
+		if recipeVersions == nil {
+			recipeVersions = []*DomainEntity.RecipeVersion{}
+		}
+
+		printTable("RecipeVersion", recipeVersions, DomainEntity.RecipeVersion{})
+
+		return StatusOk, nil
+	}
+}
+
+func recipeVersionInfo(message string) (int, error) {
+	if message == "RecipeVersionInfo" {
+		showDialogMessage("input id for RecipeVersion")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeVersionIdValue, errorRecipeVersionId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "recipe_version_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorRecipeVersionId != nil {
+		return StatusError, errorRecipeVersionId
+	} else {
+		recipeVersion, errorRecipeVersion := handler.RecipeVersionInfo(&recipeVersionIdValue, &token.UserId, parentId)
+
+		if errorRecipeVersion != nil {
+			return StatusError, errorRecipeVersion
+		} else {
+			recipeVersion.Entity.Snapshot = nil
+
+			printTable("RecipeVersionAggregate", []*DomainAggregate.RecipeVersion{recipeVersion}, DomainAggregate.RecipeVersion{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func recipeVersionDiff(message string) (int, error) {
+	if message == "RecipeVersionDiff" {
+		recipeVersionFromId = nil
+		showDialogMessage("input id for RecipeVersion to compare from")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeVersionIdValue, errorRecipeVersionId := uuid.Parse(message)
+
+	if errorRecipeVersionId != nil {
+		recipeVersionFromId = nil
+
+		return StatusError, errorRecipeVersionId
+	}
+
+	if recipeVersionFromId == nil {
+		recipeVersionFromId = &recipeVersionIdValue
+		showDialogMessage("input id for RecipeVersion to compare to")
+
+		return StatusContinue, nil
+	}
+
+	fromId := recipeVersionFromId
+	recipeVersionFromId = nil
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "recipe_version_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	recipeDiff, errorRecipeDiff := handler.RecipeVersionDiff(fromId, &recipeVersionIdValue, &token.UserId, parentId)
+
+	if errorRecipeDiff != nil {
+		return StatusError, errorRecipeDiff
+	} else {
+		printTable("RecipeDiffAggregate", []*DomainAggregate.RecipeDiff{recipeDiff}, DomainAggregate.RecipeDiff{})
+
+		return StatusOk, nil
+	}
+}
+
+func recipeVersionRevert(message string) (int, error) {
+	if message == "RecipeVersionRevert" {
+		showDialogMessage("input id for RecipeVersion")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	recipeVersionIdValue, errorRecipeVersionId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "recipe_version_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorRecipeVersionId != nil {
+		return StatusError, errorRecipeVersionId
+	} else {
+		recipe, errorRecipe := handler.RecipeVersionRevert(&recipeVersionIdValue, &token.UserId, parentId)
+
+		if errorRecipe != nil {
+			return StatusError, errorRecipe
+		} else {
+			printTable("RecipeAggregate", []*DomainAggregate.Recipe{recipe}, DomainAggregate.Recipe{})
+
+			return StatusOk, nil
+		}
+	}
+}
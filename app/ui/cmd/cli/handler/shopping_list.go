@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	shoppingListDTO                     *DomainEntity.ShoppingList
+	shoppingListId                      *uuid.UUID
+	shoppingListRange                   bool
+	shoppingListItemDTO                 *DomainEntity.ShoppingListItem
+	shoppingListItemId                  *uuid.UUID
+	statusShoppingListDeleteSuccess     = "the shopping list has been deleted successful"
+	statusShoppingListDeleteError       = errors.New("the shopping list has not been deleted")
+	statusShoppingListItemDeleteSuccess = "the shopping list item has been deleted successful"
+	statusShoppingListItemDeleteError   = errors.New("the shopping list item has not been deleted")
+	errorShoppingListItemAmount         = errors.New("the amount of the shopping list item must be greater than zero")
+)
+
+func shoppingListsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	shoppingLists, errorShoppingLists := handler.ShoppingListsInfo(&token.UserId, nil)
+
+	if errorShoppingLists != nil {
+		return StatusError, errorShoppingLists
+	} else {
+		if shoppingLists == nil {
+			shoppingLists = []*DomainAggregate.ShoppingList{}
+		}
+
+		printTable("ShoppingListAggregate", shoppingLists, DomainAggregate.ShoppingList{})
+
+		return StatusOk, nil
+	}
+}
+
+func shoppingListGenerate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if shoppingListDTO == nil {
+		shoppingListDTO = &DomainEntity.ShoppingList{}
+		shoppingListRange = false
+		showDialogMessage("input planner id for ShoppingList or \"-\" to use a date range")
+	} else if !shoppingListRange && reflect.ValueOf(shoppingListDTO.PlannerId).IsZero() {
+		if message == "-" {
+			shoppingListRange = true
+			showDialogMessage("input start time for ShoppingList")
+
+			return StatusContinue, nil
+		}
+
+		plannerIdValue, errorPlannerId := uuid.Parse(message)
+
+		if errorPlannerId != nil {
+			shoppingListDTO = nil
+
+			return StatusError, errorPlannerId
+		}
+
+		shoppingListDTO.PlannerId = plannerIdValue
+		showDialogMessage("input name for ShoppingList or \"-\" to use the default one")
+	} else if shoppingListRange && reflect.ValueOf(shoppingListDTO.StartTime).IsZero() {
+		parsedDate, errorParsedDate := time.Parse(time.RFC3339, message)
+
+		if errorParsedDate != nil {
+			shoppingListDTO = nil
+
+			return StatusError, errorParsedDate
+		}
+
+		shoppingListDTO.StartTime = parsedDate
+		showDialogMessage("input end time for ShoppingList")
+	} else if shoppingListRange && reflect.ValueOf(shoppingListDTO.EndTime).IsZero() {
+		parsedDate, errorParsedDate := time.Parse(time.RFC3339, message)
+
+		if errorParsedDate != nil {
+			shoppingListDTO = nil
+
+			return StatusError, errorParsedDate
+		}
+
+		shoppingListDTO.EndTime = parsedDate
+		showDialogMessage("input name for ShoppingList or \"-\" to use the default one")
+	} else {
+		if message != "-" {
+			shoppingListDTO.Name = message
+		}
+
+		shoppingList, errorShoppingList := handler.ShoppingListGenerate(&token.UserId, shoppingListDTO)
+
+		shoppingListDTO = nil
+
+		if errorShoppingList != nil {
+			return StatusError, errorShoppingList
+		} else {
+			printTable("ShoppingListAggregate", []*DomainAggregate.ShoppingList{shoppingList}, DomainAggregate.ShoppingList{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func shoppingListInfo(message string) (int, error) {
+	return shoppingListAction(message, "ShoppingListInfo", func(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.ShoppingList, error) {
+		return handler.ShoppingListInfo(id, userId, nil)
+	})
+}
+
+func shoppingListRegenerate(message string) (int, error) {
+	return shoppingListAction(message, "ShoppingListRegenerate", handler.ShoppingListRegenerate)
+}
+
+func shoppingListDelete(message string) (int, error) {
+	var (
+		shoppingListIdValue uuid.UUID
+		errorShoppingListId error
+	)
+
+	if message == "ShoppingListDelete" {
+		showDialogMessage("input id for ShoppingList")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	shoppingListIdValue, errorShoppingListId = uuid.Parse(message)
+
+	if errorShoppingListId != nil {
+		return StatusError, errorShoppingListId
+	} else {
+		shoppingListDeleteStatus, errorShoppingListDeleteStatus := handler.ShoppingListDelete(&shoppingListIdValue, &token.UserId)
+
+		if errorShoppingListDeleteStatus != nil {
+			return StatusError, errorShoppingListDeleteStatus
+		} else if shoppingListDeleteStatus {
+			showInfoMessage(statusShoppingListDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusShoppingListDeleteError
+		}
+	}
+}
+
+func shoppingListItemsInfo(_ string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "shopping_list_item_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	}
+
+	shoppingListItems, errorShoppingListItems := handler.ShoppingListItemsInfo(&token.UserId, parentId, nil)
+
+	if errorShoppingListItems != nil {
+		return StatusError, errorShoppingListItems
+	} else {
+		if shoppingListItems == nil {
+			shoppingListItems = []*DomainAggregate.ShoppingListItem{}
+		}
+
+		printTable("ShoppingListItemAggregate", shoppingListItems, DomainAggregate.ShoppingListItem{})
+
+		return StatusOk, nil
+	}
+}
+
+func shoppingListItemCreate(message string) (int, error) {
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "shopping_list_item_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if shoppingListItemDTO == nil {
+		shoppingListItemDTO = &DomainEntity.ShoppingListItem{}
+		showDialogMessage("input name for ShoppingListItem")
+	} else if shoppingListItemDTO.Name == "" {
+		shoppingListItemDTO.Name = message
+		showDialogMessage("input amount for ShoppingListItem")
+	} else if shoppingListItemDTO.Amount == 0 {
+		amount, errorAmount := parseShoppingListItemAmount(message)
+
+		if errorAmount != nil {
+			return StatusError, errorAmount
+		}
+
+		shoppingListItemDTO.Amount = amount
+
+		shoppingListItem, errorShoppingListItem := handler.ShoppingListItemCreate(&token.UserId, parentId, shoppingListItemDTO)
+
+		shoppingListItemDTO = nil
+
+		if errorShoppingListItem != nil {
+			return StatusError, errorShoppingListItem
+		} else {
+			printTable("ShoppingListItemAggregate", []*DomainAggregate.ShoppingListItem{shoppingListItem}, DomainAggregate.ShoppingListItem{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func shoppingListItemUpdate(message string) (int, error) {
+	var (
+		shoppingListItemIdValue uuid.UUID
+		errorShoppingListItemId error
+	)
+
+	if message == "ShoppingListItemUpdate" {
+		showDialogMessage("input id for ShoppingListItem")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	if shoppingListItemId == nil {
+		shoppingListItemIdValue, errorShoppingListItemId = uuid.Parse(message)
+
+		shoppingListItemId = &shoppingListItemIdValue
+	} else {
+		errorShoppingListItemId = nil
+	}
+
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "shopping_list_item_id")
+
+	if errorParentId != nil {
+		shoppingListItemId = nil
+
+		return StatusError, errorParentId
+	} else if errorShoppingListItemId != nil {
+		shoppingListItemId = nil
+
+		return StatusError, errorShoppingListItemId
+	} else if shoppingListItemDTO == nil {
+		shoppingListItemDTO = &DomainEntity.ShoppingListItem{}
+		showDialogMessage("input amount for ShoppingListItem")
+	} else if shoppingListItemDTO.Amount == 0 {
+		amount, errorAmount := parseShoppingListItemAmount(message)
+
+		if errorAmount != nil {
+			return StatusError, errorAmount
+		}
+
+		shoppingListItemDTO.Amount = amount
+		showDialogMessage("input status for ShoppingListItem. choose from (%v,%v)", kind.ShoppingListItemStatusUnChecked, kind.ShoppingListItemStatusChecked)
+	} else if shoppingListItemDTO.Status == "" {
+		shoppingListItemDTO.Status = kind.ShoppingListItemStatus(message)
+
+		shoppingListItem, errorShoppingListItem := handler.ShoppingListItemUpdate(shoppingListItemId, &token.UserId, parentId, shoppingListItemDTO)
+
+		shoppingListItemId = nil
+		shoppingListItemDTO = nil
+
+		if errorShoppingListItem != nil {
+			return StatusError, errorShoppingListItem
+		} else {
+			printTable("ShoppingListItemAggregate", []*DomainAggregate.ShoppingListItem{shoppingListItem}, DomainAggregate.ShoppingListItem{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func shoppingListItemDelete(message string) (int, error) {
+	if message == "ShoppingListItemDelete" {
+		showDialogMessage("input id for ShoppingListItem")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	shoppingListItemIdValue, errorShoppingListItemId := uuid.Parse(message)
+	parentId, errorParentId := UiService.GetParentId(parentIdKeys, parentIdValues, "shopping_list_item_id")
+
+	if errorParentId != nil {
+		return StatusError, errorParentId
+	} else if errorShoppingListItemId != nil {
+		return StatusError, errorShoppingListItemId
+	} else {
+		shoppingListItemDeleteStatus, errorShoppingListItemDeleteStatus := handler.ShoppingListItemDelete(&shoppingListItemIdValue, &token.UserId, parentId)
+
+		if errorShoppingListItemDeleteStatus != nil {
+			return StatusError, errorShoppingListItemDeleteStatus
+		} else if shoppingListItemDeleteStatus {
+			showInfoMessage(statusShoppingListItemDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusShoppingListItemDeleteError
+		}
+	}
+}
+
+func shoppingListAction(
+	message string,
+	command string,
+	action func(id *uuid.UUID, userId *uuid.UUID) (*DomainAggregate.ShoppingList, error),
+) (int, error) {
+	if message == command {
+		showDialogMessage("input id for ShoppingList")
+
+		return StatusContinue, nil
+	}
+
+	token, errorExtractClaimsFromContext := tokenFromContext()
+
+	if errorExtractClaimsFromContext != nil {
+		return StatusError, errorExtractClaimsFromContext
+	}
+
+	shoppingListIdValue, errorShoppingListId := uuid.Parse(message)
+
+	if errorShoppingListId != nil {
+		return StatusError, errorShoppingListId
+	} else {
+		shoppingList, errorShoppingList := action(&shoppingListIdValue, &token.UserId)
+
+		if errorShoppingList != nil {
+			return StatusError, errorShoppingList
+		} else {
+			printTable("ShoppingListAggregate", []*DomainAggregate.ShoppingList{shoppingList}, DomainAggregate.ShoppingList{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func parseShoppingListItemAmount(message string) (int64, error) {
+	amount, errorAmount := strconv.ParseInt(message, 10, 64)
+
+	if errorAmount != nil {
+		return 0, errorAmount
+	} else if amount <= 0 {
+		return 0, errorShoppingListItemAmount
+	}
+
+	return amount, nil
+}
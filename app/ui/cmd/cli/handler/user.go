@@ -4,20 +4,96 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
 var (
-	userDTO                 *dto.UserDTO
-	userId                  *uuid.UUID
-	statusUserDeleteSuccess = "the recipe user has been deleted successful"
-	statusUserDeleteError   = errors.New("the recipe user has not been deleted")
+	userDTO                     *dto.UserDTO
+	userId                      *uuid.UUID
+	userQuery                   *DomainAggregate.UserQuery
+	userQueryStep               int
+	userToRoleDTO               *DomainEntity.UserToRole
+	statusUserDeleteSuccess     = "the recipe user has been deleted successful"
+	statusUserDeleteError       = errors.New("the recipe user has not been deleted")
+	statusUserRoleDeleteSuccess = "the role of the user has been deleted successful"
+	statusUserRoleDeleteError   = errors.New("the role of the user has not been deleted")
 )
 
+func usersInfo(message string) (int, error) {
+	if userQuery == nil {
+		userQuery = &DomainAggregate.UserQuery{}
+		userQueryStep = 0
+		showDialogMessage("input words to search by or \"-\" to skip")
+
+		return StatusContinue, nil
+	}
+
+	userQueryStep++
+
+	switch userQueryStep {
+	case 1:
+		if message != "-" {
+			userQuery.Query = message
+		}
+
+		showDialogMessage("input status for User or \"-\" to skip. choose from (%v,%v,%v)", kind.UserStatusDisabled, kind.UserStatusRegister, kind.UserStatusNeedConfirmation)
+	case 2:
+		if message != "-" {
+			userQuery.Status = kind.UserStatus(message)
+		}
+
+		showDialogMessage("input limit or \"-\" to skip")
+	case 3:
+		if message != "-" {
+			limit, errorLimit := strconv.ParseInt(message, 10, 64)
+
+			if errorLimit != nil {
+				userQuery = nil
+
+				return StatusError, errorLimit
+			}
+
+			userQuery.Limit = limit
+		}
+
+		showDialogMessage("input offset or \"-\" to skip")
+	default:
+		if message != "-" {
+			offset, errorOffset := strconv.ParseInt(message, 10, 64)
+
+			if errorOffset != nil {
+				userQuery = nil
+
+				return StatusError, errorOffset
+			}
+
+			userQuery.Offset = offset
+		}
+
+		users, errorUsers := handler.UsersInfo(userQuery)
+
+		userQuery = nil
+
+		if errorUsers != nil {
+			return StatusError, errorUsers
+		} else {
+			printTable("User", users.Users, DomainEntity.User{})
+			showInfoMessage("shown %d of %d users", len(users.Users), users.Total)
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
 func userInfo(message string) (int, error) {
 	var (
 		userIdValue uuid.UUID
@@ -41,14 +117,14 @@ func userInfo(message string) (int, error) {
 	if errorUserId != nil {
 		return StatusError, errorUserId
 	} else {
-		user, errorUser := handler.UserInfo(userId)
+		user, errorUser := handler.UserAdminInfo(userId)
 
 		userId = nil
 
 		if errorUser != nil {
 			return StatusError, errorUser
 		} else {
-			printTable("User", []*DomainEntity.User{user}, DomainEntity.User{})
+			printTable("UserAggregate", []*DomainAggregate.User{user}, DomainAggregate.User{})
 
 			return StatusOk, nil
 		}
@@ -162,3 +238,169 @@ func userDelete(message string) (int, error) {
 		}
 	}
 }
+
+func userDisable(message string) (int, error) {
+	if message == "UserDisable" {
+		showDialogMessage("input id for User")
+
+		return StatusContinue, nil
+	}
+
+	userIdValue, errorUserId := uuid.Parse(message)
+
+	if errorUserId != nil {
+		return StatusError, errorUserId
+	} else {
+		user, errorUser := handler.UserDisable(&userIdValue)
+
+		if errorUser != nil {
+			return StatusError, errorUser
+		} else {
+			printTable("User", []*DomainEntity.User{user}, DomainEntity.User{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func userEnable(message string) (int, error) {
+	if message == "UserEnable" {
+		showDialogMessage("input id for User")
+
+		return StatusContinue, nil
+	}
+
+	userIdValue, errorUserId := uuid.Parse(message)
+
+	if errorUserId != nil {
+		return StatusError, errorUserId
+	} else {
+		user, errorUser := handler.UserEnable(&userIdValue)
+
+		if errorUser != nil {
+			return StatusError, errorUser
+		} else {
+			printTable("User", []*DomainEntity.User{user}, DomainEntity.User{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func userLogout(message string) (int, error) {
+	if message == "UserLogout" {
+		showDialogMessage("input id for User")
+
+		return StatusContinue, nil
+	}
+
+	userIdValue, errorUserId := uuid.Parse(message)
+
+	if errorUserId != nil {
+		return StatusError, errorUserId
+	} else {
+		user, errorUser := handler.UserLogout(&userIdValue)
+
+		if errorUser != nil {
+			return StatusError, errorUser
+		} else {
+			printTable("User", []*DomainEntity.User{user}, DomainEntity.User{})
+
+			return StatusOk, nil
+		}
+	}
+}
+
+func userRoleCreate(message string) (int, error) {
+	if message == "UserRoleCreate" {
+		showDialogMessage("input id for User")
+
+		return StatusContinue, nil
+	}
+
+	if userId == nil {
+		userIdValue, errorUserId := uuid.Parse(message)
+
+		if errorUserId != nil {
+			return StatusError, errorUserId
+		}
+
+		userId = &userIdValue
+		userToRoleDTO = &DomainEntity.UserToRole{}
+		showDialogMessage("input id for Role")
+	} else if userToRoleDTO.RoleId == uuid.Nil {
+		roleIdValue, errorRoleId := uuid.Parse(message)
+
+		if errorRoleId != nil {
+			userId = nil
+			userToRoleDTO = nil
+
+			return StatusError, errorRoleId
+		}
+
+		userToRoleDTO.RoleId = roleIdValue
+		showDialogMessage("input rights of the binding separated by commas or \"-\" to keep the permissions of the role. choose from (%v,%v)", kind.UserRightRead, kind.UserRightWrite)
+	} else {
+		if message != "-" {
+			for _, right := range strings.Split(message, ",") {
+				userToRoleDTO.Rights = append(userToRoleDTO.Rights, kind.UserRight(strings.TrimSpace(right)))
+			}
+		}
+
+		userToRole, errorUserToRole := handler.UserRoleCreate(userId, userToRoleDTO)
+
+		userId = nil
+		userToRoleDTO = nil
+
+		if errorUserToRole != nil {
+			return StatusError, errorUserToRole
+		} else {
+			printTable("UserToRole", []*DomainEntity.UserToRole{userToRole}, DomainEntity.UserToRole{})
+
+			return StatusOk, nil
+		}
+	}
+
+	return StatusContinue, nil
+}
+
+func userRoleDelete(message string) (int, error) {
+	if message == "UserRoleDelete" {
+		showDialogMessage("input id for User")
+
+		return StatusContinue, nil
+	}
+
+	if userId == nil {
+		userIdValue, errorUserId := uuid.Parse(message)
+
+		if errorUserId != nil {
+			return StatusError, errorUserId
+		}
+
+		userId = &userIdValue
+		showDialogMessage("input id for UserToRole")
+
+		return StatusContinue, nil
+	}
+
+	userToRoleIdValue, errorUserToRoleId := uuid.Parse(message)
+	userIdValue := *userId
+	userId = nil
+
+	if errorUserToRoleId != nil {
+		return StatusError, errorUserToRoleId
+	} else {
+		userRoleDeleteStatus, errorUserRoleDeleteStatus := handler.UserRoleDelete(&userToRoleIdValue, &userIdValue)
+
+		if errorUserRoleDeleteStatus != nil {
+			return StatusError, errorUserRoleDeleteStatus
+		} else if userRoleDeleteStatus {
+			showInfoMessage(statusUserRoleDeleteSuccess)
+
+			return StatusOk, nil
+		} else {
+			return StatusError, statusUserRoleDeleteError
+		}
+	}
+}
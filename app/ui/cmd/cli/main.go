@@ -27,6 +27,7 @@ var flagCommands commands
 func init() {
 	InfrastructureService.PrepareCache()
 	InfrastructureService.PreparePersistence()
+	InfrastructureService.PrepareNotification()
 	flag.Var(&flagCommands, "command", "Generate router documentation")
 }
 
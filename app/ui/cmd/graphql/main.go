@@ -11,15 +11,18 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/go-chi/render"
+	ApplicationHandler "github.com/sergeygardner/meal-planner-api/application/handler"
 	ApplicationServicePassword "github.com/sergeygardner/meal-planner-api/application/service/password"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
 	ApplicationMiddleware "github.com/sergeygardner/meal-planner-api/infrastructure/service/jwt"
 	"github.com/sergeygardner/meal-planner-api/ui/graphql"
 	"github.com/sergeygardner/meal-planner-api/ui/graphql/directive"
 	RestHandler "github.com/sergeygardner/meal-planner-api/ui/rest/handler"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
 	log "github.com/sirupsen/logrus"
 	"net/http"
 	"os"
+	"time"
 )
 
 var (
@@ -30,6 +33,7 @@ var (
 	flagCORS              bool
 	flagContentTypeJSON   bool
 	flagDev               bool
+	flagRecurInterval     time.Duration
 )
 
 func init() {
@@ -38,6 +42,7 @@ func init() {
 	flag.BoolVar(&flagCORS, "cors", false, "To use CORS")
 	flag.BoolVar(&flagContentTypeJSON, "contentTypeJSON", false, "To use JSON")
 	flag.BoolVar(&flagDev, "dev", false, "To use Dev")
+	flag.DurationVar(&flagRecurInterval, "recurInterval", time.Minute, "To make the due planners of recurring templates every interval, 0 disables it")
 }
 
 func main() {
@@ -54,6 +59,11 @@ func main() {
 
 	InfrastructureService.PrepareCache()
 	InfrastructureService.PreparePersistence()
+	InfrastructureService.PrepareNotification()
+
+	if flagRecurInterval > 0 {
+		go ApplicationHandler.PlannerTemplatesRecurEvery(flagRecurInterval)
+	}
 
 	prepareGraphQLServer()
 	prepareGraphQLServer()
@@ -65,6 +75,7 @@ func prepareGraphQLServer() {
 
 	router.Use(middleware.RequestID)
 	router.Use(middleware.Logger)
+	router.Use(UiService.IpContext)
 
 	if flagCORS {
 		router.Use(cors.Handler(cors.Options{
@@ -92,6 +103,7 @@ func prepareGraphQLServer() {
 
 	if flagJwtAuthentication {
 		config.Directives.Auth = directive.Auth
+		config.Directives.Policy = directive.Policy
 	}
 
 	srv := handler.New(graphql.NewExecutableSchema(config))
@@ -2,19 +2,23 @@ package main
 
 import (
 	"flag"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
 	"github.com/sergeygardner/meal-planner-api/ui/grpc"
 	log "github.com/sirupsen/logrus"
+	"time"
 )
 
 var (
-	flagDev      bool
-	flagGRPCPort int
+	flagDev           bool
+	flagGRPCPort      int
+	flagRecurInterval time.Duration
 )
 
 func init() {
 	flag.BoolVar(&flagDev, "dev", false, "To use Dev")
 	flag.IntVar(&flagGRPCPort, "gRPCPort", 50051, "To use a port in gRPC")
+	flag.DurationVar(&flagRecurInterval, "recurInterval", time.Minute, "To make the due planners of recurring templates every interval, 0 disables it")
 }
 
 func main() {
@@ -31,6 +35,11 @@ func main() {
 
 	InfrastructureService.PrepareCache()
 	InfrastructureService.PreparePersistence()
+	InfrastructureService.PrepareNotification()
+
+	if flagRecurInterval > 0 {
+		go handler.PlannerTemplatesRecurEvery(flagRecurInterval)
+	}
 
 	server, listener := grpc.GetServer(flagGRPCPort)
 	log.Printf("server listening at %v", listener.Addr())
@@ -8,7 +8,9 @@ import (
 	"github.com/go-chi/docgen"
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/go-chi/render"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
 	ApplicationServicePassword "github.com/sergeygardner/meal-planner-api/application/service/password"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	InfrastructureService "github.com/sergeygardner/meal-planner-api/infrastructure/service"
 	ApplicationMiddleware "github.com/sergeygardner/meal-planner-api/infrastructure/service/jwt"
 	RestHandler "github.com/sergeygardner/meal-planner-api/ui/rest/handler"
@@ -17,6 +19,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"net/http"
 	"os"
+	"time"
 )
 
 var (
@@ -28,6 +31,7 @@ var (
 	flagContentTypeJSON   bool
 	flagDev               bool
 	flagRoutes            bool
+	flagRecurInterval     time.Duration
 )
 
 func init() {
@@ -37,6 +41,7 @@ func init() {
 	flag.BoolVar(&flagContentTypeJSON, "contentTypeJSON", false, "To use JSON")
 	flag.BoolVar(&flagDev, "dev", false, "To use Dev")
 	flag.BoolVar(&flagRoutes, "flagRoutes", false, "Generate router documentation")
+	flag.DurationVar(&flagRecurInterval, "recurInterval", time.Minute, "To make the due planners of recurring templates every interval, 0 disables it")
 }
 
 func main() {
@@ -53,15 +58,30 @@ func main() {
 
 	InfrastructureService.PrepareCache()
 	InfrastructureService.PreparePersistence()
+	InfrastructureService.PrepareNotification()
+
+	if flagRecurInterval > 0 {
+		go handler.PlannerTemplatesRecurEvery(flagRecurInterval)
+	}
 
 	prepareHTTPServer()
 	startHTTPServer()
 }
 
 func middleWareJWT(router chi.Router) {
+	middleWareJWTOfType(router, kind.TokenTypeAccess)
+}
+
+// middleWareJWTRefresh lets only the refresh token through, it is used by the refreshing of the tokens only.
+func middleWareJWTRefresh(router chi.Router) {
+	middleWareJWTOfType(router, kind.TokenTypeRefresh)
+}
+
+func middleWareJWTOfType(router chi.Router, tokenType kind.TokenType) {
 	if flagJwtAuthentication {
 		router.Use(jwtauth.Verifier(jwtAuth))
 		router.Use(jwtauth.Authenticator)
+		router.Use(service.EnsureToken(tokenType))
 	}
 	if flagContentTypeJSON {
 		router.Use(render.SetContentType(render.ContentTypeJSON))
@@ -114,6 +134,24 @@ func startHTTPServer() {
 				router.Group(func(router chi.Router) {
 					middleWareJWT(router)
 					router.Get("/", RestHandler.AuthCheck)
+					router.Post("/logout", RestHandler.AuthLogout)
+					router.Options("/logout", RestHandler.AuthLogout)
+					router.Get("/sessions", RestHandler.AuthSessionsInfo)
+					router.Delete("/sessions/{session_id}", RestHandler.AuthSessionRevoke)
+					router.Group(func(router chi.Router) {
+						router.Use(service.EnsurePolicy(kind.UserResourceAccount))
+						router.Post("/password", RestHandler.AuthPasswordChange)
+						router.Options("/password", RestHandler.AuthPasswordChange)
+						router.Post("/totp", RestHandler.AuthTotpEnrol)
+						router.Options("/totp", RestHandler.AuthTotpEnrol)
+						router.Post("/totp/verify", RestHandler.AuthTotpVerify)
+						router.Options("/totp/verify", RestHandler.AuthTotpVerify)
+						router.Post("/totp/disable", RestHandler.AuthTotpDisable)
+						router.Options("/totp/disable", RestHandler.AuthTotpDisable)
+					})
+				})
+				router.Group(func(router chi.Router) {
+					middleWareJWTRefresh(router)
 					router.Get("/refresh", RestHandler.AuthRefresh)
 					router.Options("/refresh", RestHandler.AuthRefresh)
 				})
@@ -123,21 +161,45 @@ func startHTTPServer() {
 				router.Options("/confirmation", RestHandler.AuthConfirmation)
 				router.Post("/register", RestHandler.AuthRegister)
 				router.Options("/register", RestHandler.AuthRegister)
+				router.Post("/password/reset", RestHandler.AuthPasswordReset)
+				router.Options("/password/reset", RestHandler.AuthPasswordReset)
+				router.Post("/password/reset/confirmation", RestHandler.AuthPasswordResetConfirmation)
+				router.Options("/password/reset/confirmation", RestHandler.AuthPasswordResetConfirmation)
+			})
+			router.Get("/calendar/{calendar_token}.ics", RestHandler.PlannerCalendarFeed)
+			router.Route("/public", func(router chi.Router) {
+				router.Get("/recipes", RestHandler.PublicRecipesInfo)
+				router.Get("/recipes/{recipe_id}", RestHandler.PublicRecipeInfo)
 			})
 			router.Group(func(router chi.Router) {
 				middleWareJWT(router)
 				router.Route("/user", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceAccount))
 					router.Get("/", RestHandler.UserInfo)
 					router.Patch("/", RestHandler.UserUpdate)
 					router.Delete("/", RestHandler.UserDelete)
 				})
 				router.Route("/recipes", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceRecipe))
 					router.Get("/", RestHandler.RecipesInfo)
 					router.Post("/", RestHandler.RecipeCreate)
+					router.Post("/import", RestHandler.RecipeImport)
+					router.Post("/cookable", RestHandler.RecipesCookable)
 					router.Route("/{recipe_id}", func(router chi.Router) {
 						router.Get("/", RestHandler.RecipeInfo)
 						router.Patch("/", RestHandler.RecipeUpdate)
 						router.Delete("/", RestHandler.RecipeDelete)
+						router.Get("/cost", RestHandler.RecipeCostInfo)
+						router.Get("/substitutes", RestHandler.RecipeSubstitutesInfo)
+						router.Post("/substitute", RestHandler.RecipeSubstitute)
+						router.Post("/fork", RestHandler.RecipeFork)
+						router.Get("/upstream", RestHandler.RecipeForkUpstream)
+						router.Route("/versions", func(router chi.Router) {
+							router.Get("/", RestHandler.RecipeVersionsInfo)
+							router.Get("/diff", RestHandler.RecipeVersionDiff)
+							router.Get("/{recipe_version_id}", RestHandler.RecipeVersionInfo)
+							router.Post("/{recipe_version_id}/revert", RestHandler.RecipeVersionRevert)
+						})
 						router.Route("/categories", func(router chi.Router) {
 							router.Get("/", RestHandler.RecipeCategoriesInfo)
 							router.Post("/", RestHandler.RecipeCategoryCreate)
@@ -182,6 +244,7 @@ func startHTTPServer() {
 					})
 				})
 				router.Route("/units", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceRecipe))
 					router.Get("/", RestHandler.UnitsInfo)
 					router.Post("/", RestHandler.UnitCreate)
 					router.Get("/{unit_id}", RestHandler.UnitInfo)
@@ -190,8 +253,10 @@ func startHTTPServer() {
 					setAltNameRouting(router)
 				})
 				router.Route("/categories", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceRecipe))
 					router.Get("/", RestHandler.CategoriesInfo)
 					router.Post("/", RestHandler.CategoryCreate)
+					router.Get("/duplicates", RestHandler.CategoryDuplicates)
 					router.Route("/{category_id}", func(router chi.Router) {
 						router.Get("/", RestHandler.CategoryInfo)
 						router.Patch("/", RestHandler.CategoryUpdate)
@@ -201,17 +266,109 @@ func startHTTPServer() {
 					})
 				})
 				router.Route("/ingredients", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceRecipe))
 					router.Get("/", RestHandler.IngredientsInfo)
 					router.Post("/", RestHandler.IngredientCreate)
+					router.Get("/duplicates", RestHandler.IngredientDuplicates)
 					router.Route("/{ingredient_id}", func(router chi.Router) {
 						router.Get("/", RestHandler.IngredientInfo)
 						router.Patch("/", RestHandler.IngredientUpdate)
 						router.Delete("/", RestHandler.IngredientDelete)
 						setPictureRouting(router)
 						setAltNameRouting(router)
+						router.Route("/prices", func(router chi.Router) {
+							router.Get("/", RestHandler.IngredientPricesInfo)
+							router.Post("/", RestHandler.IngredientPriceCreate)
+							router.Get("/{ingredient_price_id}", RestHandler.IngredientPriceInfo)
+							router.Patch("/{ingredient_price_id}", RestHandler.IngredientPriceUpdate)
+							router.Delete("/{ingredient_price_id}", RestHandler.IngredientPriceDelete)
+						})
+						router.Route("/substitutes", func(router chi.Router) {
+							router.Get("/", RestHandler.IngredientSubstitutesInfo)
+							router.Post("/", RestHandler.IngredientSubstituteCreate)
+							router.Get("/{ingredient_substitute_id}", RestHandler.IngredientSubstituteInfo)
+							router.Patch("/{ingredient_substitute_id}", RestHandler.IngredientSubstituteUpdate)
+							router.Delete("/{ingredient_substitute_id}", RestHandler.IngredientSubstituteDelete)
+						})
+					})
+				})
+				router.Route("/catalogue/{catalogue_entity}", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceRecipe))
+					router.Get("/export", RestHandler.CatalogueExport)
+					router.Post("/import", RestHandler.CatalogueImport)
+				})
+				router.With(service.EnsurePolicy(kind.UserResourceRecipe)).Get("/search", RestHandler.Search)
+				router.Route("/pantry", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourcePantry))
+					router.Get("/", RestHandler.PantryItemsInfo)
+					router.Post("/", RestHandler.PantryItemCreate)
+					router.Get("/{pantry_item_id}", RestHandler.PantryItemInfo)
+					router.Patch("/{pantry_item_id}", RestHandler.PantryItemUpdate)
+					router.Delete("/{pantry_item_id}", RestHandler.PantryItemDelete)
+				})
+				router.Route("/shopping-lists", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceShoppingList))
+					router.Get("/", RestHandler.ShoppingListsInfo)
+					router.Post("/", RestHandler.ShoppingListGenerate)
+					router.Route("/{shopping_list_id}", func(router chi.Router) {
+						router.Get("/", RestHandler.ShoppingListInfo)
+						router.Patch("/", RestHandler.ShoppingListUpdate)
+						router.Delete("/", RestHandler.ShoppingListDelete)
+						router.Post("/regenerate", RestHandler.ShoppingListRegenerate)
+						router.Route("/items", func(router chi.Router) {
+							router.Get("/", RestHandler.ShoppingListItemsInfo)
+							router.Post("/", RestHandler.ShoppingListItemCreate)
+							router.Get("/{shopping_list_item_id}", RestHandler.ShoppingListItemInfo)
+							router.Patch("/{shopping_list_item_id}", RestHandler.ShoppingListItemUpdate)
+							router.Delete("/{shopping_list_item_id}", RestHandler.ShoppingListItemDelete)
+						})
+					})
+				})
+				router.Route("/planner-templates", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourcePlanner))
+					router.Get("/", RestHandler.PlannerTemplatesInfo)
+					router.Post("/", RestHandler.PlannerTemplateCreate)
+					router.Route("/{planner_template_id}", func(router chi.Router) {
+						router.Get("/", RestHandler.PlannerTemplateInfo)
+						router.Patch("/", RestHandler.PlannerTemplateUpdate)
+						router.Delete("/", RestHandler.PlannerTemplateDelete)
+						router.Post("/instantiate", RestHandler.PlannerTemplateInstantiate)
+						router.Route("/intervals", func(router chi.Router) {
+							router.Get("/", RestHandler.PlannerTemplateIntervalsInfo)
+							router.Post("/", RestHandler.PlannerTemplateIntervalCreate)
+							router.Route("/{planner_template_interval_id}", func(router chi.Router) {
+								router.Get("/", RestHandler.PlannerTemplateIntervalInfo)
+								router.Patch("/", RestHandler.PlannerTemplateIntervalUpdate)
+								router.Delete("/", RestHandler.PlannerTemplateIntervalDelete)
+							})
+						})
+					})
+				})
+				router.Route("/households", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourceHousehold))
+					router.Get("/", RestHandler.HouseholdsInfo)
+					router.Post("/", RestHandler.HouseholdCreate)
+					router.Route("/invitations", func(router chi.Router) {
+						router.Get("/", RestHandler.HouseholdInvitationsInfo)
+						router.Post("/{household_member_id}/accept", RestHandler.HouseholdInvitationAccept)
+						router.Delete("/{household_member_id}", RestHandler.HouseholdInvitationDecline)
+					})
+					router.Route("/{household_id}", func(router chi.Router) {
+						router.Get("/", RestHandler.HouseholdInfo)
+						router.Patch("/", RestHandler.HouseholdUpdate)
+						router.Delete("/", RestHandler.HouseholdDelete)
+						router.Route("/members", func(router chi.Router) {
+							router.Post("/", RestHandler.HouseholdInvite)
+							router.Delete("/{household_member_id}", RestHandler.HouseholdMemberRevoke)
+						})
+						router.Route("/grants", func(router chi.Router) {
+							router.Post("/", RestHandler.HouseholdGrantCreate)
+							router.Delete("/{household_grant_id}", RestHandler.HouseholdGrantDelete)
+						})
 					})
 				})
 				router.Route("/planners", func(router chi.Router) {
+					router.Use(service.EnsurePolicy(kind.UserResourcePlanner))
 					router.Get("/", RestHandler.PlannersInfo)
 					router.Post("/", RestHandler.PlannerCreate)
 					router.Route("/{planner_id}", func(router chi.Router) {
@@ -219,6 +376,13 @@ func startHTTPServer() {
 						router.Patch("/", RestHandler.PlannerUpdate)
 						router.Delete("/", RestHandler.PlannerDelete)
 						router.Get("/calculate", RestHandler.PlannerCalculateInfo)
+						router.Get("/shopping-list", RestHandler.PlannerShoppingListInfo)
+						router.Get("/cost", RestHandler.PlannerCostInfo)
+						router.Post("/generate", RestHandler.PlannerGenerate)
+						router.Get("/calendar.ics", RestHandler.PlannerCalendar)
+						router.Post("/calendar.ics", RestHandler.PlannerCalendarImport)
+						router.Post("/calendar-token", RestHandler.PlannerCalendarTokenCreate)
+						router.Delete("/calendar-token", RestHandler.PlannerCalendarTokenDelete)
 						router.Route("/intervals", func(router chi.Router) {
 							router.Get("/", RestHandler.PlannerIntervalsInfo)
 							router.Post("/", RestHandler.PlannerIntervalCreate)
@@ -233,6 +397,7 @@ func startHTTPServer() {
 										router.Get("/", RestHandler.PlannerRecipeInfo)
 										router.Patch("/", RestHandler.PlannerRecipeUpdate)
 										router.Delete("/", RestHandler.PlannerRecipeDelete)
+										router.Post("/cook", RestHandler.PlannerRecipeCook)
 									})
 								})
 							})
@@ -244,10 +409,42 @@ func startHTTPServer() {
 				router.Route("/admin", func(router chi.Router) {
 					router.Route("/users", func(router chi.Router) {
 						middleWareJWT(router)
-						router.Use(service.EnsureAdmin)
-						router.Get("/", RestAdminHandler.UserInfo)
-						router.Patch("/", RestAdminHandler.UserUpdate)
-						router.Delete("/", RestAdminHandler.UserDelete)
+						router.Use(service.EnsurePolicy(kind.UserResourceUser))
+						router.Get("/", RestAdminHandler.UsersInfo)
+						router.Route("/{user_id}", func(router chi.Router) {
+							router.Get("/", RestAdminHandler.UserInfo)
+							router.Patch("/", RestAdminHandler.UserUpdate)
+							router.Delete("/", RestAdminHandler.UserDelete)
+							router.Post("/disable", RestAdminHandler.UserDisable)
+							router.Post("/enable", RestAdminHandler.UserEnable)
+							router.Post("/logout", RestAdminHandler.UserLogout)
+							router.Route("/roles", func(router chi.Router) {
+								router.Post("/", RestAdminHandler.UserRoleCreate)
+								router.Delete("/{user_to_role_id}", RestAdminHandler.UserRoleDelete)
+							})
+						})
+					})
+					router.Group(func(router chi.Router) {
+						middleWareJWT(router)
+						router.Use(service.EnsurePolicy(kind.UserResourceMerge))
+						router.Post("/ingredients/merge", RestAdminHandler.IngredientMerge)
+						router.Post("/categories/merge", RestAdminHandler.CategoryMerge)
+					})
+					router.Route("/roles", func(router chi.Router) {
+						middleWareJWT(router)
+						router.Use(service.EnsurePolicy(kind.UserResourceRole))
+						router.Get("/", RestAdminHandler.RolesInfo)
+						router.Post("/", RestAdminHandler.RoleCreate)
+						router.Route("/{role_id}", func(router chi.Router) {
+							router.Get("/", RestAdminHandler.RoleInfo)
+							router.Patch("/", RestAdminHandler.RoleUpdate)
+							router.Delete("/", RestAdminHandler.RoleDelete)
+							router.Route("/users", func(router chi.Router) {
+								router.Get("/", RestAdminHandler.RoleUsersInfo)
+								router.Post("/", RestAdminHandler.RoleUserCreate)
+								router.Delete("/{user_to_role_id}", RestAdminHandler.RoleUserDelete)
+							})
+						})
 					})
 				})
 			})
@@ -0,0 +1,26 @@
+package directive
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+)
+
+// Policy /** @see handler.PolicyEnforce
+func Policy(ctx context.Context, _ interface{}, next graphql.Resolver, resource string, right string) (interface{}, error) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	errorPolicy := handler.PolicyEnforce(&token.UserId, kind.UserResource(resource), kind.UserRight(right))
+
+	if errorPolicy != nil {
+		return nil, errorPolicy
+	}
+
+	return next(ctx)
+}
@@ -13,6 +13,7 @@ import (
 	"net/http"
 
 	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/dto"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	"github.com/sergeygardner/meal-planner-api/domain/response"
@@ -22,6 +23,8 @@ import (
 var (
 	errorAuthenticationIsRequired    = errors.New("authentication is required")
 	errorAuthenticationIsNotRequired = errors.New("authentication is not required")
+	statusAuthPasswordSuccess        = "the password has been changed successful"
+	statusAuthTotpDisableSuccess     = "the two-factor authentication has been disabled successful"
 )
 
 // Auth is the resolver for the auth field.
@@ -35,6 +38,12 @@ func (r *queryResolver) AuthCheck(ctx context.Context) (*string, error) {
 		return nil, errorAuthenticationIsRequired
 	}
 
+	_, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
 	message := fmt.Sprintf("status is %d", http.StatusOK)
 
 	return &message, nil
@@ -46,7 +55,7 @@ func (r *queryResolver) AuthCredentials(ctx context.Context, input dto.UserCrede
 		return nil, errorAuthenticationIsNotRequired
 	}
 
-	authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(input)
+	authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(input, RestService.ExtractIpFromContext(ctx))
 
 	if errorAuthCredentials != nil {
 		return nil, errorAuthCredentials
@@ -61,7 +70,7 @@ func (r *queryResolver) AuthConfirmation(ctx context.Context, input dto.AuthConf
 		return nil, errorAuthenticationIsNotRequired
 	}
 
-	authToken, errorAuthConfirmation := handler.AuthConfirmation(input)
+	authToken, errorAuthConfirmation := handler.AuthConfirmation(input, RestService.ExtractIpFromContext(ctx))
 
 	if errorAuthConfirmation != nil {
 		return nil, errorAuthConfirmation
@@ -91,21 +100,165 @@ func (r *queryResolver) AuthRefresh(ctx context.Context) (*response.AuthToken, e
 		return nil, errorAuthenticationIsRequired
 	}
 
-	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+	token, errorExtractClaimsFromContext := RestService.ExtractRefreshClaimsFromContext(ctx)
 
 	if errorExtractClaimsFromContext != nil {
 		return nil, errorExtractClaimsFromContext
 	}
 
-	authToken, errorAuthTokenByUserId := handler.AuthTokenByUserId(&token.UserId)
+	authToken, errorAuthRefresh := handler.AuthRefresh(token)
 
-	if errorAuthTokenByUserId != nil {
-		return nil, errorAuthTokenByUserId
+	if errorAuthRefresh != nil {
+		return nil, errorAuthRefresh
 	}
 
 	return authToken, nil
 }
 
+// AuthPasswordReset is the resolver for the AuthPasswordReset field.
+func (r *queryResolver) AuthPasswordReset(ctx context.Context, input dto.AuthPasswordResetDTO) (*response.AuthConfirmation, error) {
+	if service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsNotRequired
+	}
+
+	authConfirmation, _, errorAuthPasswordReset := handler.AuthPasswordReset(input)
+
+	if errorAuthPasswordReset != nil {
+		return nil, errorAuthPasswordReset
+	}
+
+	return authConfirmation, nil
+}
+
+// AuthPasswordResetConfirmation is the resolver for the AuthPasswordResetConfirmation field.
+func (r *queryResolver) AuthPasswordResetConfirmation(ctx context.Context, input dto.AuthPasswordResetConfirmationDTO) (*response.AuthConfirmation, error) {
+	if service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsNotRequired
+	}
+
+	_, errorAuthPasswordResetConfirmation := handler.AuthPasswordResetConfirmation(input, RestService.ExtractIpFromContext(ctx))
+
+	if errorAuthPasswordResetConfirmation != nil {
+		return nil, errorAuthPasswordResetConfirmation
+	}
+
+	return &response.AuthConfirmation{Message: statusAuthPasswordSuccess, Status: http.StatusOK}, nil
+}
+
+// AuthPasswordChange is the resolver for the AuthPasswordChange field.
+func (r *queryResolver) AuthPasswordChange(ctx context.Context, input dto.AuthPasswordChangeDTO) (*response.AuthConfirmation, error) {
+	if !service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsRequired
+	}
+
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	_, errorAuthPasswordChange := handler.AuthPasswordChange(&token.UserId, input)
+
+	if errorAuthPasswordChange != nil {
+		return nil, errorAuthPasswordChange
+	}
+
+	return &response.AuthConfirmation{Message: statusAuthPasswordSuccess, Status: http.StatusOK}, nil
+}
+
+// AuthTotpEnrol is the resolver for the AuthTotpEnrol field.
+func (r *queryResolver) AuthTotpEnrol(ctx context.Context) (*response.AuthTotpEnrol, error) {
+	if !service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsRequired
+	}
+
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	return handler.AuthTotpEnrol(&token.UserId)
+}
+
+// AuthTotpVerify is the resolver for the AuthTotpVerify field.
+func (r *queryResolver) AuthTotpVerify(ctx context.Context, input dto.AuthTotpDTO) (*response.AuthTotpRecoveryCodes, error) {
+	if !service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsRequired
+	}
+
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	return handler.AuthTotpVerify(&token.UserId, input)
+}
+
+// AuthTotpDisable is the resolver for the AuthTotpDisable field.
+func (r *queryResolver) AuthTotpDisable(ctx context.Context, input dto.AuthTotpDisableDTO) (*response.AuthConfirmation, error) {
+	if !service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsRequired
+	}
+
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	_, errorAuthTotpDisable := handler.AuthTotpDisable(&token.UserId, input)
+
+	if errorAuthTotpDisable != nil {
+		return nil, errorAuthTotpDisable
+	}
+
+	return &response.AuthConfirmation{Message: statusAuthTotpDisableSuccess, Status: http.StatusOK}, nil
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, limit *int) ([]*aggregate.SearchResult, error) {
+	if !service.CheckTokenFromContext(ctx) {
+		return nil, errorAuthenticationIsRequired
+	}
+
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	var searchLimit int64
+
+	if limit != nil {
+		searchLimit = int64(*limit)
+	}
+
+	searchResults, errorSearch := handler.Search(&token.UserId, query, searchLimit)
+
+	if errorSearch != nil {
+		return nil, errorSearch
+	}
+
+	return searchResults, nil
+}
+
+// ID is the resolver for the id field.
+func (r *recipeResolver) ID(_ context.Context, obj *entity.Recipe) (string, error) {
+	return obj.Id.String(), nil
+}
+
+// Status is the resolver for the status field.
+func (r *recipeResolver) Status(_ context.Context, obj *entity.Recipe) (string, error) {
+	return obj.Status.String(), nil
+}
+
+// Field is the resolver for the field field.
+func (r *searchHighlightResolver) Field(_ context.Context, obj *aggregate.SearchHighlight) (string, error) {
+	return obj.Field.String(), nil
+}
+
 // ID is the resolver for the id field.
 func (r *userResolver) ID(_ context.Context, obj *entity.User) (string, error) {
 	return obj.Id.String(), nil
@@ -117,9 +270,17 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Recipe returns RecipeResolver implementation.
+func (r *Resolver) Recipe() RecipeResolver { return &recipeResolver{r} }
+
+// SearchHighlight returns SearchHighlightResolver implementation.
+func (r *Resolver) SearchHighlight() SearchHighlightResolver { return &searchHighlightResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type recipeResolver struct{ *Resolver }
+type searchHighlightResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
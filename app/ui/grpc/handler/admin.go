@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	"net/http"
+	"time"
+)
+
+var (
+	statusUserRoleDeleteSuccess = "the role of the user has been deleted successful"
+	statusUserRoleDeleteError   = errors.New("the role of the user has not been deleted")
+)
+
+type AdminUserServer struct {
+	protoBuf.UnimplementedAdminUserServer
+}
+
+func (s *AdminUserServer) UsersInfo(_ context.Context, usersInfoMessage *protoBuf.UsersInfoRequest) (*protoBuf.Users, error) {
+	users, errorUsers := handler.UsersInfo(
+		&DomainAggregate.UserQuery{
+			Query:  usersInfoMessage.GetQuery(),
+			Status: kind.UserStatus(usersInfoMessage.GetStatus()),
+			Limit:  usersInfoMessage.GetLimit(),
+			Offset: usersInfoMessage.GetOffset(),
+		},
+	)
+
+	if errorUsers != nil {
+		return nil, errorUsers
+	}
+
+	usersMessage := &protoBuf.Users{
+		Users:  make([]*protoBuf.UserMessage, 0, len(users.Users)),
+		Total:  users.Total,
+		Limit:  users.Limit,
+		Offset: users.Offset,
+	}
+
+	for _, user := range users.Users {
+		usersMessage.Users = append(usersMessage.Users, userToMessage(user))
+	}
+
+	return usersMessage, nil
+}
+
+func (s *AdminUserServer) UserInfo(_ context.Context, userIdMessage *protoBuf.UserId) (*protoBuf.UserAdminMessage, error) {
+	userId, errorUserId := uuid.Parse(userIdMessage.GetId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	user, errorUser := handler.UserAdminInfo(&userId)
+
+	if errorUser != nil {
+		return nil, errorUser
+	}
+
+	userAdminMessage := &protoBuf.UserAdminMessage{
+		User:          userToMessage(user.Entity),
+		Confirmations: make([]*protoBuf.UserConfirmation, 0, len(user.Confirmations)),
+		Roles:         make([]*protoBuf.UserToRole, 0, len(user.Roles)),
+	}
+
+	for _, userConfirmation := range user.Confirmations {
+		userAdminMessage.Confirmations = append(
+			userAdminMessage.Confirmations,
+			&protoBuf.UserConfirmation{
+				Id:         userConfirmation.Id.String(),
+				UserId:     userConfirmation.UserId.String(),
+				Value:      userConfirmation.Value,
+				Active:     userConfirmation.Active,
+				DateInsert: userConfirmation.DateInsert.Format(time.RFC3339),
+				DateUpdate: userConfirmation.DateUpdate.Format(time.RFC3339),
+			},
+		)
+	}
+
+	for _, userToRole := range user.Roles {
+		userAdminMessage.Roles = append(userAdminMessage.Roles, userToRoleToMessage(userToRole))
+	}
+
+	return userAdminMessage, nil
+}
+
+func (s *AdminUserServer) UserDisable(_ context.Context, userIdMessage *protoBuf.UserId) (*protoBuf.UserMessage, error) {
+	userId, errorUserId := uuid.Parse(userIdMessage.GetId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	user, errorUser := handler.UserDisable(&userId)
+
+	if errorUser != nil {
+		return nil, errorUser
+	}
+
+	return userToMessage(user), nil
+}
+
+func (s *AdminUserServer) UserEnable(_ context.Context, userIdMessage *protoBuf.UserId) (*protoBuf.UserMessage, error) {
+	userId, errorUserId := uuid.Parse(userIdMessage.GetId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	user, errorUser := handler.UserEnable(&userId)
+
+	if errorUser != nil {
+		return nil, errorUser
+	}
+
+	return userToMessage(user), nil
+}
+
+func (s *AdminUserServer) UserLogout(_ context.Context, userIdMessage *protoBuf.UserId) (*protoBuf.UserMessage, error) {
+	userId, errorUserId := uuid.Parse(userIdMessage.GetId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	user, errorUser := handler.UserLogout(&userId)
+
+	if errorUser != nil {
+		return nil, errorUser
+	}
+
+	return userToMessage(user), nil
+}
+
+func (s *AdminUserServer) UserRoleCreate(_ context.Context, userRoleMessage *protoBuf.UserRoleDTO) (*protoBuf.UserToRole, error) {
+	userId, errorUserId := uuid.Parse(userRoleMessage.GetUserId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	roleId, errorRoleId := uuid.Parse(userRoleMessage.GetRoleId())
+
+	if errorRoleId != nil {
+		return nil, errorRoleId
+	}
+
+	rights := make([]kind.UserRight, 0, len(userRoleMessage.GetRights()))
+
+	for _, right := range userRoleMessage.GetRights() {
+		rights = append(rights, kind.UserRight(right))
+	}
+
+	userToRole, errorUserToRole := handler.UserRoleCreate(&userId, &DomainEntity.UserToRole{RoleId: roleId, Rights: rights})
+
+	if errorUserToRole != nil {
+		return nil, errorUserToRole
+	}
+
+	return userToRoleToMessage(userToRole), nil
+}
+
+func (s *AdminUserServer) UserRoleDelete(_ context.Context, userRoleIdMessage *protoBuf.UserRoleId) (*protoBuf.AdminUserStatus, error) {
+	userId, errorUserId := uuid.Parse(userRoleIdMessage.GetUserId())
+
+	if errorUserId != nil {
+		return nil, errorUserId
+	}
+
+	userToRoleId, errorUserToRoleId := uuid.Parse(userRoleIdMessage.GetId())
+
+	if errorUserToRoleId != nil {
+		return nil, errorUserToRoleId
+	}
+
+	userRoleDeleteStatus, errorUserRoleDeleteStatus := handler.UserRoleDelete(&userToRoleId, &userId)
+
+	if errorUserRoleDeleteStatus != nil {
+		return nil, errorUserRoleDeleteStatus
+	} else if !userRoleDeleteStatus {
+		return nil, statusUserRoleDeleteError
+	}
+
+	return &protoBuf.AdminUserStatus{Message: statusUserRoleDeleteSuccess, Status: http.StatusOK}, nil
+}
+
+func userToMessage(user *DomainEntity.User) *protoBuf.UserMessage {
+	userMessage := &protoBuf.UserMessage{
+		Id:         user.Id.String(),
+		Username:   user.Username,
+		Name:       user.Name,
+		Surname:    user.Surname,
+		MiddleName: user.MiddleName,
+		Birthday:   user.Birthday.Format(time.RFC3339),
+		Status:     user.Status.String(),
+		Active:     user.Active,
+		Roles:      make([]string, 0, len(user.Roles)),
+		DateInsert: user.DateInsert.Format(time.RFC3339),
+		DateUpdate: user.DateUpdate.Format(time.RFC3339),
+		DateLogout: user.DateLogout.Format(time.RFC3339),
+	}
+
+	for _, role := range user.Roles {
+		userMessage.Roles = append(userMessage.Roles, role.String())
+	}
+
+	return userMessage
+}
+
+func userToRoleToMessage(userToRole *DomainEntity.UserToRole) *protoBuf.UserToRole {
+	userToRoleMessage := &protoBuf.UserToRole{
+		Id:         userToRole.Id.String(),
+		UserId:     userToRole.UserId.String(),
+		RoleId:     userToRole.RoleId.String(),
+		Rights:     make([]string, 0, len(userToRole.Rights)),
+		Status:     userToRole.Status.String(),
+		DateInsert: userToRole.DateInsert.Format(time.RFC3339),
+		DateUpdate: userToRole.DateUpdate.Format(time.RFC3339),
+	}
+
+	for _, right := range userToRole.Rights {
+		userToRoleMessage.Rights = append(userToRoleMessage.Rights, right.String())
+	}
+
+	return userToRoleMessage
+}
@@ -0,0 +1,352 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
+	"net/http"
+	"time"
+)
+
+var (
+	statusHouseholdDeleteSuccess       = "the household has been deleted successful"
+	statusHouseholdDeleteError         = errors.New("the household has not been deleted")
+	statusHouseholdMemberRevokeSuccess = "the household member has been revoked successful"
+	statusHouseholdMemberRevokeError   = errors.New("the household member has not been revoked")
+	statusHouseholdGrantDeleteSuccess  = "the household grant has been deleted successful"
+	statusHouseholdGrantDeleteError    = errors.New("the household grant has not been deleted")
+)
+
+type HouseholdServer struct {
+	protoBuf.UnimplementedHouseholdServer
+}
+
+func (s *HouseholdServer) HouseholdsInfo(ctx context.Context, _ *protoBuf.HouseholdsInfoRequest) (*protoBuf.Households, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	households, errorHouseholds := handler.HouseholdsInfo(&token.UserId)
+
+	if errorHouseholds != nil {
+		return nil, errorHouseholds
+	}
+
+	householdsMessage := &protoBuf.Households{Households: make([]*protoBuf.HouseholdMessage, 0, len(households))}
+
+	for _, household := range households {
+		householdsMessage.Households = append(householdsMessage.Households, householdToMessage(household))
+	}
+
+	return householdsMessage, nil
+}
+
+func (s *HouseholdServer) HouseholdCreate(ctx context.Context, householdMessage *protoBuf.HouseholdDTO) (*protoBuf.HouseholdMessage, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	household, errorHousehold := handler.HouseholdCreate(
+		&token.UserId,
+		&DomainEntity.Household{Name: householdMessage.GetName(), Status: kind.HouseholdStatus(householdMessage.GetStatus())},
+	)
+
+	if errorHousehold != nil {
+		return nil, errorHousehold
+	}
+
+	return householdToMessage(household), nil
+}
+
+func (s *HouseholdServer) HouseholdInfo(ctx context.Context, householdIdMessage *protoBuf.HouseholdId) (*protoBuf.HouseholdMessage, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdIdMessage.GetId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	household, errorHousehold := handler.HouseholdInfo(&householdId, &token.UserId)
+
+	if errorHousehold != nil {
+		return nil, errorHousehold
+	}
+
+	return householdToMessage(household), nil
+}
+
+func (s *HouseholdServer) HouseholdDelete(ctx context.Context, householdIdMessage *protoBuf.HouseholdId) (*protoBuf.HouseholdStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdIdMessage.GetId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	householdDeleteStatus, errorHouseholdDeleteStatus := handler.HouseholdDelete(&householdId, &token.UserId)
+
+	if errorHouseholdDeleteStatus != nil {
+		return nil, errorHouseholdDeleteStatus
+	} else if !householdDeleteStatus {
+		return nil, statusHouseholdDeleteError
+	}
+
+	return &protoBuf.HouseholdStatus{Message: statusHouseholdDeleteSuccess, Status: http.StatusOK}, nil
+}
+
+func (s *HouseholdServer) HouseholdInvite(ctx context.Context, householdInviteMessage *protoBuf.HouseholdInviteRequest) (*protoBuf.HouseholdMember, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdInviteMessage.GetHouseholdId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	householdMember, errorHouseholdMember := handler.HouseholdInvite(&householdId, &token.UserId, householdInviteMessage.GetUsername())
+
+	if errorHouseholdMember != nil {
+		return nil, errorHouseholdMember
+	}
+
+	return householdMemberToMessage(householdMember), nil
+}
+
+func (s *HouseholdServer) HouseholdMemberRevoke(ctx context.Context, householdMemberIdMessage *protoBuf.HouseholdMemberId) (*protoBuf.HouseholdStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdMemberIdMessage.GetHouseholdId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	householdMemberId, errorHouseholdMemberId := uuid.Parse(householdMemberIdMessage.GetId())
+
+	if errorHouseholdMemberId != nil {
+		return nil, errorHouseholdMemberId
+	}
+
+	householdMemberRevokeStatus, errorHouseholdMemberRevokeStatus := handler.HouseholdMemberRevoke(&householdMemberId, &token.UserId, &householdId)
+
+	if errorHouseholdMemberRevokeStatus != nil {
+		return nil, errorHouseholdMemberRevokeStatus
+	} else if !householdMemberRevokeStatus {
+		return nil, statusHouseholdMemberRevokeError
+	}
+
+	return &protoBuf.HouseholdStatus{Message: statusHouseholdMemberRevokeSuccess, Status: http.StatusOK}, nil
+}
+
+func (s *HouseholdServer) HouseholdInvitationsInfo(ctx context.Context, _ *protoBuf.HouseholdInvitationsInfoRequest) (*protoBuf.HouseholdInvitations, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdInvitations, errorHouseholdInvitations := handler.HouseholdInvitationsInfo(&token.UserId)
+
+	if errorHouseholdInvitations != nil {
+		return nil, errorHouseholdInvitations
+	}
+
+	householdInvitationsMessage := &protoBuf.HouseholdInvitations{Invitations: make([]*protoBuf.HouseholdInvitation, 0, len(householdInvitations))}
+
+	for _, householdInvitation := range householdInvitations {
+		householdInvitationsMessage.Invitations = append(
+			householdInvitationsMessage.Invitations,
+			&protoBuf.HouseholdInvitation{Member: householdMemberToMessage(householdInvitation.Entity), HouseholdName: householdInvitation.Household.Name},
+		)
+	}
+
+	return householdInvitationsMessage, nil
+}
+
+func (s *HouseholdServer) HouseholdInvitationAccept(ctx context.Context, householdInvitationIdMessage *protoBuf.HouseholdInvitationId) (*protoBuf.HouseholdMessage, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdMemberId, errorHouseholdMemberId := uuid.Parse(householdInvitationIdMessage.GetId())
+
+	if errorHouseholdMemberId != nil {
+		return nil, errorHouseholdMemberId
+	}
+
+	household, errorHousehold := handler.HouseholdInvitationAccept(&householdMemberId, &token.UserId)
+
+	if errorHousehold != nil {
+		return nil, errorHousehold
+	}
+
+	return householdToMessage(household), nil
+}
+
+func (s *HouseholdServer) HouseholdInvitationDecline(ctx context.Context, householdInvitationIdMessage *protoBuf.HouseholdInvitationId) (*protoBuf.HouseholdStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdMemberId, errorHouseholdMemberId := uuid.Parse(householdInvitationIdMessage.GetId())
+
+	if errorHouseholdMemberId != nil {
+		return nil, errorHouseholdMemberId
+	}
+
+	householdMemberRevokeStatus, errorHouseholdMemberRevokeStatus := handler.HouseholdInvitationDecline(&householdMemberId, &token.UserId)
+
+	if errorHouseholdMemberRevokeStatus != nil {
+		return nil, errorHouseholdMemberRevokeStatus
+	} else if !householdMemberRevokeStatus {
+		return nil, statusHouseholdMemberRevokeError
+	}
+
+	return &protoBuf.HouseholdStatus{Message: statusHouseholdMemberRevokeSuccess, Status: http.StatusOK}, nil
+}
+
+func (s *HouseholdServer) HouseholdGrantCreate(ctx context.Context, householdGrantMessage *protoBuf.HouseholdGrantDTO) (*protoBuf.HouseholdGrant, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdGrantMessage.GetHouseholdId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	subjectId, errorSubjectId := uuid.Parse(householdGrantMessage.GetSubjectId())
+
+	if errorSubjectId != nil {
+		return nil, errorSubjectId
+	}
+
+	householdGrant, errorHouseholdGrant := handler.HouseholdGrantCreate(
+		&token.UserId,
+		&householdId,
+		&DomainEntity.HouseholdGrant{
+			SubjectId: subjectId,
+			Subject:   kind.HouseholdSubject(householdGrantMessage.GetSubject()),
+			Right:     kind.UserRight(householdGrantMessage.GetRight()),
+		},
+	)
+
+	if errorHouseholdGrant != nil {
+		return nil, errorHouseholdGrant
+	}
+
+	return householdGrantToMessage(householdGrant), nil
+}
+
+func (s *HouseholdServer) HouseholdGrantDelete(ctx context.Context, householdGrantIdMessage *protoBuf.HouseholdGrantId) (*protoBuf.HouseholdStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(householdGrantIdMessage.GetHouseholdId())
+
+	if errorHouseholdId != nil {
+		return nil, errorHouseholdId
+	}
+
+	householdGrantId, errorHouseholdGrantId := uuid.Parse(householdGrantIdMessage.GetId())
+
+	if errorHouseholdGrantId != nil {
+		return nil, errorHouseholdGrantId
+	}
+
+	householdGrantDeleteStatus, errorHouseholdGrantDeleteStatus := handler.HouseholdGrantDelete(&householdGrantId, &token.UserId, &householdId)
+
+	if errorHouseholdGrantDeleteStatus != nil {
+		return nil, errorHouseholdGrantDeleteStatus
+	} else if !householdGrantDeleteStatus {
+		return nil, statusHouseholdGrantDeleteError
+	}
+
+	return &protoBuf.HouseholdStatus{Message: statusHouseholdGrantDeleteSuccess, Status: http.StatusOK}, nil
+}
+
+func householdToMessage(household *DomainAggregate.Household) *protoBuf.HouseholdMessage {
+	householdMessage := &protoBuf.HouseholdMessage{
+		Id:         household.Entity.Id.String(),
+		UserId:     household.Entity.UserId.String(),
+		Name:       household.Entity.Name,
+		Status:     household.Entity.Status.String(),
+		DateInsert: household.Entity.DateInsert.Format(time.RFC3339),
+		DateUpdate: household.Entity.DateUpdate.Format(time.RFC3339),
+		Members:    make([]*protoBuf.HouseholdMember, 0, len(household.Members)),
+		Grants:     make([]*protoBuf.HouseholdGrant, 0, len(household.Grants)),
+	}
+
+	for _, householdMember := range household.Members {
+		householdMessage.Members = append(householdMessage.Members, householdMemberToMessage(householdMember))
+	}
+
+	for _, householdGrant := range household.Grants {
+		householdMessage.Grants = append(householdMessage.Grants, householdGrantToMessage(householdGrant))
+	}
+
+	return householdMessage
+}
+
+func householdMemberToMessage(householdMember *DomainEntity.HouseholdMember) *protoBuf.HouseholdMember {
+	return &protoBuf.HouseholdMember{
+		Id:          householdMember.Id.String(),
+		UserId:      householdMember.UserId.String(),
+		HouseholdId: householdMember.EntityId.String(),
+		InviterId:   householdMember.InviterId.String(),
+		Status:      householdMember.Status.String(),
+		DateInsert:  householdMember.DateInsert.Format(time.RFC3339),
+		DateUpdate:  householdMember.DateUpdate.Format(time.RFC3339),
+	}
+}
+
+func householdGrantToMessage(householdGrant *DomainEntity.HouseholdGrant) *protoBuf.HouseholdGrant {
+	return &protoBuf.HouseholdGrant{
+		Id:          householdGrant.Id.String(),
+		UserId:      householdGrant.UserId.String(),
+		HouseholdId: householdGrant.EntityId.String(),
+		SubjectId:   householdGrant.SubjectId.String(),
+		Subject:     householdGrant.Subject.String(),
+		Right:       householdGrant.Right.String(),
+		DateInsert:  householdGrant.DateInsert.Format(time.RFC3339),
+		DateUpdate:  householdGrant.DateUpdate.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
+	"net/http"
+	"time"
+)
+
+var (
+	statusPantryItemDeleteSuccess = "the pantry item has been deleted successful"
+	statusPantryItemDeleteError   = errors.New("the pantry item has not been deleted")
+)
+
+type PantryServer struct {
+	protoBuf.UnimplementedPantryServer
+}
+
+func (s *PantryServer) PantryItemsInfo(ctx context.Context, _ *protoBuf.PantryItemsInfoRequest) (*protoBuf.PantryItems, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	pantryItems, errorPantryItems := handler.PantryItemsInfo(&token.UserId, nil)
+
+	if errorPantryItems != nil {
+		return nil, errorPantryItems
+	}
+
+	pantryItemsMessage := &protoBuf.PantryItems{PantryItems: make([]*protoBuf.PantryItem, 0, len(pantryItems))}
+
+	for _, pantryItem := range pantryItems {
+		pantryItemsMessage.PantryItems = append(pantryItemsMessage.PantryItems, pantryItemToMessage(pantryItem))
+	}
+
+	return pantryItemsMessage, nil
+}
+
+func (s *PantryServer) PantryItemCreate(ctx context.Context, pantryItemMessage *protoBuf.PantryItemDTO) (*protoBuf.PantryItem, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	pantryItemDTO, errorPantryItemDTO := pantryItemFromMessage(pantryItemMessage)
+
+	if errorPantryItemDTO != nil {
+		return nil, errorPantryItemDTO
+	}
+
+	pantryItem, errorPantryItem := handler.PantryItemCreate(&token.UserId, pantryItemDTO)
+
+	if errorPantryItem != nil {
+		return nil, errorPantryItem
+	}
+
+	return pantryItemToMessage(pantryItem), nil
+}
+
+func (s *PantryServer) PantryItemInfo(ctx context.Context, pantryItemIdMessage *protoBuf.PantryItemId) (*protoBuf.PantryItem, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(pantryItemIdMessage.GetId())
+
+	if errorPantryItemId != nil {
+		return nil, errorPantryItemId
+	}
+
+	pantryItem, errorPantryItem := handler.PantryItemInfo(&pantryItemId, &token.UserId, nil)
+
+	if errorPantryItem != nil {
+		return nil, errorPantryItem
+	}
+
+	return pantryItemToMessage(pantryItem), nil
+}
+
+func (s *PantryServer) PantryItemUpdate(ctx context.Context, pantryItemMessage *protoBuf.PantryItemDTO) (*protoBuf.PantryItem, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(pantryItemMessage.GetId())
+
+	if errorPantryItemId != nil {
+		return nil, errorPantryItemId
+	}
+
+	pantryItemDTO, errorPantryItemDTO := pantryItemFromMessage(pantryItemMessage)
+
+	if errorPantryItemDTO != nil {
+		return nil, errorPantryItemDTO
+	}
+
+	pantryItem, errorPantryItem := handler.PantryItemUpdate(&pantryItemId, &token.UserId, pantryItemDTO)
+
+	if errorPantryItem != nil {
+		return nil, errorPantryItem
+	}
+
+	return pantryItemToMessage(pantryItem), nil
+}
+
+func (s *PantryServer) PantryItemDelete(ctx context.Context, pantryItemIdMessage *protoBuf.PantryItemId) (*protoBuf.PantryItemDeleteStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(pantryItemIdMessage.GetId())
+
+	if errorPantryItemId != nil {
+		return nil, errorPantryItemId
+	}
+
+	pantryItemDeleteStatus, errorPantryItemDeleteStatus := handler.PantryItemDelete(&pantryItemId, &token.UserId)
+
+	if errorPantryItemDeleteStatus != nil {
+		return nil, errorPantryItemDeleteStatus
+	} else if !pantryItemDeleteStatus {
+		return nil, statusPantryItemDeleteError
+	}
+
+	return &protoBuf.PantryItemDeleteStatus{Message: statusPantryItemDeleteSuccess, Status: http.StatusOK}, nil
+}
+
+func pantryItemFromMessage(pantryItemMessage *protoBuf.PantryItemDTO) (*DomainEntity.PantryItem, error) {
+	pantryItemDTO := &DomainEntity.PantryItem{
+		Quantity: pantryItemMessage.GetQuantity(),
+		Status:   kind.PantryItemStatus(pantryItemMessage.GetStatus()),
+	}
+
+	if pantryItemMessage.GetIngredientId() != "" {
+		ingredientId, errorIngredientId := uuid.Parse(pantryItemMessage.GetIngredientId())
+
+		if errorIngredientId != nil {
+			return nil, errorIngredientId
+		}
+
+		pantryItemDTO.IngredientId = ingredientId
+	}
+
+	if pantryItemMessage.GetUnitId() != "" {
+		unitId, errorUnitId := uuid.Parse(pantryItemMessage.GetUnitId())
+
+		if errorUnitId != nil {
+			return nil, errorUnitId
+		}
+
+		pantryItemDTO.UnitId = unitId
+	}
+
+	if pantryItemMessage.GetExpiryTime() != "" {
+		expiryTime, errorExpiryTime := time.Parse(time.RFC3339, pantryItemMessage.GetExpiryTime())
+
+		if errorExpiryTime != nil {
+			return nil, errorExpiryTime
+		}
+
+		pantryItemDTO.ExpiryTime = expiryTime
+	}
+
+	return pantryItemDTO, nil
+}
+
+func pantryItemToMessage(pantryItem *DomainAggregate.PantryItem) *protoBuf.PantryItem {
+	pantryItemMessage := &protoBuf.PantryItem{
+		Id:           pantryItem.Entity.Id.String(),
+		UserId:       pantryItem.Entity.UserId.String(),
+		IngredientId: pantryItem.Entity.IngredientId.String(),
+		UnitId:       pantryItem.Entity.UnitId.String(),
+		Quantity:     pantryItem.Entity.Quantity,
+		Status:       pantryItem.Entity.Status.String(),
+		DateInsert:   pantryItem.Entity.DateInsert.Format(time.RFC3339),
+		DateUpdate:   pantryItem.Entity.DateUpdate.Format(time.RFC3339),
+	}
+
+	if !pantryItem.Entity.ExpiryTime.IsZero() {
+		pantryItemMessage.ExpiryTime = pantryItem.Entity.ExpiryTime.Format(time.RFC3339)
+	}
+
+	if pantryItem.Ingredient != nil {
+		pantryItemMessage.IngredientName = pantryItem.Ingredient.Name
+	}
+
+	if pantryItem.Unit != nil {
+		pantryItemMessage.UnitName = pantryItem.Unit.Name
+	}
+
+	return pantryItemMessage
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
+	"net/http"
+)
+
+var (
+	statusAuthPasswordSuccess = "the password has been changed successful"
+	statusAuthPasswordError   = errors.New("the password has not been changed")
+)
+
+type AuthPasswordServer struct {
+	protoBuf.UnimplementedAuthPasswordServer
+}
+
+func (s *AuthPasswordServer) PasswordReset(_ context.Context, passwordResetMessage *protoBuf.PasswordResetRequest) (*protoBuf.AuthPasswordStatus, error) {
+	authConfirmation, _, errorAuthPasswordReset := handler.AuthPasswordReset(dto.AuthPasswordResetDTO{Username: passwordResetMessage.GetUsername()})
+
+	if errorAuthPasswordReset != nil {
+		return nil, errorAuthPasswordReset
+	}
+
+	return &protoBuf.AuthPasswordStatus{Message: authConfirmation.Message, Status: int64(authConfirmation.Status)}, nil
+}
+
+func (s *AuthPasswordServer) PasswordResetConfirmation(ctx context.Context, passwordResetConfirmationMessage *protoBuf.PasswordResetConfirmationRequest) (*protoBuf.AuthPasswordStatus, error) {
+	authPasswordStatus, errorAuthPasswordStatus := handler.AuthPasswordResetConfirmation(
+		dto.AuthPasswordResetConfirmationDTO{
+			Username: passwordResetConfirmationMessage.GetUsername(),
+			Code:     passwordResetConfirmationMessage.GetCode(),
+			Password: passwordResetConfirmationMessage.GetPassword(),
+		},
+		GrpcService.ExtractIpFromContext(ctx),
+	)
+
+	if errorAuthPasswordStatus != nil {
+		return nil, errorAuthPasswordStatus
+	} else if !authPasswordStatus {
+		return nil, statusAuthPasswordError
+	}
+
+	return &protoBuf.AuthPasswordStatus{Message: statusAuthPasswordSuccess, Status: http.StatusOK}, nil
+}
+
+func (s *AuthPasswordServer) PasswordChange(ctx context.Context, passwordChangeMessage *protoBuf.PasswordChangeRequest) (*protoBuf.AuthPasswordStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	authPasswordStatus, errorAuthPasswordStatus := handler.AuthPasswordChange(
+		&token.UserId,
+		dto.AuthPasswordChangeDTO{
+			Password:    passwordChangeMessage.GetPassword(),
+			PasswordNew: passwordChangeMessage.GetPasswordNew(),
+		},
+	)
+
+	if errorAuthPasswordStatus != nil {
+		return nil, errorAuthPasswordStatus
+	} else if !authPasswordStatus {
+		return nil, statusAuthPasswordError
+	}
+
+	return &protoBuf.AuthPasswordStatus{Message: statusAuthPasswordSuccess, Status: http.StatusOK}, nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
+)
+
+type PlannerServer struct {
+	protoBuf.UnimplementedPlannerServer
+}
+
+func (s *PlannerServer) PlannerGenerate(ctx context.Context, plannerGenerateMessage *protoBuf.PlannerGenerateRequest) (*protoBuf.PlannerGenerateResponse, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(plannerGenerateMessage.GetId())
+
+	if errorPlannerId != nil {
+		return nil, errorPlannerId
+	}
+
+	plannerGenerateConstraints, errorPlannerGenerateConstraints := plannerGenerateConstraintsFromMessage(plannerGenerateMessage)
+
+	if errorPlannerGenerateConstraints != nil {
+		return nil, errorPlannerGenerateConstraints
+	}
+
+	plannerGenerate, errorPlannerGenerate := handler.PlannerGenerate(&plannerId, &token.UserId, plannerGenerateConstraints)
+
+	if errorPlannerGenerate != nil {
+		return nil, errorPlannerGenerate
+	}
+
+	return plannerGenerateToMessage(plannerGenerate), nil
+}
+
+func plannerGenerateConstraintsFromMessage(plannerGenerateMessage *protoBuf.PlannerGenerateRequest) (*DomainAggregate.PlannerGenerateConstraints, error) {
+	tags, errorTags := uuidsFromMessage(plannerGenerateMessage.GetTags())
+
+	if errorTags != nil {
+		return nil, errorTags
+	}
+
+	plannerGenerateConstraints := &DomainAggregate.PlannerGenerateConstraints{
+		Seed:         plannerGenerateMessage.GetSeed(),
+		Tags:         tags,
+		MaxRepeats:   plannerGenerateMessage.GetMaxRepeats(),
+		CaloriesMin:  plannerGenerateMessage.GetCaloriesMin(),
+		CaloriesMax:  plannerGenerateMessage.GetCaloriesMax(),
+		Budget:       plannerGenerateMessage.GetBudget(),
+		Currency:     plannerGenerateMessage.GetCurrency(),
+		PreferPantry: plannerGenerateMessage.GetPreferPantry(),
+		Replace:      plannerGenerateMessage.GetReplace(),
+	}
+
+	for _, slotMessage := range plannerGenerateMessage.GetSlots() {
+		intervalId, errorIntervalId := uuid.Parse(slotMessage.GetIntervalId())
+
+		if errorIntervalId != nil {
+			return nil, errorIntervalId
+		}
+
+		categories, errorCategories := uuidsFromMessage(slotMessage.GetCategories())
+
+		if errorCategories != nil {
+			return nil, errorCategories
+		}
+
+		plannerGenerateConstraints.Slots = append(
+			plannerGenerateConstraints.Slots,
+			&DomainAggregate.PlannerGenerateSlot{
+				IntervalId:  intervalId,
+				Categories:  categories,
+				CaloriesMin: slotMessage.GetCaloriesMin(),
+				CaloriesMax: slotMessage.GetCaloriesMax(),
+			},
+		)
+	}
+
+	return plannerGenerateConstraints, nil
+}
+
+func plannerGenerateToMessage(plannerGenerate *DomainAggregate.PlannerGenerate) *protoBuf.PlannerGenerateResponse {
+	plannerGenerateMessage := &protoBuf.PlannerGenerateResponse{
+		Assignments: make([]*protoBuf.PlannerGenerateAssignment, 0, len(plannerGenerate.Assignments)),
+		Seed:        plannerGenerate.Seed,
+		Total:       plannerGenerate.Total,
+		Currency:    plannerGenerate.Currency,
+		Warnings:    plannerGenerate.Warnings,
+	}
+
+	if plannerGenerate.Planner != nil && plannerGenerate.Planner.Entity != nil {
+		plannerGenerateMessage.PlannerId = plannerGenerate.Planner.Entity.Id.String()
+	}
+
+	for _, assignment := range plannerGenerate.Assignments {
+		plannerGenerateMessage.Assignments = append(
+			plannerGenerateMessage.Assignments,
+			&protoBuf.PlannerGenerateAssignment{
+				IntervalId:   assignment.Interval.Id.String(),
+				IntervalName: assignment.Interval.Name,
+				RecipeId:     assignment.Recipe.Id.String(),
+				RecipeName:   assignment.Recipe.Name,
+				Cost:         assignment.Cost,
+			},
+		)
+	}
+
+	return plannerGenerateMessage
+}
+
+func uuidsFromMessage(values []string) ([]uuid.UUID, error) {
+	var uuids []uuid.UUID
+
+	for _, value := range values {
+		parsed, errorParsed := uuid.Parse(value)
+
+		if errorParsed != nil {
+			return nil, errorParsed
+		}
+
+		uuids = append(uuids, parsed)
+	}
+
+	return uuids, nil
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/dto"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
+	"net/http"
+)
+
+var (
+	statusAuthTotpDisableSuccess = "the two-factor authentication has been disabled successful"
+	statusAuthTotpDisableError   = errors.New("the two-factor authentication has not been disabled")
+)
+
+type AuthTotpServer struct {
+	protoBuf.UnimplementedAuthTotpServer
+}
+
+func (s *AuthTotpServer) TotpEnrol(ctx context.Context, _ *protoBuf.TotpEnrolRequest) (*protoBuf.TotpEnrolResponse, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	authTotpEnrol, errorAuthTotpEnrol := handler.AuthTotpEnrol(&token.UserId)
+
+	if errorAuthTotpEnrol != nil {
+		return nil, errorAuthTotpEnrol
+	}
+
+	return &protoBuf.TotpEnrolResponse{Secret: authTotpEnrol.Secret, Uri: authTotpEnrol.Uri}, nil
+}
+
+func (s *AuthTotpServer) TotpVerify(ctx context.Context, totpVerifyMessage *protoBuf.TotpVerifyRequest) (*protoBuf.TotpRecoveryCodesResponse, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	authTotpRecoveryCodes, errorAuthTotpVerify := handler.AuthTotpVerify(&token.UserId, dto.AuthTotpDTO{Code: totpVerifyMessage.GetCode()})
+
+	if errorAuthTotpVerify != nil {
+		return nil, errorAuthTotpVerify
+	}
+
+	return &protoBuf.TotpRecoveryCodesResponse{RecoveryCodes: authTotpRecoveryCodes.RecoveryCodes}, nil
+}
+
+func (s *AuthTotpServer) TotpDisable(ctx context.Context, totpDisableMessage *protoBuf.TotpDisableRequest) (*protoBuf.AuthTotpStatus, error) {
+	token, errorExtractClaimsFromContext := GrpcService.ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, errorExtractClaimsFromContext
+	}
+
+	authTotpDisableStatus, errorAuthTotpDisableStatus := handler.AuthTotpDisable(
+		&token.UserId,
+		dto.AuthTotpDisableDTO{
+			Password: totpDisableMessage.GetPassword(),
+			Code:     totpDisableMessage.GetCode(),
+		},
+	)
+
+	if errorAuthTotpDisableStatus != nil {
+		return nil, errorAuthTotpDisableStatus
+	} else if !authTotpDisableStatus {
+		return nil, statusAuthTotpDisableError
+	}
+
+	return &protoBuf.AuthTotpStatus{Message: statusAuthTotpDisableSuccess, Status: http.StatusOK}, nil
+}
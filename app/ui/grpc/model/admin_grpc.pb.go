@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: admin.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AdminUser_UsersInfo_FullMethodName      = "/AdminUser.AdminUser/UsersInfo"
+	AdminUser_UserInfo_FullMethodName       = "/AdminUser.AdminUser/UserInfo"
+	AdminUser_UserDisable_FullMethodName    = "/AdminUser.AdminUser/UserDisable"
+	AdminUser_UserEnable_FullMethodName     = "/AdminUser.AdminUser/UserEnable"
+	AdminUser_UserLogout_FullMethodName     = "/AdminUser.AdminUser/UserLogout"
+	AdminUser_UserRoleCreate_FullMethodName = "/AdminUser.AdminUser/UserRoleCreate"
+	AdminUser_UserRoleDelete_FullMethodName = "/AdminUser.AdminUser/UserRoleDelete"
+)
+
+// AdminUserClient is the client API for AdminUser service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminUserClient interface {
+	// Shows a page of the users filtered by the query
+	UsersInfo(ctx context.Context, in *UsersInfoRequest, opts ...grpc.CallOption) (*Users, error)
+	// Shows a user with its confirmations and roles
+	UserInfo(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserAdminMessage, error)
+	// Disables a user and logs it out
+	UserDisable(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error)
+	// Enables a disabled user
+	UserEnable(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error)
+	// Revokes all of the tokens issued to a user
+	UserLogout(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error)
+	// Binds a role to a user
+	UserRoleCreate(ctx context.Context, in *UserRoleDTO, opts ...grpc.CallOption) (*UserToRole, error)
+	// Deletes a role binding of a user
+	UserRoleDelete(ctx context.Context, in *UserRoleId, opts ...grpc.CallOption) (*AdminUserStatus, error)
+}
+
+type adminUserClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminUserClient(cc grpc.ClientConnInterface) AdminUserClient {
+	return &adminUserClient{cc}
+}
+
+func (c *adminUserClient) UsersInfo(ctx context.Context, in *UsersInfoRequest, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, AdminUser_UsersInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserInfo(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserAdminMessage, error) {
+	out := new(UserAdminMessage)
+	err := c.cc.Invoke(ctx, AdminUser_UserInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserDisable(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error) {
+	out := new(UserMessage)
+	err := c.cc.Invoke(ctx, AdminUser_UserDisable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserEnable(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error) {
+	out := new(UserMessage)
+	err := c.cc.Invoke(ctx, AdminUser_UserEnable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserLogout(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*UserMessage, error) {
+	out := new(UserMessage)
+	err := c.cc.Invoke(ctx, AdminUser_UserLogout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserRoleCreate(ctx context.Context, in *UserRoleDTO, opts ...grpc.CallOption) (*UserToRole, error) {
+	out := new(UserToRole)
+	err := c.cc.Invoke(ctx, AdminUser_UserRoleCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminUserClient) UserRoleDelete(ctx context.Context, in *UserRoleId, opts ...grpc.CallOption) (*AdminUserStatus, error) {
+	out := new(AdminUserStatus)
+	err := c.cc.Invoke(ctx, AdminUser_UserRoleDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminUserServer is the server API for AdminUser service.
+// All implementations must embed UnimplementedAdminUserServer
+// for forward compatibility
+type AdminUserServer interface {
+	// Shows a page of the users filtered by the query
+	UsersInfo(context.Context, *UsersInfoRequest) (*Users, error)
+	// Shows a user with its confirmations and roles
+	UserInfo(context.Context, *UserId) (*UserAdminMessage, error)
+	// Disables a user and logs it out
+	UserDisable(context.Context, *UserId) (*UserMessage, error)
+	// Enables a disabled user
+	UserEnable(context.Context, *UserId) (*UserMessage, error)
+	// Revokes all of the tokens issued to a user
+	UserLogout(context.Context, *UserId) (*UserMessage, error)
+	// Binds a role to a user
+	UserRoleCreate(context.Context, *UserRoleDTO) (*UserToRole, error)
+	// Deletes a role binding of a user
+	UserRoleDelete(context.Context, *UserRoleId) (*AdminUserStatus, error)
+	mustEmbedUnimplementedAdminUserServer()
+}
+
+// UnimplementedAdminUserServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminUserServer struct {
+}
+
+func (UnimplementedAdminUserServer) UsersInfo(context.Context, *UsersInfoRequest) (*Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsersInfo not implemented")
+}
+func (UnimplementedAdminUserServer) UserInfo(context.Context, *UserId) (*UserAdminMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
+}
+func (UnimplementedAdminUserServer) UserDisable(context.Context, *UserId) (*UserMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDisable not implemented")
+}
+func (UnimplementedAdminUserServer) UserEnable(context.Context, *UserId) (*UserMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserEnable not implemented")
+}
+func (UnimplementedAdminUserServer) UserLogout(context.Context, *UserId) (*UserMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLogout not implemented")
+}
+func (UnimplementedAdminUserServer) UserRoleCreate(context.Context, *UserRoleDTO) (*UserToRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRoleCreate not implemented")
+}
+func (UnimplementedAdminUserServer) UserRoleDelete(context.Context, *UserRoleId) (*AdminUserStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRoleDelete not implemented")
+}
+func (UnimplementedAdminUserServer) mustEmbedUnimplementedAdminUserServer() {}
+
+// UnsafeAdminUserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminUserServer will
+// result in compilation errors.
+type UnsafeAdminUserServer interface {
+	mustEmbedUnimplementedAdminUserServer()
+}
+
+func RegisterAdminUserServer(s grpc.ServiceRegistrar, srv AdminUserServer) {
+	s.RegisterService(&AdminUser_ServiceDesc, srv)
+}
+
+func _AdminUser_UsersInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UsersInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UsersInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UsersInfo(ctx, req.(*UsersInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserInfo(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserDisable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserDisable(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserEnable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserEnable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserEnable(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserLogout(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserRoleCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRoleDTO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserRoleCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserRoleCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserRoleCreate(ctx, req.(*UserRoleDTO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminUser_UserRoleDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRoleId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminUserServer).UserRoleDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminUser_UserRoleDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminUserServer).UserRoleDelete(ctx, req.(*UserRoleId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminUser_ServiceDesc is the grpc.ServiceDesc for AdminUser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminUser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "AdminUser.AdminUser",
+	HandlerType: (*AdminUserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UsersInfo",
+			Handler:    _AdminUser_UsersInfo_Handler,
+		},
+		{
+			MethodName: "UserInfo",
+			Handler:    _AdminUser_UserInfo_Handler,
+		},
+		{
+			MethodName: "UserDisable",
+			Handler:    _AdminUser_UserDisable_Handler,
+		},
+		{
+			MethodName: "UserEnable",
+			Handler:    _AdminUser_UserEnable_Handler,
+		},
+		{
+			MethodName: "UserLogout",
+			Handler:    _AdminUser_UserLogout_Handler,
+		},
+		{
+			MethodName: "UserRoleCreate",
+			Handler:    _AdminUser_UserRoleCreate_Handler,
+		},
+		{
+			MethodName: "UserRoleDelete",
+			Handler:    _AdminUser_UserRoleDelete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
@@ -0,0 +1,1460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: household.proto
+
+package Auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message to show all of households.
+type HouseholdsInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HouseholdsInfoRequest) Reset() {
+	*x = HouseholdsInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdsInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdsInfoRequest) ProtoMessage() {}
+
+func (x *HouseholdsInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdsInfoRequest.ProtoReflect.Descriptor instead.
+func (*HouseholdsInfoRequest) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{0}
+}
+
+// The request message to show all of invitations.
+type HouseholdInvitationsInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HouseholdInvitationsInfoRequest) Reset() {
+	*x = HouseholdInvitationsInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdInvitationsInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdInvitationsInfoRequest) ProtoMessage() {}
+
+func (x *HouseholdInvitationsInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdInvitationsInfoRequest.ProtoReflect.Descriptor instead.
+func (*HouseholdInvitationsInfoRequest) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{1}
+}
+
+// The request message containing the household id.
+type HouseholdId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HouseholdId) Reset() {
+	*x = HouseholdId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdId) ProtoMessage() {}
+
+func (x *HouseholdId) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdId.ProtoReflect.Descriptor instead.
+func (*HouseholdId) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HouseholdId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request message containing the household data.
+type HouseholdDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *HouseholdDTO) Reset() {
+	*x = HouseholdDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdDTO) ProtoMessage() {}
+
+func (x *HouseholdDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdDTO.ProtoReflect.Descriptor instead.
+func (*HouseholdDTO) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HouseholdDTO) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HouseholdDTO) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// The request message containing the household id and the username of the invited user.
+type HouseholdInviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseholdId string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Username    string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *HouseholdInviteRequest) Reset() {
+	*x = HouseholdInviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdInviteRequest) ProtoMessage() {}
+
+func (x *HouseholdInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdInviteRequest.ProtoReflect.Descriptor instead.
+func (*HouseholdInviteRequest) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HouseholdInviteRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdInviteRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// The request message containing the household id and the member id.
+type HouseholdMemberId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseholdId string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HouseholdMemberId) Reset() {
+	*x = HouseholdMemberId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdMemberId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMemberId) ProtoMessage() {}
+
+func (x *HouseholdMemberId) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMemberId.ProtoReflect.Descriptor instead.
+func (*HouseholdMemberId) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HouseholdMemberId) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdMemberId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request message containing the invitation id.
+type HouseholdInvitationId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HouseholdInvitationId) Reset() {
+	*x = HouseholdInvitationId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdInvitationId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdInvitationId) ProtoMessage() {}
+
+func (x *HouseholdInvitationId) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdInvitationId.ProtoReflect.Descriptor instead.
+func (*HouseholdInvitationId) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HouseholdInvitationId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request message containing the grant data.
+type HouseholdGrantDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseholdId string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	SubjectId   string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Subject     string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Right       string `protobuf:"bytes,4,opt,name=right,proto3" json:"right,omitempty"`
+}
+
+func (x *HouseholdGrantDTO) Reset() {
+	*x = HouseholdGrantDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdGrantDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdGrantDTO) ProtoMessage() {}
+
+func (x *HouseholdGrantDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdGrantDTO.ProtoReflect.Descriptor instead.
+func (*HouseholdGrantDTO) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HouseholdGrantDTO) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdGrantDTO) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *HouseholdGrantDTO) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *HouseholdGrantDTO) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+// The request message containing the household id and the grant id.
+type HouseholdGrantId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseholdId string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HouseholdGrantId) Reset() {
+	*x = HouseholdGrantId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdGrantId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdGrantId) ProtoMessage() {}
+
+func (x *HouseholdGrantId) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdGrantId.ProtoReflect.Descriptor instead.
+func (*HouseholdGrantId) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HouseholdGrantId) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdGrantId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The response message containing the member of a household.
+type HouseholdMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HouseholdId string `protobuf:"bytes,3,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	InviterId   string `protobuf:"bytes,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	Status      string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DateInsert  string `protobuf:"bytes,6,opt,name=date_insert,json=dateInsert,proto3" json:"date_insert,omitempty"`
+	DateUpdate  string `protobuf:"bytes,7,opt,name=date_update,json=dateUpdate,proto3" json:"date_update,omitempty"`
+}
+
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMember) ProtoMessage() {}
+
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HouseholdMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetDateInsert() string {
+	if x != nil {
+		return x.DateInsert
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetDateUpdate() string {
+	if x != nil {
+		return x.DateUpdate
+	}
+	return ""
+}
+
+// The response message containing the grant of a household.
+type HouseholdGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HouseholdId string `protobuf:"bytes,3,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	SubjectId   string `protobuf:"bytes,4,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Subject     string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Right       string `protobuf:"bytes,6,opt,name=right,proto3" json:"right,omitempty"`
+	DateInsert  string `protobuf:"bytes,7,opt,name=date_insert,json=dateInsert,proto3" json:"date_insert,omitempty"`
+	DateUpdate  string `protobuf:"bytes,8,opt,name=date_update,json=dateUpdate,proto3" json:"date_update,omitempty"`
+}
+
+func (x *HouseholdGrant) Reset() {
+	*x = HouseholdGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdGrant) ProtoMessage() {}
+
+func (x *HouseholdGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdGrant.ProtoReflect.Descriptor instead.
+func (*HouseholdGrant) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HouseholdGrant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetDateInsert() string {
+	if x != nil {
+		return x.DateInsert
+	}
+	return ""
+}
+
+func (x *HouseholdGrant) GetDateUpdate() string {
+	if x != nil {
+		return x.DateUpdate
+	}
+	return ""
+}
+
+// The response message containing the household with its members and grants.
+type HouseholdMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string             `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status     string             `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DateInsert string             `protobuf:"bytes,5,opt,name=date_insert,json=dateInsert,proto3" json:"date_insert,omitempty"`
+	DateUpdate string             `protobuf:"bytes,6,opt,name=date_update,json=dateUpdate,proto3" json:"date_update,omitempty"`
+	Members    []*HouseholdMember `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Grants     []*HouseholdGrant  `protobuf:"bytes,8,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *HouseholdMessage) Reset() {
+	*x = HouseholdMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMessage) ProtoMessage() {}
+
+func (x *HouseholdMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMessage.ProtoReflect.Descriptor instead.
+func (*HouseholdMessage) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HouseholdMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetDateInsert() string {
+	if x != nil {
+		return x.DateInsert
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetDateUpdate() string {
+	if x != nil {
+		return x.DateUpdate
+	}
+	return ""
+}
+
+func (x *HouseholdMessage) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *HouseholdMessage) GetGrants() []*HouseholdGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// The response message containing the households.
+type Households struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Households []*HouseholdMessage `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+}
+
+func (x *Households) Reset() {
+	*x = Households{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Households) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Households) ProtoMessage() {}
+
+func (x *Households) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Households.ProtoReflect.Descriptor instead.
+func (*Households) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Households) GetHouseholds() []*HouseholdMessage {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+// The response message containing the invitation with its household.
+type HouseholdInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member        *HouseholdMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	HouseholdName string           `protobuf:"bytes,2,opt,name=household_name,json=householdName,proto3" json:"household_name,omitempty"`
+}
+
+func (x *HouseholdInvitation) Reset() {
+	*x = HouseholdInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdInvitation) ProtoMessage() {}
+
+func (x *HouseholdInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdInvitation.ProtoReflect.Descriptor instead.
+func (*HouseholdInvitation) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HouseholdInvitation) GetMember() *HouseholdMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *HouseholdInvitation) GetHouseholdName() string {
+	if x != nil {
+		return x.HouseholdName
+	}
+	return ""
+}
+
+// The response message containing the invitations.
+type HouseholdInvitations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*HouseholdInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *HouseholdInvitations) Reset() {
+	*x = HouseholdInvitations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdInvitations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdInvitations) ProtoMessage() {}
+
+func (x *HouseholdInvitations) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdInvitations.ProtoReflect.Descriptor instead.
+func (*HouseholdInvitations) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HouseholdInvitations) GetInvitations() []*HouseholdInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// The response message containing the status of deleting or revoking
+type HouseholdStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status  int64  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *HouseholdStatus) Reset() {
+	*x = HouseholdStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_household_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseholdStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdStatus) ProtoMessage() {}
+
+func (x *HouseholdStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_household_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdStatus.ProtoReflect.Descriptor instead.
+func (*HouseholdStatus) Descriptor() ([]byte, []int) {
+	return file_household_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HouseholdStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HouseholdStatus) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+var File_household_proto protoreflect.FileDescriptor
+
+var file_household_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x17, 0x0a, 0x15,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x0b, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x44, 0x54, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x57, 0x0a, 0x16, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x11,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x01,
+	0x0a, 0x11, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x44, 0x54, 0x4f, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45, 0x0a, 0x10, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd6, 0x01, 0x0a,
+	0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x10, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x0a, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x13, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x40, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+	0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x43, 0x0a, 0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x94, 0x07, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x49, 0x0a, 0x0e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12,
+	0x47, 0x0a, 0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x17, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x54, 0x4f, 0x1a, 0x1b, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49,
+	0x64, 0x1a, 0x1b, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45,
+	0x0a, 0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x16, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x1a, 0x1a, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x15, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x12, 0x1c, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75,
+	0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x1a,
+	0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x18, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f,
+	0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x5a, 0x0a, 0x19, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x12, 0x20, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75,
+	0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x5a, 0x0a, 0x1a, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x2e,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a,
+	0x1a, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x54,
+	0x4f, 0x1a, 0x19, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x14,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+	0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x1a, 0x1a, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3e, 0x5a,
+	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x67,
+	0x65, 0x79, 0x67, 0x61, 0x72, 0x64, 0x6e, 0x65, 0x72, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x2d, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x69, 0x2f, 0x47, 0x52,
+	0x50, 0x53, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x41, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_household_proto_rawDescOnce sync.Once
+	file_household_proto_rawDescData = file_household_proto_rawDesc
+)
+
+func file_household_proto_rawDescGZIP() []byte {
+	file_household_proto_rawDescOnce.Do(func() {
+		file_household_proto_rawDescData = protoimpl.X.CompressGZIP(file_household_proto_rawDescData)
+	})
+	return file_household_proto_rawDescData
+}
+
+var file_household_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_household_proto_goTypes = []interface{}{
+	(*HouseholdsInfoRequest)(nil),           // 0: Household.HouseholdsInfoRequest
+	(*HouseholdInvitationsInfoRequest)(nil), // 1: Household.HouseholdInvitationsInfoRequest
+	(*HouseholdId)(nil),                     // 2: Household.HouseholdId
+	(*HouseholdDTO)(nil),                    // 3: Household.HouseholdDTO
+	(*HouseholdInviteRequest)(nil),          // 4: Household.HouseholdInviteRequest
+	(*HouseholdMemberId)(nil),               // 5: Household.HouseholdMemberId
+	(*HouseholdInvitationId)(nil),           // 6: Household.HouseholdInvitationId
+	(*HouseholdGrantDTO)(nil),               // 7: Household.HouseholdGrantDTO
+	(*HouseholdGrantId)(nil),                // 8: Household.HouseholdGrantId
+	(*HouseholdMember)(nil),                 // 9: Household.HouseholdMember
+	(*HouseholdGrant)(nil),                  // 10: Household.HouseholdGrant
+	(*HouseholdMessage)(nil),                // 11: Household.HouseholdMessage
+	(*Households)(nil),                      // 12: Household.Households
+	(*HouseholdInvitation)(nil),             // 13: Household.HouseholdInvitation
+	(*HouseholdInvitations)(nil),            // 14: Household.HouseholdInvitations
+	(*HouseholdStatus)(nil),                 // 15: Household.HouseholdStatus
+}
+var file_household_proto_depIdxs = []int32{
+	9,  // 0: Household.HouseholdMessage.members:type_name -> Household.HouseholdMember
+	10, // 1: Household.HouseholdMessage.grants:type_name -> Household.HouseholdGrant
+	11, // 2: Household.Households.households:type_name -> Household.HouseholdMessage
+	9,  // 3: Household.HouseholdInvitation.member:type_name -> Household.HouseholdMember
+	13, // 4: Household.HouseholdInvitations.invitations:type_name -> Household.HouseholdInvitation
+	0,  // 5: Household.Household.HouseholdsInfo:input_type -> Household.HouseholdsInfoRequest
+	3,  // 6: Household.Household.HouseholdCreate:input_type -> Household.HouseholdDTO
+	2,  // 7: Household.Household.HouseholdInfo:input_type -> Household.HouseholdId
+	2,  // 8: Household.Household.HouseholdDelete:input_type -> Household.HouseholdId
+	4,  // 9: Household.Household.HouseholdInvite:input_type -> Household.HouseholdInviteRequest
+	5,  // 10: Household.Household.HouseholdMemberRevoke:input_type -> Household.HouseholdMemberId
+	1,  // 11: Household.Household.HouseholdInvitationsInfo:input_type -> Household.HouseholdInvitationsInfoRequest
+	6,  // 12: Household.Household.HouseholdInvitationAccept:input_type -> Household.HouseholdInvitationId
+	6,  // 13: Household.Household.HouseholdInvitationDecline:input_type -> Household.HouseholdInvitationId
+	7,  // 14: Household.Household.HouseholdGrantCreate:input_type -> Household.HouseholdGrantDTO
+	8,  // 15: Household.Household.HouseholdGrantDelete:input_type -> Household.HouseholdGrantId
+	12, // 16: Household.Household.HouseholdsInfo:output_type -> Household.Households
+	11, // 17: Household.Household.HouseholdCreate:output_type -> Household.HouseholdMessage
+	11, // 18: Household.Household.HouseholdInfo:output_type -> Household.HouseholdMessage
+	15, // 19: Household.Household.HouseholdDelete:output_type -> Household.HouseholdStatus
+	9,  // 20: Household.Household.HouseholdInvite:output_type -> Household.HouseholdMember
+	15, // 21: Household.Household.HouseholdMemberRevoke:output_type -> Household.HouseholdStatus
+	14, // 22: Household.Household.HouseholdInvitationsInfo:output_type -> Household.HouseholdInvitations
+	11, // 23: Household.Household.HouseholdInvitationAccept:output_type -> Household.HouseholdMessage
+	15, // 24: Household.Household.HouseholdInvitationDecline:output_type -> Household.HouseholdStatus
+	10, // 25: Household.Household.HouseholdGrantCreate:output_type -> Household.HouseholdGrant
+	15, // 26: Household.Household.HouseholdGrantDelete:output_type -> Household.HouseholdStatus
+	16, // [16:27] is the sub-list for method output_type
+	5,  // [5:16] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_household_proto_init() }
+func file_household_proto_init() {
+	if File_household_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_household_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdsInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdInvitationsInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdMemberId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdInvitationId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdGrantDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdGrantId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Households); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdInvitations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_household_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseholdStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_household_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_household_proto_goTypes,
+		DependencyIndexes: file_household_proto_depIdxs,
+		MessageInfos:      file_household_proto_msgTypes,
+	}.Build()
+	File_household_proto = out.File
+	file_household_proto_rawDesc = nil
+	file_household_proto_goTypes = nil
+	file_household_proto_depIdxs = nil
+}
@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: household.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Household_HouseholdsInfo_FullMethodName             = "/Household.Household/HouseholdsInfo"
+	Household_HouseholdCreate_FullMethodName            = "/Household.Household/HouseholdCreate"
+	Household_HouseholdInfo_FullMethodName              = "/Household.Household/HouseholdInfo"
+	Household_HouseholdDelete_FullMethodName            = "/Household.Household/HouseholdDelete"
+	Household_HouseholdInvite_FullMethodName            = "/Household.Household/HouseholdInvite"
+	Household_HouseholdMemberRevoke_FullMethodName      = "/Household.Household/HouseholdMemberRevoke"
+	Household_HouseholdInvitationsInfo_FullMethodName   = "/Household.Household/HouseholdInvitationsInfo"
+	Household_HouseholdInvitationAccept_FullMethodName  = "/Household.Household/HouseholdInvitationAccept"
+	Household_HouseholdInvitationDecline_FullMethodName = "/Household.Household/HouseholdInvitationDecline"
+	Household_HouseholdGrantCreate_FullMethodName       = "/Household.Household/HouseholdGrantCreate"
+	Household_HouseholdGrantDelete_FullMethodName       = "/Household.Household/HouseholdGrantDelete"
+)
+
+// HouseholdClient is the client API for Household service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HouseholdClient interface {
+	// Shows all of households of the user
+	HouseholdsInfo(ctx context.Context, in *HouseholdsInfoRequest, opts ...grpc.CallOption) (*Households, error)
+	// Creates a household
+	HouseholdCreate(ctx context.Context, in *HouseholdDTO, opts ...grpc.CallOption) (*HouseholdMessage, error)
+	// Shows a household
+	HouseholdInfo(ctx context.Context, in *HouseholdId, opts ...grpc.CallOption) (*HouseholdMessage, error)
+	// Deletes a household
+	HouseholdDelete(ctx context.Context, in *HouseholdId, opts ...grpc.CallOption) (*HouseholdStatus, error)
+	// Invites a user to a household
+	HouseholdInvite(ctx context.Context, in *HouseholdInviteRequest, opts ...grpc.CallOption) (*HouseholdMember, error)
+	// Revokes a member of a household
+	HouseholdMemberRevoke(ctx context.Context, in *HouseholdMemberId, opts ...grpc.CallOption) (*HouseholdStatus, error)
+	// Shows all of pending invitations of the user
+	HouseholdInvitationsInfo(ctx context.Context, in *HouseholdInvitationsInfoRequest, opts ...grpc.CallOption) (*HouseholdInvitations, error)
+	// Accepts an invitation
+	HouseholdInvitationAccept(ctx context.Context, in *HouseholdInvitationId, opts ...grpc.CallOption) (*HouseholdMessage, error)
+	// Declines an invitation
+	HouseholdInvitationDecline(ctx context.Context, in *HouseholdInvitationId, opts ...grpc.CallOption) (*HouseholdStatus, error)
+	// Shares a planner or a recipe with a household
+	HouseholdGrantCreate(ctx context.Context, in *HouseholdGrantDTO, opts ...grpc.CallOption) (*HouseholdGrant, error)
+	// Deletes a grant of a household
+	HouseholdGrantDelete(ctx context.Context, in *HouseholdGrantId, opts ...grpc.CallOption) (*HouseholdStatus, error)
+}
+
+type householdClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHouseholdClient(cc grpc.ClientConnInterface) HouseholdClient {
+	return &householdClient{cc}
+}
+
+func (c *householdClient) HouseholdsInfo(ctx context.Context, in *HouseholdsInfoRequest, opts ...grpc.CallOption) (*Households, error) {
+	out := new(Households)
+	err := c.cc.Invoke(ctx, Household_HouseholdsInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdCreate(ctx context.Context, in *HouseholdDTO, opts ...grpc.CallOption) (*HouseholdMessage, error) {
+	out := new(HouseholdMessage)
+	err := c.cc.Invoke(ctx, Household_HouseholdCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdInfo(ctx context.Context, in *HouseholdId, opts ...grpc.CallOption) (*HouseholdMessage, error) {
+	out := new(HouseholdMessage)
+	err := c.cc.Invoke(ctx, Household_HouseholdInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdDelete(ctx context.Context, in *HouseholdId, opts ...grpc.CallOption) (*HouseholdStatus, error) {
+	out := new(HouseholdStatus)
+	err := c.cc.Invoke(ctx, Household_HouseholdDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdInvite(ctx context.Context, in *HouseholdInviteRequest, opts ...grpc.CallOption) (*HouseholdMember, error) {
+	out := new(HouseholdMember)
+	err := c.cc.Invoke(ctx, Household_HouseholdInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdMemberRevoke(ctx context.Context, in *HouseholdMemberId, opts ...grpc.CallOption) (*HouseholdStatus, error) {
+	out := new(HouseholdStatus)
+	err := c.cc.Invoke(ctx, Household_HouseholdMemberRevoke_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdInvitationsInfo(ctx context.Context, in *HouseholdInvitationsInfoRequest, opts ...grpc.CallOption) (*HouseholdInvitations, error) {
+	out := new(HouseholdInvitations)
+	err := c.cc.Invoke(ctx, Household_HouseholdInvitationsInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdInvitationAccept(ctx context.Context, in *HouseholdInvitationId, opts ...grpc.CallOption) (*HouseholdMessage, error) {
+	out := new(HouseholdMessage)
+	err := c.cc.Invoke(ctx, Household_HouseholdInvitationAccept_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdInvitationDecline(ctx context.Context, in *HouseholdInvitationId, opts ...grpc.CallOption) (*HouseholdStatus, error) {
+	out := new(HouseholdStatus)
+	err := c.cc.Invoke(ctx, Household_HouseholdInvitationDecline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdGrantCreate(ctx context.Context, in *HouseholdGrantDTO, opts ...grpc.CallOption) (*HouseholdGrant, error) {
+	out := new(HouseholdGrant)
+	err := c.cc.Invoke(ctx, Household_HouseholdGrantCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdClient) HouseholdGrantDelete(ctx context.Context, in *HouseholdGrantId, opts ...grpc.CallOption) (*HouseholdStatus, error) {
+	out := new(HouseholdStatus)
+	err := c.cc.Invoke(ctx, Household_HouseholdGrantDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HouseholdServer is the server API for Household service.
+// All implementations must embed UnimplementedHouseholdServer
+// for forward compatibility
+type HouseholdServer interface {
+	// Shows all of households of the user
+	HouseholdsInfo(context.Context, *HouseholdsInfoRequest) (*Households, error)
+	// Creates a household
+	HouseholdCreate(context.Context, *HouseholdDTO) (*HouseholdMessage, error)
+	// Shows a household
+	HouseholdInfo(context.Context, *HouseholdId) (*HouseholdMessage, error)
+	// Deletes a household
+	HouseholdDelete(context.Context, *HouseholdId) (*HouseholdStatus, error)
+	// Invites a user to a household
+	HouseholdInvite(context.Context, *HouseholdInviteRequest) (*HouseholdMember, error)
+	// Revokes a member of a household
+	HouseholdMemberRevoke(context.Context, *HouseholdMemberId) (*HouseholdStatus, error)
+	// Shows all of pending invitations of the user
+	HouseholdInvitationsInfo(context.Context, *HouseholdInvitationsInfoRequest) (*HouseholdInvitations, error)
+	// Accepts an invitation
+	HouseholdInvitationAccept(context.Context, *HouseholdInvitationId) (*HouseholdMessage, error)
+	// Declines an invitation
+	HouseholdInvitationDecline(context.Context, *HouseholdInvitationId) (*HouseholdStatus, error)
+	// Shares a planner or a recipe with a household
+	HouseholdGrantCreate(context.Context, *HouseholdGrantDTO) (*HouseholdGrant, error)
+	// Deletes a grant of a household
+	HouseholdGrantDelete(context.Context, *HouseholdGrantId) (*HouseholdStatus, error)
+	mustEmbedUnimplementedHouseholdServer()
+}
+
+// UnimplementedHouseholdServer must be embedded to have forward compatible implementations.
+type UnimplementedHouseholdServer struct {
+}
+
+func (UnimplementedHouseholdServer) HouseholdsInfo(context.Context, *HouseholdsInfoRequest) (*Households, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdsInfo not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdCreate(context.Context, *HouseholdDTO) (*HouseholdMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdCreate not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdInfo(context.Context, *HouseholdId) (*HouseholdMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdInfo not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdDelete(context.Context, *HouseholdId) (*HouseholdStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdDelete not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdInvite(context.Context, *HouseholdInviteRequest) (*HouseholdMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdInvite not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdMemberRevoke(context.Context, *HouseholdMemberId) (*HouseholdStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdMemberRevoke not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdInvitationsInfo(context.Context, *HouseholdInvitationsInfoRequest) (*HouseholdInvitations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdInvitationsInfo not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdInvitationAccept(context.Context, *HouseholdInvitationId) (*HouseholdMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdInvitationAccept not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdInvitationDecline(context.Context, *HouseholdInvitationId) (*HouseholdStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdInvitationDecline not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdGrantCreate(context.Context, *HouseholdGrantDTO) (*HouseholdGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdGrantCreate not implemented")
+}
+func (UnimplementedHouseholdServer) HouseholdGrantDelete(context.Context, *HouseholdGrantId) (*HouseholdStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseholdGrantDelete not implemented")
+}
+func (UnimplementedHouseholdServer) mustEmbedUnimplementedHouseholdServer() {}
+
+// UnsafeHouseholdServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HouseholdServer will
+// result in compilation errors.
+type UnsafeHouseholdServer interface {
+	mustEmbedUnimplementedHouseholdServer()
+}
+
+func RegisterHouseholdServer(s grpc.ServiceRegistrar, srv HouseholdServer) {
+	s.RegisterService(&Household_ServiceDesc, srv)
+}
+
+func _Household_HouseholdsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdsInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdsInfo(ctx, req.(*HouseholdsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdDTO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdCreate(ctx, req.(*HouseholdDTO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdInfo(ctx, req.(*HouseholdId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdDelete(ctx, req.(*HouseholdId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdInvite(ctx, req.(*HouseholdInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdMemberRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMemberId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdMemberRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdMemberRevoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdMemberRevoke(ctx, req.(*HouseholdMemberId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdInvitationsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdInvitationsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdInvitationsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdInvitationsInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdInvitationsInfo(ctx, req.(*HouseholdInvitationsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdInvitationAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdInvitationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdInvitationAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdInvitationAccept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdInvitationAccept(ctx, req.(*HouseholdInvitationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdInvitationDecline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdInvitationId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdInvitationDecline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdInvitationDecline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdInvitationDecline(ctx, req.(*HouseholdInvitationId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdGrantCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdGrantDTO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdGrantCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdGrantCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdGrantCreate(ctx, req.(*HouseholdGrantDTO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Household_HouseholdGrantDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdGrantId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServer).HouseholdGrantDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Household_HouseholdGrantDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServer).HouseholdGrantDelete(ctx, req.(*HouseholdGrantId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Household_ServiceDesc is the grpc.ServiceDesc for Household service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Household_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Household.Household",
+	HandlerType: (*HouseholdServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HouseholdsInfo",
+			Handler:    _Household_HouseholdsInfo_Handler,
+		},
+		{
+			MethodName: "HouseholdCreate",
+			Handler:    _Household_HouseholdCreate_Handler,
+		},
+		{
+			MethodName: "HouseholdInfo",
+			Handler:    _Household_HouseholdInfo_Handler,
+		},
+		{
+			MethodName: "HouseholdDelete",
+			Handler:    _Household_HouseholdDelete_Handler,
+		},
+		{
+			MethodName: "HouseholdInvite",
+			Handler:    _Household_HouseholdInvite_Handler,
+		},
+		{
+			MethodName: "HouseholdMemberRevoke",
+			Handler:    _Household_HouseholdMemberRevoke_Handler,
+		},
+		{
+			MethodName: "HouseholdInvitationsInfo",
+			Handler:    _Household_HouseholdInvitationsInfo_Handler,
+		},
+		{
+			MethodName: "HouseholdInvitationAccept",
+			Handler:    _Household_HouseholdInvitationAccept_Handler,
+		},
+		{
+			MethodName: "HouseholdInvitationDecline",
+			Handler:    _Household_HouseholdInvitationDecline_Handler,
+		},
+		{
+			MethodName: "HouseholdGrantCreate",
+			Handler:    _Household_HouseholdGrantCreate_Handler,
+		},
+		{
+			MethodName: "HouseholdGrantDelete",
+			Handler:    _Household_HouseholdGrantDelete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "household.proto",
+}
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: pantry.proto
+
+package Auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message to show all of pantry items.
+type PantryItemsInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PantryItemsInfoRequest) Reset() {
+	*x = PantryItemsInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItemsInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItemsInfoRequest) ProtoMessage() {}
+
+func (x *PantryItemsInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItemsInfoRequest.ProtoReflect.Descriptor instead.
+func (*PantryItemsInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{0}
+}
+
+// The request message containing the pantry item id.
+type PantryItemId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PantryItemId) Reset() {
+	*x = PantryItemId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItemId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItemId) ProtoMessage() {}
+
+func (x *PantryItemId) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItemId.ProtoReflect.Descriptor instead.
+func (*PantryItemId) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PantryItemId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The request message containing the pantry item data.
+type PantryItemDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IngredientId string `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	UnitId       string `protobuf:"bytes,3,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	Quantity     int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiryTime   string `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Status       string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PantryItemDTO) Reset() {
+	*x = PantryItemDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItemDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItemDTO) ProtoMessage() {}
+
+func (x *PantryItemDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItemDTO.ProtoReflect.Descriptor instead.
+func (*PantryItemDTO) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PantryItemDTO) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PantryItemDTO) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *PantryItemDTO) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *PantryItemDTO) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PantryItemDTO) GetExpiryTime() string {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return ""
+}
+
+func (x *PantryItemDTO) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// The response message containing the pantry item.
+type PantryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IngredientId   string `protobuf:"bytes,3,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	UnitId         string `protobuf:"bytes,4,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	Quantity       int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiryTime     string `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Status         string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	DateInsert     string `protobuf:"bytes,8,opt,name=date_insert,json=dateInsert,proto3" json:"date_insert,omitempty"`
+	DateUpdate     string `protobuf:"bytes,9,opt,name=date_update,json=dateUpdate,proto3" json:"date_update,omitempty"`
+	IngredientName string `protobuf:"bytes,10,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
+	UnitName       string `protobuf:"bytes,11,opt,name=unit_name,json=unitName,proto3" json:"unit_name,omitempty"`
+}
+
+func (x *PantryItem) Reset() {
+	*x = PantryItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItem) ProtoMessage() {}
+
+func (x *PantryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItem.ProtoReflect.Descriptor instead.
+func (*PantryItem) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PantryItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PantryItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PantryItem) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *PantryItem) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *PantryItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PantryItem) GetExpiryTime() string {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return ""
+}
+
+func (x *PantryItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PantryItem) GetDateInsert() string {
+	if x != nil {
+		return x.DateInsert
+	}
+	return ""
+}
+
+func (x *PantryItem) GetDateUpdate() string {
+	if x != nil {
+		return x.DateUpdate
+	}
+	return ""
+}
+
+func (x *PantryItem) GetIngredientName() string {
+	if x != nil {
+		return x.IngredientName
+	}
+	return ""
+}
+
+func (x *PantryItem) GetUnitName() string {
+	if x != nil {
+		return x.UnitName
+	}
+	return ""
+}
+
+// The response message containing the pantry items.
+type PantryItems struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PantryItems []*PantryItem `protobuf:"bytes,1,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
+}
+
+func (x *PantryItems) Reset() {
+	*x = PantryItems{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItems) ProtoMessage() {}
+
+func (x *PantryItems) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItems.ProtoReflect.Descriptor instead.
+func (*PantryItems) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PantryItems) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
+// The response message containing the status of deleting
+type PantryItemDeleteStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status  int64  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PantryItemDeleteStatus) Reset() {
+	*x = PantryItemDeleteStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pantry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PantryItemDeleteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItemDeleteStatus) ProtoMessage() {}
+
+func (x *PantryItemDeleteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pantry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItemDeleteStatus.ProtoReflect.Descriptor instead.
+func (*PantryItemDeleteStatus) Descriptor() ([]byte, []int) {
+	return file_pantry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PantryItemDeleteStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PantryItemDeleteStatus) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+var File_pantry_proto protoreflect.FileDescriptor
+
+var file_pantry_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x70, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x1e, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xb2, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x44,
+	0x54, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x0a, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x0b, 0x50, 0x61, 0x6e, 0x74,
+	0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4a,
+	0x0a, 0x16, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xd4, 0x02, 0x0a, 0x06, 0x50,
+	0x61, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x46, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3d, 0x0a,
+	0x10, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x15, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x54, 0x4f, 0x1a, 0x12, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3a, 0x0a, 0x0e,
+	0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61,
+	0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x10, 0x50, 0x61, 0x6e, 0x74,
+	0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x50,
+	0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x44, 0x54, 0x4f, 0x1a, 0x12, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e,
+	0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x10, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x50, 0x61,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x1a, 0x1e, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x65, 0x72, 0x67, 0x65, 0x79, 0x67, 0x61, 0x72, 0x64, 0x6e, 0x65, 0x72, 0x2f, 0x6d, 0x65,
+	0x61, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x75,
+	0x69, 0x2f, 0x47, 0x52, 0x50, 0x53, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x41, 0x75, 0x74,
+	0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pantry_proto_rawDescOnce sync.Once
+	file_pantry_proto_rawDescData = file_pantry_proto_rawDesc
+)
+
+func file_pantry_proto_rawDescGZIP() []byte {
+	file_pantry_proto_rawDescOnce.Do(func() {
+		file_pantry_proto_rawDescData = protoimpl.X.CompressGZIP(file_pantry_proto_rawDescData)
+	})
+	return file_pantry_proto_rawDescData
+}
+
+var file_pantry_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pantry_proto_goTypes = []interface{}{
+	(*PantryItemsInfoRequest)(nil), // 0: Pantry.PantryItemsInfoRequest
+	(*PantryItemId)(nil),           // 1: Pantry.PantryItemId
+	(*PantryItemDTO)(nil),          // 2: Pantry.PantryItemDTO
+	(*PantryItem)(nil),             // 3: Pantry.PantryItem
+	(*PantryItems)(nil),            // 4: Pantry.PantryItems
+	(*PantryItemDeleteStatus)(nil), // 5: Pantry.PantryItemDeleteStatus
+}
+var file_pantry_proto_depIdxs = []int32{
+	3, // 0: Pantry.PantryItems.pantry_items:type_name -> Pantry.PantryItem
+	0, // 1: Pantry.Pantry.PantryItemsInfo:input_type -> Pantry.PantryItemsInfoRequest
+	2, // 2: Pantry.Pantry.PantryItemCreate:input_type -> Pantry.PantryItemDTO
+	1, // 3: Pantry.Pantry.PantryItemInfo:input_type -> Pantry.PantryItemId
+	2, // 4: Pantry.Pantry.PantryItemUpdate:input_type -> Pantry.PantryItemDTO
+	1, // 5: Pantry.Pantry.PantryItemDelete:input_type -> Pantry.PantryItemId
+	4, // 6: Pantry.Pantry.PantryItemsInfo:output_type -> Pantry.PantryItems
+	3, // 7: Pantry.Pantry.PantryItemCreate:output_type -> Pantry.PantryItem
+	3, // 8: Pantry.Pantry.PantryItemInfo:output_type -> Pantry.PantryItem
+	3, // 9: Pantry.Pantry.PantryItemUpdate:output_type -> Pantry.PantryItem
+	5, // 10: Pantry.Pantry.PantryItemDelete:output_type -> Pantry.PantryItemDeleteStatus
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pantry_proto_init() }
+func file_pantry_proto_init() {
+	if File_pantry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pantry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItemsInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pantry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItemId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pantry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItemDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pantry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pantry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pantry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PantryItemDeleteStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pantry_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pantry_proto_goTypes,
+		DependencyIndexes: file_pantry_proto_depIdxs,
+		MessageInfos:      file_pantry_proto_msgTypes,
+	}.Build()
+	File_pantry_proto = out.File
+	file_pantry_proto_rawDesc = nil
+	file_pantry_proto_goTypes = nil
+	file_pantry_proto_depIdxs = nil
+}
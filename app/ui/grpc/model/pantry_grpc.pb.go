@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: pantry.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Pantry_PantryItemsInfo_FullMethodName  = "/Pantry.Pantry/PantryItemsInfo"
+	Pantry_PantryItemCreate_FullMethodName = "/Pantry.Pantry/PantryItemCreate"
+	Pantry_PantryItemInfo_FullMethodName   = "/Pantry.Pantry/PantryItemInfo"
+	Pantry_PantryItemUpdate_FullMethodName = "/Pantry.Pantry/PantryItemUpdate"
+	Pantry_PantryItemDelete_FullMethodName = "/Pantry.Pantry/PantryItemDelete"
+)
+
+// PantryClient is the client API for Pantry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PantryClient interface {
+	// Shows all of pantry items of the user
+	PantryItemsInfo(ctx context.Context, in *PantryItemsInfoRequest, opts ...grpc.CallOption) (*PantryItems, error)
+	// Creates a pantry item
+	PantryItemCreate(ctx context.Context, in *PantryItemDTO, opts ...grpc.CallOption) (*PantryItem, error)
+	// Shows a pantry item
+	PantryItemInfo(ctx context.Context, in *PantryItemId, opts ...grpc.CallOption) (*PantryItem, error)
+	// Updates a pantry item
+	PantryItemUpdate(ctx context.Context, in *PantryItemDTO, opts ...grpc.CallOption) (*PantryItem, error)
+	// Deletes a pantry item
+	PantryItemDelete(ctx context.Context, in *PantryItemId, opts ...grpc.CallOption) (*PantryItemDeleteStatus, error)
+}
+
+type pantryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPantryClient(cc grpc.ClientConnInterface) PantryClient {
+	return &pantryClient{cc}
+}
+
+func (c *pantryClient) PantryItemsInfo(ctx context.Context, in *PantryItemsInfoRequest, opts ...grpc.CallOption) (*PantryItems, error) {
+	out := new(PantryItems)
+	err := c.cc.Invoke(ctx, Pantry_PantryItemsInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryClient) PantryItemCreate(ctx context.Context, in *PantryItemDTO, opts ...grpc.CallOption) (*PantryItem, error) {
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, Pantry_PantryItemCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryClient) PantryItemInfo(ctx context.Context, in *PantryItemId, opts ...grpc.CallOption) (*PantryItem, error) {
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, Pantry_PantryItemInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryClient) PantryItemUpdate(ctx context.Context, in *PantryItemDTO, opts ...grpc.CallOption) (*PantryItem, error) {
+	out := new(PantryItem)
+	err := c.cc.Invoke(ctx, Pantry_PantryItemUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pantryClient) PantryItemDelete(ctx context.Context, in *PantryItemId, opts ...grpc.CallOption) (*PantryItemDeleteStatus, error) {
+	out := new(PantryItemDeleteStatus)
+	err := c.cc.Invoke(ctx, Pantry_PantryItemDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PantryServer is the server API for Pantry service.
+// All implementations must embed UnimplementedPantryServer
+// for forward compatibility
+type PantryServer interface {
+	// Shows all of pantry items of the user
+	PantryItemsInfo(context.Context, *PantryItemsInfoRequest) (*PantryItems, error)
+	// Creates a pantry item
+	PantryItemCreate(context.Context, *PantryItemDTO) (*PantryItem, error)
+	// Shows a pantry item
+	PantryItemInfo(context.Context, *PantryItemId) (*PantryItem, error)
+	// Updates a pantry item
+	PantryItemUpdate(context.Context, *PantryItemDTO) (*PantryItem, error)
+	// Deletes a pantry item
+	PantryItemDelete(context.Context, *PantryItemId) (*PantryItemDeleteStatus, error)
+	mustEmbedUnimplementedPantryServer()
+}
+
+// UnimplementedPantryServer must be embedded to have forward compatible implementations.
+type UnimplementedPantryServer struct {
+}
+
+func (UnimplementedPantryServer) PantryItemsInfo(context.Context, *PantryItemsInfoRequest) (*PantryItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PantryItemsInfo not implemented")
+}
+func (UnimplementedPantryServer) PantryItemCreate(context.Context, *PantryItemDTO) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PantryItemCreate not implemented")
+}
+func (UnimplementedPantryServer) PantryItemInfo(context.Context, *PantryItemId) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PantryItemInfo not implemented")
+}
+func (UnimplementedPantryServer) PantryItemUpdate(context.Context, *PantryItemDTO) (*PantryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PantryItemUpdate not implemented")
+}
+func (UnimplementedPantryServer) PantryItemDelete(context.Context, *PantryItemId) (*PantryItemDeleteStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PantryItemDelete not implemented")
+}
+func (UnimplementedPantryServer) mustEmbedUnimplementedPantryServer() {}
+
+// UnsafePantryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PantryServer will
+// result in compilation errors.
+type UnsafePantryServer interface {
+	mustEmbedUnimplementedPantryServer()
+}
+
+func RegisterPantryServer(s grpc.ServiceRegistrar, srv PantryServer) {
+	s.RegisterService(&Pantry_ServiceDesc, srv)
+}
+
+func _Pantry_PantryItemsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PantryItemsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServer).PantryItemsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pantry_PantryItemsInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServer).PantryItemsInfo(ctx, req.(*PantryItemsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pantry_PantryItemCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PantryItemDTO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServer).PantryItemCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pantry_PantryItemCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServer).PantryItemCreate(ctx, req.(*PantryItemDTO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pantry_PantryItemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PantryItemId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServer).PantryItemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pantry_PantryItemInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServer).PantryItemInfo(ctx, req.(*PantryItemId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pantry_PantryItemUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PantryItemDTO)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServer).PantryItemUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pantry_PantryItemUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServer).PantryItemUpdate(ctx, req.(*PantryItemDTO))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pantry_PantryItemDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PantryItemId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PantryServer).PantryItemDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pantry_PantryItemDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PantryServer).PantryItemDelete(ctx, req.(*PantryItemId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pantry_ServiceDesc is the grpc.ServiceDesc for Pantry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pantry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Pantry.Pantry",
+	HandlerType: (*PantryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PantryItemsInfo",
+			Handler:    _Pantry_PantryItemsInfo_Handler,
+		},
+		{
+			MethodName: "PantryItemCreate",
+			Handler:    _Pantry_PantryItemCreate_Handler,
+		},
+		{
+			MethodName: "PantryItemInfo",
+			Handler:    _Pantry_PantryItemInfo_Handler,
+		},
+		{
+			MethodName: "PantryItemUpdate",
+			Handler:    _Pantry_PantryItemUpdate_Handler,
+		},
+		{
+			MethodName: "PantryItemDelete",
+			Handler:    _Pantry_PantryItemDelete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pantry.proto",
+}
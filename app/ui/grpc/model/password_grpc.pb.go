@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: password.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuthPassword_PasswordReset_FullMethodName             = "/AuthPassword.AuthPassword/PasswordReset"
+	AuthPassword_PasswordResetConfirmation_FullMethodName = "/AuthPassword.AuthPassword/PasswordResetConfirmation"
+	AuthPassword_PasswordChange_FullMethodName            = "/AuthPassword.AuthPassword/PasswordChange"
+)
+
+// AuthPasswordClient is the client API for AuthPassword service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthPasswordClient interface {
+	// Sends a code to set a new password
+	PasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error)
+	// Sets a new password by the sent code
+	PasswordResetConfirmation(ctx context.Context, in *PasswordResetConfirmationRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error)
+	// Changes the password of the authenticated user by the current one
+	PasswordChange(ctx context.Context, in *PasswordChangeRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error)
+}
+
+type authPasswordClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthPasswordClient(cc grpc.ClientConnInterface) AuthPasswordClient {
+	return &authPasswordClient{cc}
+}
+
+func (c *authPasswordClient) PasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error) {
+	out := new(AuthPasswordStatus)
+	err := c.cc.Invoke(ctx, AuthPassword_PasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authPasswordClient) PasswordResetConfirmation(ctx context.Context, in *PasswordResetConfirmationRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error) {
+	out := new(AuthPasswordStatus)
+	err := c.cc.Invoke(ctx, AuthPassword_PasswordResetConfirmation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authPasswordClient) PasswordChange(ctx context.Context, in *PasswordChangeRequest, opts ...grpc.CallOption) (*AuthPasswordStatus, error) {
+	out := new(AuthPasswordStatus)
+	err := c.cc.Invoke(ctx, AuthPassword_PasswordChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthPasswordServer is the server API for AuthPassword service.
+// All implementations must embed UnimplementedAuthPasswordServer
+// for forward compatibility
+type AuthPasswordServer interface {
+	// Sends a code to set a new password
+	PasswordReset(context.Context, *PasswordResetRequest) (*AuthPasswordStatus, error)
+	// Sets a new password by the sent code
+	PasswordResetConfirmation(context.Context, *PasswordResetConfirmationRequest) (*AuthPasswordStatus, error)
+	// Changes the password of the authenticated user by the current one
+	PasswordChange(context.Context, *PasswordChangeRequest) (*AuthPasswordStatus, error)
+	mustEmbedUnimplementedAuthPasswordServer()
+}
+
+// UnimplementedAuthPasswordServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthPasswordServer struct {
+}
+
+func (UnimplementedAuthPasswordServer) PasswordReset(context.Context, *PasswordResetRequest) (*AuthPasswordStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordReset not implemented")
+}
+func (UnimplementedAuthPasswordServer) PasswordResetConfirmation(context.Context, *PasswordResetConfirmationRequest) (*AuthPasswordStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordResetConfirmation not implemented")
+}
+func (UnimplementedAuthPasswordServer) PasswordChange(context.Context, *PasswordChangeRequest) (*AuthPasswordStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordChange not implemented")
+}
+func (UnimplementedAuthPasswordServer) mustEmbedUnimplementedAuthPasswordServer() {}
+
+// UnsafeAuthPasswordServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthPasswordServer will
+// result in compilation errors.
+type UnsafeAuthPasswordServer interface {
+	mustEmbedUnimplementedAuthPasswordServer()
+}
+
+func RegisterAuthPasswordServer(s grpc.ServiceRegistrar, srv AuthPasswordServer) {
+	s.RegisterService(&AuthPassword_ServiceDesc, srv)
+}
+
+func _AuthPassword_PasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthPasswordServer).PasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthPassword_PasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthPasswordServer).PasswordReset(ctx, req.(*PasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthPassword_PasswordResetConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthPasswordServer).PasswordResetConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthPassword_PasswordResetConfirmation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthPasswordServer).PasswordResetConfirmation(ctx, req.(*PasswordResetConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthPassword_PasswordChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthPasswordServer).PasswordChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthPassword_PasswordChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthPasswordServer).PasswordChange(ctx, req.(*PasswordChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthPassword_ServiceDesc is the grpc.ServiceDesc for AuthPassword service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthPassword_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "AuthPassword.AuthPassword",
+	HandlerType: (*AuthPasswordServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PasswordReset",
+			Handler:    _AuthPassword_PasswordReset_Handler,
+		},
+		{
+			MethodName: "PasswordResetConfirmation",
+			Handler:    _AuthPassword_PasswordResetConfirmation_Handler,
+		},
+		{
+			MethodName: "PasswordChange",
+			Handler:    _AuthPassword_PasswordChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "password.proto",
+}
@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: planner.proto
+
+package Auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The constraints of a slot, the slot is an interval of the planner.
+type PlannerGenerateSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalId  string   `protobuf:"bytes,1,opt,name=interval_id,json=intervalId,proto3" json:"interval_id,omitempty"`
+	Categories  []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	CaloriesMin int64    `protobuf:"varint,3,opt,name=calories_min,json=caloriesMin,proto3" json:"calories_min,omitempty"`
+	CaloriesMax int64    `protobuf:"varint,4,opt,name=calories_max,json=caloriesMax,proto3" json:"calories_max,omitempty"`
+}
+
+func (x *PlannerGenerateSlot) Reset() {
+	*x = PlannerGenerateSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_planner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlannerGenerateSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannerGenerateSlot) ProtoMessage() {}
+
+func (x *PlannerGenerateSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannerGenerateSlot.ProtoReflect.Descriptor instead.
+func (*PlannerGenerateSlot) Descriptor() ([]byte, []int) {
+	return file_planner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlannerGenerateSlot) GetIntervalId() string {
+	if x != nil {
+		return x.IntervalId
+	}
+	return ""
+}
+
+func (x *PlannerGenerateSlot) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PlannerGenerateSlot) GetCaloriesMin() int64 {
+	if x != nil {
+		return x.CaloriesMin
+	}
+	return 0
+}
+
+func (x *PlannerGenerateSlot) GetCaloriesMax() int64 {
+	if x != nil {
+		return x.CaloriesMax
+	}
+	return 0
+}
+
+// The request message containing the planner id and the constraints.
+type PlannerGenerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seed         int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Slots        []*PlannerGenerateSlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	Tags         []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	MaxRepeats   int64                  `protobuf:"varint,5,opt,name=max_repeats,json=maxRepeats,proto3" json:"max_repeats,omitempty"`
+	CaloriesMin  int64                  `protobuf:"varint,6,opt,name=calories_min,json=caloriesMin,proto3" json:"calories_min,omitempty"`
+	CaloriesMax  int64                  `protobuf:"varint,7,opt,name=calories_max,json=caloriesMax,proto3" json:"calories_max,omitempty"`
+	Budget       int64                  `protobuf:"varint,8,opt,name=budget,proto3" json:"budget,omitempty"`
+	Currency     string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	PreferPantry bool                   `protobuf:"varint,10,opt,name=prefer_pantry,json=preferPantry,proto3" json:"prefer_pantry,omitempty"`
+	Replace      bool                   `protobuf:"varint,11,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *PlannerGenerateRequest) Reset() {
+	*x = PlannerGenerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_planner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlannerGenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannerGenerateRequest) ProtoMessage() {}
+
+func (x *PlannerGenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannerGenerateRequest.ProtoReflect.Descriptor instead.
+func (*PlannerGenerateRequest) Descriptor() ([]byte, []int) {
+	return file_planner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlannerGenerateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlannerGenerateRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *PlannerGenerateRequest) GetSlots() []*PlannerGenerateSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *PlannerGenerateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PlannerGenerateRequest) GetMaxRepeats() int64 {
+	if x != nil {
+		return x.MaxRepeats
+	}
+	return 0
+}
+
+func (x *PlannerGenerateRequest) GetCaloriesMin() int64 {
+	if x != nil {
+		return x.CaloriesMin
+	}
+	return 0
+}
+
+func (x *PlannerGenerateRequest) GetCaloriesMax() int64 {
+	if x != nil {
+		return x.CaloriesMax
+	}
+	return 0
+}
+
+func (x *PlannerGenerateRequest) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *PlannerGenerateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PlannerGenerateRequest) GetPreferPantry() bool {
+	if x != nil {
+		return x.PreferPantry
+	}
+	return false
+}
+
+func (x *PlannerGenerateRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+// The message containing a recipe put into an interval of the planner.
+type PlannerGenerateAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalId   string `protobuf:"bytes,1,opt,name=interval_id,json=intervalId,proto3" json:"interval_id,omitempty"`
+	IntervalName string `protobuf:"bytes,2,opt,name=interval_name,json=intervalName,proto3" json:"interval_name,omitempty"`
+	RecipeId     string `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeName   string `protobuf:"bytes,4,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	Cost         int64  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *PlannerGenerateAssignment) Reset() {
+	*x = PlannerGenerateAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_planner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlannerGenerateAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannerGenerateAssignment) ProtoMessage() {}
+
+func (x *PlannerGenerateAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannerGenerateAssignment.ProtoReflect.Descriptor instead.
+func (*PlannerGenerateAssignment) Descriptor() ([]byte, []int) {
+	return file_planner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlannerGenerateAssignment) GetIntervalId() string {
+	if x != nil {
+		return x.IntervalId
+	}
+	return ""
+}
+
+func (x *PlannerGenerateAssignment) GetIntervalName() string {
+	if x != nil {
+		return x.IntervalName
+	}
+	return ""
+}
+
+func (x *PlannerGenerateAssignment) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *PlannerGenerateAssignment) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *PlannerGenerateAssignment) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// The response message containing the generated recipes of the planner.
+type PlannerGenerateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlannerId   string                       `protobuf:"bytes,1,opt,name=planner_id,json=plannerId,proto3" json:"planner_id,omitempty"`
+	Assignments []*PlannerGenerateAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Seed        int64                        `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Total       int64                        `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Currency    string                       `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Warnings    []string                     `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *PlannerGenerateResponse) Reset() {
+	*x = PlannerGenerateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_planner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlannerGenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannerGenerateResponse) ProtoMessage() {}
+
+func (x *PlannerGenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannerGenerateResponse.ProtoReflect.Descriptor instead.
+func (*PlannerGenerateResponse) Descriptor() ([]byte, []int) {
+	return file_planner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlannerGenerateResponse) GetPlannerId() string {
+	if x != nil {
+		return x.PlannerId
+	}
+	return ""
+}
+
+func (x *PlannerGenerateResponse) GetAssignments() []*PlannerGenerateAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *PlannerGenerateResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *PlannerGenerateResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlannerGenerateResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PlannerGenerateResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+var File_planner_proto protoreflect.FileDescriptor
+
+var file_planner_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6d, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x4d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x78, 0x22, 0xde, 0x02, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4d,
+	0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0xe0,
+	0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x32, 0x5f, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0f,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x65, 0x72, 0x67, 0x65, 0x79, 0x67, 0x61, 0x72, 0x64, 0x6e, 0x65, 0x72, 0x2f, 0x6d,
+	0x65, 0x61, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f,
+	0x75, 0x69, 0x2f, 0x47, 0x52, 0x50, 0x53, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x41, 0x75,
+	0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_planner_proto_rawDescOnce sync.Once
+	file_planner_proto_rawDescData = file_planner_proto_rawDesc
+)
+
+func file_planner_proto_rawDescGZIP() []byte {
+	file_planner_proto_rawDescOnce.Do(func() {
+		file_planner_proto_rawDescData = protoimpl.X.CompressGZIP(file_planner_proto_rawDescData)
+	})
+	return file_planner_proto_rawDescData
+}
+
+var file_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_planner_proto_goTypes = []interface{}{
+	(*PlannerGenerateSlot)(nil),       // 0: Planner.PlannerGenerateSlot
+	(*PlannerGenerateRequest)(nil),    // 1: Planner.PlannerGenerateRequest
+	(*PlannerGenerateAssignment)(nil), // 2: Planner.PlannerGenerateAssignment
+	(*PlannerGenerateResponse)(nil),   // 3: Planner.PlannerGenerateResponse
+}
+var file_planner_proto_depIdxs = []int32{
+	0, // 0: Planner.PlannerGenerateRequest.slots:type_name -> Planner.PlannerGenerateSlot
+	2, // 1: Planner.PlannerGenerateResponse.assignments:type_name -> Planner.PlannerGenerateAssignment
+	1, // 2: Planner.Planner.PlannerGenerate:input_type -> Planner.PlannerGenerateRequest
+	3, // 3: Planner.Planner.PlannerGenerate:output_type -> Planner.PlannerGenerateResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_planner_proto_init() }
+func file_planner_proto_init() {
+	if File_planner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_planner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlannerGenerateSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_planner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlannerGenerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_planner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlannerGenerateAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_planner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlannerGenerateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_planner_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_planner_proto_goTypes,
+		DependencyIndexes: file_planner_proto_depIdxs,
+		MessageInfos:      file_planner_proto_msgTypes,
+	}.Build()
+	File_planner_proto = out.File
+	file_planner_proto_rawDesc = nil
+	file_planner_proto_goTypes = nil
+	file_planner_proto_depIdxs = nil
+}
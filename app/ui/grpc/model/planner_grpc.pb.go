@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: planner.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Planner_PlannerGenerate_FullMethodName = "/Planner.Planner/PlannerGenerate"
+)
+
+// PlannerClient is the client API for Planner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlannerClient interface {
+	// Fills the empty intervals of a planner by the recipes satisfying the constraints
+	PlannerGenerate(ctx context.Context, in *PlannerGenerateRequest, opts ...grpc.CallOption) (*PlannerGenerateResponse, error)
+}
+
+type plannerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlannerClient(cc grpc.ClientConnInterface) PlannerClient {
+	return &plannerClient{cc}
+}
+
+func (c *plannerClient) PlannerGenerate(ctx context.Context, in *PlannerGenerateRequest, opts ...grpc.CallOption) (*PlannerGenerateResponse, error) {
+	out := new(PlannerGenerateResponse)
+	err := c.cc.Invoke(ctx, Planner_PlannerGenerate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlannerServer is the server API for Planner service.
+// All implementations must embed UnimplementedPlannerServer
+// for forward compatibility
+type PlannerServer interface {
+	// Fills the empty intervals of a planner by the recipes satisfying the constraints
+	PlannerGenerate(context.Context, *PlannerGenerateRequest) (*PlannerGenerateResponse, error)
+	mustEmbedUnimplementedPlannerServer()
+}
+
+// UnimplementedPlannerServer must be embedded to have forward compatible implementations.
+type UnimplementedPlannerServer struct {
+}
+
+func (UnimplementedPlannerServer) PlannerGenerate(context.Context, *PlannerGenerateRequest) (*PlannerGenerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlannerGenerate not implemented")
+}
+func (UnimplementedPlannerServer) mustEmbedUnimplementedPlannerServer() {}
+
+// UnsafePlannerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlannerServer will
+// result in compilation errors.
+type UnsafePlannerServer interface {
+	mustEmbedUnimplementedPlannerServer()
+}
+
+func RegisterPlannerServer(s grpc.ServiceRegistrar, srv PlannerServer) {
+	s.RegisterService(&Planner_ServiceDesc, srv)
+}
+
+func _Planner_PlannerGenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlannerGenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServer).PlannerGenerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Planner_PlannerGenerate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServer).PlannerGenerate(ctx, req.(*PlannerGenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Planner_ServiceDesc is the grpc.ServiceDesc for Planner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Planner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Planner.Planner",
+	HandlerType: (*PlannerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlannerGenerate",
+			Handler:    _Planner_PlannerGenerate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "planner.proto",
+}
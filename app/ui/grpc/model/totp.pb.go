@@ -0,0 +1,505 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.12
+// source: totp.proto
+
+package Auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message of the enrolment.
+type TotpEnrolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TotpEnrolRequest) Reset() {
+	*x = TotpEnrolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotpEnrolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotpEnrolRequest) ProtoMessage() {}
+
+func (x *TotpEnrolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotpEnrolRequest.ProtoReflect.Descriptor instead.
+func (*TotpEnrolRequest) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{0}
+}
+
+// The response message containing the secret and the otpauth URI of the secret.
+type TotpEnrolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *TotpEnrolResponse) Reset() {
+	*x = TotpEnrolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotpEnrolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotpEnrolResponse) ProtoMessage() {}
+
+func (x *TotpEnrolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotpEnrolResponse.ProtoReflect.Descriptor instead.
+func (*TotpEnrolResponse) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TotpEnrolResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TotpEnrolResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+// The request message containing the code of the authenticator application.
+type TotpVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *TotpVerifyRequest) Reset() {
+	*x = TotpVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotpVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotpVerifyRequest) ProtoMessage() {}
+
+func (x *TotpVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotpVerifyRequest.ProtoReflect.Descriptor instead.
+func (*TotpVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TotpVerifyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The response message containing the recovery codes.
+type TotpRecoveryCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *TotpRecoveryCodesResponse) Reset() {
+	*x = TotpRecoveryCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotpRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotpRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *TotpRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotpRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*TotpRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TotpRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// The request message containing the password and the code of the authenticator application or a recovery code.
+type TotpDisableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *TotpDisableRequest) Reset() {
+	*x = TotpDisableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotpDisableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotpDisableRequest) ProtoMessage() {}
+
+func (x *TotpDisableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotpDisableRequest.ProtoReflect.Descriptor instead.
+func (*TotpDisableRequest) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TotpDisableRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *TotpDisableRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// The response message containing the status
+type AuthTotpStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status  int64  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AuthTotpStatus) Reset() {
+	*x = AuthTotpStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_totp_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthTotpStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthTotpStatus) ProtoMessage() {}
+
+func (x *AuthTotpStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_totp_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthTotpStatus.ProtoReflect.Descriptor instead.
+func (*AuthTotpStatus) Descriptor() ([]byte, []int) {
+	return file_totp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthTotpStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuthTotpStatus) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+var File_totp_proto protoreflect.FileDescriptor
+
+var file_totp_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x22, 0x12, 0x0a, 0x10, 0x54, 0x6f, 0x74, 0x70, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x11, 0x54, 0x6f,
+	0x74, 0x70, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x11, 0x54, 0x6f, 0x74,
+	0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x42, 0x0a, 0x19, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x54, 0x6f, 0x74, 0x70, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x0e,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x32, 0xe7, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x12, 0x44, 0x0a,
+	0x09, 0x54, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x12, 0x1a, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x74, 0x70, 0x2e, 0x54, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74,
+	0x70, 0x2e, 0x54, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x12, 0x1b, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x2e, 0x54, 0x6f, 0x74,
+	0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x2e, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x70, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x2e, 0x54, 0x6f,
+	0x74, 0x70, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x70, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x67, 0x65, 0x79, 0x67,
+	0x61, 0x72, 0x64, 0x6e, 0x65, 0x72, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x69, 0x2f, 0x47, 0x52, 0x50, 0x53, 0x2f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x41, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_totp_proto_rawDescOnce sync.Once
+	file_totp_proto_rawDescData = file_totp_proto_rawDesc
+)
+
+func file_totp_proto_rawDescGZIP() []byte {
+	file_totp_proto_rawDescOnce.Do(func() {
+		file_totp_proto_rawDescData = protoimpl.X.CompressGZIP(file_totp_proto_rawDescData)
+	})
+	return file_totp_proto_rawDescData
+}
+
+var file_totp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_totp_proto_goTypes = []interface{}{
+	(*TotpEnrolRequest)(nil),          // 0: AuthTotp.TotpEnrolRequest
+	(*TotpEnrolResponse)(nil),         // 1: AuthTotp.TotpEnrolResponse
+	(*TotpVerifyRequest)(nil),         // 2: AuthTotp.TotpVerifyRequest
+	(*TotpRecoveryCodesResponse)(nil), // 3: AuthTotp.TotpRecoveryCodesResponse
+	(*TotpDisableRequest)(nil),        // 4: AuthTotp.TotpDisableRequest
+	(*AuthTotpStatus)(nil),            // 5: AuthTotp.AuthTotpStatus
+}
+var file_totp_proto_depIdxs = []int32{
+	0, // 0: AuthTotp.AuthTotp.TotpEnrol:input_type -> AuthTotp.TotpEnrolRequest
+	2, // 1: AuthTotp.AuthTotp.TotpVerify:input_type -> AuthTotp.TotpVerifyRequest
+	4, // 2: AuthTotp.AuthTotp.TotpDisable:input_type -> AuthTotp.TotpDisableRequest
+	1, // 3: AuthTotp.AuthTotp.TotpEnrol:output_type -> AuthTotp.TotpEnrolResponse
+	3, // 4: AuthTotp.AuthTotp.TotpVerify:output_type -> AuthTotp.TotpRecoveryCodesResponse
+	5, // 5: AuthTotp.AuthTotp.TotpDisable:output_type -> AuthTotp.AuthTotpStatus
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_totp_proto_init() }
+func file_totp_proto_init() {
+	if File_totp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_totp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotpEnrolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_totp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotpEnrolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_totp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotpVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_totp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotpRecoveryCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_totp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotpDisableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_totp_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTotpStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_totp_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_totp_proto_goTypes,
+		DependencyIndexes: file_totp_proto_depIdxs,
+		MessageInfos:      file_totp_proto_msgTypes,
+	}.Build()
+	File_totp_proto = out.File
+	file_totp_proto_rawDesc = nil
+	file_totp_proto_goTypes = nil
+	file_totp_proto_depIdxs = nil
+}
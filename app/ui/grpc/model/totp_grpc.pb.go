@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: totp.proto
+
+package Auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AuthTotp_TotpEnrol_FullMethodName   = "/AuthTotp.AuthTotp/TotpEnrol"
+	AuthTotp_TotpVerify_FullMethodName  = "/AuthTotp.AuthTotp/TotpVerify"
+	AuthTotp_TotpDisable_FullMethodName = "/AuthTotp.AuthTotp/TotpDisable"
+)
+
+// AuthTotpClient is the client API for AuthTotp service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthTotpClient interface {
+	// Generates a secret of the authenticator application
+	TotpEnrol(ctx context.Context, in *TotpEnrolRequest, opts ...grpc.CallOption) (*TotpEnrolResponse, error)
+	// Enables the two-factor authentication by the code of the authenticator application
+	TotpVerify(ctx context.Context, in *TotpVerifyRequest, opts ...grpc.CallOption) (*TotpRecoveryCodesResponse, error)
+	// Disables the two-factor authentication by the password and the code of the authenticator application or a recovery code
+	TotpDisable(ctx context.Context, in *TotpDisableRequest, opts ...grpc.CallOption) (*AuthTotpStatus, error)
+}
+
+type authTotpClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthTotpClient(cc grpc.ClientConnInterface) AuthTotpClient {
+	return &authTotpClient{cc}
+}
+
+func (c *authTotpClient) TotpEnrol(ctx context.Context, in *TotpEnrolRequest, opts ...grpc.CallOption) (*TotpEnrolResponse, error) {
+	out := new(TotpEnrolResponse)
+	err := c.cc.Invoke(ctx, AuthTotp_TotpEnrol_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTotpClient) TotpVerify(ctx context.Context, in *TotpVerifyRequest, opts ...grpc.CallOption) (*TotpRecoveryCodesResponse, error) {
+	out := new(TotpRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthTotp_TotpVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authTotpClient) TotpDisable(ctx context.Context, in *TotpDisableRequest, opts ...grpc.CallOption) (*AuthTotpStatus, error) {
+	out := new(AuthTotpStatus)
+	err := c.cc.Invoke(ctx, AuthTotp_TotpDisable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthTotpServer is the server API for AuthTotp service.
+// All implementations must embed UnimplementedAuthTotpServer
+// for forward compatibility
+type AuthTotpServer interface {
+	// Generates a secret of the authenticator application
+	TotpEnrol(context.Context, *TotpEnrolRequest) (*TotpEnrolResponse, error)
+	// Enables the two-factor authentication by the code of the authenticator application
+	TotpVerify(context.Context, *TotpVerifyRequest) (*TotpRecoveryCodesResponse, error)
+	// Disables the two-factor authentication by the password and the code of the authenticator application or a recovery code
+	TotpDisable(context.Context, *TotpDisableRequest) (*AuthTotpStatus, error)
+	mustEmbedUnimplementedAuthTotpServer()
+}
+
+// UnimplementedAuthTotpServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthTotpServer struct {
+}
+
+func (UnimplementedAuthTotpServer) TotpEnrol(context.Context, *TotpEnrolRequest) (*TotpEnrolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpEnrol not implemented")
+}
+func (UnimplementedAuthTotpServer) TotpVerify(context.Context, *TotpVerifyRequest) (*TotpRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpVerify not implemented")
+}
+func (UnimplementedAuthTotpServer) TotpDisable(context.Context, *TotpDisableRequest) (*AuthTotpStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotpDisable not implemented")
+}
+func (UnimplementedAuthTotpServer) mustEmbedUnimplementedAuthTotpServer() {}
+
+// UnsafeAuthTotpServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthTotpServer will
+// result in compilation errors.
+type UnsafeAuthTotpServer interface {
+	mustEmbedUnimplementedAuthTotpServer()
+}
+
+func RegisterAuthTotpServer(s grpc.ServiceRegistrar, srv AuthTotpServer) {
+	s.RegisterService(&AuthTotp_ServiceDesc, srv)
+}
+
+func _AuthTotp_TotpEnrol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpEnrolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTotpServer).TotpEnrol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthTotp_TotpEnrol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTotpServer).TotpEnrol(ctx, req.(*TotpEnrolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthTotp_TotpVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTotpServer).TotpVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthTotp_TotpVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTotpServer).TotpVerify(ctx, req.(*TotpVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthTotp_TotpDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpDisableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTotpServer).TotpDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthTotp_TotpDisable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTotpServer).TotpDisable(ctx, req.(*TotpDisableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthTotp_ServiceDesc is the grpc.ServiceDesc for AuthTotp service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthTotp_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "AuthTotp.AuthTotp",
+	HandlerType: (*AuthTotpServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TotpEnrol",
+			Handler:    _AuthTotp_TotpEnrol_Handler,
+		},
+		{
+			MethodName: "TotpVerify",
+			Handler:    _AuthTotp_TotpVerify_Handler,
+		},
+		{
+			MethodName: "TotpDisable",
+			Handler:    _AuthTotp_TotpDisable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "totp.proto",
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/sergeygardner/meal-planner-api/application/handler"
+	GrpcHandler "github.com/sergeygardner/meal-planner-api/ui/grpc/handler"
 	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	GrpcService "github.com/sergeygardner/meal-planner-api/ui/grpc/service"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"net"
@@ -14,8 +16,8 @@ type server struct {
 	protoBuf.UnimplementedAuthServer
 }
 
-func (s *server) Credentials(_ context.Context, authCredentialsDTO *protoBuf.AuthCredentialsDTO) (*protoBuf.AuthConfirmation, error) {
-	authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(authCredentialsDTO.UserCredentialsDTO)
+func (s *server) Credentials(ctx context.Context, authCredentialsDTO *protoBuf.AuthCredentialsDTO) (*protoBuf.AuthConfirmation, error) {
+	authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(authCredentialsDTO.UserCredentialsDTO, GrpcService.ExtractIpFromContext(ctx))
 
 	if errorAuthCredentials != nil {
 		return nil, errorAuthCredentials
@@ -29,8 +31,14 @@ func GetServer(port int) (*grpc.Server, net.Listener) {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(GrpcService.PolicyUnaryInterceptor))
 	protoBuf.RegisterAuthServer(grpcServer, &server{})
+	protoBuf.RegisterPantryServer(grpcServer, &GrpcHandler.PantryServer{})
+	protoBuf.RegisterPlannerServer(grpcServer, &GrpcHandler.PlannerServer{})
+	protoBuf.RegisterHouseholdServer(grpcServer, &GrpcHandler.HouseholdServer{})
+	protoBuf.RegisterAdminUserServer(grpcServer, &GrpcHandler.AdminUserServer{})
+	protoBuf.RegisterAuthPasswordServer(grpcServer, &GrpcHandler.AuthPasswordServer{})
+	protoBuf.RegisterAuthTotpServer(grpcServer, &GrpcHandler.AuthTotpServer{})
 
 	return grpcServer, listener
 }
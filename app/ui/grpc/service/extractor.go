@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/domain/model"
+	ApplicationMiddleware "github.com/sergeygardner/meal-planner-api/infrastructure/service/jwt"
+	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"strings"
+)
+
+var (
+	jwtAuth                = jwtauth.New("HS256", ApplicationMiddleware.GetJwtKey(), nil)
+	errorAuthorizationData = errors.New("the authorization data is not found in the metadata")
+)
+
+func ExtractClaimsFromContext(ctx context.Context) (*model.Token, error) {
+	incomingMetadata, okIncomingMetadata := metadata.FromIncomingContext(ctx)
+
+	if !okIncomingMetadata {
+		return nil, errorAuthorizationData
+	}
+
+	authorization := incomingMetadata.Get("authorization")
+
+	if len(authorization) == 0 {
+		return nil, errorAuthorizationData
+	}
+
+	tokenString := strings.TrimSpace(authorization[0])
+
+	if len(tokenString) > 7 && strings.ToUpper(tokenString[0:6]) == "BEARER" {
+		tokenString = strings.TrimSpace(tokenString[7:])
+	}
+
+	jwtToken, errorVerifyToken := jwtauth.VerifyToken(jwtAuth, tokenString)
+
+	if errorVerifyToken != nil {
+		return nil, errorVerifyToken
+	}
+
+	return UiService.ExtractClaimsFromContext(jwtauth.NewContext(ctx, jwtToken, nil))
+}
+
+// ExtractIpFromContext returns the ip address of the client of the call, it is empty when the peer is unknown.
+func ExtractIpFromContext(ctx context.Context) string {
+	clientPeer, okClientPeer := peer.FromContext(ctx)
+
+	if !okClientPeer || clientPeer.Addr == nil {
+		return ""
+	}
+
+	return UiService.ExtractIpFromAddress(clientPeer.Addr.String())
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type policyRule struct {
+	resource kind.UserResource
+	right    kind.UserRight
+}
+
+var (
+	policyRules = map[string]policyRule{
+		protoBuf.Pantry_PantryItemsInfo_FullMethodName:               {kind.UserResourcePantry, kind.UserRightRead},
+		protoBuf.Pantry_PantryItemCreate_FullMethodName:              {kind.UserResourcePantry, kind.UserRightWrite},
+		protoBuf.Pantry_PantryItemInfo_FullMethodName:                {kind.UserResourcePantry, kind.UserRightRead},
+		protoBuf.Pantry_PantryItemUpdate_FullMethodName:              {kind.UserResourcePantry, kind.UserRightWrite},
+		protoBuf.Pantry_PantryItemDelete_FullMethodName:              {kind.UserResourcePantry, kind.UserRightWrite},
+		protoBuf.Planner_PlannerGenerate_FullMethodName:              {kind.UserResourcePlanner, kind.UserRightWrite},
+		protoBuf.Household_HouseholdsInfo_FullMethodName:             {kind.UserResourceHousehold, kind.UserRightRead},
+		protoBuf.Household_HouseholdCreate_FullMethodName:            {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdInfo_FullMethodName:              {kind.UserResourceHousehold, kind.UserRightRead},
+		protoBuf.Household_HouseholdDelete_FullMethodName:            {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdInvite_FullMethodName:            {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdMemberRevoke_FullMethodName:      {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdInvitationsInfo_FullMethodName:   {kind.UserResourceHousehold, kind.UserRightRead},
+		protoBuf.Household_HouseholdInvitationAccept_FullMethodName:  {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdInvitationDecline_FullMethodName: {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdGrantCreate_FullMethodName:       {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.Household_HouseholdGrantDelete_FullMethodName:       {kind.UserResourceHousehold, kind.UserRightWrite},
+		protoBuf.AdminUser_UsersInfo_FullMethodName:                  {kind.UserResourceUser, kind.UserRightRead},
+		protoBuf.AdminUser_UserInfo_FullMethodName:                   {kind.UserResourceUser, kind.UserRightRead},
+		protoBuf.AdminUser_UserDisable_FullMethodName:                {kind.UserResourceUser, kind.UserRightWrite},
+		protoBuf.AdminUser_UserEnable_FullMethodName:                 {kind.UserResourceUser, kind.UserRightWrite},
+		protoBuf.AdminUser_UserLogout_FullMethodName:                 {kind.UserResourceUser, kind.UserRightWrite},
+		protoBuf.AdminUser_UserRoleCreate_FullMethodName:             {kind.UserResourceUser, kind.UserRightWrite},
+		protoBuf.AdminUser_UserRoleDelete_FullMethodName:             {kind.UserResourceUser, kind.UserRightWrite},
+		protoBuf.AuthPassword_PasswordChange_FullMethodName:          {kind.UserResourceAccount, kind.UserRightWrite},
+		protoBuf.AuthTotp_TotpEnrol_FullMethodName:                   {kind.UserResourceAccount, kind.UserRightWrite},
+		protoBuf.AuthTotp_TotpVerify_FullMethodName:                  {kind.UserResourceAccount, kind.UserRightWrite},
+		protoBuf.AuthTotp_TotpDisable_FullMethodName:                 {kind.UserResourceAccount, kind.UserRightWrite},
+	}
+	// policyPublic are the methods which are called before the authentication, so they have no rule.
+	policyPublic = map[string]bool{
+		protoBuf.Auth_Credentials_FullMethodName:                       true,
+		protoBuf.AuthPassword_PasswordReset_FullMethodName:             true,
+		protoBuf.AuthPassword_PasswordResetConfirmation_FullMethodName: true,
+	}
+)
+
+var (
+	errorPolicyRuleNotFound = errors.New("the method has no policy rule")
+)
+
+// PolicyUnaryInterceptor enforces the policy of the called method. The public methods are let through and the other
+// methods without a rule are denied, so a new method is not exposed until it gets a rule.
+func PolicyUnaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+	if policyPublic[info.FullMethod] {
+		return next(ctx, request)
+	}
+
+	rule, okRule := policyRules[info.FullMethod]
+
+	if !okRule {
+		return nil, status.Error(codes.PermissionDenied, errorPolicyRuleNotFound.Error())
+	}
+
+	token, errorExtractClaimsFromContext := ExtractClaimsFromContext(ctx)
+
+	if errorExtractClaimsFromContext != nil {
+		return nil, status.Error(codes.Unauthenticated, errorExtractClaimsFromContext.Error())
+	}
+
+	errorPolicy := handler.PolicyEnforce(&token.UserId, rule.resource, rule.right)
+
+	if errorPolicy != nil {
+		return nil, status.Error(codes.PermissionDenied, errorPolicy.Error())
+	}
+
+	return next(ctx, request)
+}
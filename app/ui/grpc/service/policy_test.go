@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	protoBuf "github.com/sergeygardner/meal-planner-api/ui/grpc/model"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestPolicyRules(t *testing.T) {
+	serviceDescs := []grpc.ServiceDesc{
+		protoBuf.Auth_ServiceDesc,
+		protoBuf.AuthPassword_ServiceDesc,
+		protoBuf.AuthTotp_ServiceDesc,
+		protoBuf.Pantry_ServiceDesc,
+		protoBuf.Planner_ServiceDesc,
+		protoBuf.Household_ServiceDesc,
+		protoBuf.AdminUser_ServiceDesc,
+	}
+
+	for _, serviceDesc := range serviceDescs {
+		for _, method := range serviceDesc.Methods {
+			fullMethod := fmt.Sprintf("/%s/%s", serviceDesc.ServiceName, method.MethodName)
+			_, okRule := policyRules[fullMethod]
+
+			assert.True(t, okRule || policyPublic[fullMethod], "the method %s has no policy rule", fullMethod)
+		}
+	}
+}
+
+func TestPolicyUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		fullMethod string
+		called     bool
+		code       codes.Code
+	}{
+		{
+			name:       "Test case with a public method",
+			fullMethod: protoBuf.Auth_Credentials_FullMethodName,
+			called:     true,
+			code:       codes.OK,
+		},
+		{
+			name:       "Test case with a method without a rule",
+			fullMethod: "/Unknown/Method",
+			called:     false,
+			code:       codes.PermissionDenied,
+		},
+		{
+			name:       "Test case with a method with a rule and without a token",
+			fullMethod: protoBuf.Pantry_PantryItemsInfo_FullMethodName,
+			called:     false,
+			code:       codes.Unauthenticated,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				called := false
+				next := func(_ context.Context, _ interface{}) (interface{}, error) {
+					called = true
+
+					return nil, nil
+				}
+
+				_, errorPolicy := PolicyUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testCase.fullMethod}, next)
+
+				assert.Equal(t, testCase.called, called)
+				assert.Equal(t, testCase.code, status.Code(errorPolicy))
+			},
+		)
+	}
+}
@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+func IngredientMerge(w http.ResponseWriter, r *http.Request) {
+	mergeDTO, errorJsonDecode := DomainService.CreateDTOFromMerge(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = service.Error400HandleService(w, errorJsonDecode)
+	} else {
+		merge, errorMerge := handler.IngredientMerge(&mergeDTO)
+
+		if errorMerge != nil {
+			payload = service.Error400HandleService(w, errorMerge)
+		} else {
+			payload = &response.Merge{Merge: *merge}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func CategoryMerge(w http.ResponseWriter, r *http.Request) {
+	mergeDTO, errorJsonDecode := DomainService.CreateDTOFromMerge(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = service.Error400HandleService(w, errorJsonDecode)
+	} else {
+		merge, errorMerge := handler.CategoryMerge(&mergeDTO)
+
+		if errorMerge != nil {
+			payload = service.Error400HandleService(w, errorMerge)
+		} else {
+			payload = &response.Merge{Merge: *merge}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -0,0 +1,219 @@
+package admin
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusRoleDeleteSuccess     = "the role has been deleted successful"
+	statusRoleDeleteError       = errors.New("the role has not been deleted")
+	statusRoleUserDeleteSuccess = "the role binding has been deleted successful"
+	statusRoleUserDeleteError   = errors.New("the role binding has not been deleted")
+)
+
+func RolesInfo(w http.ResponseWriter, r *http.Request) {
+	roles, errorRoles := handler.RolesInfo()
+
+	if errorRoles != nil {
+		payload = service.Error400HandleService(w, errorRoles)
+	} else {
+		if roles == nil {
+			roles = []*DomainEntity.UserRole{}
+		}
+
+		payload = &response.RolesInfo{Roles: roles}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleCreate(w http.ResponseWriter, r *http.Request) {
+	roleDTO, errorJsonDecode := DomainService.CreateEntityFromUserRoleUpdate(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = service.Error400HandleService(w, errorJsonDecode)
+	} else {
+		role, errorRole := handler.RoleCreate(&roleDTO)
+
+		if errorRole != nil {
+			payload = service.Error400HandleService(w, errorRole)
+		} else {
+			payload = &response.RoleInfo{UserRole: *role}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleInfo(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else {
+		role, errorRole := handler.RoleInfo(&roleId)
+
+		if errorRole != nil {
+			payload = service.Error400HandleService(w, errorRole)
+		} else {
+			payload = &response.RoleInfo{UserRole: *role}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleUpdate(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else {
+		roleDTO, errorJsonDecode := DomainService.CreateEntityFromUserRoleUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = service.Error400HandleService(w, errorJsonDecode)
+		} else {
+			role, errorRole := handler.RoleUpdate(&roleId, &roleDTO)
+
+			if errorRole != nil {
+				payload = service.Error400HandleService(w, errorRole)
+			} else {
+				payload = &response.RoleInfo{UserRole: *role}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleDelete(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else {
+		roleDeleteStatus, errorRoleDeleteStatus := handler.RoleDelete(&roleId)
+
+		if errorRoleDeleteStatus != nil {
+			payload = service.Error400HandleService(w, errorRoleDeleteStatus)
+		} else if roleDeleteStatus {
+			payload = &response.RoleDelete{Message: statusRoleDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = service.Error400HandleService(w, statusRoleDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleUsersInfo(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else {
+		userToRoles, errorUserToRoles := handler.RoleUsersInfo(&roleId)
+
+		if errorUserToRoles != nil {
+			payload = service.Error400HandleService(w, errorUserToRoles)
+		} else {
+			if userToRoles == nil {
+				userToRoles = []*DomainEntity.UserToRole{}
+			}
+
+			payload = &response.RoleUsersInfo{Users: userToRoles}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleUserCreate(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else {
+		userToRoleDTO, errorJsonDecode := DomainService.CreateEntityFromUserToRoleUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = service.Error400HandleService(w, errorJsonDecode)
+		} else {
+			userToRole, errorUserToRole := handler.RoleUserCreate(&roleId, &userToRoleDTO)
+
+			if errorUserToRole != nil {
+				payload = service.Error400HandleService(w, errorUserToRole)
+			} else {
+				payload = &response.RoleUserInfo{UserToRole: *userToRole}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RoleUserDelete(w http.ResponseWriter, r *http.Request) {
+	roleId, errorRoleId := uuid.Parse(chi.URLParam(r, "role_id"))
+	userToRoleId, errorUserToRoleId := uuid.Parse(chi.URLParam(r, "user_to_role_id"))
+
+	if errorRoleId != nil {
+		payload = service.Error400HandleService(w, errorRoleId)
+	} else if errorUserToRoleId != nil {
+		payload = service.Error400HandleService(w, errorUserToRoleId)
+	} else {
+		roleUserDeleteStatus, errorRoleUserDeleteStatus := handler.RoleUserDelete(&userToRoleId, &roleId)
+
+		if errorRoleUserDeleteStatus != nil {
+			payload = service.Error400HandleService(w, errorRoleUserDeleteStatus)
+		} else if roleUserDeleteStatus {
+			payload = &response.RoleUserDelete{Message: statusRoleUserDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = service.Error400HandleService(w, statusRoleUserDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
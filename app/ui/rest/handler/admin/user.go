@@ -5,31 +5,80 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
 	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
 	"github.com/sergeygardner/meal-planner-api/ui/rest/service"
 	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"strconv"
 )
 
 var (
-	statusUserDeleteSuccess = "the user has been deleted successful"
-	statusUserDeleteError   = errors.New("the user has not been deleted")
+	statusUserDeleteSuccess     = "the user has been deleted successful"
+	statusUserDeleteError       = errors.New("the user has not been deleted")
+	statusUserRoleDeleteSuccess = "the role of the user has been deleted successful"
+	statusUserRoleDeleteError   = errors.New("the role of the user has not been deleted")
 )
 
+func UsersInfo(w http.ResponseWriter, r *http.Request) {
+	var (
+		limit       int64
+		offset      int64
+		errorLimit  error
+		errorOffset error
+	)
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, errorLimit = strconv.ParseInt(value, 10, 64)
+	}
+
+	if value := r.URL.Query().Get("offset"); value != "" {
+		offset, errorOffset = strconv.ParseInt(value, 10, 64)
+	}
+
+	if errorLimit != nil {
+		payload = service.Error400HandleService(w, errorLimit)
+	} else if errorOffset != nil {
+		payload = service.Error400HandleService(w, errorOffset)
+	} else {
+		users, errorUsers := handler.UsersInfo(
+			&DomainAggregate.UserQuery{
+				Query:  r.URL.Query().Get("q"),
+				Status: kind.UserStatus(r.URL.Query().Get("status")),
+				Limit:  limit,
+				Offset: offset,
+			},
+		)
+
+		if errorUsers != nil {
+			payload = service.Error400HandleService(w, errorUsers)
+		} else {
+			payload = &response.UsersInfo{Users: *users}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
 func UserInfo(w http.ResponseWriter, r *http.Request) {
-	userId, errorUserId := uuid.Parse(chi.URLParam(r, "id"))
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
 
 	if errorUserId != nil {
 		payload = service.Error400HandleService(w, errorUserId)
 	} else {
-		user, errorUser := handler.UserInfo(&userId)
+		user, errorUser := handler.UserAdminInfo(&userId)
 
 		if errorUser != nil {
 			payload = service.Error400HandleService(w, errorUser)
 		} else {
-			payload = &response.UserInfo{User: *user}
+			payload = &response.UserAdminInfo{User: *user}
 		}
 	}
 
@@ -41,7 +90,7 @@ func UserInfo(w http.ResponseWriter, r *http.Request) {
 }
 
 func UserUpdate(w http.ResponseWriter, r *http.Request) {
-	userId, errorUserId := uuid.Parse(chi.URLParam(r, "id"))
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
 
 	if errorUserId != nil {
 		payload = service.Error400HandleService(w, errorUserId)
@@ -69,7 +118,7 @@ func UserUpdate(w http.ResponseWriter, r *http.Request) {
 }
 
 func UserDelete(w http.ResponseWriter, r *http.Request) {
-	userId, errorUserId := uuid.Parse(chi.URLParam(r, "id"))
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
 
 	if errorUserId != nil {
 		payload = service.Error400HandleService(w, errorUserId)
@@ -91,3 +140,124 @@ func UserDelete(w http.ResponseWriter, r *http.Request) {
 		log.Panic(errorRender)
 	}
 }
+
+func UserDisable(w http.ResponseWriter, r *http.Request) {
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
+
+	if errorUserId != nil {
+		payload = service.Error400HandleService(w, errorUserId)
+	} else {
+		user, errorUser := handler.UserDisable(&userId)
+
+		if errorUser != nil {
+			payload = service.Error400HandleService(w, errorUser)
+		} else {
+			payload = &response.UserInfo{User: *user}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func UserEnable(w http.ResponseWriter, r *http.Request) {
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
+
+	if errorUserId != nil {
+		payload = service.Error400HandleService(w, errorUserId)
+	} else {
+		user, errorUser := handler.UserEnable(&userId)
+
+		if errorUser != nil {
+			payload = service.Error400HandleService(w, errorUser)
+		} else {
+			payload = &response.UserInfo{User: *user}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func UserLogout(w http.ResponseWriter, r *http.Request) {
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
+
+	if errorUserId != nil {
+		payload = service.Error400HandleService(w, errorUserId)
+	} else {
+		user, errorUser := handler.UserLogout(&userId)
+
+		if errorUser != nil {
+			payload = service.Error400HandleService(w, errorUser)
+		} else {
+			payload = &response.UserInfo{User: *user}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func UserRoleCreate(w http.ResponseWriter, r *http.Request) {
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
+
+	if errorUserId != nil {
+		payload = service.Error400HandleService(w, errorUserId)
+	} else {
+		userToRoleDTO, errorJsonDecode := DomainService.CreateEntityFromUserToRoleUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = service.Error400HandleService(w, errorJsonDecode)
+		} else {
+			userToRole, errorUserToRole := handler.UserRoleCreate(&userId, &userToRoleDTO)
+
+			if errorUserToRole != nil {
+				payload = service.Error400HandleService(w, errorUserToRole)
+			} else {
+				payload = &response.RoleUserInfo{UserToRole: *userToRole}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func UserRoleDelete(w http.ResponseWriter, r *http.Request) {
+	userId, errorUserId := uuid.Parse(chi.URLParam(r, "user_id"))
+	userToRoleId, errorUserToRoleId := uuid.Parse(chi.URLParam(r, "user_to_role_id"))
+
+	if errorUserId != nil {
+		payload = service.Error400HandleService(w, errorUserId)
+	} else if errorUserToRoleId != nil {
+		payload = service.Error400HandleService(w, errorUserToRoleId)
+	} else {
+		userRoleDeleteStatus, errorUserRoleDeleteStatus := handler.UserRoleDelete(&userToRoleId, &userId)
+
+		if errorUserRoleDeleteStatus != nil {
+			payload = service.Error400HandleService(w, errorUserRoleDeleteStatus)
+		} else if userRoleDeleteStatus {
+			payload = &response.RoleUserDelete{Message: statusUserRoleDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = service.Error400HandleService(w, statusUserRoleDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/sergeygardner/meal-planner-api/application/handler"
 	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
 	RestResponse "github.com/sergeygardner/meal-planner-api/ui/rest/response"
@@ -9,7 +12,26 @@ import (
 	"net/http"
 )
 
-func AuthCheck(w http.ResponseWriter, _ *http.Request) {
+var (
+	statusAuthLogoutSuccess        = "the session has been logged out successful"
+	statusAuthLogoutError          = errors.New("the session has not been logged out")
+	statusUserSessionRevokeSuccess = "the session has been revoked successful"
+	statusUserSessionRevokeError   = errors.New("the session has not been revoked")
+	statusAuthPasswordSuccess      = "the password has been changed successful"
+	statusAuthPasswordError        = errors.New("the password has not been changed")
+	statusAuthTotpDisableSuccess   = "the two-factor authentication has been disabled successful"
+	statusAuthTotpDisableError     = errors.New("the two-factor authentication has not been disabled")
+)
+
+func AuthCheck(w http.ResponseWriter, r *http.Request) {
+	_, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -19,7 +41,7 @@ func AuthCredentials(w http.ResponseWriter, r *http.Request) {
 	if errorJsonDecode != nil {
 		payload = RestService.Error400HandleService(w, errorJsonDecode)
 	} else {
-		authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(authCredentialsDTO)
+		authConfirmation, _, errorAuthCredentials := handler.AuthCredentials(authCredentialsDTO, RestService.ExtractIpFromRequest(r))
 
 		if errorAuthCredentials != nil {
 			payload = RestService.Error400HandleService(w, errorAuthCredentials)
@@ -41,7 +63,7 @@ func AuthConfirmation(w http.ResponseWriter, r *http.Request) {
 	if errorJsonDecode != nil {
 		payload = RestService.Error400HandleService(w, errorJsonDecode)
 	} else {
-		authToken, errorAuthConfirmation := handler.AuthConfirmation(authConfirmationDTO)
+		authToken, errorAuthConfirmation := handler.AuthConfirmation(authConfirmationDTO, RestService.ExtractIpFromRequest(r))
 
 		if errorAuthConfirmation != nil {
 			payload = RestService.Error400HandleService(w, errorAuthConfirmation)
@@ -58,12 +80,12 @@ func AuthConfirmation(w http.ResponseWriter, r *http.Request) {
 }
 
 func AuthRefresh(w http.ResponseWriter, r *http.Request) {
-	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+	token, errorExtractClaimsFromContext := RestService.ExtractRefreshClaimsFromContext(r.Context())
 
 	if errorExtractClaimsFromContext != nil {
 		payload = RestService.Error400HandleService(w, errorExtractClaimsFromContext)
 	} else {
-		authToken, errorAuthToken := handler.AuthTokenByUserId(&token.UserId)
+		authToken, errorAuthToken := handler.AuthRefresh(token)
 
 		if errorAuthToken != nil {
 			payload = RestService.Error400HandleService(w, errorAuthToken)
@@ -79,6 +101,252 @@ func AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func AuthLogout(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	authLogoutStatus, errorAuthLogoutStatus := handler.AuthLogout(token)
+
+	if errorAuthLogoutStatus != nil {
+		payload = RestService.Error400HandleService(w, errorAuthLogoutStatus)
+	} else if authLogoutStatus {
+		payload = &RestResponse.UserSessionRevoke{Message: statusAuthLogoutSuccess, Status: http.StatusOK}
+	} else {
+		payload = RestService.Error400HandleService(w, statusAuthLogoutError)
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthSessionsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	userSessions, errorUserSessions := handler.UserSessionsInfo(&token.UserId)
+
+	if errorUserSessions != nil {
+		payload = RestService.Error400HandleService(w, errorUserSessions)
+	} else {
+		payload = &RestResponse.UserSessionsInfo{Sessions: userSessions}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthSessionRevoke(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	sessionId, errorSessionId := uuid.Parse(chi.URLParam(r, "session_id"))
+
+	if errorSessionId != nil {
+		payload = RestService.Error400HandleService(w, errorSessionId)
+	} else {
+		userSessionRevokeStatus, errorUserSessionRevokeStatus := handler.UserSessionRevoke(&sessionId, &token.UserId)
+
+		if errorUserSessionRevokeStatus != nil {
+			payload = RestService.Error400HandleService(w, errorUserSessionRevokeStatus)
+		} else if userSessionRevokeStatus {
+			payload = &RestResponse.UserSessionRevoke{Message: statusUserSessionRevokeSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusUserSessionRevokeError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthPasswordReset(w http.ResponseWriter, r *http.Request) {
+	authPasswordResetDTO, errorJsonDecode := DomainService.CreateDTOFromAuthPasswordReset(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		authConfirmation, _, errorAuthPasswordReset := handler.AuthPasswordReset(authPasswordResetDTO)
+
+		if errorAuthPasswordReset != nil {
+			payload = RestService.Error400HandleService(w, errorAuthPasswordReset)
+		} else {
+			payload = &RestResponse.AuthConfirmation{AuthConfirmation: *authConfirmation}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthPasswordResetConfirmation(w http.ResponseWriter, r *http.Request) {
+	authPasswordResetConfirmationDTO, errorJsonDecode := DomainService.CreateDTOFromAuthPasswordResetConfirmation(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		authPasswordStatus, errorAuthPasswordStatus := handler.AuthPasswordResetConfirmation(authPasswordResetConfirmationDTO, RestService.ExtractIpFromRequest(r))
+
+		if errorAuthPasswordStatus != nil {
+			payload = RestService.Error400HandleService(w, errorAuthPasswordStatus)
+		} else if authPasswordStatus {
+			payload = &RestResponse.AuthPassword{Message: statusAuthPasswordSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusAuthPasswordError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthPasswordChange(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	authPasswordChangeDTO, errorJsonDecode := DomainService.CreateDTOFromAuthPasswordChange(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		authPasswordStatus, errorAuthPasswordStatus := handler.AuthPasswordChange(&token.UserId, authPasswordChangeDTO)
+
+		if errorAuthPasswordStatus != nil {
+			payload = RestService.Error400HandleService(w, errorAuthPasswordStatus)
+		} else if authPasswordStatus {
+			payload = &RestResponse.AuthPassword{Message: statusAuthPasswordSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusAuthPasswordError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthTotpEnrol(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	authTotpEnrol, errorAuthTotpEnrol := handler.AuthTotpEnrol(&token.UserId)
+
+	if errorAuthTotpEnrol != nil {
+		payload = RestService.Error400HandleService(w, errorAuthTotpEnrol)
+	} else {
+		payload = &RestResponse.AuthTotpEnrol{AuthTotpEnrol: *authTotpEnrol}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthTotpVerify(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	authTotpDTO, errorJsonDecode := DomainService.CreateDTOFromAuthTotp(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		authTotpRecoveryCodes, errorAuthTotpVerify := handler.AuthTotpVerify(&token.UserId, authTotpDTO)
+
+		if errorAuthTotpVerify != nil {
+			payload = RestService.Error400HandleService(w, errorAuthTotpVerify)
+		} else {
+			payload = &RestResponse.AuthTotpRecoveryCodes{AuthTotpRecoveryCodes: *authTotpRecoveryCodes}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func AuthTotpDisable(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	authTotpDisableDTO, errorJsonDecode := DomainService.CreateDTOFromAuthTotpDisable(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		authTotpDisableStatus, errorAuthTotpDisableStatus := handler.AuthTotpDisable(&token.UserId, authTotpDisableDTO)
+
+		if errorAuthTotpDisableStatus != nil {
+			payload = RestService.Error400HandleService(w, errorAuthTotpDisableStatus)
+		} else if authTotpDisableStatus {
+			payload = &RestResponse.AuthTotpDisable{Message: statusAuthTotpDisableSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusAuthTotpDisableError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
 func AuthRegister(w http.ResponseWriter, r *http.Request) {
 	userRegisterDTO, errorJsonDecode := DomainService.CreateDTOFromUserRegister(r.Body)
 
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var statusCatalogueFileError = errors.New("the catalogue file has not been uploaded")
+
+func CatalogueExport(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	catalogueEntity := kind.CatalogueEntity(chi.URLParam(r, "catalogue_entity"))
+	format := catalogueFormat(r.URL.Query().Get("format"), "")
+
+	w.Header().Set("Content-Type", format.ContentType()+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s", catalogueEntity, format)))
+
+	errorCatalogueExport := handler.CatalogueExport(&token.UserId, catalogueEntity, format, w)
+
+	if errorCatalogueExport == nil {
+		return
+	}
+
+	w.Header().Del("Content-Type")
+	w.Header().Del("Content-Disposition")
+
+	payload = RestService.Error400HandleService(w, errorCatalogueExport)
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func CatalogueImport(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	file, fileName, errorFile := catalogueFile(r)
+
+	if errorFile != nil {
+		payload = RestService.Error400HandleService(w, errorFile)
+	} else {
+		dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+		catalogueImport, errorCatalogueImport := handler.CatalogueImport(
+			&token.UserId,
+			kind.CatalogueEntity(chi.URLParam(r, "catalogue_entity")),
+			catalogueFormat(r.URL.Query().Get("format"), fileName),
+			file,
+			dryRun,
+		)
+
+		if errorCatalogueImport != nil {
+			payload = RestService.Error400HandleService(w, errorCatalogueImport)
+		} else {
+			payload = &response.CatalogueImport{CatalogueImport: *catalogueImport}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+// catalogueFormat returns the format of the query, the format is taken by the extension of the file when the query
+// has no format.
+func catalogueFormat(format string, fileName string) kind.CatalogueFormat {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(fileName)) {
+		case ".ndjson", ".jsonl":
+			return kind.CatalogueFormatNDJSON
+		default:
+			return kind.CatalogueFormatCSV
+		}
+	}
+
+	return kind.CatalogueFormat(strings.ToLower(format))
+}
+
+// catalogueFile returns the part of the multipart form with the file, the part is read as it comes, so the file
+// is not kept in memory.
+func catalogueFile(r *http.Request) (io.Reader, string, error) {
+	multipartReader, errorMultipartReader := r.MultipartReader()
+
+	if errorMultipartReader != nil {
+		return nil, "", errors.Wrap(statusCatalogueFileError, errorMultipartReader.Error())
+	}
+
+	for {
+		part, errorPart := multipartReader.NextPart()
+
+		if errorPart == io.EOF {
+			return nil, "", statusCatalogueFileError
+		} else if errorPart != nil {
+			return nil, "", errors.Wrap(statusCatalogueFileError, errorPart.Error())
+		}
+
+		if part.FormName() == "file" {
+			return part, part.FileName(), nil
+		}
+	}
+}
@@ -64,7 +64,13 @@ func CategoryCreate(w http.ResponseWriter, r *http.Request) {
 		if errorCategory != nil {
 			payload = RestService.Error400HandleService(w, errorCategory)
 		} else {
-			payload = &response.CategoryInfo{Category: *category}
+			warnings, errorWarnings := handler.CategorySimilar(&token.UserId, category.Entity.Name, &category.Entity.Id)
+
+			if errorWarnings != nil {
+				log.Error(errorWarnings)
+			}
+
+			payload = &response.CategoryInfo{Category: *category, Warnings: warnings}
 		}
 	}
 
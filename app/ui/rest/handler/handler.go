@@ -1,11 +1,26 @@
 package handler
 
 import (
+	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"net/http"
 )
 
 func getParentId(routerContext *chi.Context, exclude string) (*uuid.UUID, error) {
 	return UiService.GetParentId(routerContext.URLParams.Keys, routerContext.URLParams.Values, exclude)
 }
+
+// apiLink returns the link of the API version which the request has come to.
+func apiLink(r *http.Request) string {
+	scheme := "http"
+
+	if r.TLS != nil {
+		scheme = "https"
+	} else if forwardedProto := r.Header.Get("X-Forwarded-Proto"); forwardedProto != "" {
+		scheme = forwardedProto
+	}
+
+	return fmt.Sprintf("%s://%s/api/%s", scheme, r.Host, chi.URLParam(r, "version"))
+}
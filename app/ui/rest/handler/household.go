@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusHouseholdDeleteSuccess       = "the household has been deleted successful"
+	statusHouseholdDeleteError         = errors.New("the household has not been deleted")
+	statusHouseholdMemberRevokeSuccess = "the household member has been revoked successful"
+	statusHouseholdMemberRevokeError   = errors.New("the household member has not been revoked")
+	statusHouseholdGrantDeleteSuccess  = "the household grant has been deleted successful"
+	statusHouseholdGrantDeleteError    = errors.New("the household grant has not been deleted")
+)
+
+func HouseholdsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	households, errorHouseholds := handler.HouseholdsInfo(&token.UserId)
+
+	if errorHouseholds != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholds)
+	} else {
+		payload = &response.HouseholdsInfo{Households: households}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromHouseholdUpdate(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		household, errorHousehold := handler.HouseholdCreate(&token.UserId, &householdUpdateDTO)
+
+		if errorHousehold != nil {
+			payload = RestService.Error400HandleService(w, errorHousehold)
+		} else {
+			payload = &response.HouseholdInfo{Household: *household}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		household, errorHousehold := handler.HouseholdInfo(&householdId, &token.UserId)
+
+		if errorHousehold != nil {
+			payload = RestService.Error400HandleService(w, errorHousehold)
+		} else {
+			payload = &response.HouseholdInfo{Household: *household}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromHouseholdUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			household, errorHousehold := handler.HouseholdUpdate(&householdId, &token.UserId, &householdUpdateDTO)
+
+			if errorHousehold != nil {
+				payload = RestService.Error400HandleService(w, errorHousehold)
+			} else {
+				payload = &response.HouseholdInfo{Household: *household}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdDeleteStatus, errorHouseholdDeleteStatus := handler.HouseholdDelete(&householdId, &token.UserId)
+
+		if errorHouseholdDeleteStatus != nil {
+			payload = RestService.Error400HandleService(w, errorHouseholdDeleteStatus)
+		} else if householdDeleteStatus {
+			payload = &response.HouseholdDelete{Message: statusHouseholdDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusHouseholdDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdInvite(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdInviteDTO, errorJsonDecode := DomainService.CreateAggregateFromHouseholdInvite(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			householdMember, errorHouseholdMember := handler.HouseholdInvite(&householdId, &token.UserId, householdInviteDTO.Username)
+
+			if errorHouseholdMember != nil {
+				payload = RestService.Error400HandleService(w, errorHouseholdMember)
+			} else {
+				payload = &response.HouseholdMemberInfo{HouseholdMember: *householdMember}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdMemberRevoke(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdMemberId, errorHouseholdMemberId := uuid.Parse(chi.URLParam(r, "household_member_id"))
+
+		if errorHouseholdMemberId != nil {
+			payload = RestService.Error400HandleService(w, errorHouseholdMemberId)
+		} else {
+			householdMemberRevokeStatus, errorHouseholdMemberRevokeStatus := handler.HouseholdMemberRevoke(&householdMemberId, &token.UserId, &householdId)
+
+			if errorHouseholdMemberRevokeStatus != nil {
+				payload = RestService.Error400HandleService(w, errorHouseholdMemberRevokeStatus)
+			} else if householdMemberRevokeStatus {
+				payload = &response.HouseholdMemberRevoke{Message: statusHouseholdMemberRevokeSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusHouseholdMemberRevokeError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdInvitationsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdInvitations, errorHouseholdInvitations := handler.HouseholdInvitationsInfo(&token.UserId)
+
+	if errorHouseholdInvitations != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdInvitations)
+	} else {
+		payload = &response.HouseholdInvitationsInfo{Invitations: householdInvitations}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdInvitationAccept(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdMemberId, errorHouseholdMemberId := uuid.Parse(chi.URLParam(r, "household_member_id"))
+
+	if errorHouseholdMemberId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdMemberId)
+	} else {
+		household, errorHousehold := handler.HouseholdInvitationAccept(&householdMemberId, &token.UserId)
+
+		if errorHousehold != nil {
+			payload = RestService.Error400HandleService(w, errorHousehold)
+		} else {
+			payload = &response.HouseholdInfo{Household: *household}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdInvitationDecline(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdMemberId, errorHouseholdMemberId := uuid.Parse(chi.URLParam(r, "household_member_id"))
+
+	if errorHouseholdMemberId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdMemberId)
+	} else {
+		householdMemberRevokeStatus, errorHouseholdMemberRevokeStatus := handler.HouseholdInvitationDecline(&householdMemberId, &token.UserId)
+
+		if errorHouseholdMemberRevokeStatus != nil {
+			payload = RestService.Error400HandleService(w, errorHouseholdMemberRevokeStatus)
+		} else if householdMemberRevokeStatus {
+			payload = &response.HouseholdMemberRevoke{Message: statusHouseholdMemberRevokeSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusHouseholdMemberRevokeError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdGrantCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdGrantUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromHouseholdGrantUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			householdGrant, errorHouseholdGrant := handler.HouseholdGrantCreate(&token.UserId, &householdId, &householdGrantUpdateDTO)
+
+			if errorHouseholdGrant != nil {
+				payload = RestService.Error400HandleService(w, errorHouseholdGrant)
+			} else {
+				payload = &response.HouseholdGrantInfo{HouseholdGrant: *householdGrant}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func HouseholdGrantDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	householdId, errorHouseholdId := uuid.Parse(chi.URLParam(r, "household_id"))
+
+	if errorHouseholdId != nil {
+		payload = RestService.Error400HandleService(w, errorHouseholdId)
+	} else {
+		householdGrantId, errorHouseholdGrantId := uuid.Parse(chi.URLParam(r, "household_grant_id"))
+
+		if errorHouseholdGrantId != nil {
+			payload = RestService.Error400HandleService(w, errorHouseholdGrantId)
+		} else {
+			householdGrantDeleteStatus, errorHouseholdGrantDeleteStatus := handler.HouseholdGrantDelete(&householdGrantId, &token.UserId, &householdId)
+
+			if errorHouseholdGrantDeleteStatus != nil {
+				payload = RestService.Error400HandleService(w, errorHouseholdGrantDeleteStatus)
+			} else if householdGrantDeleteStatus {
+				payload = &response.HouseholdGrantDelete{Message: statusHouseholdGrantDeleteSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusHouseholdGrantDeleteError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
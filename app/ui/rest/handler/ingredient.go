@@ -64,7 +64,13 @@ func IngredientCreate(w http.ResponseWriter, r *http.Request) {
 		if errorIngredient != nil {
 			payload = RestService.Error400HandleService(w, errorIngredient)
 		} else {
-			payload = &response.IngredientInfo{Ingredient: *ingredient}
+			warnings, errorWarnings := handler.IngredientSimilar(&token.UserId, ingredient.Name, &ingredient.Id)
+
+			if errorWarnings != nil {
+				log.Error(errorWarnings)
+			}
+
+			payload = &response.IngredientInfo{Ingredient: *ingredient, Warnings: warnings}
 		}
 	}
 
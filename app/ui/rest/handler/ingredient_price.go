@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusIngredientPriceDeleteSuccess = "the ingredient price has been deleted successful"
+	statusIngredientPriceDeleteError   = errors.New("the ingredient price has not been deleted")
+)
+
+func IngredientPricesInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientPrices, errorIngredientPrices := handler.IngredientPricesInfo(&token.UserId, &ingredientId, nil)
+
+		if errorIngredientPrices != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientPrices)
+		} else {
+			if ingredientPrices == nil {
+				ingredientPrices = []*DomainAggregate.IngredientPrice{}
+			}
+			payload = &response.IngredientPricesInfo{IngredientPrices: ingredientPrices}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientPriceCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientPriceUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromIngredientPriceUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			ingredientPrice, errorIngredientPrice := handler.IngredientPriceCreate(&token.UserId, &ingredientId, &ingredientPriceUpdateDTO)
+
+			if errorIngredientPrice != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientPrice)
+			} else {
+				payload = &response.IngredientPriceInfo{IngredientPrice: *ingredientPrice}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientPriceInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientPriceId, errorIngredientPriceId := uuid.Parse(chi.URLParam(r, "ingredient_price_id"))
+
+		if errorIngredientPriceId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientPriceId)
+		} else {
+			ingredientPrice, errorIngredientPrice := handler.IngredientPriceInfo(&ingredientPriceId, &token.UserId, &ingredientId, nil)
+
+			if errorIngredientPrice != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientPrice)
+			} else {
+				payload = &response.IngredientPriceInfo{IngredientPrice: *ingredientPrice}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientPriceUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientPriceId, errorIngredientPriceId := uuid.Parse(chi.URLParam(r, "ingredient_price_id"))
+
+		if errorIngredientPriceId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientPriceId)
+		} else {
+			ingredientPriceUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromIngredientPriceUpdate(r.Body)
+
+			if errorJsonDecode != nil {
+				payload = RestService.Error400HandleService(w, errorJsonDecode)
+			} else {
+				ingredientPrice, errorIngredientPrice := handler.IngredientPriceUpdate(&ingredientPriceId, &token.UserId, &ingredientId, &ingredientPriceUpdateDTO)
+
+				if errorIngredientPrice != nil {
+					payload = RestService.Error400HandleService(w, errorIngredientPrice)
+				} else {
+					payload = &response.IngredientPriceInfo{IngredientPrice: *ingredientPrice}
+				}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientPriceDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientPriceId, errorIngredientPriceId := uuid.Parse(chi.URLParam(r, "ingredient_price_id"))
+
+		if errorIngredientPriceId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientPriceId)
+		} else {
+			ingredientPriceDeleteStatus, errorIngredientPriceDeleteStatus := handler.IngredientPriceDelete(&ingredientPriceId, &token.UserId, &ingredientId)
+
+			if errorIngredientPriceDeleteStatus != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientPriceDeleteStatus)
+			} else if ingredientPriceDeleteStatus {
+				payload = &response.IngredientPriceDelete{Message: statusIngredientPriceDeleteSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusIngredientPriceDeleteError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	statusIngredientSubstituteDeleteSuccess = "the ingredient substitute has been deleted successful"
+	statusIngredientSubstituteDeleteError   = errors.New("the ingredient substitute has not been deleted")
+)
+
+func IngredientSubstitutesInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientSubstitutes, errorIngredientSubstitutes := handler.IngredientSubstitutesInfo(&token.UserId, &ingredientId, nil)
+
+		if errorIngredientSubstitutes != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientSubstitutes)
+		} else {
+			if ingredientSubstitutes == nil {
+				ingredientSubstitutes = []*DomainAggregate.IngredientSubstitute{}
+			}
+			payload = &response.IngredientSubstitutesInfo{IngredientSubstitutes: ingredientSubstitutes}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientSubstituteCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientSubstituteUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromIngredientSubstituteUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			ingredientSubstitute, errorIngredientSubstitute := handler.IngredientSubstituteCreate(&token.UserId, &ingredientId, &ingredientSubstituteUpdateDTO)
+
+			if errorIngredientSubstitute != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientSubstitute)
+			} else {
+				payload = &response.IngredientSubstituteInfo{IngredientSubstitute: *ingredientSubstitute}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientSubstituteInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientSubstituteId, errorIngredientSubstituteId := uuid.Parse(chi.URLParam(r, "ingredient_substitute_id"))
+
+		if errorIngredientSubstituteId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientSubstituteId)
+		} else {
+			ingredientSubstitute, errorIngredientSubstitute := handler.IngredientSubstituteInfo(&ingredientSubstituteId, &token.UserId, &ingredientId, nil)
+
+			if errorIngredientSubstitute != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientSubstitute)
+			} else {
+				payload = &response.IngredientSubstituteInfo{IngredientSubstitute: *ingredientSubstitute}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientSubstituteUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientSubstituteId, errorIngredientSubstituteId := uuid.Parse(chi.URLParam(r, "ingredient_substitute_id"))
+
+		if errorIngredientSubstituteId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientSubstituteId)
+		} else {
+			ingredientSubstituteUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromIngredientSubstituteUpdate(r.Body)
+
+			if errorJsonDecode != nil {
+				payload = RestService.Error400HandleService(w, errorJsonDecode)
+			} else {
+				ingredientSubstitute, errorIngredientSubstitute := handler.IngredientSubstituteUpdate(&ingredientSubstituteId, &token.UserId, &ingredientId, &ingredientSubstituteUpdateDTO)
+
+				if errorIngredientSubstitute != nil {
+					payload = RestService.Error400HandleService(w, errorIngredientSubstitute)
+				} else {
+					payload = &response.IngredientSubstituteInfo{IngredientSubstitute: *ingredientSubstitute}
+				}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func IngredientSubstituteDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	ingredientId, errorIngredientId := uuid.Parse(chi.URLParam(r, "ingredient_id"))
+
+	if errorIngredientId != nil {
+		payload = RestService.Error400HandleService(w, errorIngredientId)
+	} else {
+		ingredientSubstituteId, errorIngredientSubstituteId := uuid.Parse(chi.URLParam(r, "ingredient_substitute_id"))
+
+		if errorIngredientSubstituteId != nil {
+			payload = RestService.Error400HandleService(w, errorIngredientSubstituteId)
+		} else {
+			ingredientSubstituteDeleteStatus, errorIngredientSubstituteDeleteStatus := handler.IngredientSubstituteDelete(&ingredientSubstituteId, &token.UserId, &ingredientId)
+
+			if errorIngredientSubstituteDeleteStatus != nil {
+				payload = RestService.Error400HandleService(w, errorIngredientSubstituteDeleteStatus)
+			} else if ingredientSubstituteDeleteStatus {
+				payload = &response.IngredientSubstituteDelete{Message: statusIngredientSubstituteDeleteSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusIngredientSubstituteDeleteError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeSubstitutesInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipeSubstitutes, errorRecipeSubstitutes := handler.RecipeSubstitutesInfo(&recipeId, &token.UserId, substituteContexts(r.URL.Query().Get("context")))
+
+		if errorRecipeSubstitutes != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeSubstitutes)
+		} else {
+			payload = &response.RecipeSubstitutesInfo{Ingredients: recipeSubstitutes}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeSubstitute(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		substituteQuery, errorJsonDecode := DomainService.CreateAggregateFromSubstituteQuery(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			recipeSubstitute, errorRecipeSubstitute := handler.RecipeSubstitute(&recipeId, &token.UserId, &substituteQuery)
+
+			if errorRecipeSubstitute != nil {
+				payload = RestService.Error400HandleService(w, errorRecipeSubstitute)
+			} else {
+				payload = &response.RecipeSubstitute{RecipeSubstitute: *recipeSubstitute}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+// substituteContexts parses the comma separated contexts of a query.
+func substituteContexts(value string) []kind.SubstituteContext {
+	var contexts []kind.SubstituteContext
+
+	for _, context := range strings.Split(value, ",") {
+		if context = strings.TrimSpace(context); context != "" {
+			contexts = append(contexts, kind.SubstituteContext(context))
+		}
+	}
+
+	return contexts
+}
+
+// substituteQuery returns the substitute query of the contexts and the pantry flag of a request, nil is returned
+// when neither of them is given.
+func substituteQuery(r *http.Request) *DomainAggregate.SubstituteQuery {
+	contexts := substituteContexts(r.URL.Query().Get("context"))
+	usePantry, _ := strconv.ParseBool(r.URL.Query().Get("pantry"))
+
+	if len(contexts) == 0 && !usePantry {
+		return nil
+	}
+
+	return &DomainAggregate.SubstituteQuery{Contexts: contexts, UsePantry: usePantry}
+}
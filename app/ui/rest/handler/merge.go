@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+func IngredientDuplicates(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	suggestions, errorSuggestions := handler.IngredientDuplicates(&token.UserId)
+
+	if errorSuggestions != nil {
+		payload = RestService.Error400HandleService(w, errorSuggestions)
+	} else {
+		if suggestions == nil {
+			suggestions = []*DomainAggregate.MergeSuggestion{}
+		}
+		payload = &response.MergeSuggestions{Suggestions: suggestions}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func CategoryDuplicates(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	suggestions, errorSuggestions := handler.CategoryDuplicates(&token.UserId)
+
+	if errorSuggestions != nil {
+		payload = RestService.Error400HandleService(w, errorSuggestions)
+	} else {
+		if suggestions == nil {
+			suggestions = []*DomainAggregate.MergeSuggestion{}
+		}
+		payload = &response.MergeSuggestions{Suggestions: suggestions}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusPantryItemDeleteSuccess = "the pantry item has been deleted successful"
+	statusPantryItemDeleteError   = errors.New("the pantry item has not been deleted")
+)
+
+func PantryItemsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	pantryItems, errorPantryItems := handler.PantryItemsInfo(&token.UserId, nil)
+
+	if errorPantryItems != nil {
+		payload = RestService.Error400HandleService(w, errorPantryItems)
+	} else {
+		if pantryItems == nil {
+			pantryItems = []*DomainAggregate.PantryItem{}
+		}
+		payload = &response.PantryItemsInfo{PantryItems: pantryItems}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PantryItemCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	pantryItemUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPantryItemUpdate(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		pantryItem, errorPantryItem := handler.PantryItemCreate(&token.UserId, &pantryItemUpdateDTO)
+
+		if errorPantryItem != nil {
+			payload = RestService.Error400HandleService(w, errorPantryItem)
+		} else {
+			payload = &response.PantryItemInfo{PantryItem: *pantryItem}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PantryItemInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(chi.URLParam(r, "pantry_item_id"))
+
+	if errorPantryItemId != nil {
+		payload = RestService.Error400HandleService(w, errorPantryItemId)
+	} else {
+		pantryItem, errorPantryItem := handler.PantryItemInfo(&pantryItemId, &token.UserId, nil)
+
+		if errorPantryItem != nil {
+			payload = RestService.Error400HandleService(w, errorPantryItem)
+		} else {
+			payload = &response.PantryItemInfo{PantryItem: *pantryItem}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PantryItemUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(chi.URLParam(r, "pantry_item_id"))
+
+	if errorPantryItemId != nil {
+		payload = RestService.Error400HandleService(w, errorPantryItemId)
+	} else {
+		pantryItemUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPantryItemUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			pantryItem, errorPantryItem := handler.PantryItemUpdate(&pantryItemId, &token.UserId, &pantryItemUpdateDTO)
+
+			if errorPantryItem != nil {
+				payload = RestService.Error400HandleService(w, errorPantryItem)
+			} else {
+				payload = &response.PantryItemInfo{PantryItem: *pantryItem}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PantryItemDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	pantryItemId, errorPantryItemId := uuid.Parse(chi.URLParam(r, "pantry_item_id"))
+
+	if errorPantryItemId != nil {
+		payload = RestService.Error400HandleService(w, errorPantryItemId)
+	} else {
+		pantryItemDeleteStatus, errorPantryItemDeleteStatus := handler.PantryItemDelete(&pantryItemId, &token.UserId)
+
+		if errorPantryItemDeleteStatus != nil {
+			payload = RestService.Error400HandleService(w, errorPantryItemDeleteStatus)
+		} else if pantryItemDeleteStatus {
+			payload = &response.PantryItemDelete{Message: statusPantryItemDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusPantryItemDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
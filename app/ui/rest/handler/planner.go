@@ -187,15 +187,127 @@ func PlannerCalculateInfo(w http.ResponseWriter, r *http.Request) {
 	if errorPlannerId != nil {
 		payload = RestService.Error400HandleService(w, errorPlannerId)
 	} else {
-		plannerCalculation, errorPlannerCalculation := handler.PlannerCalculate(&plannerId, &token.UserId)
+		if plannerSubstituteQuery := substituteQuery(r); plannerSubstituteQuery != nil {
+			plannerSubstitute, errorPlannerSubstitute := handler.PlannerSubstitute(&plannerId, &token.UserId, plannerSubstituteQuery)
 
-		if errorPlannerCalculation != nil {
-			payload = RestService.Error400HandleService(w, errorPlannerCalculation)
+			if errorPlannerSubstitute != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerSubstitute)
+			} else {
+				if plannerSubstitute.Calculations == nil {
+					plannerSubstitute.Calculations = []*DomainAggregate.PlannerCalculation{}
+				}
+				payload = &response.PlannerCalculation{Overall: plannerSubstitute.Calculations, Substitutions: plannerSubstitute.Substitutions}
+			}
 		} else {
-			if plannerCalculation == nil {
-				plannerCalculation = []*DomainAggregate.PlannerCalculation{}
+			plannerCalculation, errorPlannerCalculation := handler.PlannerCalculate(&plannerId, &token.UserId)
+
+			if errorPlannerCalculation != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerCalculation)
+			} else {
+				if plannerCalculation == nil {
+					plannerCalculation = []*DomainAggregate.PlannerCalculation{}
+				}
+				payload = &response.PlannerCalculation{Overall: plannerCalculation}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerShoppingListInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		plannerShoppingList, errorPlannerShoppingList := handler.PlannerShoppingList(&plannerId, &token.UserId)
+
+		if errorPlannerShoppingList != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerShoppingList)
+		} else {
+			if plannerShoppingList == nil {
+				plannerShoppingList = []*DomainAggregate.PlannerCalculation{}
+			}
+			payload = &response.PlannerShoppingList{Items: plannerShoppingList}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerCostInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		plannerCost, errorPlannerCost := handler.PlannerCost(&plannerId, &token.UserId)
+
+		if errorPlannerCost != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerCost)
+		} else {
+			payload = &response.PlannerCost{PlannerCost: *plannerCost}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerGenerate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		plannerGenerateConstraints, errorJsonDecode := DomainService.CreateAggregateFromPlannerGenerateConstraints(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			plannerGenerate, errorPlannerGenerate := handler.PlannerGenerate(&plannerId, &token.UserId, &plannerGenerateConstraints)
+
+			if errorPlannerGenerate != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerGenerate)
+			} else {
+				payload = &response.PlannerGenerate{PlannerGenerate: *plannerGenerate}
 			}
-			payload = &response.PlannerCalculation{Overall: plannerCalculation}
 		}
 	}
 
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+const contentTypeCalendar = "text/calendar; charset=utf-8"
+
+func PlannerCalendar(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		calendar, errorCalendar := handler.PlannerCalendar(&plannerId, &token.UserId, apiLink(r))
+
+		if errorCalendar != nil {
+			payload = RestService.Error400HandleService(w, errorCalendar)
+		} else {
+			writeCalendar(w, calendar)
+
+			return
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	calendar, errorCalendar := handler.PlannerCalendarFeed(chi.URLParam(r, "calendar_token"), apiLink(r))
+
+	if errorCalendar != nil {
+		payload = RestService.ErrorHandleService(http.StatusNotFound, w, errorCalendar)
+	} else {
+		writeCalendar(w, calendar)
+
+		return
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerCalendarImport(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		plannerCalendarImport, errorPlannerCalendarImport := handler.PlannerCalendarImport(&plannerId, &token.UserId, r.Body)
+
+		if errorPlannerCalendarImport != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerCalendarImport)
+		} else {
+			payload = &response.PlannerCalendarImport{PlannerCalendarImport: *plannerCalendarImport}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerCalendarTokenCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		planner, errorPlanner := handler.PlannerCalendarTokenCreate(&plannerId, &token.UserId)
+
+		if errorPlanner != nil {
+			payload = RestService.Error400HandleService(w, errorPlanner)
+		} else {
+			payload = &response.PlannerInfo{Planner: *planner}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerCalendarTokenDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerId, errorPlannerId := uuid.Parse(chi.URLParam(r, "planner_id"))
+
+	if errorPlannerId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerId)
+	} else {
+		planner, errorPlanner := handler.PlannerCalendarTokenDelete(&plannerId, &token.UserId)
+
+		if errorPlanner != nil {
+			payload = RestService.Error400HandleService(w, errorPlanner)
+		} else {
+			payload = &response.PlannerInfo{Planner: *planner}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func writeCalendar(w http.ResponseWriter, calendar string) {
+	w.Header().Set("Content-Type", contentTypeCalendar)
+	w.WriteHeader(http.StatusOK)
+
+	_, errorWrite := w.Write([]byte(calendar))
+
+	if errorWrite != nil {
+		log.Error(errorWrite)
+	}
+}
@@ -202,3 +202,39 @@ func PlannerRecipeDelete(w http.ResponseWriter, r *http.Request) {
 		log.Panic(errorRender)
 	}
 }
+
+func PlannerRecipeCook(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	intervalId, errorIntervalId := uuid.Parse(chi.URLParam(r, "interval_id"))
+
+	if errorIntervalId != nil {
+		payload = RestService.Error400HandleService(w, errorIntervalId)
+	} else {
+		plannerRecipeId, errorPlannerRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+		if errorPlannerRecipeId != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerRecipeId)
+		} else {
+			plannerRecipe, errorPlannerRecipe := handler.PlannerRecipeCook(&plannerRecipeId, &token.UserId, &intervalId)
+
+			if errorPlannerRecipe != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerRecipe)
+			} else {
+				payload = &response.PlannerRecipeInfo{PlannerRecipe: *plannerRecipe}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
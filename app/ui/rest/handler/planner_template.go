@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+)
+
+var (
+	statusPlannerTemplateDeleteSuccess = "the planner template has been deleted successful"
+	statusPlannerTemplateDeleteError   = errors.New("the planner template has not been deleted")
+)
+
+func PlannerTemplatesInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplates, errorPlannerTemplates := handler.PlannerTemplatesInfo(&token.UserId, nil)
+
+	if errorPlannerTemplates != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplates)
+	} else {
+		if plannerTemplates == nil {
+			plannerTemplates = []*DomainAggregate.PlannerTemplate{}
+		}
+		payload = &response.PlannerTemplatesInfo{PlannerTemplates: plannerTemplates}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPlannerTemplateUpdate(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		plannerTemplate, errorPlannerTemplate := handler.PlannerTemplateCreate(&token.UserId, &plannerTemplateUpdateDTO)
+
+		if errorPlannerTemplate != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplate)
+		} else {
+			payload = &response.PlannerTemplateInfo{PlannerTemplate: *plannerTemplate}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplate, errorPlannerTemplate := handler.PlannerTemplateInfo(&plannerTemplateId, &token.UserId, nil)
+
+		if errorPlannerTemplate != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplate)
+		} else {
+			payload = &response.PlannerTemplateInfo{PlannerTemplate: *plannerTemplate}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPlannerTemplateUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			plannerTemplate, errorPlannerTemplate := handler.PlannerTemplateUpdate(&plannerTemplateId, &token.UserId, &plannerTemplateUpdateDTO)
+
+			if errorPlannerTemplate != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerTemplate)
+			} else {
+				payload = &response.PlannerTemplateInfo{PlannerTemplate: *plannerTemplate}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateDeleteStatus, errorPlannerTemplateDeleteStatus := handler.PlannerTemplateDelete(&plannerTemplateId, &token.UserId)
+
+		if errorPlannerTemplateDeleteStatus != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplateDeleteStatus)
+		} else if plannerTemplateDeleteStatus {
+			payload = &response.PlannerTemplateDelete{Message: statusPlannerTemplateDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusPlannerTemplateDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateInstantiate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		startTime, errorStartTime := time.Parse(time.RFC3339, r.URL.Query().Get("start_time"))
+
+		if errorStartTime != nil {
+			payload = RestService.Error400HandleService(w, errorStartTime)
+		} else {
+			planner, errorPlanner := handler.PlannerTemplateInstantiate(&plannerTemplateId, &token.UserId, startTime)
+
+			if errorPlanner != nil {
+				payload = RestService.Error400HandleService(w, errorPlanner)
+			} else {
+				payload = &response.PlannerTemplateInstantiate{Planner: *planner}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusPlannerTemplateIntervalDeleteSuccess = "the planner template interval has been deleted successful"
+	statusPlannerTemplateIntervalDeleteError   = errors.New("the planner template interval has not been deleted")
+)
+
+func PlannerTemplateIntervalsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateIntervals, errorPlannerTemplateIntervals := handler.PlannerTemplateIntervalsInfo(&token.UserId, &plannerTemplateId, nil)
+
+		if errorPlannerTemplateIntervals != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplateIntervals)
+		} else {
+			if plannerTemplateIntervals == nil {
+				plannerTemplateIntervals = []*DomainEntity.PlannerTemplateInterval{}
+			}
+			payload = &response.PlannerTemplateIntervalsInfo{PlannerTemplateIntervals: plannerTemplateIntervals}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateIntervalCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateIntervalUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPlannerTemplateIntervalUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			plannerTemplateInterval, errorPlannerTemplateInterval := handler.PlannerTemplateIntervalCreate(&token.UserId, &plannerTemplateId, &plannerTemplateIntervalUpdateDTO)
+
+			if errorPlannerTemplateInterval != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerTemplateInterval)
+			} else {
+				payload = &response.PlannerTemplateIntervalInfo{PlannerTemplateInterval: *plannerTemplateInterval}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateIntervalInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateIntervalId, errorPlannerTemplateIntervalId := uuid.Parse(chi.URLParam(r, "planner_template_interval_id"))
+
+		if errorPlannerTemplateIntervalId != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplateIntervalId)
+		} else {
+			plannerTemplateInterval, errorPlannerTemplateInterval := handler.PlannerTemplateIntervalInfo(&plannerTemplateIntervalId, &token.UserId, &plannerTemplateId, nil)
+
+			if errorPlannerTemplateInterval != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerTemplateInterval)
+			} else {
+				payload = &response.PlannerTemplateIntervalInfo{PlannerTemplateInterval: *plannerTemplateInterval}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateIntervalUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateIntervalId, errorPlannerTemplateIntervalId := uuid.Parse(chi.URLParam(r, "planner_template_interval_id"))
+
+		if errorPlannerTemplateIntervalId != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplateIntervalId)
+		} else {
+			plannerTemplateIntervalUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromPlannerTemplateIntervalUpdate(r.Body)
+
+			if errorJsonDecode != nil {
+				payload = RestService.Error400HandleService(w, errorJsonDecode)
+			} else {
+				plannerTemplateInterval, errorPlannerTemplateInterval := handler.PlannerTemplateIntervalUpdate(&plannerTemplateIntervalId, &token.UserId, &plannerTemplateId, &plannerTemplateIntervalUpdateDTO)
+
+				if errorPlannerTemplateInterval != nil {
+					payload = RestService.Error400HandleService(w, errorPlannerTemplateInterval)
+				} else {
+					payload = &response.PlannerTemplateIntervalInfo{PlannerTemplateInterval: *plannerTemplateInterval}
+				}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PlannerTemplateIntervalDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	plannerTemplateId, errorPlannerTemplateId := uuid.Parse(chi.URLParam(r, "planner_template_id"))
+
+	if errorPlannerTemplateId != nil {
+		payload = RestService.Error400HandleService(w, errorPlannerTemplateId)
+	} else {
+		plannerTemplateIntervalId, errorPlannerTemplateIntervalId := uuid.Parse(chi.URLParam(r, "planner_template_interval_id"))
+
+		if errorPlannerTemplateIntervalId != nil {
+			payload = RestService.Error400HandleService(w, errorPlannerTemplateIntervalId)
+		} else {
+			plannerTemplateIntervalDeleteStatus, errorPlannerTemplateIntervalDeleteStatus := handler.PlannerTemplateIntervalDelete(&plannerTemplateIntervalId, &token.UserId, &plannerTemplateId)
+
+			if errorPlannerTemplateIntervalDeleteStatus != nil {
+				payload = RestService.Error400HandleService(w, errorPlannerTemplateIntervalDeleteStatus)
+			} else if plannerTemplateIntervalDeleteStatus {
+				payload = &response.PlannerTemplateIntervalDelete{Message: statusPlannerTemplateIntervalDeleteSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusPlannerTemplateIntervalDeleteError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
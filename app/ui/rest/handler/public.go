@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+func PublicRecipesInfo(w http.ResponseWriter, r *http.Request) {
+	var (
+		limit       int64
+		offset      int64
+		errorLimit  error
+		errorOffset error
+	)
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, errorLimit = strconv.ParseInt(value, 10, 64)
+	}
+
+	if value := r.URL.Query().Get("offset"); value != "" {
+		offset, errorOffset = strconv.ParseInt(value, 10, 64)
+	}
+
+	if errorLimit != nil {
+		payload = RestService.Error400HandleService(w, errorLimit)
+	} else if errorOffset != nil {
+		payload = RestService.Error400HandleService(w, errorOffset)
+	} else {
+		publicRecipes, errorPublicRecipes := handler.PublicRecipesInfo(
+			&DomainAggregate.PublicRecipeQuery{
+				Query:      r.URL.Query().Get("q"),
+				Category:   r.URL.Query().Get("category"),
+				Ingredient: r.URL.Query().Get("ingredient"),
+				Limit:      limit,
+				Offset:     offset,
+			},
+		)
+
+		if errorPublicRecipes != nil {
+			payload = RestService.Error400HandleService(w, errorPublicRecipes)
+		} else {
+			payload = &response.PublicRecipesInfo{PublicRecipes: *publicRecipes}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func PublicRecipeInfo(w http.ResponseWriter, r *http.Request) {
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipe, errorRecipe := handler.PublicRecipeInfo(&recipeId)
+
+		if errorRecipe != nil {
+			payload = RestService.Error400HandleService(w, errorRecipe)
+		} else {
+			payload = &response.RecipeInfo{Recipe: *recipe}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
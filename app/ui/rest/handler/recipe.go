@@ -81,9 +81,22 @@ func RecipeInfo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+	format, okFormat := recipeExportFormat(r.Header.Get("Accept"))
+
+	w.Header().Add("Vary", "Accept")
 
 	if errorRecipeId != nil {
 		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else if okFormat {
+		document, errorDocument := handler.RecipeExport(&recipeId, &token.UserId, format, apiLink(r))
+
+		if errorDocument != nil {
+			payload = RestService.Error400HandleService(w, errorDocument)
+		} else {
+			writeRecipeExport(w, format, document)
+
+			return
+		}
 	} else {
 		recipe, errorRecipe := handler.RecipeInfo(&recipeId, &token.UserId, nil)
 
@@ -168,3 +181,63 @@ func RecipeDelete(w http.ResponseWriter, r *http.Request) {
 		log.Panic(errorRender)
 	}
 }
+
+func RecipeCostInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipeCost, errorRecipeCost := handler.RecipeCost(&recipeId, &token.UserId, r.URL.Query().Get("currency"))
+
+		if errorRecipeCost != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeCost)
+		} else {
+			payload = &response.RecipeCost{RecipeCost: *recipeCost}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipesCookable(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	cookableQuery, errorJsonDecode := DomainService.CreateAggregateFromCookableQuery(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		cookables, errorCookables := handler.RecipesCookable(&token.UserId, &cookableQuery)
+
+		if errorCookables != nil {
+			payload = RestService.Error400HandleService(w, errorCookables)
+		} else {
+			payload = &response.RecipesCookable{Recipes: cookables}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
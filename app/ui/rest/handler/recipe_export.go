@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	log "github.com/sirupsen/logrus"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+var recipeExportContentTypes = map[string]kind.RecipeExportFormat{
+	"application/ld+json": kind.RecipeExportFormatJSONLD,
+	"text/markdown":       kind.RecipeExportFormatMarkdown,
+}
+
+// recipeExportFormat returns the export format of the first media type of the Accept header which is an export one,
+// JSON or a wildcard goes first means the recipe is rendered as usual.
+func recipeExportFormat(accept string) (kind.RecipeExportFormat, bool) {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, errorMediaType := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+
+		if errorMediaType != nil {
+			continue
+		}
+
+		if format, ok := recipeExportContentTypes[mediaType]; ok {
+			return format, true
+		}
+
+		if mediaType == "application/json" || mediaType == "*/*" {
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+func writeRecipeExport(w http.ResponseWriter, format kind.RecipeExportFormat, document string) {
+	for contentType, contentTypeFormat := range recipeExportContentTypes {
+		if contentTypeFormat == format {
+			w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	_, errorWrite := w.Write([]byte(document))
+
+	if errorWrite != nil {
+		log.Error(errorWrite)
+	}
+}
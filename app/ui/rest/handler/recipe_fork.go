@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+func RecipeFork(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipe, errorRecipe := handler.RecipeFork(&recipeId, &token.UserId, r.URL.Query().Get("name"))
+
+		if errorRecipe != nil {
+			payload = RestService.Error400HandleService(w, errorRecipe)
+		} else {
+			payload = &response.RecipeFork{Recipe: *recipe}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeForkUpstream(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipeDiff, errorRecipeDiff := handler.RecipeForkUpstreamDiff(&recipeId, &token.UserId)
+
+		if errorRecipeDiff != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeDiff)
+		} else {
+			payload = &response.RecipeVersionDiff{RecipeDiff: *recipeDiff}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
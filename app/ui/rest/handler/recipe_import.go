@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+func RecipeImport(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipe, errorRecipeImport := handler.RecipeImport(&token.UserId, r.Body)
+
+	if errorRecipeImport != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeImport)
+	} else {
+		payload = &response.RecipeInfo{Recipe: *recipe}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainEntity "github.com/sergeygardner/meal-planner-api/domain/entity"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+func RecipeVersionsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else {
+		recipeVersions, errorRecipeVersions := handler.RecipeVersionsInfo(&token.UserId, &recipeId)
+
+		if errorRecipeVersions != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeVersions)
+		} else {
+			if recipeVersions == nil {
+				recipeVersions = []*DomainEntity.RecipeVersion{}
+			}
+			payload = &response.RecipeVersionsInfo{RecipeVersions: recipeVersions}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeVersionInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+	recipeVersionId, errorRecipeVersionId := uuid.Parse(chi.URLParam(r, "recipe_version_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else if errorRecipeVersionId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeVersionId)
+	} else {
+		recipeVersion, errorRecipeVersion := handler.RecipeVersionInfo(&recipeVersionId, &token.UserId, &recipeId)
+
+		if errorRecipeVersion != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeVersion)
+		} else {
+			payload = &response.RecipeVersionInfo{RecipeVersion: *recipeVersion}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeVersionDiff(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+	fromId, errorFromId := uuid.Parse(r.URL.Query().Get("from"))
+	toId, errorToId := uuid.Parse(r.URL.Query().Get("to"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else if errorFromId != nil {
+		payload = RestService.Error400HandleService(w, errorFromId)
+	} else if errorToId != nil {
+		payload = RestService.Error400HandleService(w, errorToId)
+	} else {
+		recipeDiff, errorRecipeDiff := handler.RecipeVersionDiff(&fromId, &toId, &token.UserId, &recipeId)
+
+		if errorRecipeDiff != nil {
+			payload = RestService.Error400HandleService(w, errorRecipeDiff)
+		} else {
+			payload = &response.RecipeVersionDiff{RecipeDiff: *recipeDiff}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func RecipeVersionRevert(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	recipeId, errorRecipeId := uuid.Parse(chi.URLParam(r, "recipe_id"))
+	recipeVersionId, errorRecipeVersionId := uuid.Parse(chi.URLParam(r, "recipe_version_id"))
+
+	if errorRecipeId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeId)
+	} else if errorRecipeVersionId != nil {
+		payload = RestService.Error400HandleService(w, errorRecipeVersionId)
+	} else {
+		recipe, errorRecipe := handler.RecipeVersionRevert(&recipeVersionId, &token.UserId, &recipeId)
+
+		if errorRecipe != nil {
+			payload = RestService.Error400HandleService(w, errorRecipe)
+		} else {
+			payload = &response.RecipeInfo{Recipe: *recipe}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
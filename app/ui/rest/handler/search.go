@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+)
+
+func Search(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	var (
+		limit      int64
+		errorLimit error
+	)
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, errorLimit = strconv.ParseInt(value, 10, 64)
+	}
+
+	if errorLimit != nil {
+		payload = RestService.Error400HandleService(w, errorLimit)
+	} else {
+		searchResults, errorSearch := handler.Search(&token.UserId, r.URL.Query().Get("q"), limit)
+
+		if errorSearch != nil {
+			payload = RestService.Error400HandleService(w, errorSearch)
+		} else {
+			payload = &response.Search{Results: searchResults}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
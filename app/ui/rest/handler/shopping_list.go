@@ -0,0 +1,391 @@
+package handler
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	DomainAggregate "github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	DomainService "github.com/sergeygardner/meal-planner-api/domain/service"
+	"github.com/sergeygardner/meal-planner-api/ui/rest/response"
+	RestService "github.com/sergeygardner/meal-planner-api/ui/rest/service"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+)
+
+var (
+	statusShoppingListDeleteSuccess     = "the shopping list has been deleted successful"
+	statusShoppingListDeleteError       = errors.New("the shopping list has not been deleted")
+	statusShoppingListItemDeleteSuccess = "the shopping list item has been deleted successful"
+	statusShoppingListItemDeleteError   = errors.New("the shopping list item has not been deleted")
+)
+
+func ShoppingListsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingLists, errorShoppingLists := handler.ShoppingListsInfo(&token.UserId, nil)
+
+	if errorShoppingLists != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingLists)
+	} else {
+		if shoppingLists == nil {
+			shoppingLists = []*DomainAggregate.ShoppingList{}
+		}
+		payload = &response.ShoppingListsInfo{ShoppingLists: shoppingLists}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListGenerate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromShoppingListUpdate(r.Body)
+
+	if errorJsonDecode != nil {
+		payload = RestService.Error400HandleService(w, errorJsonDecode)
+	} else {
+		shoppingList, errorShoppingList := handler.ShoppingListGenerate(&token.UserId, &shoppingListUpdateDTO)
+
+		if errorShoppingList != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingList)
+		} else {
+			payload = &response.ShoppingListInfo{ShoppingList: *shoppingList}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingList, errorShoppingList := handler.ShoppingListInfo(&shoppingListId, &token.UserId, nil)
+
+		if errorShoppingList != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingList)
+		} else {
+			payload = &response.ShoppingListInfo{ShoppingList: *shoppingList}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListRegenerate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingList, errorShoppingList := handler.ShoppingListRegenerate(&shoppingListId, &token.UserId)
+
+		if errorShoppingList != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingList)
+		} else {
+			payload = &response.ShoppingListInfo{ShoppingList: *shoppingList}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromShoppingListUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			shoppingList, errorShoppingList := handler.ShoppingListUpdate(&shoppingListId, &token.UserId, &shoppingListUpdateDTO)
+
+			if errorShoppingList != nil {
+				payload = RestService.Error400HandleService(w, errorShoppingList)
+			} else {
+				payload = &response.ShoppingListInfo{ShoppingList: *shoppingList}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListDeleteStatus, errorShoppingListDeleteStatus := handler.ShoppingListDelete(&shoppingListId, &token.UserId)
+
+		if errorShoppingListDeleteStatus != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingListDeleteStatus)
+		} else if shoppingListDeleteStatus {
+			payload = &response.ShoppingListDelete{Message: statusShoppingListDeleteSuccess, Status: http.StatusOK}
+		} else {
+			payload = RestService.Error400HandleService(w, statusShoppingListDeleteError)
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListItemsInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListItems, errorShoppingListItems := handler.ShoppingListItemsInfo(&token.UserId, &shoppingListId, nil)
+
+		if errorShoppingListItems != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingListItems)
+		} else {
+			if shoppingListItems == nil {
+				shoppingListItems = []*DomainAggregate.ShoppingListItem{}
+			}
+			payload = &response.ShoppingListItemsInfo{ShoppingListItems: shoppingListItems}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListItemCreate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListItemUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromShoppingListItemUpdate(r.Body)
+
+		if errorJsonDecode != nil {
+			payload = RestService.Error400HandleService(w, errorJsonDecode)
+		} else {
+			shoppingListItem, errorShoppingListItem := handler.ShoppingListItemCreate(&token.UserId, &shoppingListId, &shoppingListItemUpdateDTO)
+
+			if errorShoppingListItem != nil {
+				payload = RestService.Error400HandleService(w, errorShoppingListItem)
+			} else {
+				payload = &response.ShoppingListItemInfo{ShoppingListItem: *shoppingListItem}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListItemInfo(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListItemId, errorShoppingListItemId := uuid.Parse(chi.URLParam(r, "shopping_list_item_id"))
+
+		if errorShoppingListItemId != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingListItemId)
+		} else {
+			shoppingListItem, errorShoppingListItem := handler.ShoppingListItemInfo(&shoppingListItemId, &token.UserId, &shoppingListId, nil)
+
+			if errorShoppingListItem != nil {
+				payload = RestService.Error400HandleService(w, errorShoppingListItem)
+			} else {
+				payload = &response.ShoppingListItemInfo{ShoppingListItem: *shoppingListItem}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListItemUpdate(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListItemId, errorShoppingListItemId := uuid.Parse(chi.URLParam(r, "shopping_list_item_id"))
+
+		if errorShoppingListItemId != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingListItemId)
+		} else {
+			shoppingListItemUpdateDTO, errorJsonDecode := DomainService.CreateEntityFromShoppingListItemUpdate(r.Body)
+
+			if errorJsonDecode != nil {
+				payload = RestService.Error400HandleService(w, errorJsonDecode)
+			} else {
+				shoppingListItem, errorShoppingListItem := handler.ShoppingListItemUpdate(&shoppingListItemId, &token.UserId, &shoppingListId, &shoppingListItemUpdateDTO)
+
+				if errorShoppingListItem != nil {
+					payload = RestService.Error400HandleService(w, errorShoppingListItem)
+				} else {
+					payload = &response.ShoppingListItemInfo{ShoppingListItem: *shoppingListItem}
+				}
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
+
+func ShoppingListItemDelete(w http.ResponseWriter, r *http.Request) {
+	token, errorExtractClaimsFromContext := RestService.ExtractClaimsFromContext(r.Context())
+
+	if errorExtractClaimsFromContext != nil {
+		http.Error(w, errorExtractClaimsFromContext.Error(), 401)
+
+		return
+	}
+
+	shoppingListId, errorShoppingListId := uuid.Parse(chi.URLParam(r, "shopping_list_id"))
+
+	if errorShoppingListId != nil {
+		payload = RestService.Error400HandleService(w, errorShoppingListId)
+	} else {
+		shoppingListItemId, errorShoppingListItemId := uuid.Parse(chi.URLParam(r, "shopping_list_item_id"))
+
+		if errorShoppingListItemId != nil {
+			payload = RestService.Error400HandleService(w, errorShoppingListItemId)
+		} else {
+			shoppingListItemDeleteStatus, errorShoppingListItemDeleteStatus := handler.ShoppingListItemDelete(&shoppingListItemId, &token.UserId, &shoppingListId)
+
+			if errorShoppingListItemDeleteStatus != nil {
+				payload = RestService.Error400HandleService(w, errorShoppingListItemDeleteStatus)
+			} else if shoppingListItemDeleteStatus {
+				payload = &response.ShoppingListItemDelete{Message: statusShoppingListItemDeleteSuccess, Status: http.StatusOK}
+			} else {
+				payload = RestService.Error400HandleService(w, statusShoppingListItemDeleteError)
+			}
+		}
+	}
+
+	errorRender := RestService.Render(w, r, payload)
+
+	if errorRender != nil {
+		log.Panic(errorRender)
+	}
+}
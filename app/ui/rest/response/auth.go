@@ -1,6 +1,7 @@
 package response
 
 import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	DomainResponse "github.com/sergeygardner/meal-planner-api/domain/response"
 	"net/http"
 )
@@ -30,3 +31,84 @@ func (ac *AuthConfirmation) Render(_ http.ResponseWriter, _ *http.Request) error
 func (ac *AuthConfirmation) GetStatus() int {
 	return ac.Status
 }
+
+type UserSessionsInfo struct {
+	Sessions []*entity.UserSession `json:"sessions"`
+	Response `json:",omitempty"`
+}
+
+func (usi *UserSessionsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (usi *UserSessionsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type UserSessionRevoke struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (usr *UserSessionRevoke) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (usr *UserSessionRevoke) GetStatus() int {
+	return usr.Status
+}
+
+type AuthPassword struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (ap *AuthPassword) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ap *AuthPassword) GetStatus() int {
+	return ap.Status
+}
+
+type AuthTotpEnrol struct {
+	DomainResponse.AuthTotpEnrol
+	Response `json:",omitempty"`
+}
+
+func (ate *AuthTotpEnrol) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ate *AuthTotpEnrol) GetStatus() int {
+	return http.StatusOK
+}
+
+type AuthTotpRecoveryCodes struct {
+	DomainResponse.AuthTotpRecoveryCodes
+	Response `json:",omitempty"`
+}
+
+func (atrc *AuthTotpRecoveryCodes) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (atrc *AuthTotpRecoveryCodes) GetStatus() int {
+	return http.StatusOK
+}
+
+type AuthTotpDisable struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (atd *AuthTotpDisable) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (atd *AuthTotpDisable) GetStatus() int {
+	return atd.Status
+}
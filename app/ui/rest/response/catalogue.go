@@ -0,0 +1,19 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type CatalogueImport struct {
+	aggregate.CatalogueImport
+	Response `json:",omitempty"`
+}
+
+func (ci *CatalogueImport) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ci *CatalogueImport) GetStatus() int {
+	return http.StatusOK
+}
@@ -7,6 +7,7 @@ import (
 
 type CategoryInfo struct {
 	aggregate.Category
+	Warnings []*aggregate.FuzzyMatch `json:"warnings,omitempty"`
 	Response `json:",omitempty"`
 }
 
@@ -0,0 +1,114 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"net/http"
+)
+
+type HouseholdInfo struct {
+	aggregate.Household
+	Response `json:",omitempty"`
+}
+
+func (hi *HouseholdInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hi *HouseholdInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type HouseholdsInfo struct {
+	Households []*aggregate.Household `json:"households"`
+	Response   `json:",omitempty"`
+}
+
+func (hi *HouseholdsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hi *HouseholdsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type HouseholdDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (hd *HouseholdDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hd *HouseholdDelete) GetStatus() int {
+	return hd.Status
+}
+
+type HouseholdMemberInfo struct {
+	entity.HouseholdMember
+	Response `json:",omitempty"`
+}
+
+func (hmi *HouseholdMemberInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hmi *HouseholdMemberInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type HouseholdMemberRevoke struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (hmr *HouseholdMemberRevoke) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hmr *HouseholdMemberRevoke) GetStatus() int {
+	return hmr.Status
+}
+
+type HouseholdInvitationsInfo struct {
+	Invitations []*aggregate.HouseholdInvitation `json:"invitations"`
+	Response    `json:",omitempty"`
+}
+
+func (hii *HouseholdInvitationsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hii *HouseholdInvitationsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type HouseholdGrantInfo struct {
+	entity.HouseholdGrant
+	Response `json:",omitempty"`
+}
+
+func (hgi *HouseholdGrantInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hgi *HouseholdGrantInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type HouseholdGrantDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (hgd *HouseholdGrantDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (hgd *HouseholdGrantDelete) GetStatus() int {
+	return hgd.Status
+}
@@ -1,12 +1,14 @@
 package response
 
 import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	"net/http"
 )
 
 type IngredientInfo struct {
 	entity.Ingredient
+	Warnings []*aggregate.FuzzyMatch `json:"warnings,omitempty"`
 	Response `json:",omitempty"`
 }
 
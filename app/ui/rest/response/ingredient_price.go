@@ -0,0 +1,46 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type IngredientPriceInfo struct {
+	aggregate.IngredientPrice
+	Response `json:",omitempty"`
+}
+
+func (ipi *IngredientPriceInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ipi *IngredientPriceInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type IngredientPricesInfo struct {
+	IngredientPrices []*aggregate.IngredientPrice `json:"ingredient_prices"`
+	Response         `json:",omitempty"`
+}
+
+func (ipi *IngredientPricesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ipi *IngredientPricesInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type IngredientPriceDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (ipd *IngredientPriceDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ipd *IngredientPriceDelete) GetStatus() int {
+	return ipd.Status
+}
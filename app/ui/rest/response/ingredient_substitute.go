@@ -0,0 +1,72 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type IngredientSubstituteInfo struct {
+	aggregate.IngredientSubstitute
+	Response `json:",omitempty"`
+}
+
+func (isi *IngredientSubstituteInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (isi *IngredientSubstituteInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type IngredientSubstitutesInfo struct {
+	IngredientSubstitutes []*aggregate.IngredientSubstitute `json:"ingredient_substitutes"`
+	Response              `json:",omitempty"`
+}
+
+func (isi *IngredientSubstitutesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (isi *IngredientSubstitutesInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type IngredientSubstituteDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (isd *IngredientSubstituteDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (isd *IngredientSubstituteDelete) GetStatus() int {
+	return isd.Status
+}
+
+type RecipeSubstitutesInfo struct {
+	Ingredients []*aggregate.RecipeIngredientSubstitutes `json:"ingredients"`
+	Response    `json:",omitempty"`
+}
+
+func (rsi *RecipeSubstitutesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rsi *RecipeSubstitutesInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RecipeSubstitute struct {
+	aggregate.RecipeSubstitute
+	Response `json:",omitempty"`
+}
+
+func (rs *RecipeSubstitute) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rs *RecipeSubstitute) GetStatus() int {
+	return http.StatusCreated
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type MergeSuggestions struct {
+	Suggestions []*aggregate.MergeSuggestion `json:"suggestions"`
+	Response    `json:",omitempty"`
+}
+
+func (ms *MergeSuggestions) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ms *MergeSuggestions) GetStatus() int {
+	return http.StatusOK
+}
+
+type Merge struct {
+	aggregate.Merge
+	Response `json:",omitempty"`
+}
+
+func (m *Merge) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (m *Merge) GetStatus() int {
+	return http.StatusOK
+}
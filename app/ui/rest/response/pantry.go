@@ -0,0 +1,46 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type PantryItemInfo struct {
+	aggregate.PantryItem
+	Response `json:",omitempty"`
+}
+
+func (pii *PantryItemInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pii *PantryItemInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PantryItemsInfo struct {
+	PantryItems []*aggregate.PantryItem `json:"pantry_items"`
+	Response    `json:",omitempty"`
+}
+
+func (pii *PantryItemsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pii *PantryItemsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PantryItemDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (pid *PantryItemDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pid *PantryItemDelete) GetStatus() int {
+	return pid.Status
+}
@@ -46,8 +46,9 @@ func (ud *PlannerDelete) GetStatus() int {
 }
 
 type PlannerCalculation struct {
-	Overall  []*aggregate.PlannerCalculation `json:"overall"`
-	Response `json:",omitempty"`
+	Overall       []*aggregate.PlannerCalculation `json:"overall"`
+	Substitutions []*aggregate.Substitution       `json:"substitutions,omitempty"`
+	Response      `json:",omitempty"`
 }
 
 func (pc *PlannerCalculation) Render(_ http.ResponseWriter, _ *http.Request) error {
@@ -57,3 +58,55 @@ func (pc *PlannerCalculation) Render(_ http.ResponseWriter, _ *http.Request) err
 func (pc *PlannerCalculation) GetStatus() int {
 	return http.StatusOK
 }
+
+type PlannerShoppingList struct {
+	Items    []*aggregate.PlannerCalculation `json:"items"`
+	Response `json:",omitempty"`
+}
+
+func (psl *PlannerShoppingList) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (psl *PlannerShoppingList) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerCost struct {
+	aggregate.PlannerCost
+	Response `json:",omitempty"`
+}
+
+func (pc *PlannerCost) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pc *PlannerCost) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerGenerate struct {
+	aggregate.PlannerGenerate
+	Response `json:",omitempty"`
+}
+
+func (pg *PlannerGenerate) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pg *PlannerGenerate) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerCalendarImport struct {
+	aggregate.PlannerCalendarImport
+	Response `json:",omitempty"`
+}
+
+func (pci *PlannerCalendarImport) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pci *PlannerCalendarImport) GetStatus() int {
+	return http.StatusOK
+}
@@ -0,0 +1,59 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type PlannerTemplateInfo struct {
+	aggregate.PlannerTemplate
+	Response `json:",omitempty"`
+}
+
+func (ri *PlannerTemplateInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *PlannerTemplateInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerTemplatesInfo struct {
+	PlannerTemplates []*aggregate.PlannerTemplate `json:"planner_templates"`
+	Response         `json:",omitempty"`
+}
+
+func (ri *PlannerTemplatesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *PlannerTemplatesInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerTemplateDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (ud *PlannerTemplateDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ud *PlannerTemplateDelete) GetStatus() int {
+	return ud.Status
+}
+
+type PlannerTemplateInstantiate struct {
+	aggregate.Planner
+	Response `json:",omitempty"`
+}
+
+func (pi *PlannerTemplateInstantiate) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pi *PlannerTemplateInstantiate) GetStatus() int {
+	return http.StatusCreated
+}
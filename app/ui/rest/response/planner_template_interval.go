@@ -0,0 +1,46 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"net/http"
+)
+
+type PlannerTemplateIntervalInfo struct {
+	entity.PlannerTemplateInterval
+	Response `json:",omitempty"`
+}
+
+func (ri *PlannerTemplateIntervalInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *PlannerTemplateIntervalInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerTemplateIntervalsInfo struct {
+	PlannerTemplateIntervals []*entity.PlannerTemplateInterval `json:"planner_template_intervals"`
+	Response                 `json:",omitempty"`
+}
+
+func (ri *PlannerTemplateIntervalsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *PlannerTemplateIntervalsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type PlannerTemplateIntervalDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (ud *PlannerTemplateIntervalDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ud *PlannerTemplateIntervalDelete) GetStatus() int {
+	return ud.Status
+}
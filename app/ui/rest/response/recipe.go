@@ -44,3 +44,55 @@ func (ud *RecipeDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
 func (ud *RecipeDelete) GetStatus() int {
 	return ud.Status
 }
+
+type RecipeCost struct {
+	aggregate.RecipeCost
+	Response `json:",omitempty"`
+}
+
+func (rc *RecipeCost) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rc *RecipeCost) GetStatus() int {
+	return http.StatusOK
+}
+
+type RecipesCookable struct {
+	Recipes  []*aggregate.Cookable `json:"recipes"`
+	Response `json:",omitempty"`
+}
+
+func (rc *RecipesCookable) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rc *RecipesCookable) GetStatus() int {
+	return http.StatusOK
+}
+
+type RecipeFork struct {
+	aggregate.Recipe
+	Response `json:",omitempty"`
+}
+
+func (rf *RecipeFork) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rf *RecipeFork) GetStatus() int {
+	return http.StatusCreated
+}
+
+type PublicRecipesInfo struct {
+	aggregate.PublicRecipes
+	Response `json:",omitempty"`
+}
+
+func (pri *PublicRecipesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (pri *PublicRecipesInfo) GetStatus() int {
+	return http.StatusOK
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"net/http"
+)
+
+type RecipeVersionInfo struct {
+	aggregate.RecipeVersion
+	Response `json:",omitempty"`
+}
+
+func (rvi *RecipeVersionInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rvi *RecipeVersionInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RecipeVersionsInfo struct {
+	RecipeVersions []*entity.RecipeVersion `json:"recipe_versions"`
+	Response       `json:",omitempty"`
+}
+
+func (rvi *RecipeVersionsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rvi *RecipeVersionsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RecipeVersionDiff struct {
+	aggregate.RecipeDiff
+	Response `json:",omitempty"`
+}
+
+func (rvd *RecipeVersionDiff) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rvd *RecipeVersionDiff) GetStatus() int {
+	return http.StatusOK
+}
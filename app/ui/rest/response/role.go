@@ -0,0 +1,86 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/entity"
+	"net/http"
+)
+
+type RoleInfo struct {
+	entity.UserRole
+	Response `json:",omitempty"`
+}
+
+func (ri *RoleInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *RoleInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RolesInfo struct {
+	Roles    []*entity.UserRole `json:"roles"`
+	Response `json:",omitempty"`
+}
+
+func (ri *RolesInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ri *RolesInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RoleDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (rd *RoleDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rd *RoleDelete) GetStatus() int {
+	return rd.Status
+}
+
+type RoleUserInfo struct {
+	entity.UserToRole
+	Response `json:",omitempty"`
+}
+
+func (rui *RoleUserInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rui *RoleUserInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RoleUsersInfo struct {
+	Users    []*entity.UserToRole `json:"users"`
+	Response `json:",omitempty"`
+}
+
+func (rui *RoleUsersInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rui *RoleUsersInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type RoleUserDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (rud *RoleUserDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (rud *RoleUserDelete) GetStatus() int {
+	return rud.Status
+}
@@ -0,0 +1,19 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type Search struct {
+	Results  []*aggregate.SearchResult `json:"results"`
+	Response `json:",omitempty"`
+}
+
+func (s *Search) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (s *Search) GetStatus() int {
+	return http.StatusOK
+}
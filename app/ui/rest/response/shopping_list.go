@@ -0,0 +1,86 @@
+package response
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
+	"net/http"
+)
+
+type ShoppingListInfo struct {
+	aggregate.ShoppingList
+	Response `json:",omitempty"`
+}
+
+func (sli *ShoppingListInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (sli *ShoppingListInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type ShoppingListsInfo struct {
+	ShoppingLists []*aggregate.ShoppingList `json:"shopping_lists"`
+	Response      `json:",omitempty"`
+}
+
+func (sli *ShoppingListsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (sli *ShoppingListsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type ShoppingListDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (sld *ShoppingListDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (sld *ShoppingListDelete) GetStatus() int {
+	return sld.Status
+}
+
+type ShoppingListItemInfo struct {
+	aggregate.ShoppingListItem
+	Response `json:",omitempty"`
+}
+
+func (slii *ShoppingListItemInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (slii *ShoppingListItemInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type ShoppingListItemsInfo struct {
+	ShoppingListItems []*aggregate.ShoppingListItem `json:"shopping_list_items"`
+	Response          `json:",omitempty"`
+}
+
+func (slii *ShoppingListItemsInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (slii *ShoppingListItemsInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type ShoppingListItemDelete struct {
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	Response `json:",omitempty"`
+}
+
+func (slid *ShoppingListItemDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (slid *ShoppingListItemDelete) GetStatus() int {
+	return slid.Status
+}
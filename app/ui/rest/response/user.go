@@ -1,6 +1,7 @@
 package response
 
 import (
+	"github.com/sergeygardner/meal-planner-api/domain/aggregate"
 	"github.com/sergeygardner/meal-planner-api/domain/entity"
 	"net/http"
 )
@@ -31,3 +32,29 @@ func (ud *UserDelete) Render(_ http.ResponseWriter, _ *http.Request) error {
 func (ud *UserDelete) GetStatus() int {
 	return ud.Status
 }
+
+type UsersInfo struct {
+	aggregate.Users
+	Response `json:",omitempty"`
+}
+
+func (ui *UsersInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (ui *UsersInfo) GetStatus() int {
+	return http.StatusOK
+}
+
+type UserAdminInfo struct {
+	aggregate.User
+	Response `json:",omitempty"`
+}
+
+func (uai *UserAdminInfo) Render(_ http.ResponseWriter, _ *http.Request) error {
+	return nil
+}
+
+func (uai *UserAdminInfo) GetStatus() int {
+	return http.StatusOK
+}
@@ -4,8 +4,21 @@ import (
 	"context"
 	"github.com/sergeygardner/meal-planner-api/domain/model"
 	UiService "github.com/sergeygardner/meal-planner-api/ui/service"
+	"net/http"
 )
 
 func ExtractClaimsFromContext(ctx context.Context) (*model.Token, error) {
 	return UiService.ExtractClaimsFromContext(ctx)
 }
+
+func ExtractRefreshClaimsFromContext(ctx context.Context) (*model.Token, error) {
+	return UiService.ExtractRefreshClaimsFromContext(ctx)
+}
+
+func ExtractIpFromRequest(r *http.Request) string {
+	return UiService.ExtractIpFromAddress(r.RemoteAddr)
+}
+
+func ExtractIpFromContext(ctx context.Context) string {
+	return UiService.ExtractIpFromContext(ctx)
+}
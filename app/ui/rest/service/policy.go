@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/application/handler"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"net/http"
+)
+
+// EnsurePolicy lets the request through when the roles of the user allow the resource, safe methods require the read
+// right and the other ones require the write right.
+func EnsurePolicy(resource kind.UserResource) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, errorExtractClaimsFromContext := ExtractClaimsFromContext(r.Context())
+
+			if errorExtractClaimsFromContext != nil {
+				http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+				return
+			}
+
+			right := kind.UserRightWrite
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				right = kind.UserRightRead
+			}
+
+			errorPolicy := handler.PolicyEnforce(&token.UserId, resource, right)
+
+			if errorPolicy != nil {
+				http.Error(w, errorPolicy.Error(), http.StatusForbidden)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
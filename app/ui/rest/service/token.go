@@ -0,0 +1,30 @@
+package service
+
+import (
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"net/http"
+)
+
+// EnsureToken lets the request through when the token of the request is of the type, so a refresh token can't be used
+// instead of an access token and the other way round.
+func EnsureToken(tokenType kind.TokenType) func(next http.Handler) http.Handler {
+	extractClaimsFromContext := ExtractClaimsFromContext
+
+	if tokenType == kind.TokenTypeRefresh {
+		extractClaimsFromContext = ExtractRefreshClaimsFromContext
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, errorExtractClaimsFromContext := extractClaimsFromContext(r.Context())
+
+			if errorExtractClaimsFromContext != nil {
+				http.Error(w, errorExtractClaimsFromContext.Error(), http.StatusUnauthorized)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
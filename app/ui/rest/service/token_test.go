@@ -0,0 +1,79 @@
+package service
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/sergeygardner/meal-planner-api/domain/kind"
+	"github.com/sergeygardner/meal-planner-api/domain/model"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEnsureToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenType kind.TokenType
+		route     kind.TokenType
+		message   string
+	}{
+		{
+			name:      "Test case with a refresh token sent to a protected route",
+			tokenType: kind.TokenTypeRefresh,
+			route:     kind.TokenTypeAccess,
+			message:   "token is not an access token",
+		},
+		{
+			name:      "Test case with an access token sent to the refreshing route",
+			tokenType: kind.TokenTypeAccess,
+			route:     kind.TokenTypeRefresh,
+			message:   "token is not a refresh token",
+		},
+	}
+
+	key := []byte("key")
+
+	for _, testCase := range tests {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				signedString, errorSignedString := jwt.NewWithClaims(
+					jwt.SigningMethodHS256,
+					model.Token{
+						UserId:    uuid.New(),
+						Username:  "username",
+						UserRoles: kind.UserRoles{kind.UserRoleCommon},
+						SessionId: uuid.New(),
+						Type:      testCase.tokenType,
+						RegisteredClaims: jwt.RegisteredClaims{
+							ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(time.Hour)),
+							IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+							ID:        uuid.NewString(),
+						},
+					},
+				).SignedString(key)
+
+				assert.Nil(t, errorSignedString)
+
+				router := chi.NewRouter()
+				router.Use(jwtauth.Verifier(jwtauth.New("HS256", key, nil)))
+				router.Use(jwtauth.Authenticator)
+				router.Use(EnsureToken(testCase.route))
+				router.Get("/", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+				request := httptest.NewRequest(http.MethodGet, "/", nil)
+				request.Header.Set("Authorization", "Bearer "+signedString)
+				recorder := httptest.NewRecorder()
+
+				router.ServeHTTP(recorder, request)
+
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), testCase.message)
+			},
+		)
+	}
+}
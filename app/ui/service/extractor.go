@@ -6,13 +6,25 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/sergeygardner/meal-planner-api/application/handler"
 	"github.com/sergeygardner/meal-planner-api/domain/kind"
 	"github.com/sergeygardner/meal-planner-api/domain/model"
 )
 
 var errorParentId = errors.New("the picture is not found by criteria")
 
+// ExtractClaimsFromContext returns the claims of the access token of the context, any other token is rejected.
 func ExtractClaimsFromContext(ctx context.Context) (*model.Token, error) {
+	return extractClaimsFromContext(ctx, kind.TokenTypeAccess)
+}
+
+// ExtractRefreshClaimsFromContext returns the claims of the refresh token of the context, it is used by the refreshing
+// of the tokens only.
+func ExtractRefreshClaimsFromContext(ctx context.Context) (*model.Token, error) {
+	return extractClaimsFromContext(ctx, kind.TokenTypeRefresh)
+}
+
+func extractClaimsFromContext(ctx context.Context, tokenType kind.TokenType) (*model.Token, error) {
 	token, claims, errorFromContext := jwtauth.FromContext(ctx)
 
 	if errorFromContext != nil {
@@ -46,17 +58,41 @@ func ExtractClaimsFromContext(ctx context.Context) (*model.Token, error) {
 		return nil, errors.New("error get claims from context")
 	}
 
-	return &model.Token{
+	sessionIdParsed := uuid.Nil
+
+	if sessionId, okSessionId := claims["session_id"].(string); okSessionId {
+		sessionIdParsed, errorUuidParsed = uuid.Parse(sessionId)
+
+		if errorUuidParsed != nil {
+			return nil, errors.New("error get claims from context")
+		}
+	}
+
+	claimsTokenType, _ := claims["token_type"].(string)
+
+	claimsToken := &model.Token{
 		UserId:    uuidParsed,
 		Username:  usernameParsed,
 		UserRoles: userRolesParsed,
+		SessionId: sessionIdParsed,
+		Type:      kind.TokenType(claimsTokenType),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(token.Expiration()),
-		}}, nil
+			IssuedAt:  jwt.NewNumericDate(token.IssuedAt()),
+			ID:        token.JwtID(),
+		}}
+
+	errorAuthTokenVerify := handler.AuthTokenVerify(claimsToken, tokenType)
+
+	if errorAuthTokenVerify != nil {
+		return nil, errorAuthTokenVerify
+	}
+
+	return claimsToken, nil
 }
 
 func GetParentId(keys []string, values []string, exclude string) (*uuid.UUID, error) {
-	keyIDs := []string{"picture_id", "category_id", "ingredient_id", "process_id", "recipe_id"}
+	keyIDs := []string{"picture_id", "category_id", "ingredient_id", "process_id", "recipe_id", "shopping_list_id", "planner_template_id", "household_id"}
 
 	for _, keyId := range keyIDs {
 		for key, value := range keys {
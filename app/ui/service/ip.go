@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+type ipContextKey struct{}
+
+// ExtractIpFromAddress returns the host of the remote address of the client, the forwarded headers are not trusted, so
+// the address can't be spoofed to avoid the limit of the failed attempts.
+func ExtractIpFromAddress(address string) string {
+	host, _, errorSplitHostPort := net.SplitHostPort(address)
+
+	if errorSplitHostPort != nil {
+		return address
+	}
+
+	return host
+}
+
+// ExtractIpFromContext returns the ip address kept by IpContext.
+func ExtractIpFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ipContextKey{}).(string)
+
+	return ip
+}
+
+// IpContext keeps the ip address of the client in the context of the request for the handlers which get the context
+// only.
+func IpContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ipContextKey{}, ExtractIpFromAddress(r.RemoteAddr))))
+		},
+	)
+}